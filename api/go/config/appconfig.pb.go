@@ -76,6 +76,123 @@ func (MetaDataType) EnumDescriptor() ([]byte, []int) {
 	return file_config_appconfig_proto_rawDescGZIP(), []int{0}
 }
 
+// AppDependencyCondition is the state of the application instance
+// which is required by the dependent application instance to start.
+type AppDependencyCondition int32
+
+const (
+	// Same as APP_DEPENDENCY_CONDITION_RUNNING.
+	AppDependencyCondition_APP_DEPENDENCY_CONDITION_UNSPECIFIED AppDependencyCondition = 0
+	// The application instance is booted and running.
+	AppDependencyCondition_APP_DEPENDENCY_CONDITION_RUNNING AppDependencyCondition = 1
+	// The application instance is running and its readiness probe succeeds
+	// (see AppHealthConfig). Without a readiness probe it is the same as
+	// APP_DEPENDENCY_CONDITION_RUNNING.
+	AppDependencyCondition_APP_DEPENDENCY_CONDITION_READY AppDependencyCondition = 2
+)
+
+// Enum value maps for AppDependencyCondition.
+var (
+	AppDependencyCondition_name = map[int32]string{
+		0: "APP_DEPENDENCY_CONDITION_UNSPECIFIED",
+		1: "APP_DEPENDENCY_CONDITION_RUNNING",
+		2: "APP_DEPENDENCY_CONDITION_READY",
+	}
+	AppDependencyCondition_value = map[string]int32{
+		"APP_DEPENDENCY_CONDITION_UNSPECIFIED": 0,
+		"APP_DEPENDENCY_CONDITION_RUNNING":     1,
+		"APP_DEPENDENCY_CONDITION_READY":       2,
+	}
+)
+
+func (x AppDependencyCondition) Enum() *AppDependencyCondition {
+	p := new(AppDependencyCondition)
+	*p = x
+	return p
+}
+
+func (x AppDependencyCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppDependencyCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[1].Descriptor()
+}
+
+func (AppDependencyCondition) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[1]
+}
+
+func (x AppDependencyCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppDependencyCondition.Descriptor instead.
+func (AppDependencyCondition) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{1}
+}
+
+// AppRestartPolicy determines if EVE restarts an application instance
+// which stopped running or whose liveness probe is failing.
+type AppRestartPolicy int32
+
+const (
+	// Legacy behavior: halted application instance is booted again
+	// after a fixed delay, crashed container requires a restart command.
+	AppRestartPolicy_APP_RESTART_POLICY_UNSPECIFIED AppRestartPolicy = 0
+	// Restart whenever the application instance stops running,
+	// even if it exited successfully.
+	AppRestartPolicy_APP_RESTART_POLICY_ALWAYS AppRestartPolicy = 1
+	// Restart only if the application instance crashed, exited with
+	// a non-zero status or failed its liveness probe.
+	AppRestartPolicy_APP_RESTART_POLICY_ON_FAILURE AppRestartPolicy = 2
+	// Never restart automatically. Failures are only reported.
+	AppRestartPolicy_APP_RESTART_POLICY_NEVER AppRestartPolicy = 3
+)
+
+// Enum value maps for AppRestartPolicy.
+var (
+	AppRestartPolicy_name = map[int32]string{
+		0: "APP_RESTART_POLICY_UNSPECIFIED",
+		1: "APP_RESTART_POLICY_ALWAYS",
+		2: "APP_RESTART_POLICY_ON_FAILURE",
+		3: "APP_RESTART_POLICY_NEVER",
+	}
+	AppRestartPolicy_value = map[string]int32{
+		"APP_RESTART_POLICY_UNSPECIFIED": 0,
+		"APP_RESTART_POLICY_ALWAYS":      1,
+		"APP_RESTART_POLICY_ON_FAILURE":  2,
+		"APP_RESTART_POLICY_NEVER":       3,
+	}
+)
+
+func (x AppRestartPolicy) Enum() *AppRestartPolicy {
+	p := new(AppRestartPolicy)
+	*p = x
+	return p
+}
+
+func (x AppRestartPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppRestartPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_appconfig_proto_enumTypes[2].Descriptor()
+}
+
+func (AppRestartPolicy) Type() protoreflect.EnumType {
+	return &file_config_appconfig_proto_enumTypes[2]
+}
+
+func (x AppRestartPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppRestartPolicy.Descriptor instead.
+func (AppRestartPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+}
+
 type InstanceOpsCmd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -212,6 +329,17 @@ type AppInstanceConfig struct {
 	// be started independent of the global or local profile specified for the
 	// device.
 	ProfileList []string `protobuf:"bytes,18,rep,name=profile_list,json=profileList,proto3" json:"profile_list,omitempty"`
+	// Health checking of the application instance and the policy
+	// for restarting it automatically.
+	// If not set, EVE only retries to boot an application instance which
+	// halted unexpectedly (after a fixed delay).
+	Health *AppHealthConfig `protobuf:"bytes,19,opt,name=health,proto3" json:"health,omitempty"`
+	// Application instances which must be running (or ready) before this
+	// application instance is started. Dependencies are stopped only after
+	// this application instance when both are deactivated.
+	// Cyclic dependencies and dependencies on unknown application instances
+	// are reported as errors.
+	Dependencies []*AppDependency `protobuf:"bytes,20,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 }
 
 func (x *AppInstanceConfig) Reset() {
@@ -358,6 +486,481 @@ func (x *AppInstanceConfig) GetProfileList() []string {
 	return nil
 }
 
+func (x *AppInstanceConfig) GetHealth() *AppHealthConfig {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *AppInstanceConfig) GetDependencies() []*AppDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// AppDependency refers to another application instance of the same device.
+type AppDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the application instance (AppInstanceConfig.uuidandversion.uuid)
+	AppUuid   string                 `protobuf:"bytes,1,opt,name=app_uuid,json=appUuid,proto3" json:"app_uuid,omitempty"`
+	Condition AppDependencyCondition `protobuf:"varint,2,opt,name=condition,proto3,enum=org.lfedge.eve.config.AppDependencyCondition" json:"condition,omitempty"`
+}
+
+func (x *AppDependency) Reset() {
+	*x = AppDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppDependency) ProtoMessage() {}
+
+func (x *AppDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppDependency.ProtoReflect.Descriptor instead.
+func (*AppDependency) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppDependency) GetAppUuid() string {
+	if x != nil {
+		return x.AppUuid
+	}
+	return ""
+}
+
+func (x *AppDependency) GetCondition() AppDependencyCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return AppDependencyCondition_APP_DEPENDENCY_CONDITION_UNSPECIFIED
+}
+
+// HTTP GET request sent to the application. The probe succeeds if the
+// response status code is at least 200 and below 400.
+type AppHttpGetProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// Path to request, defaults to "/".
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *AppHttpGetProbe) Reset() {
+	*x = AppHttpGetProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppHttpGetProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppHttpGetProbe) ProtoMessage() {}
+
+func (x *AppHttpGetProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppHttpGetProbe.ProtoReflect.Descriptor instead.
+func (*AppHttpGetProbe) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AppHttpGetProbe) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *AppHttpGetProbe) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// TCP connection to the application. The probe succeeds if the connection
+// is established.
+type AppTcpSocketProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *AppTcpSocketProbe) Reset() {
+	*x = AppTcpSocketProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppTcpSocketProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppTcpSocketProbe) ProtoMessage() {}
+
+func (x *AppTcpSocketProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppTcpSocketProbe.ProtoReflect.Descriptor instead.
+func (*AppTcpSocketProbe) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AppTcpSocketProbe) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// Command executed inside the application container. The probe succeeds
+// if the command exits with status zero.
+// Supported only for native containers (VirtualizationMode NOHYPER).
+type AppExecProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command []string `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *AppExecProbe) Reset() {
+	*x = AppExecProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppExecProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppExecProbe) ProtoMessage() {}
+
+func (x *AppExecProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppExecProbe.ProtoReflect.Descriptor instead.
+func (*AppExecProbe) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppExecProbe) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+// AppProbe is a periodic check of the application instance.
+// Exactly one of http_get, tcp_socket and exec should be set.
+type AppProbe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//	*AppProbe_HttpGet
+	//	*AppProbe_TcpSocket
+	//	*AppProbe_Exec
+	Action isAppProbe_Action `protobuf_oneof:"action"`
+	// Index into AppInstanceConfig.interfaces of the interface whose
+	// IP address is used to reach the application by http_get and tcp_socket
+	// probes. The interface must be connected to a network instance
+	// where EVE allocates (or knows) the application IP address.
+	InterfaceIndex uint32 `protobuf:"varint,4,opt,name=interface_index,json=interfaceIndex,proto3" json:"interface_index,omitempty"`
+	// Seconds after the application instance is started before the probe
+	// is run for the first time.
+	InitialDelay uint32 `protobuf:"varint,5,opt,name=initial_delay,json=initialDelay,proto3" json:"initial_delay,omitempty"`
+	// How often (in seconds) to run the probe. Defaults to 10 seconds.
+	Period uint32 `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty"`
+	// Seconds after which the probe times out. Defaults to 1 second.
+	Timeout uint32 `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Number of consecutive failures after which the probe is considered
+	// failed. Defaults to 3.
+	FailureThreshold uint32 `protobuf:"varint,8,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	// Number of consecutive successes after which the probe is considered
+	// successful again after a failure. Defaults to 1.
+	SuccessThreshold uint32 `protobuf:"varint,9,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"`
+}
+
+func (x *AppProbe) Reset() {
+	*x = AppProbe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppProbe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppProbe) ProtoMessage() {}
+
+func (x *AppProbe) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppProbe.ProtoReflect.Descriptor instead.
+func (*AppProbe) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *AppProbe) GetAction() isAppProbe_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *AppProbe) GetHttpGet() *AppHttpGetProbe {
+	if x, ok := x.GetAction().(*AppProbe_HttpGet); ok {
+		return x.HttpGet
+	}
+	return nil
+}
+
+func (x *AppProbe) GetTcpSocket() *AppTcpSocketProbe {
+	if x, ok := x.GetAction().(*AppProbe_TcpSocket); ok {
+		return x.TcpSocket
+	}
+	return nil
+}
+
+func (x *AppProbe) GetExec() *AppExecProbe {
+	if x, ok := x.GetAction().(*AppProbe_Exec); ok {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *AppProbe) GetInterfaceIndex() uint32 {
+	if x != nil {
+		return x.InterfaceIndex
+	}
+	return 0
+}
+
+func (x *AppProbe) GetInitialDelay() uint32 {
+	if x != nil {
+		return x.InitialDelay
+	}
+	return 0
+}
+
+func (x *AppProbe) GetPeriod() uint32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *AppProbe) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *AppProbe) GetFailureThreshold() uint32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *AppProbe) GetSuccessThreshold() uint32 {
+	if x != nil {
+		return x.SuccessThreshold
+	}
+	return 0
+}
+
+type isAppProbe_Action interface {
+	isAppProbe_Action()
+}
+
+type AppProbe_HttpGet struct {
+	HttpGet *AppHttpGetProbe `protobuf:"bytes,1,opt,name=http_get,json=httpGet,proto3,oneof"`
+}
+
+type AppProbe_TcpSocket struct {
+	TcpSocket *AppTcpSocketProbe `protobuf:"bytes,2,opt,name=tcp_socket,json=tcpSocket,proto3,oneof"`
+}
+
+type AppProbe_Exec struct {
+	Exec *AppExecProbe `protobuf:"bytes,3,opt,name=exec,proto3,oneof"`
+}
+
+func (*AppProbe_HttpGet) isAppProbe_Action() {}
+
+func (*AppProbe_TcpSocket) isAppProbe_Action() {}
+
+func (*AppProbe_Exec) isAppProbe_Action() {}
+
+// AppHealthConfig : probes and the restart policy of an application instance.
+type AppHealthConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Failed liveness probe causes the application instance to be restarted
+	// (unless restart_policy is NEVER).
+	Liveness *AppProbe `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	// Readiness probe only determines if the application instance is reported
+	// as ready.
+	Readiness     *AppProbe        `protobuf:"bytes,2,opt,name=readiness,proto3" json:"readiness,omitempty"`
+	RestartPolicy AppRestartPolicy `protobuf:"varint,3,opt,name=restart_policy,json=restartPolicy,proto3,enum=org.lfedge.eve.config.AppRestartPolicy" json:"restart_policy,omitempty"`
+	// Delay (in seconds) before the first automatic restart. It is doubled
+	// with every consecutive restart up to backoff_max. The number
+	// of consecutive restarts is reset once the application instance runs
+	// without failures for 10 minutes.
+	// Defaults to 10 seconds.
+	BackoffInitial uint32 `protobuf:"varint,4,opt,name=backoff_initial,json=backoffInitial,proto3" json:"backoff_initial,omitempty"`
+	// Maximum delay (in seconds) between automatic restarts.
+	// Defaults to 300 seconds.
+	BackoffMax uint32 `protobuf:"varint,5,opt,name=backoff_max,json=backoffMax,proto3" json:"backoff_max,omitempty"`
+}
+
+func (x *AppHealthConfig) Reset() {
+	*x = AppHealthConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_appconfig_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppHealthConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppHealthConfig) ProtoMessage() {}
+
+func (x *AppHealthConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_appconfig_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppHealthConfig.ProtoReflect.Descriptor instead.
+func (*AppHealthConfig) Descriptor() ([]byte, []int) {
+	return file_config_appconfig_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AppHealthConfig) GetLiveness() *AppProbe {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *AppHealthConfig) GetReadiness() *AppProbe {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+func (x *AppHealthConfig) GetRestartPolicy() AppRestartPolicy {
+	if x != nil {
+		return x.RestartPolicy
+	}
+	return AppRestartPolicy_APP_RESTART_POLICY_UNSPECIFIED
+}
+
+func (x *AppHealthConfig) GetBackoffInitial() uint32 {
+	if x != nil {
+		return x.BackoffInitial
+	}
+	return 0
+}
+
+func (x *AppHealthConfig) GetBackoffMax() uint32 {
+	if x != nil {
+		return x.BackoffMax
+	}
+	return 0
+}
+
 // Reference to a Volume specified separately in the API
 // If a volume is purged (re-created from scratch) it will either have a new
 // UUID or a new generationCount
@@ -376,7 +979,7 @@ type VolumeRef struct {
 func (x *VolumeRef) Reset() {
 	*x = VolumeRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_appconfig_proto_msgTypes[2]
+		mi := &file_config_appconfig_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +992,7 @@ func (x *VolumeRef) String() string {
 func (*VolumeRef) ProtoMessage() {}
 
 func (x *VolumeRef) ProtoReflect() protoreflect.Message {
-	mi := &file_config_appconfig_proto_msgTypes[2]
+	mi := &file_config_appconfig_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +1005,7 @@ func (x *VolumeRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeRef.ProtoReflect.Descriptor instead.
 func (*VolumeRef) Descriptor() ([]byte, []int) {
-	return file_config_appconfig_proto_rawDescGZIP(), []int{2}
+	return file_config_appconfig_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *VolumeRef) GetUuid() string {
@@ -443,7 +1046,7 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x6d, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
 	0x6f, 0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8a, 0x07, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x70, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x94, 0x08, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e,
 	0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
@@ -500,24 +1103,114 @@ var file_config_appconfig_proto_rawDesc = []byte{
 	0x70, 0x65, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
 	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74,
 	0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x09, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66,
-	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x2a, 0x66, 0x0a, 0x0c, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e,
-	0x53, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x72,
-	0x74, 0x10, 0x03, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65,
-	0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x12, 0x48, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52,
+	0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0x77, 0x0a,
+	0x0d, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x55, 0x75, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x48, 0x74, 0x74,
+	0x70, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x27, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x54, 0x63, 0x70, 0x53, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x41, 0x70,
+	0x70, 0x45, 0x78, 0x65, 0x63, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x22, 0xb9, 0x03, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x12, 0x43, 0x0a, 0x08, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x48,
+	0x74, 0x74, 0x70, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x48, 0x00, 0x52, 0x07, 0x68,
+	0x74, 0x74, 0x70, 0x47, 0x65, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x54, 0x63, 0x70, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x48, 0x00, 0x52, 0x09, 0x74, 0x63, 0x70, 0x53, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x39, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x78, 0x65, 0x63, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x65, 0x63, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xa7, 0x02, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41,
+	0x70, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x08, 0x6c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
+	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x70, 0x70,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x4e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
+	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x78, 0x22, 0x66, 0x0a, 0x09, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64,
+	0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x69, 0x72, 0x2a, 0x66, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x10, 0x02, 0x12, 0x1a,
+	0x0a, 0x16, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x61, 0x72, 0x74, 0x10, 0x03, 0x2a, 0x8c, 0x01, 0x0a, 0x16, 0x41,
+	0x70, 0x70, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x24, 0x0a, 0x20, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
+	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x50, 0x50, 0x5f, 0x44, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x02, 0x2a, 0x96, 0x01, 0x0a, 0x10, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22,
+	0x0a, 0x1e, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10,
+	0x01, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x50, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52,
+	0x10, 0x03, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f,
+	0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -532,36 +1225,53 @@ func file_config_appconfig_proto_rawDescGZIP() []byte {
 	return file_config_appconfig_proto_rawDescData
 }
 
-var file_config_appconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_config_appconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_appconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_config_appconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_config_appconfig_proto_goTypes = []interface{}{
-	(MetaDataType)(0),         // 0: org.lfedge.eve.config.MetaDataType
-	(*InstanceOpsCmd)(nil),    // 1: org.lfedge.eve.config.InstanceOpsCmd
-	(*AppInstanceConfig)(nil), // 2: org.lfedge.eve.config.AppInstanceConfig
-	(*VolumeRef)(nil),         // 3: org.lfedge.eve.config.VolumeRef
-	(*UUIDandVersion)(nil),    // 4: org.lfedge.eve.config.UUIDandVersion
-	(*VmConfig)(nil),          // 5: org.lfedge.eve.config.VmConfig
-	(*Drive)(nil),             // 6: org.lfedge.eve.config.Drive
-	(*NetworkAdapter)(nil),    // 7: org.lfedge.eve.config.NetworkAdapter
-	(*Adapter)(nil),           // 8: org.lfedge.eve.config.Adapter
-	(*CipherBlock)(nil),       // 9: org.lfedge.eve.config.CipherBlock
+	(MetaDataType)(0),           // 0: org.lfedge.eve.config.MetaDataType
+	(AppDependencyCondition)(0), // 1: org.lfedge.eve.config.AppDependencyCondition
+	(AppRestartPolicy)(0),       // 2: org.lfedge.eve.config.AppRestartPolicy
+	(*InstanceOpsCmd)(nil),      // 3: org.lfedge.eve.config.InstanceOpsCmd
+	(*AppInstanceConfig)(nil),   // 4: org.lfedge.eve.config.AppInstanceConfig
+	(*AppDependency)(nil),       // 5: org.lfedge.eve.config.AppDependency
+	(*AppHttpGetProbe)(nil),     // 6: org.lfedge.eve.config.AppHttpGetProbe
+	(*AppTcpSocketProbe)(nil),   // 7: org.lfedge.eve.config.AppTcpSocketProbe
+	(*AppExecProbe)(nil),        // 8: org.lfedge.eve.config.AppExecProbe
+	(*AppProbe)(nil),            // 9: org.lfedge.eve.config.AppProbe
+	(*AppHealthConfig)(nil),     // 10: org.lfedge.eve.config.AppHealthConfig
+	(*VolumeRef)(nil),           // 11: org.lfedge.eve.config.VolumeRef
+	(*UUIDandVersion)(nil),      // 12: org.lfedge.eve.config.UUIDandVersion
+	(*VmConfig)(nil),            // 13: org.lfedge.eve.config.VmConfig
+	(*Drive)(nil),               // 14: org.lfedge.eve.config.Drive
+	(*NetworkAdapter)(nil),      // 15: org.lfedge.eve.config.NetworkAdapter
+	(*Adapter)(nil),             // 16: org.lfedge.eve.config.Adapter
+	(*CipherBlock)(nil),         // 17: org.lfedge.eve.config.CipherBlock
 }
 var file_config_appconfig_proto_depIdxs = []int32{
-	4,  // 0: org.lfedge.eve.config.AppInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
-	5,  // 1: org.lfedge.eve.config.AppInstanceConfig.fixedresources:type_name -> org.lfedge.eve.config.VmConfig
-	6,  // 2: org.lfedge.eve.config.AppInstanceConfig.drives:type_name -> org.lfedge.eve.config.Drive
-	7,  // 3: org.lfedge.eve.config.AppInstanceConfig.interfaces:type_name -> org.lfedge.eve.config.NetworkAdapter
-	8,  // 4: org.lfedge.eve.config.AppInstanceConfig.adapters:type_name -> org.lfedge.eve.config.Adapter
-	1,  // 5: org.lfedge.eve.config.AppInstanceConfig.restart:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	1,  // 6: org.lfedge.eve.config.AppInstanceConfig.purge:type_name -> org.lfedge.eve.config.InstanceOpsCmd
-	9,  // 7: org.lfedge.eve.config.AppInstanceConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	3,  // 8: org.lfedge.eve.config.AppInstanceConfig.volumeRefList:type_name -> org.lfedge.eve.config.VolumeRef
+	12, // 0: org.lfedge.eve.config.AppInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	13, // 1: org.lfedge.eve.config.AppInstanceConfig.fixedresources:type_name -> org.lfedge.eve.config.VmConfig
+	14, // 2: org.lfedge.eve.config.AppInstanceConfig.drives:type_name -> org.lfedge.eve.config.Drive
+	15, // 3: org.lfedge.eve.config.AppInstanceConfig.interfaces:type_name -> org.lfedge.eve.config.NetworkAdapter
+	16, // 4: org.lfedge.eve.config.AppInstanceConfig.adapters:type_name -> org.lfedge.eve.config.Adapter
+	3,  // 5: org.lfedge.eve.config.AppInstanceConfig.restart:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	3,  // 6: org.lfedge.eve.config.AppInstanceConfig.purge:type_name -> org.lfedge.eve.config.InstanceOpsCmd
+	17, // 7: org.lfedge.eve.config.AppInstanceConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	11, // 8: org.lfedge.eve.config.AppInstanceConfig.volumeRefList:type_name -> org.lfedge.eve.config.VolumeRef
 	0,  // 9: org.lfedge.eve.config.AppInstanceConfig.metaDataType:type_name -> org.lfedge.eve.config.MetaDataType
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	10, // 10: org.lfedge.eve.config.AppInstanceConfig.health:type_name -> org.lfedge.eve.config.AppHealthConfig
+	5,  // 11: org.lfedge.eve.config.AppInstanceConfig.dependencies:type_name -> org.lfedge.eve.config.AppDependency
+	1,  // 12: org.lfedge.eve.config.AppDependency.condition:type_name -> org.lfedge.eve.config.AppDependencyCondition
+	6,  // 13: org.lfedge.eve.config.AppProbe.http_get:type_name -> org.lfedge.eve.config.AppHttpGetProbe
+	7,  // 14: org.lfedge.eve.config.AppProbe.tcp_socket:type_name -> org.lfedge.eve.config.AppTcpSocketProbe
+	8,  // 15: org.lfedge.eve.config.AppProbe.exec:type_name -> org.lfedge.eve.config.AppExecProbe
+	9,  // 16: org.lfedge.eve.config.AppHealthConfig.liveness:type_name -> org.lfedge.eve.config.AppProbe
+	9,  // 17: org.lfedge.eve.config.AppHealthConfig.readiness:type_name -> org.lfedge.eve.config.AppProbe
+	2,  // 18: org.lfedge.eve.config.AppHealthConfig.restart_policy:type_name -> org.lfedge.eve.config.AppRestartPolicy
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_config_appconfig_proto_init() }
@@ -600,6 +1310,78 @@ func file_config_appconfig_proto_init() {
 			}
 		}
 		file_config_appconfig_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppHttpGetProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppTcpSocketProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppExecProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppProbe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppHealthConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_appconfig_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VolumeRef); i {
 			case 0:
 				return &v.state
@@ -612,13 +1394,18 @@ func file_config_appconfig_proto_init() {
 			}
 		}
 	}
+	file_config_appconfig_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*AppProbe_HttpGet)(nil),
+		(*AppProbe_TcpSocket)(nil),
+		(*AppProbe_Exec)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_appconfig_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -137,6 +137,8 @@ type NetworkAdapter struct {
 	// valid vlan id range: 2 - 4093
 	// vlan id 1 is implicitly used by linux bridges
 	AccessVlanId uint32 `protobuf:"varint,41,opt,name=access_vlan_id,json=accessVlanId,proto3" json:"access_vlan_id,omitempty"`
+	// bitrate limits applied to all traffic of the adapter
+	Bandwidth *AdapterBandwidth `protobuf:"bytes,42,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
 }
 
 func (x *NetworkAdapter) Reset() {
@@ -248,6 +250,72 @@ func (x *NetworkAdapter) GetAccessVlanId() uint32 {
 	return 0
 }
 
+func (x *NetworkAdapter) GetBandwidth() *AdapterBandwidth {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return nil
+}
+
+// Bitrate limits of an application network adapter in kbit/s.
+// Ingress is the traffic towards the application, egress is the traffic
+// sent by the application. Zero means no limit.
+// Guaranteed bitrates of shaping ACEs are shares of these limits.
+type AdapterBandwidth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IngressLimit uint32 `protobuf:"varint,1,opt,name=ingress_limit,json=ingressLimit,proto3" json:"ingress_limit,omitempty"`
+	EgressLimit  uint32 `protobuf:"varint,2,opt,name=egress_limit,json=egressLimit,proto3" json:"egress_limit,omitempty"`
+}
+
+func (x *AdapterBandwidth) Reset() {
+	*x = AdapterBandwidth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netconfig_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdapterBandwidth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdapterBandwidth) ProtoMessage() {}
+
+func (x *AdapterBandwidth) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netconfig_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdapterBandwidth.ProtoReflect.Descriptor instead.
+func (*AdapterBandwidth) Descriptor() ([]byte, []int) {
+	return file_config_netconfig_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdapterBandwidth) GetIngressLimit() uint32 {
+	if x != nil {
+		return x.IngressLimit
+	}
+	return 0
+}
+
+func (x *AdapterBandwidth) GetEgressLimit() uint32 {
+	if x != nil {
+		return x.EgressLimit
+	}
+	return 0
+}
+
 type WirelessConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -261,7 +329,7 @@ type WirelessConfig struct {
 func (x *WirelessConfig) Reset() {
 	*x = WirelessConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[2]
+		mi := &file_config_netconfig_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -274,7 +342,7 @@ func (x *WirelessConfig) String() string {
 func (*WirelessConfig) ProtoMessage() {}
 
 func (x *WirelessConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[2]
+	mi := &file_config_netconfig_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +355,7 @@ func (x *WirelessConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WirelessConfig.ProtoReflect.Descriptor instead.
 func (*WirelessConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{2}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *WirelessConfig) GetType() WirelessType {
@@ -326,7 +394,7 @@ type CellularConfig struct {
 func (x *CellularConfig) Reset() {
 	*x = CellularConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[3]
+		mi := &file_config_netconfig_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +407,7 @@ func (x *CellularConfig) String() string {
 func (*CellularConfig) ProtoMessage() {}
 
 func (x *CellularConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[3]
+	mi := &file_config_netconfig_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +420,7 @@ func (x *CellularConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularConfig.ProtoReflect.Descriptor instead.
 func (*CellularConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{3}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CellularConfig) GetAPN() string {
@@ -389,7 +457,7 @@ type CellularConnectivityProbe struct {
 func (x *CellularConnectivityProbe) Reset() {
 	*x = CellularConnectivityProbe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[4]
+		mi := &file_config_netconfig_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -402,7 +470,7 @@ func (x *CellularConnectivityProbe) String() string {
 func (*CellularConnectivityProbe) ProtoMessage() {}
 
 func (x *CellularConnectivityProbe) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[4]
+	mi := &file_config_netconfig_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +483,7 @@ func (x *CellularConnectivityProbe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CellularConnectivityProbe.ProtoReflect.Descriptor instead.
 func (*CellularConnectivityProbe) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{4}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CellularConnectivityProbe) GetDisable() bool {
@@ -451,7 +519,7 @@ type WifiConfig struct {
 func (x *WifiConfig) Reset() {
 	*x = WifiConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[5]
+		mi := &file_config_netconfig_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -464,7 +532,7 @@ func (x *WifiConfig) String() string {
 func (*WifiConfig) ProtoMessage() {}
 
 func (x *WifiConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[5]
+	mi := &file_config_netconfig_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,7 +545,7 @@ func (x *WifiConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiConfig.ProtoReflect.Descriptor instead.
 func (*WifiConfig) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{5}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *WifiConfig) GetWifiSSID() string {
@@ -541,7 +609,7 @@ type WifiConfigCryptoblock struct {
 func (x *WifiConfigCryptoblock) Reset() {
 	*x = WifiConfigCryptoblock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_netconfig_proto_msgTypes[6]
+		mi := &file_config_netconfig_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +622,7 @@ func (x *WifiConfigCryptoblock) String() string {
 func (*WifiConfigCryptoblock) ProtoMessage() {}
 
 func (x *WifiConfigCryptoblock) ProtoReflect() protoreflect.Message {
-	mi := &file_config_netconfig_proto_msgTypes[6]
+	mi := &file_config_netconfig_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +635,7 @@ func (x *WifiConfigCryptoblock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WifiConfigCryptoblock.ProtoReflect.Descriptor instead.
 func (*WifiConfigCryptoblock) Descriptor() ([]byte, []int) {
-	return file_config_netconfig_proto_rawDescGZIP(), []int{5, 0}
+	return file_config_netconfig_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *WifiConfigCryptoblock) GetIdentity() string {
@@ -614,7 +682,7 @@ var file_config_netconfig_proto_rawDesc = []byte{
 	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
 	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
 	0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
-	0x77, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x0e, 0x4e, 0x65, 0x74,
+	0x77, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x22, 0xb3, 0x03, 0x0a, 0x0e, 0x4e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
@@ -637,62 +705,72 @@ var file_config_netconfig_proto_rawDesc = []byte{
 	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x43, 0x45, 0x52, 0x04, 0x61, 0x63, 0x6c,
 	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x76, 0x6c, 0x61, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x56, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x57, 0x69, 0x72, 0x65,
-	0x6c, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43,
-	0x66, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x66, 0x67, 0x12, 0x3b, 0x0a, 0x07,
-	0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x22, 0x6a, 0x0a, 0x0e, 0x43, 0x65, 0x6c,
-	0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x41,
-	0x50, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x50, 0x4e, 0x12, 0x46, 0x0a,
-	0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f,
-	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x05,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x22, 0x5a, 0x0a, 0x19, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61,
-	0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
-	0x62, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x92, 0x03, 0x0a, 0x0a, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x12, 0x42, 0x0a, 0x09,
-	0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x0a, 0x63,
-	0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x1a,
-	0x45, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
-	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a,
-	0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65,
-	0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x56, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x5a,
+	0x0a, 0x10, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x57,
+	0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c,
+	0x61, 0x72, 0x43, 0x66, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0b, 0x63, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x66, 0x67, 0x12,
+	0x3b, 0x0a, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x07, 0x77, 0x69, 0x66, 0x69, 0x43, 0x66, 0x67, 0x22, 0x6a, 0x0a, 0x0e,
+	0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x41, 0x50, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x41, 0x50, 0x4e,
+	0x12, 0x46, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x22, 0x5a, 0x0a, 0x19, 0x43, 0x65, 0x6c, 0x6c,
+	0x75, 0x6c, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x92, 0x03, 0x0a, 0x0a, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x66, 0x69, 0x53, 0x53, 0x49, 0x44, 0x12,
+	0x42, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x4b,
+	0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x06, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x57, 0x69, 0x66, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x42,
+	0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x45, 0x0a, 0x0b, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67,
+	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -707,43 +785,45 @@ func file_config_netconfig_proto_rawDescGZIP() []byte {
 	return file_config_netconfig_proto_rawDescData
 }
 
-var file_config_netconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_config_netconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_config_netconfig_proto_goTypes = []interface{}{
 	(*NetworkConfig)(nil),             // 0: org.lfedge.eve.config.NetworkConfig
 	(*NetworkAdapter)(nil),            // 1: org.lfedge.eve.config.NetworkAdapter
-	(*WirelessConfig)(nil),            // 2: org.lfedge.eve.config.WirelessConfig
-	(*CellularConfig)(nil),            // 3: org.lfedge.eve.config.CellularConfig
-	(*CellularConnectivityProbe)(nil), // 4: org.lfedge.eve.config.CellularConnectivityProbe
-	(*WifiConfig)(nil),                // 5: org.lfedge.eve.config.WifiConfig
-	(*WifiConfigCryptoblock)(nil),     // 6: org.lfedge.eve.config.WifiConfig.cryptoblock
-	(NetworkType)(0),                  // 7: org.lfedge.eve.config.NetworkType
-	(*Ipspec)(nil),                    // 8: org.lfedge.eve.config.ipspec
-	(*ZnetStaticDNSEntry)(nil),        // 9: org.lfedge.eve.config.ZnetStaticDNSEntry
-	(*ProxyConfig)(nil),               // 10: org.lfedge.eve.config.ProxyConfig
-	(*ACE)(nil),                       // 11: org.lfedge.eve.config.ACE
-	(WirelessType)(0),                 // 12: org.lfedge.eve.config.WirelessType
-	(WiFiKeyScheme)(0),                // 13: org.lfedge.eve.config.WiFiKeyScheme
-	(*CipherBlock)(nil),               // 14: org.lfedge.eve.config.CipherBlock
+	(*AdapterBandwidth)(nil),          // 2: org.lfedge.eve.config.AdapterBandwidth
+	(*WirelessConfig)(nil),            // 3: org.lfedge.eve.config.WirelessConfig
+	(*CellularConfig)(nil),            // 4: org.lfedge.eve.config.CellularConfig
+	(*CellularConnectivityProbe)(nil), // 5: org.lfedge.eve.config.CellularConnectivityProbe
+	(*WifiConfig)(nil),                // 6: org.lfedge.eve.config.WifiConfig
+	(*WifiConfigCryptoblock)(nil),     // 7: org.lfedge.eve.config.WifiConfig.cryptoblock
+	(NetworkType)(0),                  // 8: org.lfedge.eve.config.NetworkType
+	(*Ipspec)(nil),                    // 9: org.lfedge.eve.config.ipspec
+	(*ZnetStaticDNSEntry)(nil),        // 10: org.lfedge.eve.config.ZnetStaticDNSEntry
+	(*ProxyConfig)(nil),               // 11: org.lfedge.eve.config.ProxyConfig
+	(*ACE)(nil),                       // 12: org.lfedge.eve.config.ACE
+	(WirelessType)(0),                 // 13: org.lfedge.eve.config.WirelessType
+	(WiFiKeyScheme)(0),                // 14: org.lfedge.eve.config.WiFiKeyScheme
+	(*CipherBlock)(nil),               // 15: org.lfedge.eve.config.CipherBlock
 }
 var file_config_netconfig_proto_depIdxs = []int32{
-	7,  // 0: org.lfedge.eve.config.NetworkConfig.type:type_name -> org.lfedge.eve.config.NetworkType
-	8,  // 1: org.lfedge.eve.config.NetworkConfig.ip:type_name -> org.lfedge.eve.config.ipspec
-	9,  // 2: org.lfedge.eve.config.NetworkConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
-	10, // 3: org.lfedge.eve.config.NetworkConfig.entProxy:type_name -> org.lfedge.eve.config.ProxyConfig
-	2,  // 4: org.lfedge.eve.config.NetworkConfig.wireless:type_name -> org.lfedge.eve.config.WirelessConfig
-	11, // 5: org.lfedge.eve.config.NetworkAdapter.acls:type_name -> org.lfedge.eve.config.ACE
-	12, // 6: org.lfedge.eve.config.WirelessConfig.type:type_name -> org.lfedge.eve.config.WirelessType
-	3,  // 7: org.lfedge.eve.config.WirelessConfig.cellularCfg:type_name -> org.lfedge.eve.config.CellularConfig
-	5,  // 8: org.lfedge.eve.config.WirelessConfig.wifiCfg:type_name -> org.lfedge.eve.config.WifiConfig
-	4,  // 9: org.lfedge.eve.config.CellularConfig.probe:type_name -> org.lfedge.eve.config.CellularConnectivityProbe
-	13, // 10: org.lfedge.eve.config.WifiConfig.keyScheme:type_name -> org.lfedge.eve.config.WiFiKeyScheme
-	6,  // 11: org.lfedge.eve.config.WifiConfig.crypto:type_name -> org.lfedge.eve.config.WifiConfig.cryptoblock
-	14, // 12: org.lfedge.eve.config.WifiConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	8,  // 0: org.lfedge.eve.config.NetworkConfig.type:type_name -> org.lfedge.eve.config.NetworkType
+	9,  // 1: org.lfedge.eve.config.NetworkConfig.ip:type_name -> org.lfedge.eve.config.ipspec
+	10, // 2: org.lfedge.eve.config.NetworkConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
+	11, // 3: org.lfedge.eve.config.NetworkConfig.entProxy:type_name -> org.lfedge.eve.config.ProxyConfig
+	3,  // 4: org.lfedge.eve.config.NetworkConfig.wireless:type_name -> org.lfedge.eve.config.WirelessConfig
+	12, // 5: org.lfedge.eve.config.NetworkAdapter.acls:type_name -> org.lfedge.eve.config.ACE
+	2,  // 6: org.lfedge.eve.config.NetworkAdapter.bandwidth:type_name -> org.lfedge.eve.config.AdapterBandwidth
+	13, // 7: org.lfedge.eve.config.WirelessConfig.type:type_name -> org.lfedge.eve.config.WirelessType
+	4,  // 8: org.lfedge.eve.config.WirelessConfig.cellularCfg:type_name -> org.lfedge.eve.config.CellularConfig
+	6,  // 9: org.lfedge.eve.config.WirelessConfig.wifiCfg:type_name -> org.lfedge.eve.config.WifiConfig
+	5,  // 10: org.lfedge.eve.config.CellularConfig.probe:type_name -> org.lfedge.eve.config.CellularConnectivityProbe
+	14, // 11: org.lfedge.eve.config.WifiConfig.keyScheme:type_name -> org.lfedge.eve.config.WiFiKeyScheme
+	7,  // 12: org.lfedge.eve.config.WifiConfig.crypto:type_name -> org.lfedge.eve.config.WifiConfig.cryptoblock
+	15, // 13: org.lfedge.eve.config.WifiConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_config_netconfig_proto_init() }
@@ -780,7 +860,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WirelessConfig); i {
+			switch v := v.(*AdapterBandwidth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -792,7 +872,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularConfig); i {
+			switch v := v.(*WirelessConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -804,7 +884,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CellularConnectivityProbe); i {
+			switch v := v.(*CellularConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -816,7 +896,7 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WifiConfig); i {
+			switch v := v.(*CellularConnectivityProbe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -828,6 +908,18 @@ func file_config_netconfig_proto_init() {
 			}
 		}
 		file_config_netconfig_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WifiConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_netconfig_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WifiConfigCryptoblock); i {
 			case 0:
 				return &v.state
@@ -846,7 +938,7 @@ func file_config_netconfig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_netconfig_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
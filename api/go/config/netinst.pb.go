@@ -148,8 +148,10 @@ func (AddressType) EnumDescriptor() ([]byte, []int) {
 type ZNetworkOpaqueConfigType int32
 
 const (
-	ZNetworkOpaqueConfigType_ZNetOConfigVPN  ZNetworkOpaqueConfigType = 0
-	ZNetworkOpaqueConfigType_ZNetOConfigLisp ZNetworkOpaqueConfigType = 1
+	ZNetworkOpaqueConfigType_ZNetOConfigVPN       ZNetworkOpaqueConfigType = 0
+	ZNetworkOpaqueConfigType_ZNetOConfigLisp      ZNetworkOpaqueConfigType = 1
+	ZNetworkOpaqueConfigType_ZNetOConfigWireGuard ZNetworkOpaqueConfigType = 2
+	ZNetworkOpaqueConfigType_ZNetOConfigMesh      ZNetworkOpaqueConfigType = 3
 )
 
 // Enum value maps for ZNetworkOpaqueConfigType.
@@ -157,10 +159,14 @@ var (
 	ZNetworkOpaqueConfigType_name = map[int32]string{
 		0: "ZNetOConfigVPN",
 		1: "ZNetOConfigLisp",
+		2: "ZNetOConfigWireGuard",
+		3: "ZNetOConfigMesh",
 	}
 	ZNetworkOpaqueConfigType_value = map[string]int32{
-		"ZNetOConfigVPN":  0,
-		"ZNetOConfigLisp": 1,
+		"ZNetOConfigVPN":       0,
+		"ZNetOConfigLisp":      1,
+		"ZNetOConfigWireGuard": 2,
+		"ZNetOConfigMesh":      3,
 	}
 )
 
@@ -243,6 +249,103 @@ func (ZcServiceType) EnumDescriptor() ([]byte, []int) {
 	return file_config_netinst_proto_rawDescGZIP(), []int{3}
 }
 
+type IPv6UplinkMode int32
+
+const (
+	// IPV6_UPLINK_MODE_NAT66 - source address of IPv6 traffic is translated
+	//   to the address of the port (the subnet is typically a ULA prefix).
+	IPv6UplinkMode_IPV6_UPLINK_MODE_NAT66 IPv6UplinkMode = 0
+	// IPV6_UPLINK_MODE_ROUTED - the IPv6 subnet is a prefix delegated
+	//   to the device and routed towards the port by the uplink router.
+	//   IPv6 traffic is forwarded without address translation.
+	IPv6UplinkMode_IPV6_UPLINK_MODE_ROUTED IPv6UplinkMode = 1
+)
+
+// Enum value maps for IPv6UplinkMode.
+var (
+	IPv6UplinkMode_name = map[int32]string{
+		0: "IPV6_UPLINK_MODE_NAT66",
+		1: "IPV6_UPLINK_MODE_ROUTED",
+	}
+	IPv6UplinkMode_value = map[string]int32{
+		"IPV6_UPLINK_MODE_NAT66":  0,
+		"IPV6_UPLINK_MODE_ROUTED": 1,
+	}
+)
+
+func (x IPv6UplinkMode) Enum() *IPv6UplinkMode {
+	p := new(IPv6UplinkMode)
+	*p = x
+	return p
+}
+
+func (x IPv6UplinkMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IPv6UplinkMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netinst_proto_enumTypes[4].Descriptor()
+}
+
+func (IPv6UplinkMode) Type() protoreflect.EnumType {
+	return &file_config_netinst_proto_enumTypes[4]
+}
+
+func (x IPv6UplinkMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IPv6UplinkMode.Descriptor instead.
+func (IPv6UplinkMode) EnumDescriptor() ([]byte, []int) {
+	return file_config_netinst_proto_rawDescGZIP(), []int{4}
+}
+
+type FlowExportProtocol int32
+
+const (
+	FlowExportProtocol_FLOW_EXPORT_PROTOCOL_IPFIX      FlowExportProtocol = 0
+	FlowExportProtocol_FLOW_EXPORT_PROTOCOL_NETFLOW_V9 FlowExportProtocol = 1
+)
+
+// Enum value maps for FlowExportProtocol.
+var (
+	FlowExportProtocol_name = map[int32]string{
+		0: "FLOW_EXPORT_PROTOCOL_IPFIX",
+		1: "FLOW_EXPORT_PROTOCOL_NETFLOW_V9",
+	}
+	FlowExportProtocol_value = map[string]int32{
+		"FLOW_EXPORT_PROTOCOL_IPFIX":      0,
+		"FLOW_EXPORT_PROTOCOL_NETFLOW_V9": 1,
+	}
+)
+
+func (x FlowExportProtocol) Enum() *FlowExportProtocol {
+	p := new(FlowExportProtocol)
+	*p = x
+	return p
+}
+
+func (x FlowExportProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlowExportProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_netinst_proto_enumTypes[5].Descriptor()
+}
+
+func (FlowExportProtocol) Type() protoreflect.EnumType {
+	return &file_config_netinst_proto_enumTypes[5]
+}
+
+func (x FlowExportProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlowExportProtocol.Descriptor instead.
+func (FlowExportProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_config_netinst_proto_rawDescGZIP(), []int{5}
+}
+
 // Network Instance Opaque config. In future we might add more fields here
 // but idea is here. This is service specific configuration.
 type NetworkInstanceOpaqueConfig struct {
@@ -253,6 +356,9 @@ type NetworkInstanceOpaqueConfig struct {
 	Oconfig    string                     `protobuf:"bytes,1,opt,name=oconfig,proto3" json:"oconfig,omitempty"`
 	LispConfig *NetworkInstanceLispConfig `protobuf:"bytes,2,opt,name=lispConfig,proto3" json:"lispConfig,omitempty"`
 	Type       ZNetworkOpaqueConfigType   `protobuf:"varint,3,opt,name=type,proto3,enum=org.lfedge.eve.config.ZNetworkOpaqueConfigType" json:"type,omitempty"`
+	// cipherData - encrypted secrets of the service, e.g. the WireGuard
+	//              private key
+	CipherData *CipherBlock `protobuf:"bytes,4,opt,name=cipherData,proto3" json:"cipherData,omitempty"`
 }
 
 func (x *NetworkInstanceOpaqueConfig) Reset() {
@@ -308,6 +414,13 @@ func (x *NetworkInstanceOpaqueConfig) GetType() ZNetworkOpaqueConfigType {
 	return ZNetworkOpaqueConfigType_ZNetOConfigVPN
 }
 
+func (x *NetworkInstanceOpaqueConfig) GetCipherData() *CipherBlock {
+	if x != nil {
+		return x.CipherData
+	}
+	return nil
+}
+
 // This is way to tell the device if there is service in cloud somewhere,
 // what type it is how to access it
 type ZcServicePoint struct {
@@ -500,6 +613,19 @@ type NetworkInstanceConfig struct {
 	Ip *Ipspec `protobuf:"bytes,40,opt,name=ip,proto3" json:"ip,omitempty"`
 	// static DNS entry, if we are running DNS/DHCP service
 	Dns []*ZnetStaticDNSEntry `protobuf:"bytes,41,rep,name=dns,proto3" json:"dns,omitempty"`
+	// flowExporter - optional export of flow records and DNS requests
+	//   of applications connected to this network instance to a local
+	//   IPFIX or NetFlow v9 collector
+	FlowExporter *FlowExporter `protobuf:"bytes,42,opt,name=flowExporter,proto3" json:"flowExporter,omitempty"`
+	// ipv6 - optional IPv6 specification of a dual-stack Local network
+	//   instance. Used only with ipType IPV4, the field ip then specifies
+	//   IPv4 addressing. Only subnet, gateway and dhcpRange are used.
+	//   Applications get an address from dhcpRange using DHCPv6 in addition
+	//   to the IPv4 address.
+	Ipv6 *Ipspec `protobuf:"bytes,43,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+	// ipv6UplinkMode - how IPv6 traffic of a dual-stack network instance
+	//   is forwarded through the port.
+	Ipv6UplinkMode IPv6UplinkMode `protobuf:"varint,44,opt,name=ipv6UplinkMode,proto3,enum=org.lfedge.eve.config.IPv6UplinkMode" json:"ipv6UplinkMode,omitempty"`
 }
 
 func (x *NetworkInstanceConfig) Reset() {
@@ -597,121 +723,252 @@ func (x *NetworkInstanceConfig) GetDns() []*ZnetStaticDNSEntry {
 	return nil
 }
 
+func (x *NetworkInstanceConfig) GetFlowExporter() *FlowExporter {
+	if x != nil {
+		return x.FlowExporter
+	}
+	return nil
+}
+
+func (x *NetworkInstanceConfig) GetIpv6() *Ipspec {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
+}
+
+func (x *NetworkInstanceConfig) GetIpv6UplinkMode() IPv6UplinkMode {
+	if x != nil {
+		return x.Ipv6UplinkMode
+	}
+	return IPv6UplinkMode_IPV6_UPLINK_MODE_NAT66
+}
+
+type FlowExporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// collector - "<IP or hostname>:<UDP port>" of the collector,
+	//   empty string disables the export
+	Collector string             `protobuf:"bytes,1,opt,name=collector,proto3" json:"collector,omitempty"`
+	Protocol  FlowExportProtocol `protobuf:"varint,2,opt,name=protocol,proto3,enum=org.lfedge.eve.config.FlowExportProtocol" json:"protocol,omitempty"`
+	// enterprise_number - IANA Private Enterprise Number used for IPFIX
+	//   enterprise-specific fields with app UUID, app name and ACE ID.
+	//   If not set, 32473 (reserved for documentation, RFC 5612) is used.
+	EnterpriseNumber uint32 `protobuf:"varint,3,opt,name=enterprise_number,json=enterpriseNumber,proto3" json:"enterprise_number,omitempty"`
+}
+
+func (x *FlowExporter) Reset() {
+	*x = FlowExporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netinst_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowExporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowExporter) ProtoMessage() {}
+
+func (x *FlowExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netinst_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowExporter.ProtoReflect.Descriptor instead.
+func (*FlowExporter) Descriptor() ([]byte, []int) {
+	return file_config_netinst_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlowExporter) GetCollector() string {
+	if x != nil {
+		return x.Collector
+	}
+	return ""
+}
+
+func (x *FlowExporter) GetProtocol() FlowExportProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return FlowExportProtocol_FLOW_EXPORT_PROTOCOL_IPFIX
+}
+
+func (x *FlowExporter) GetEnterpriseNumber() uint32 {
+	if x != nil {
+		return x.EnterpriseNumber
+	}
+	return 0
+}
+
 var File_config_netinst_proto protoreflect.FileDescriptor
 
 var file_config_netinst_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x65, 0x74, 0x69, 0x6e, 0x73, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x16, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x64, 0x65, 0x76, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x65,
-	0x74, 0x63, 0x6d, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x01, 0x0a, 0x1b, 0x4e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70,
-	0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x70, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x70,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67,
-	0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0e,
-	0x5a, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3c,
-	0x0a, 0x06, 0x7a, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
-	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x7a, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x4e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x49, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x4e, 0x61, 0x6d, 0x65, 0x4f, 0x72, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xc8, 0x02, 0x0a, 0x19, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x70,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x70, 0x4d, 0x53,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
-	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x5a, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07,
-	0x4c, 0x69, 0x73, 0x70, 0x4d, 0x53, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x70, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0e, 0x4c, 0x69, 0x73, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x61, 0x6c, 0x6c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x30, 0x0a,
-	0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x6c, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x6c, 0x65, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x6c, 0x22, 0x8b, 0x04, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a,
-	0x0e, 0x75, 0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
-	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x55,
-	0x49, 0x44, 0x61, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x75,
-	0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43,
-	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x27, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x49, 0x6e, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
-	0x32, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x44, 0x0a, 0x03, 0x63, 0x66, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x18, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x61, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x69, 0x6e, 0x66,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
+	0x64, 0x65, 0x76, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x65, 0x74, 0x63, 0x6d, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x02, 0x0a, 0x1b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x63, 0x66, 0x67, 0x12, 0x3a, 0x0a, 0x06, 0x69, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50,
+	0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
+	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x43, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f,
+	0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e,
 	0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x69,
-	0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x28, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x69, 0x70, 0x73, 0x70, 0x65, 0x63,
-	0x52, 0x02, 0x69, 0x70, 0x12, 0x3b, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x6e, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x44, 0x4e, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x64, 0x6e,
-	0x73, 0x2a, 0xb3, 0x01, 0x0a, 0x10, 0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
-	0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x5a, 0x4e, 0x65, 0x74, 0x49, 0x6e,
-	0x73, 0x74, 0x46, 0x69, 0x72, 0x73, 0x74, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x5a, 0x6e, 0x65,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x10, 0x01, 0x12, 0x11, 0x0a,
-	0x0d, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x10, 0x02,
-	0x12, 0x11, 0x0a, 0x0d, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x4d,
-	0x65, 0x73, 0x68, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x48, 0x6f, 0x6e, 0x65, 0x79, 0x50, 0x6f, 0x74, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x5a,
-	0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0c, 0x5a, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x4c, 0x61, 0x73, 0x74, 0x10, 0xff, 0x01, 0x2a, 0x57, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49,
-	0x50, 0x56, 0x36, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49,
-	0x50, 0x56, 0x34, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49,
-	0x50, 0x56, 0x36, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x10, 0xff, 0x01,
-	0x2a, 0x43, 0x0a, 0x18, 0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x61, 0x71,
-	0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e,
-	0x5a, 0x4e, 0x65, 0x74, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x50, 0x4e, 0x10, 0x00,
-	0x12, 0x13, 0x0a, 0x0f, 0x5a, 0x4e, 0x65, 0x74, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c,
-	0x69, 0x73, 0x70, 0x10, 0x01, 0x2a, 0x47, 0x0a, 0x0d, 0x5a, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x7a, 0x63, 0x6c, 0x6f, 0x75, 0x64,
-	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x72, 0x76, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
-	0x6d, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x73,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x10, 0x02, 0x42, 0x3d,
-	0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x2e, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x63,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x5a, 0x63,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x06,
+	0x7a, 0x73, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x06, 0x7a, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x61,
+	0x6d, 0x65, 0x4f, 0x72, 0x49, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x61,
+	0x6d, 0x65, 0x4f, 0x72, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xc8, 0x02, 0x0a, 0x19, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x70, 0x4d, 0x53, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x63,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x07, 0x4c, 0x69,
+	0x73, 0x70, 0x4d, 0x53, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x4c,
+	0x69, 0x73, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x30, 0x0a, 0x13, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x6c,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x6c, 0x65, 0x6e, 0x12, 0x22, 0x0a,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x6c, 0x22, 0xd6, 0x05, 0x0a, 0x15, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x0e, 0x75,
+	0x75, 0x69, 0x64, 0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x55, 0x49, 0x44,
+	0x61, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x75, 0x75, 0x69, 0x64,
+	0x61, 0x6e, 0x64, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x08,
+	0x69, 0x6e, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x44, 0x0a, 0x03, 0x63, 0x66, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x03, 0x63, 0x66, 0x67, 0x12, 0x3a, 0x0a, 0x06, 0x69, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x69, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x69, 0x70, 0x73, 0x70, 0x65, 0x63, 0x52, 0x02,
+	0x69, 0x70, 0x12, 0x3b, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x5a, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x44, 0x4e, 0x53, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12,
+	0x47, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x6c,
+	0x6f, 0x77, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x52, 0x0c, 0x66, 0x6c, 0x6f, 0x77,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x69,
+	0x70, 0x73, 0x70, 0x65, 0x63, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x4d, 0x0a, 0x0e, 0x69,
+	0x70, 0x76, 0x36, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x2c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x49, 0x50, 0x76, 0x36,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x36,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x46,
+	0x6c, 0x6f, 0x77, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6f, 0x72,
+	0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2a, 0xb3, 0x01,
+	0x0a, 0x10, 0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x5a, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x5a, 0x6e, 0x65,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x10, 0x03, 0x12,
+	0x10, 0x0a, 0x0c, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x68, 0x10,
+	0x04, 0x12, 0x14, 0x0a, 0x10, 0x5a, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x48, 0x6f, 0x6e,
+	0x65, 0x79, 0x50, 0x6f, 0x74, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x5a, 0x6e, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x10, 0x06,
+	0x12, 0x11, 0x0a, 0x0c, 0x5a, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x10, 0xff, 0x01, 0x2a, 0x57, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49, 0x50, 0x56, 0x34, 0x10,
+	0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x49, 0x50, 0x56, 0x36, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x10, 0xff, 0x01, 0x2a, 0x72, 0x0a, 0x18,
+	0x5a, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x5a, 0x4e, 0x65, 0x74,
+	0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x56, 0x50, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f,
+	0x5a, 0x4e, 0x65, 0x74, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4c, 0x69, 0x73, 0x70, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x5a, 0x4e, 0x65, 0x74, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x5a,
+	0x4e, 0x65, 0x74, 0x4f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x73, 0x68, 0x10, 0x03,
+	0x2a, 0x47, 0x0a, 0x0d, 0x5a, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x7a, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x49, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x53, 0x72, 0x76, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x10, 0x02, 0x2a, 0x49, 0x0a, 0x0e, 0x49, 0x50, 0x76,
+	0x36, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x49,
+	0x50, 0x56, 0x36, 0x5f, 0x55, 0x50, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x4e, 0x41, 0x54, 0x36, 0x36, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x50, 0x56, 0x36, 0x5f,
+	0x55, 0x50, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x2a, 0x59, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x1a, 0x46, 0x4c,
+	0x4f, 0x57, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x49, 0x50, 0x46, 0x49, 0x58, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x46, 0x4c,
+	0x4f, 0x57, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x4e, 0x45, 0x54, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x56, 0x39, 0x10, 0x01, 0x42,
+	0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -726,39 +983,48 @@ func file_config_netinst_proto_rawDescGZIP() []byte {
 	return file_config_netinst_proto_rawDescData
 }
 
-var file_config_netinst_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_config_netinst_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_netinst_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_config_netinst_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_config_netinst_proto_goTypes = []interface{}{
 	(ZNetworkInstType)(0),               // 0: org.lfedge.eve.config.ZNetworkInstType
 	(AddressType)(0),                    // 1: org.lfedge.eve.config.AddressType
 	(ZNetworkOpaqueConfigType)(0),       // 2: org.lfedge.eve.config.ZNetworkOpaqueConfigType
 	(ZcServiceType)(0),                  // 3: org.lfedge.eve.config.ZcServiceType
-	(*NetworkInstanceOpaqueConfig)(nil), // 4: org.lfedge.eve.config.NetworkInstanceOpaqueConfig
-	(*ZcServicePoint)(nil),              // 5: org.lfedge.eve.config.ZcServicePoint
-	(*NetworkInstanceLispConfig)(nil),   // 6: org.lfedge.eve.config.NetworkInstanceLispConfig
-	(*NetworkInstanceConfig)(nil),       // 7: org.lfedge.eve.config.NetworkInstanceConfig
-	(*UUIDandVersion)(nil),              // 8: org.lfedge.eve.config.UUIDandVersion
-	(*Adapter)(nil),                     // 9: org.lfedge.eve.config.Adapter
-	(*Ipspec)(nil),                      // 10: org.lfedge.eve.config.ipspec
-	(*ZnetStaticDNSEntry)(nil),          // 11: org.lfedge.eve.config.ZnetStaticDNSEntry
+	(IPv6UplinkMode)(0),                 // 4: org.lfedge.eve.config.IPv6UplinkMode
+	(FlowExportProtocol)(0),             // 5: org.lfedge.eve.config.FlowExportProtocol
+	(*NetworkInstanceOpaqueConfig)(nil), // 6: org.lfedge.eve.config.NetworkInstanceOpaqueConfig
+	(*ZcServicePoint)(nil),              // 7: org.lfedge.eve.config.ZcServicePoint
+	(*NetworkInstanceLispConfig)(nil),   // 8: org.lfedge.eve.config.NetworkInstanceLispConfig
+	(*NetworkInstanceConfig)(nil),       // 9: org.lfedge.eve.config.NetworkInstanceConfig
+	(*FlowExporter)(nil),                // 10: org.lfedge.eve.config.FlowExporter
+	(*CipherBlock)(nil),                 // 11: org.lfedge.eve.config.CipherBlock
+	(*UUIDandVersion)(nil),              // 12: org.lfedge.eve.config.UUIDandVersion
+	(*Adapter)(nil),                     // 13: org.lfedge.eve.config.Adapter
+	(*Ipspec)(nil),                      // 14: org.lfedge.eve.config.ipspec
+	(*ZnetStaticDNSEntry)(nil),          // 15: org.lfedge.eve.config.ZnetStaticDNSEntry
 }
 var file_config_netinst_proto_depIdxs = []int32{
-	6,  // 0: org.lfedge.eve.config.NetworkInstanceOpaqueConfig.lispConfig:type_name -> org.lfedge.eve.config.NetworkInstanceLispConfig
+	8,  // 0: org.lfedge.eve.config.NetworkInstanceOpaqueConfig.lispConfig:type_name -> org.lfedge.eve.config.NetworkInstanceLispConfig
 	2,  // 1: org.lfedge.eve.config.NetworkInstanceOpaqueConfig.type:type_name -> org.lfedge.eve.config.ZNetworkOpaqueConfigType
-	3,  // 2: org.lfedge.eve.config.ZcServicePoint.zsType:type_name -> org.lfedge.eve.config.ZcServiceType
-	5,  // 3: org.lfedge.eve.config.NetworkInstanceLispConfig.LispMSs:type_name -> org.lfedge.eve.config.ZcServicePoint
-	8,  // 4: org.lfedge.eve.config.NetworkInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
-	0,  // 5: org.lfedge.eve.config.NetworkInstanceConfig.instType:type_name -> org.lfedge.eve.config.ZNetworkInstType
-	9,  // 6: org.lfedge.eve.config.NetworkInstanceConfig.port:type_name -> org.lfedge.eve.config.Adapter
-	4,  // 7: org.lfedge.eve.config.NetworkInstanceConfig.cfg:type_name -> org.lfedge.eve.config.NetworkInstanceOpaqueConfig
-	1,  // 8: org.lfedge.eve.config.NetworkInstanceConfig.ipType:type_name -> org.lfedge.eve.config.AddressType
-	10, // 9: org.lfedge.eve.config.NetworkInstanceConfig.ip:type_name -> org.lfedge.eve.config.ipspec
-	11, // 10: org.lfedge.eve.config.NetworkInstanceConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	11, // 2: org.lfedge.eve.config.NetworkInstanceOpaqueConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	3,  // 3: org.lfedge.eve.config.ZcServicePoint.zsType:type_name -> org.lfedge.eve.config.ZcServiceType
+	7,  // 4: org.lfedge.eve.config.NetworkInstanceLispConfig.LispMSs:type_name -> org.lfedge.eve.config.ZcServicePoint
+	12, // 5: org.lfedge.eve.config.NetworkInstanceConfig.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	0,  // 6: org.lfedge.eve.config.NetworkInstanceConfig.instType:type_name -> org.lfedge.eve.config.ZNetworkInstType
+	13, // 7: org.lfedge.eve.config.NetworkInstanceConfig.port:type_name -> org.lfedge.eve.config.Adapter
+	6,  // 8: org.lfedge.eve.config.NetworkInstanceConfig.cfg:type_name -> org.lfedge.eve.config.NetworkInstanceOpaqueConfig
+	1,  // 9: org.lfedge.eve.config.NetworkInstanceConfig.ipType:type_name -> org.lfedge.eve.config.AddressType
+	14, // 10: org.lfedge.eve.config.NetworkInstanceConfig.ip:type_name -> org.lfedge.eve.config.ipspec
+	15, // 11: org.lfedge.eve.config.NetworkInstanceConfig.dns:type_name -> org.lfedge.eve.config.ZnetStaticDNSEntry
+	10, // 12: org.lfedge.eve.config.NetworkInstanceConfig.flowExporter:type_name -> org.lfedge.eve.config.FlowExporter
+	14, // 13: org.lfedge.eve.config.NetworkInstanceConfig.ipv6:type_name -> org.lfedge.eve.config.ipspec
+	4,  // 14: org.lfedge.eve.config.NetworkInstanceConfig.ipv6UplinkMode:type_name -> org.lfedge.eve.config.IPv6UplinkMode
+	5,  // 15: org.lfedge.eve.config.FlowExporter.protocol:type_name -> org.lfedge.eve.config.FlowExportProtocol
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_config_netinst_proto_init() }
@@ -766,6 +1032,7 @@ func file_config_netinst_proto_init() {
 	if File_config_netinst_proto != nil {
 		return
 	}
+	file_config_acipherinfo_proto_init()
 	file_config_devcommon_proto_init()
 	file_config_netcmn_proto_init()
 	if !protoimpl.UnsafeEnabled {
@@ -817,14 +1084,26 @@ func file_config_netinst_proto_init() {
 				return nil
 			}
 		}
+		file_config_netinst_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowExporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_netinst_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   4,
+			NumEnums:      6,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1061,6 +1061,9 @@ type Volume struct {
 	Readonly     bool   `protobuf:"varint,6,opt,name=readonly,proto3" json:"readonly,omitempty"`                    // Will be offered to tasks as read-only
 	DisplayName  string `protobuf:"bytes,7,opt,name=displayName,proto3" json:"displayName,omitempty"`               // Optional friendly name echo'ed in info message
 	ClearText    bool   `protobuf:"varint,8,opt,name=clear_text,json=clearText,proto3" json:"clear_text,omitempty"` // Flag to indicate the volume encryption needed or not
+	// Snapshots of the volume kept by EVE, e.g. taken before an upgrade
+	// of the application using it. Removing a snapshot from the list deletes it.
+	Snapshots []*VolumeSnapshot `protobuf:"bytes,9,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
 func (x *Volume) Reset() {
@@ -1151,12 +1154,91 @@ func (x *Volume) GetClearText() bool {
 	return false
 }
 
-//DiskConfig describe desired configuration of disk
-//If we want change state to online/offline we should define its state
-//If we want to add disk we should define it here and set DiskConfigType to online or offline
-//If we want to remove disk we should set its state to unused or appdirect
-//If we want to replace disk we should fill old_disk to be replaced with disk
-//Progress of operation is expected to be available in info messages
+func (x *Volume) GetSnapshots() []*VolumeSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// VolumeSnapshot requests a snapshot of the content of a volume.
+// The snapshot is taken when it first appears in the configuration; the
+// application using the volume should be halted for that unless the volume
+// is a zvol of a VM. Snapshots of qcow2 volumes are taken only once the
+// application is halted.
+type VolumeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is unique per volume and used as the name of the snapshot,
+	// allowed characters are letters, digits, '_', '-', '.' and ':'
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Incrementing rollback_counter reverts the volume to the content of
+	// the snapshot. The application using the volume must be halted.
+	RollbackCounter uint32 `protobuf:"varint,3,opt,name=rollback_counter,json=rollbackCounter,proto3" json:"rollback_counter,omitempty"`
+}
+
+func (x *VolumeSnapshot) Reset() {
+	*x = VolumeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_storage_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeSnapshot) ProtoMessage() {}
+
+func (x *VolumeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_config_storage_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeSnapshot.ProtoReflect.Descriptor instead.
+func (*VolumeSnapshot) Descriptor() ([]byte, []int) {
+	return file_config_storage_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VolumeSnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VolumeSnapshot) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *VolumeSnapshot) GetRollbackCounter() uint32 {
+	if x != nil {
+		return x.RollbackCounter
+	}
+	return 0
+}
+
+// DiskConfig describe desired configuration of disk
+// If we want change state to online/offline we should define its state
+// If we want to add disk we should define it here and set DiskConfigType to online or offline
+// If we want to remove disk we should set its state to unused or appdirect
+// If we want to replace disk we should fill old_disk to be replaced with disk
+// Progress of operation is expected to be available in info messages
 type DiskConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1171,7 +1253,7 @@ type DiskConfig struct {
 func (x *DiskConfig) Reset() {
 	*x = DiskConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_storage_proto_msgTypes[7]
+		mi := &file_config_storage_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1184,7 +1266,7 @@ func (x *DiskConfig) String() string {
 func (*DiskConfig) ProtoMessage() {}
 
 func (x *DiskConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_storage_proto_msgTypes[7]
+	mi := &file_config_storage_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,7 +1279,7 @@ func (x *DiskConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiskConfig.ProtoReflect.Descriptor instead.
 func (*DiskConfig) Descriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{7}
+	return file_config_storage_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DiskConfig) GetDisk() *evecommon.DiskDescription {
@@ -1221,15 +1303,15 @@ func (x *DiskConfig) GetDiskConfig() DiskConfigType {
 	return DiskConfigType_DISK_CONFIG_TYPE_UNSPECIFIED
 }
 
-//DisksConfig is a configuration of disks
-//We expect information about disks to be filled and will try to adjust disks states accordingly
-//All disks defined in disks field expected to have array type defined in array_type
-//To support nested topologies we can use children field
+// DisksConfig is a configuration of disks
+// We expect information about disks to be filled and will try to adjust disks states accordingly
+// All disks defined in disks field expected to have array type defined in array_type
+// To support nested topologies we can use children field
 //
-//For example to use stripe of two pairs of mirrored disks we should define
-//DisksConfig without disks with array_type DISKS_ARRAY_TYPE_RAID0
-//with two children with properly defined disks inside and with array_type DISKS_ARRAY_TYPE_RAID1
-//and empty children
+// For example to use stripe of two pairs of mirrored disks we should define
+// DisksConfig without disks with array_type DISKS_ARRAY_TYPE_RAID0
+// with two children with properly defined disks inside and with array_type DISKS_ARRAY_TYPE_RAID1
+// and empty children
 type DisksConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1243,7 +1325,7 @@ type DisksConfig struct {
 func (x *DisksConfig) Reset() {
 	*x = DisksConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_storage_proto_msgTypes[8]
+		mi := &file_config_storage_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1256,7 +1338,7 @@ func (x *DisksConfig) String() string {
 func (*DisksConfig) ProtoMessage() {}
 
 func (x *DisksConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_storage_proto_msgTypes[8]
+	mi := &file_config_storage_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1351,7 @@ func (x *DisksConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisksConfig.ProtoReflect.Descriptor instead.
 func (*DisksConfig) Descriptor() ([]byte, []int) {
-	return file_config_storage_proto_rawDescGZIP(), []int{8}
+	return file_config_storage_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DisksConfig) GetDisks() []*DiskConfig {
@@ -1396,7 +1478,7 @@ var file_config_storage_proto_rawDesc = []byte{
 	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72,
 	0x65, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x64, 0x6f, 0x77, 0x6e,
 	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x49,
-	0x44, 0x22, 0xd7, 0x02, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x22, 0x9c, 0x03, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
 	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
 	0x12, 0x42, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x2a, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
@@ -1417,96 +1499,107 @@ var file_config_storage_proto_rawDesc = []byte{
 	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
 	0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x0a,
-	0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x69,
-	0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x64, 0x69,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c,
-	0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x07, 0x6f, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x46, 0x0a, 0x0b, 0x64, 0x69, 0x73,
-	0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
 	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x37, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x61, 0x72,
-	0x72, 0x61, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
-	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x3e, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e,
-	0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x73,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x2a, 0x85, 0x01, 0x0a, 0x06, 0x44, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44,
-	0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x73,
-	0x48, 0x74, 0x74, 0x70, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x73, 0x48, 0x74, 0x74, 0x70,
-	0x73, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x73, 0x53, 0x33, 0x10, 0x03, 0x12, 0x0a, 0x0a,
-	0x06, 0x44, 0x73, 0x53, 0x46, 0x54, 0x50, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
-	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x73, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x42, 0x6c, 0x6f,
-	0x62, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x73, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x10, 0x07, 0x2a, 0x6b, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x6d, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x51,
-	0x43, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43, 0x4f, 0x57, 0x32, 0x10, 0x03,
-	0x12, 0x07, 0x0a, 0x03, 0x56, 0x48, 0x44, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4d, 0x44,
-	0x4b, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x56, 0x41, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04,
-	0x56, 0x48, 0x44, 0x58, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
-	0x4e, 0x45, 0x52, 0x10, 0x08, 0x2a, 0x47, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x0e, 0x0a, 0x0a, 0x54, 0x67, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4b, 0x65, 0x72,
-	0x6e, 0x65, 0x6c, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x6e, 0x69, 0x74, 0x72, 0x64, 0x10,
-	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x6b, 0x10, 0x04, 0x2a, 0x49,
-	0x0a, 0x09, 0x44, 0x72, 0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x55,
-	0x6e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x43, 0x44, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x44, 0x44, 0x10,
-	0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x44,
-	0x44, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x31, 0x0a, 0x15, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x41, 0x50, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x41, 0x50, 0x5f, 0x39, 0x50, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x17,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x43, 0x4f, 0x54, 0x5f,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x43, 0x4f,
-	0x54, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x43, 0x4f,
-	0x54, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x02, 0x2a, 0xec, 0x01, 0x0a,
-	0x0e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4f, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a,
-	0x18, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x49, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x44,
-	0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x5a, 0x46, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c,
-	0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x5a, 0x46, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x04, 0x12, 0x1e,
-	0x0a, 0x1a, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x05, 0x12, 0x1b,
-	0x0a, 0x17, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x06, 0x2a, 0xa2, 0x01, 0x0a, 0x0e,
-	0x44, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
-	0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x30, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
-	0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x52, 0x41, 0x49, 0x44, 0x31, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b,
-	0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49,
-	0x44, 0x35, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52,
-	0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x36, 0x10, 0x04,
-	0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
-	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
+	0x22, 0x6e, 0x0a, 0x0e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x22, 0xd3, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x3a, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x41, 0x0a, 0x08, 0x6f,
+	0x6c, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x46,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6b, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64,
+	0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69,
+	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12,
+	0x44, 0x0a, 0x0a, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
+	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66,
+	0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x2a, 0x85, 0x01, 0x0a, 0x06, 0x44, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x44, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x44, 0x73, 0x48, 0x74, 0x74, 0x70, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x73, 0x48, 0x74, 0x74, 0x70, 0x73, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x73, 0x53, 0x33,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x73, 0x53, 0x46, 0x54, 0x50, 0x10, 0x04, 0x12, 0x17,
+	0x0a, 0x13, 0x44, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x73, 0x41, 0x7a, 0x75,
+	0x72, 0x65, 0x42, 0x6c, 0x6f, 0x62, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x73, 0x47, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x10, 0x07, 0x2a, 0x6b, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x6d, 0x74, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41, 0x57, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x51, 0x43, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x43,
+	0x4f, 0x57, 0x32, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x56, 0x48, 0x44, 0x10, 0x04, 0x12, 0x08,
+	0x0a, 0x04, 0x56, 0x4d, 0x44, 0x4b, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x56, 0x41, 0x10,
+	0x06, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x48, 0x44, 0x58, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x43,
+	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x08, 0x2a, 0x47, 0x0a, 0x06, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x67, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x4b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x6e,
+	0x69, 0x74, 0x72, 0x64, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x61, 0x6d, 0x44, 0x69, 0x73,
+	0x6b, 0x10, 0x04, 0x2a, 0x49, 0x0a, 0x09, 0x44, 0x72, 0x69, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x44, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a,
+	0x03, 0x48, 0x44, 0x44, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x48, 0x44, 0x44, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x31,
+	0x0a, 0x15, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x41, 0x50, 0x5f, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x41, 0x50, 0x5f, 0x39, 0x50, 0x10,
+	0x01, 0x2a, 0x4e, 0x0a, 0x17, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c,
+	0x56, 0x43, 0x4f, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e,
+	0x0a, 0x0a, 0x56, 0x43, 0x4f, 0x54, 0x5f, 0x42, 0x4c, 0x41, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x11,
+	0x0a, 0x0d, 0x56, 0x43, 0x4f, 0x54, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10,
+	0x02, 0x2a, 0xec, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4f, 0x53,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x49, 0x53, 0x54, 0x10, 0x02,
+	0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x46, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10,
+	0x03, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x5a, 0x46, 0x53, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e,
+	0x45, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x55, 0x53, 0x45, 0x44, 0x10, 0x06,
+	0x2a, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52,
+	0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41,
+	0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x30, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x31, 0x10, 0x02, 0x12, 0x1a, 0x0a,
+	0x16, 0x44, 0x49, 0x53, 0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x41, 0x49, 0x44, 0x35, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x53,
+	0x4b, 0x53, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x41,
+	0x49, 0x44, 0x36, 0x10, 0x04, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65,
+	0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64,
+	0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1522,7 +1615,7 @@ func file_config_storage_proto_rawDescGZIP() []byte {
 }
 
 var file_config_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_config_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_config_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_config_storage_proto_goTypes = []interface{}{
 	(DsType)(0),                       // 0: org.lfedge.eve.config.DsType
 	(Format)(0),                       // 1: org.lfedge.eve.config.Format
@@ -1539,16 +1632,17 @@ var file_config_storage_proto_goTypes = []interface{}{
 	(*ContentTree)(nil),               // 12: org.lfedge.eve.config.ContentTree
 	(*VolumeContentOrigin)(nil),       // 13: org.lfedge.eve.config.VolumeContentOrigin
 	(*Volume)(nil),                    // 14: org.lfedge.eve.config.Volume
-	(*DiskConfig)(nil),                // 15: org.lfedge.eve.config.DiskConfig
-	(*DisksConfig)(nil),               // 16: org.lfedge.eve.config.DisksConfig
-	(*CipherBlock)(nil),               // 17: org.lfedge.eve.config.CipherBlock
-	(*UUIDandVersion)(nil),            // 18: org.lfedge.eve.config.UUIDandVersion
-	(*evecommon.DiskDescription)(nil), // 19: org.lfedge.eve.common.DiskDescription
+	(*VolumeSnapshot)(nil),            // 15: org.lfedge.eve.config.VolumeSnapshot
+	(*DiskConfig)(nil),                // 16: org.lfedge.eve.config.DiskConfig
+	(*DisksConfig)(nil),               // 17: org.lfedge.eve.config.DisksConfig
+	(*CipherBlock)(nil),               // 18: org.lfedge.eve.config.CipherBlock
+	(*UUIDandVersion)(nil),            // 19: org.lfedge.eve.config.UUIDandVersion
+	(*evecommon.DiskDescription)(nil), // 20: org.lfedge.eve.common.DiskDescription
 }
 var file_config_storage_proto_depIdxs = []int32{
 	0,  // 0: org.lfedge.eve.config.DatastoreConfig.dType:type_name -> org.lfedge.eve.config.DsType
-	17, // 1: org.lfedge.eve.config.DatastoreConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
-	18, // 2: org.lfedge.eve.config.Image.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
+	18, // 1: org.lfedge.eve.config.DatastoreConfig.cipherData:type_name -> org.lfedge.eve.config.CipherBlock
+	19, // 2: org.lfedge.eve.config.Image.uuidandversion:type_name -> org.lfedge.eve.config.UUIDandVersion
 	1,  // 3: org.lfedge.eve.config.Image.iformat:type_name -> org.lfedge.eve.config.Format
 	8,  // 4: org.lfedge.eve.config.Image.siginfo:type_name -> org.lfedge.eve.config.SignatureInfo
 	10, // 5: org.lfedge.eve.config.Drive.image:type_name -> org.lfedge.eve.config.Image
@@ -1559,17 +1653,18 @@ var file_config_storage_proto_depIdxs = []int32{
 	5,  // 10: org.lfedge.eve.config.VolumeContentOrigin.type:type_name -> org.lfedge.eve.config.VolumeContentOriginType
 	13, // 11: org.lfedge.eve.config.Volume.origin:type_name -> org.lfedge.eve.config.VolumeContentOrigin
 	4,  // 12: org.lfedge.eve.config.Volume.protocols:type_name -> org.lfedge.eve.config.VolumeAccessProtocols
-	19, // 13: org.lfedge.eve.config.DiskConfig.disk:type_name -> org.lfedge.eve.common.DiskDescription
-	19, // 14: org.lfedge.eve.config.DiskConfig.old_disk:type_name -> org.lfedge.eve.common.DiskDescription
-	6,  // 15: org.lfedge.eve.config.DiskConfig.disk_config:type_name -> org.lfedge.eve.config.DiskConfigType
-	15, // 16: org.lfedge.eve.config.DisksConfig.disks:type_name -> org.lfedge.eve.config.DiskConfig
-	7,  // 17: org.lfedge.eve.config.DisksConfig.array_type:type_name -> org.lfedge.eve.config.DisksArrayType
-	16, // 18: org.lfedge.eve.config.DisksConfig.children:type_name -> org.lfedge.eve.config.DisksConfig
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	15, // 13: org.lfedge.eve.config.Volume.snapshots:type_name -> org.lfedge.eve.config.VolumeSnapshot
+	20, // 14: org.lfedge.eve.config.DiskConfig.disk:type_name -> org.lfedge.eve.common.DiskDescription
+	20, // 15: org.lfedge.eve.config.DiskConfig.old_disk:type_name -> org.lfedge.eve.common.DiskDescription
+	6,  // 16: org.lfedge.eve.config.DiskConfig.disk_config:type_name -> org.lfedge.eve.config.DiskConfigType
+	16, // 17: org.lfedge.eve.config.DisksConfig.disks:type_name -> org.lfedge.eve.config.DiskConfig
+	7,  // 18: org.lfedge.eve.config.DisksConfig.array_type:type_name -> org.lfedge.eve.config.DisksArrayType
+	17, // 19: org.lfedge.eve.config.DisksConfig.children:type_name -> org.lfedge.eve.config.DisksConfig
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_config_storage_proto_init() }
@@ -1665,7 +1760,7 @@ func file_config_storage_proto_init() {
 			}
 		}
 		file_config_storage_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiskConfig); i {
+			switch v := v.(*VolumeSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1677,6 +1772,18 @@ func file_config_storage_proto_init() {
 			}
 		}
 		file_config_storage_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_storage_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisksConfig); i {
 			case 0:
 				return &v.state
@@ -1695,7 +1802,7 @@ func file_config_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_storage_proto_rawDesc,
 			NumEnums:      8,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
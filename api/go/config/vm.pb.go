@@ -85,6 +85,58 @@ func (VmMode) EnumDescriptor() ([]byte, []int) {
 	return file_config_vm_proto_rawDescGZIP(), []int{0}
 }
 
+// Virtual TPM 2.0 device presented to the VM. The state of the TPM
+// persists across reboots of the VM and of EVE and is discarded when the
+// application instance is purged or deleted.
+type VmTpmType int32
+
+const (
+	VmTpmType_VM_TPM_TYPE_NONE VmTpmType = 0
+	VmTpmType_VM_TPM_TYPE_CRB  VmTpmType = 1 // Command Response Buffer interface (x86 only)
+	VmTpmType_VM_TPM_TYPE_TIS  VmTpmType = 2 // TPM Interface Specification interface
+)
+
+// Enum value maps for VmTpmType.
+var (
+	VmTpmType_name = map[int32]string{
+		0: "VM_TPM_TYPE_NONE",
+		1: "VM_TPM_TYPE_CRB",
+		2: "VM_TPM_TYPE_TIS",
+	}
+	VmTpmType_value = map[string]int32{
+		"VM_TPM_TYPE_NONE": 0,
+		"VM_TPM_TYPE_CRB":  1,
+		"VM_TPM_TYPE_TIS":  2,
+	}
+)
+
+func (x VmTpmType) Enum() *VmTpmType {
+	p := new(VmTpmType)
+	*p = x
+	return p
+}
+
+func (x VmTpmType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VmTpmType) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_vm_proto_enumTypes[1].Descriptor()
+}
+
+func (VmTpmType) Type() protoreflect.EnumType {
+	return &file_config_vm_proto_enumTypes[1]
+}
+
+func (x VmTpmType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VmTpmType.Descriptor instead.
+func (VmTpmType) EnumDescriptor() ([]byte, []int) {
+	return file_config_vm_proto_rawDescGZIP(), []int{1}
+}
+
 type VmConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -109,6 +161,8 @@ type VmConfig struct {
 	VncDisplay         uint32   `protobuf:"varint,17,opt,name=vncDisplay,proto3" json:"vncDisplay,omitempty"`
 	VncPasswd          string   `protobuf:"bytes,18,opt,name=vncPasswd,proto3" json:"vncPasswd,omitempty"`
 	DisableLogs        bool     `protobuf:"varint,19,opt,name=disableLogs,proto3" json:"disableLogs,omitempty"`
+	// Only supported for VMs with the KVM hypervisor
+	Vtpm VmTpmType `protobuf:"varint,20,opt,name=vtpm,proto3,enum=org.lfedge.eve.config.VmTpmType" json:"vtpm,omitempty"`
 }
 
 func (x *VmConfig) Reset() {
@@ -276,12 +330,19 @@ func (x *VmConfig) GetDisableLogs() bool {
 	return false
 }
 
+func (x *VmConfig) GetVtpm() VmTpmType {
+	if x != nil {
+		return x.Vtpm
+	}
+	return VmTpmType_VM_TPM_TYPE_NONE
+}
+
 var File_config_vm_proto protoreflect.FileDescriptor
 
 var file_config_vm_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76,
-	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xb5, 0x04, 0x0a, 0x08, 0x56, 0x6d, 0x43,
+	0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xeb, 0x04, 0x0a, 0x08, 0x56, 0x6d, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a,
 	0x07, 0x72, 0x61, 0x6d, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
@@ -317,15 +378,24 @@ var file_config_vm_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6e, 0x63, 0x50, 0x61, 0x73, 0x73, 0x77, 0x64, 0x12, 0x20,
 	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x13, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x2a, 0x47, 0x0a, 0x06, 0x56, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x50, 0x56,
-	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x56, 0x4d, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46,
-	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4d, 0x4c, 0x10, 0x03,
-	0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x48, 0x59, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x0a, 0x0a,
-	0x06, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x05, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67,
-	0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
-	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x34, 0x0a, 0x04, 0x76, 0x74, 0x70, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x6d, 0x54, 0x70, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x76, 0x74, 0x70, 0x6d, 0x2a, 0x47, 0x0a, 0x06, 0x56, 0x6d, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x06, 0x0a, 0x02, 0x50, 0x56, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x56, 0x4d, 0x10,
+	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x46, 0x4d, 0x4c, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x48, 0x59, 0x50, 0x45,
+	0x52, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x10, 0x05, 0x2a,
+	0x4b, 0x0a, 0x09, 0x56, 0x6d, 0x54, 0x70, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10,
+	0x56, 0x4d, 0x5f, 0x54, 0x50, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4d, 0x5f, 0x54, 0x50, 0x4d, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x43, 0x52, 0x42, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4d, 0x5f, 0x54, 0x50,
+	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x49, 0x53, 0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15,
+	0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -340,19 +410,21 @@ func file_config_vm_proto_rawDescGZIP() []byte {
 	return file_config_vm_proto_rawDescData
 }
 
-var file_config_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_vm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_config_vm_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_config_vm_proto_goTypes = []interface{}{
 	(VmMode)(0),      // 0: org.lfedge.eve.config.VmMode
-	(*VmConfig)(nil), // 1: org.lfedge.eve.config.VmConfig
+	(VmTpmType)(0),   // 1: org.lfedge.eve.config.VmTpmType
+	(*VmConfig)(nil), // 2: org.lfedge.eve.config.VmConfig
 }
 var file_config_vm_proto_depIdxs = []int32{
 	0, // 0: org.lfedge.eve.config.VmConfig.virtualizationMode:type_name -> org.lfedge.eve.config.VmMode
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: org.lfedge.eve.config.VmConfig.vtpm:type_name -> org.lfedge.eve.config.VmTpmType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_config_vm_proto_init() }
@@ -379,7 +451,7 @@ func file_config_vm_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_vm_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -25,7 +25,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\xc2\x05\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*f\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\xb6\x06\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\x12\x36\n\x06health\x18\x13 \x01(\x0b\x32&.org.lfedge.eve.config.AppHealthConfig\x12:\n\x0c\x64\x65pendencies\x18\x14 \x03(\x0b\x32$.org.lfedge.eve.config.AppDependency\"c\n\rAppDependency\x12\x10\n\x08\x61pp_uuid\x18\x01 \x01(\t\x12@\n\tcondition\x18\x02 \x01(\x0e\x32-.org.lfedge.eve.config.AppDependencyCondition\"-\n\x0f\x41ppHttpGetProbe\x12\x0c\n\x04port\x18\x01 \x01(\r\x12\x0c\n\x04path\x18\x02 \x01(\t\"!\n\x11\x41ppTcpSocketProbe\x12\x0c\n\x04port\x18\x01 \x01(\r\"\x1f\n\x0c\x41ppExecProbe\x12\x0f\n\x07\x63ommand\x18\x01 \x03(\t\"\xcc\x02\n\x08\x41ppProbe\x12:\n\x08http_get\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.config.AppHttpGetProbeH\x00\x12>\n\ntcp_socket\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.config.AppTcpSocketProbeH\x00\x12\x33\n\x04\x65xec\x18\x03 \x01(\x0b\x32#.org.lfedge.eve.config.AppExecProbeH\x00\x12\x17\n\x0finterface_index\x18\x04 \x01(\r\x12\x15\n\rinitial_delay\x18\x05 \x01(\r\x12\x0e\n\x06period\x18\x06 \x01(\r\x12\x0f\n\x07timeout\x18\x07 \x01(\r\x12\x19\n\x11\x66\x61ilure_threshold\x18\x08 \x01(\r\x12\x19\n\x11success_threshold\x18\t \x01(\rB\x08\n\x06\x61\x63tion\"\xe7\x01\n\x0f\x41ppHealthConfig\x12\x31\n\x08liveness\x18\x01 \x01(\x0b\x32\x1f.org.lfedge.eve.config.AppProbe\x12\x32\n\treadiness\x18\x02 \x01(\x0b\x32\x1f.org.lfedge.eve.config.AppProbe\x12?\n\x0erestart_policy\x18\x03 \x01(\x0e\x32\'.org.lfedge.eve.config.AppRestartPolicy\x12\x17\n\x0f\x62\x61\x63koff_initial\x18\x04 \x01(\r\x12\x13\n\x0b\x62\x61\x63koff_max\x18\x05 \x01(\r\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*f\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03*\x8c\x01\n\x16\x41ppDependencyCondition\x12(\n$APP_DEPENDENCY_CONDITION_UNSPECIFIED\x10\x00\x12$\n APP_DEPENDENCY_CONDITION_RUNNING\x10\x01\x12\"\n\x1e\x41PP_DEPENDENCY_CONDITION_READY\x10\x02*\x96\x01\n\x10\x41ppRestartPolicy\x12\"\n\x1e\x41PP_RESTART_POLICY_UNSPECIFIED\x10\x00\x12\x1d\n\x19\x41PP_RESTART_POLICY_ALWAYS\x10\x01\x12!\n\x1d\x41PP_RESTART_POLICY_ON_FAILURE\x10\x02\x12\x1c\n\x18\x41PP_RESTART_POLICY_NEVER\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,config_dot_vm__pb2.DESCRIPTOR,config_dot_netconfig__pb2.DESCRIPTOR,])
 
@@ -59,16 +59,90 @@ _METADATATYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=994,
-  serialized_end=1096,
+  serialized_start=1895,
+  serialized_end=1997,
 )
 _sym_db.RegisterEnumDescriptor(_METADATATYPE)
 
 MetaDataType = enum_type_wrapper.EnumTypeWrapper(_METADATATYPE)
+_APPDEPENDENCYCONDITION = _descriptor.EnumDescriptor(
+  name='AppDependencyCondition',
+  full_name='org.lfedge.eve.config.AppDependencyCondition',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_CONDITION_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_CONDITION_RUNNING', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_DEPENDENCY_CONDITION_READY', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2000,
+  serialized_end=2140,
+)
+_sym_db.RegisterEnumDescriptor(_APPDEPENDENCYCONDITION)
+
+AppDependencyCondition = enum_type_wrapper.EnumTypeWrapper(_APPDEPENDENCYCONDITION)
+_APPRESTARTPOLICY = _descriptor.EnumDescriptor(
+  name='AppRestartPolicy',
+  full_name='org.lfedge.eve.config.AppRestartPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ALWAYS', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ON_FAILURE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_NEVER', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2143,
+  serialized_end=2293,
+)
+_sym_db.RegisterEnumDescriptor(_APPRESTARTPOLICY)
+
+AppRestartPolicy = enum_type_wrapper.EnumTypeWrapper(_APPRESTARTPOLICY)
 MetaDataDrive = 0
 MetaDataNone = 1
 MetaDataOpenStack = 2
 MetaDataDriveMultipart = 3
+APP_DEPENDENCY_CONDITION_UNSPECIFIED = 0
+APP_DEPENDENCY_CONDITION_RUNNING = 1
+APP_DEPENDENCY_CONDITION_READY = 2
+APP_RESTART_POLICY_UNSPECIFIED = 0
+APP_RESTART_POLICY_ALWAYS = 1
+APP_RESTART_POLICY_ON_FAILURE = 2
+APP_RESTART_POLICY_NEVER = 3
 
 
 
@@ -231,6 +305,20 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health', full_name='org.lfedge.eve.config.AppInstanceConfig.health', index=16,
+      number=19, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='dependencies', full_name='org.lfedge.eve.config.AppInstanceConfig.dependencies', index=17,
+      number=20, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -244,7 +332,302 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=215,
-  serialized_end=921,
+  serialized_end=1037,
+)
+
+
+_APPDEPENDENCY = _descriptor.Descriptor(
+  name='AppDependency',
+  full_name='org.lfedge.eve.config.AppDependency',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='app_uuid', full_name='org.lfedge.eve.config.AppDependency.app_uuid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='condition', full_name='org.lfedge.eve.config.AppDependency.condition', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1039,
+  serialized_end=1138,
+)
+
+
+_APPHTTPGETPROBE = _descriptor.Descriptor(
+  name='AppHttpGetProbe',
+  full_name='org.lfedge.eve.config.AppHttpGetProbe',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='port', full_name='org.lfedge.eve.config.AppHttpGetProbe.port', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='path', full_name='org.lfedge.eve.config.AppHttpGetProbe.path', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1140,
+  serialized_end=1185,
+)
+
+
+_APPTCPSOCKETPROBE = _descriptor.Descriptor(
+  name='AppTcpSocketProbe',
+  full_name='org.lfedge.eve.config.AppTcpSocketProbe',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='port', full_name='org.lfedge.eve.config.AppTcpSocketProbe.port', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1187,
+  serialized_end=1220,
+)
+
+
+_APPEXECPROBE = _descriptor.Descriptor(
+  name='AppExecProbe',
+  full_name='org.lfedge.eve.config.AppExecProbe',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='command', full_name='org.lfedge.eve.config.AppExecProbe.command', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1222,
+  serialized_end=1253,
+)
+
+
+_APPPROBE = _descriptor.Descriptor(
+  name='AppProbe',
+  full_name='org.lfedge.eve.config.AppProbe',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='http_get', full_name='org.lfedge.eve.config.AppProbe.http_get', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='tcp_socket', full_name='org.lfedge.eve.config.AppProbe.tcp_socket', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='exec', full_name='org.lfedge.eve.config.AppProbe.exec', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='interface_index', full_name='org.lfedge.eve.config.AppProbe.interface_index', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='initial_delay', full_name='org.lfedge.eve.config.AppProbe.initial_delay', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='period', full_name='org.lfedge.eve.config.AppProbe.period', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timeout', full_name='org.lfedge.eve.config.AppProbe.timeout', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='failure_threshold', full_name='org.lfedge.eve.config.AppProbe.failure_threshold', index=7,
+      number=8, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='success_threshold', full_name='org.lfedge.eve.config.AppProbe.success_threshold', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+    _descriptor.OneofDescriptor(
+      name='action', full_name='org.lfedge.eve.config.AppProbe.action',
+      index=0, containing_type=None,
+      create_key=_descriptor._internal_create_key,
+    fields=[]),
+  ],
+  serialized_start=1256,
+  serialized_end=1588,
+)
+
+
+_APPHEALTHCONFIG = _descriptor.Descriptor(
+  name='AppHealthConfig',
+  full_name='org.lfedge.eve.config.AppHealthConfig',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='liveness', full_name='org.lfedge.eve.config.AppHealthConfig.liveness', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readiness', full_name='org.lfedge.eve.config.AppHealthConfig.readiness', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_policy', full_name='org.lfedge.eve.config.AppHealthConfig.restart_policy', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backoff_initial', full_name='org.lfedge.eve.config.AppHealthConfig.backoff_initial', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='backoff_max', full_name='org.lfedge.eve.config.AppHealthConfig.backoff_max', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1591,
+  serialized_end=1822,
 )
 
 
@@ -289,8 +672,8 @@ _VOLUMEREF = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=923,
-  serialized_end=992,
+  serialized_start=1824,
+  serialized_end=1893,
 )
 
 _APPINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
@@ -303,10 +686,36 @@ _APPINSTANCECONFIG.fields_by_name['purge'].message_type = _INSTANCEOPSCMD
 _APPINSTANCECONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _APPINSTANCECONFIG.fields_by_name['volumeRefList'].message_type = _VOLUMEREF
 _APPINSTANCECONFIG.fields_by_name['metaDataType'].enum_type = _METADATATYPE
+_APPINSTANCECONFIG.fields_by_name['health'].message_type = _APPHEALTHCONFIG
+_APPINSTANCECONFIG.fields_by_name['dependencies'].message_type = _APPDEPENDENCY
+_APPDEPENDENCY.fields_by_name['condition'].enum_type = _APPDEPENDENCYCONDITION
+_APPPROBE.fields_by_name['http_get'].message_type = _APPHTTPGETPROBE
+_APPPROBE.fields_by_name['tcp_socket'].message_type = _APPTCPSOCKETPROBE
+_APPPROBE.fields_by_name['exec'].message_type = _APPEXECPROBE
+_APPPROBE.oneofs_by_name['action'].fields.append(
+  _APPPROBE.fields_by_name['http_get'])
+_APPPROBE.fields_by_name['http_get'].containing_oneof = _APPPROBE.oneofs_by_name['action']
+_APPPROBE.oneofs_by_name['action'].fields.append(
+  _APPPROBE.fields_by_name['tcp_socket'])
+_APPPROBE.fields_by_name['tcp_socket'].containing_oneof = _APPPROBE.oneofs_by_name['action']
+_APPPROBE.oneofs_by_name['action'].fields.append(
+  _APPPROBE.fields_by_name['exec'])
+_APPPROBE.fields_by_name['exec'].containing_oneof = _APPPROBE.oneofs_by_name['action']
+_APPHEALTHCONFIG.fields_by_name['liveness'].message_type = _APPPROBE
+_APPHEALTHCONFIG.fields_by_name['readiness'].message_type = _APPPROBE
+_APPHEALTHCONFIG.fields_by_name['restart_policy'].enum_type = _APPRESTARTPOLICY
 DESCRIPTOR.message_types_by_name['InstanceOpsCmd'] = _INSTANCEOPSCMD
 DESCRIPTOR.message_types_by_name['AppInstanceConfig'] = _APPINSTANCECONFIG
+DESCRIPTOR.message_types_by_name['AppDependency'] = _APPDEPENDENCY
+DESCRIPTOR.message_types_by_name['AppHttpGetProbe'] = _APPHTTPGETPROBE
+DESCRIPTOR.message_types_by_name['AppTcpSocketProbe'] = _APPTCPSOCKETPROBE
+DESCRIPTOR.message_types_by_name['AppExecProbe'] = _APPEXECPROBE
+DESCRIPTOR.message_types_by_name['AppProbe'] = _APPPROBE
+DESCRIPTOR.message_types_by_name['AppHealthConfig'] = _APPHEALTHCONFIG
 DESCRIPTOR.message_types_by_name['VolumeRef'] = _VOLUMEREF
 DESCRIPTOR.enum_types_by_name['MetaDataType'] = _METADATATYPE
+DESCRIPTOR.enum_types_by_name['AppDependencyCondition'] = _APPDEPENDENCYCONDITION
+DESCRIPTOR.enum_types_by_name['AppRestartPolicy'] = _APPRESTARTPOLICY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_message.Message,), {
@@ -323,6 +732,48 @@ AppInstanceConfig = _reflection.GeneratedProtocolMessageType('AppInstanceConfig'
   })
 _sym_db.RegisterMessage(AppInstanceConfig)
 
+AppDependency = _reflection.GeneratedProtocolMessageType('AppDependency', (_message.Message,), {
+  'DESCRIPTOR' : _APPDEPENDENCY,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppDependency)
+  })
+_sym_db.RegisterMessage(AppDependency)
+
+AppHttpGetProbe = _reflection.GeneratedProtocolMessageType('AppHttpGetProbe', (_message.Message,), {
+  'DESCRIPTOR' : _APPHTTPGETPROBE,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppHttpGetProbe)
+  })
+_sym_db.RegisterMessage(AppHttpGetProbe)
+
+AppTcpSocketProbe = _reflection.GeneratedProtocolMessageType('AppTcpSocketProbe', (_message.Message,), {
+  'DESCRIPTOR' : _APPTCPSOCKETPROBE,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppTcpSocketProbe)
+  })
+_sym_db.RegisterMessage(AppTcpSocketProbe)
+
+AppExecProbe = _reflection.GeneratedProtocolMessageType('AppExecProbe', (_message.Message,), {
+  'DESCRIPTOR' : _APPEXECPROBE,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppExecProbe)
+  })
+_sym_db.RegisterMessage(AppExecProbe)
+
+AppProbe = _reflection.GeneratedProtocolMessageType('AppProbe', (_message.Message,), {
+  'DESCRIPTOR' : _APPPROBE,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppProbe)
+  })
+_sym_db.RegisterMessage(AppProbe)
+
+AppHealthConfig = _reflection.GeneratedProtocolMessageType('AppHealthConfig', (_message.Message,), {
+  'DESCRIPTOR' : _APPHEALTHCONFIG,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppHealthConfig)
+  })
+_sym_db.RegisterMessage(AppHealthConfig)
+
 VolumeRef = _reflection.GeneratedProtocolMessageType('VolumeRef', (_message.Message,), {
   'DESCRIPTOR' : _VOLUMEREF,
   '__module__' : 'config.appconfig_pb2'
@@ -22,7 +22,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xf9\x01\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"^\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\tB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/netconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x0f\x63onfig/fw.proto\x1a\x13\x63onfig/netcmn.proto\"\x9f\x02\n\rNetworkConfig\x12\n\n\x02id\x18\x01 \x01(\t\x12\x30\n\x04type\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.config.NetworkType\x12)\n\x02ip\x18\x06 \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18\x07 \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x34\n\x08\x65ntProxy\x18\x08 \x01(\x0b\x32\".org.lfedge.eve.config.ProxyConfig\x12\x37\n\x08wireless\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.WirelessConfig\"\xb5\x02\n\x0eNetworkAdapter\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnetworkId\x18\x03 \x01(\t\x12\x0c\n\x04\x61\x64\x64r\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x11\n\tcryptoEid\x18\n \x01(\t\x12\x15\n\rlispsignature\x18\x06 \x01(\t\x12\x0f\n\x07pemcert\x18\x07 \x01(\x0c\x12\x15\n\rpemprivatekey\x18\x08 \x01(\x0c\x12\x12\n\nmacAddress\x18\t \x01(\t\x12(\n\x04\x61\x63ls\x18( \x03(\x0b\x32\x1a.org.lfedge.eve.config.ACE\x12\x16\n\x0e\x61\x63\x63\x65ss_vlan_id\x18) \x01(\r\x12:\n\tbandwidth\x18* \x01(\x0b\x32\'.org.lfedge.eve.config.AdapterBandwidth\"?\n\x10\x41\x64\x61pterBandwidth\x12\x15\n\ringress_limit\x18\x01 \x01(\r\x12\x14\n\x0c\x65gress_limit\x18\x02 \x01(\r\"\xb3\x01\n\x0eWirelessConfig\x12\x31\n\x04type\x18\x01 \x01(\x0e\x32#.org.lfedge.eve.config.WirelessType\x12:\n\x0b\x63\x65llularCfg\x18\x05 \x03(\x0b\x32%.org.lfedge.eve.config.CellularConfig\x12\x32\n\x07wifiCfg\x18\n \x03(\x0b\x32!.org.lfedge.eve.config.WifiConfig\"^\n\x0e\x43\x65llularConfig\x12\x0b\n\x03\x41PN\x18\x01 \x01(\t\x12?\n\x05probe\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.CellularConnectivityProbe\"C\n\x19\x43\x65llularConnectivityProbe\x12\x0f\n\x07\x64isable\x18\x01 \x01(\x08\x12\x15\n\rprobe_address\x18\x02 \x01(\t\"\xb7\x02\n\nWifiConfig\x12\x10\n\x08wifiSSID\x18\x01 \x01(\t\x12\x37\n\tkeyScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.config.WiFiKeyScheme\x12\x10\n\x08identity\x18\x05 \x01(\t\x12\x10\n\x08password\x18\n \x01(\t\x12=\n\x06\x63rypto\x18\x14 \x01(\x0b\x32-.org.lfedge.eve.config.WifiConfig.cryptoblock\x12\x10\n\x08priority\x18\x19 \x01(\x05\x12\x36\n\ncipherData\x18\x1e \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x1a\x31\n\x0b\x63ryptoblock\x12\x10\n\x08identity\x18\x0b \x01(\t\x12\x10\n\x08password\x18\x0c \x01(\tB=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_fw__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
 
@@ -181,6 +181,13 @@ _NETWORKADAPTER = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='bandwidth', full_name='org.lfedge.eve.config.NetworkAdapter.bandwidth', index=11,
+      number=42, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -194,7 +201,46 @@ _NETWORKADAPTER = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=404,
-  serialized_end=653,
+  serialized_end=713,
+)
+
+
+_ADAPTERBANDWIDTH = _descriptor.Descriptor(
+  name='AdapterBandwidth',
+  full_name='org.lfedge.eve.config.AdapterBandwidth',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ingress_limit', full_name='org.lfedge.eve.config.AdapterBandwidth.ingress_limit', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='egress_limit', full_name='org.lfedge.eve.config.AdapterBandwidth.egress_limit', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=715,
+  serialized_end=778,
 )
 
 
@@ -239,8 +285,8 @@ _WIRELESSCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=656,
-  serialized_end=835,
+  serialized_start=781,
+  serialized_end=960,
 )
 
 
@@ -278,8 +324,8 @@ _CELLULARCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=837,
-  serialized_end=931,
+  serialized_start=962,
+  serialized_end=1056,
 )
 
 
@@ -317,8 +363,8 @@ _CELLULARCONNECTIVITYPROBE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=933,
-  serialized_end=1000,
+  serialized_start=1058,
+  serialized_end=1125,
 )
 
 
@@ -356,8 +402,8 @@ _WIFICONFIG_CRYPTOBLOCK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1265,
-  serialized_end=1314,
+  serialized_start=1390,
+  serialized_end=1439,
 )
 
 _WIFICONFIG = _descriptor.Descriptor(
@@ -429,8 +475,8 @@ _WIFICONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1003,
-  serialized_end=1314,
+  serialized_start=1128,
+  serialized_end=1439,
 )
 
 _NETWORKCONFIG.fields_by_name['type'].enum_type = config_dot_netcmn__pb2._NETWORKTYPE
@@ -439,6 +485,7 @@ _NETWORKCONFIG.fields_by_name['dns'].message_type = config_dot_netcmn__pb2._ZNET
 _NETWORKCONFIG.fields_by_name['entProxy'].message_type = config_dot_netcmn__pb2._PROXYCONFIG
 _NETWORKCONFIG.fields_by_name['wireless'].message_type = _WIRELESSCONFIG
 _NETWORKADAPTER.fields_by_name['acls'].message_type = config_dot_fw__pb2._ACE
+_NETWORKADAPTER.fields_by_name['bandwidth'].message_type = _ADAPTERBANDWIDTH
 _WIRELESSCONFIG.fields_by_name['type'].enum_type = config_dot_netcmn__pb2._WIRELESSTYPE
 _WIRELESSCONFIG.fields_by_name['cellularCfg'].message_type = _CELLULARCONFIG
 _WIRELESSCONFIG.fields_by_name['wifiCfg'].message_type = _WIFICONFIG
@@ -449,6 +496,7 @@ _WIFICONFIG.fields_by_name['crypto'].message_type = _WIFICONFIG_CRYPTOBLOCK
 _WIFICONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 DESCRIPTOR.message_types_by_name['NetworkConfig'] = _NETWORKCONFIG
 DESCRIPTOR.message_types_by_name['NetworkAdapter'] = _NETWORKADAPTER
+DESCRIPTOR.message_types_by_name['AdapterBandwidth'] = _ADAPTERBANDWIDTH
 DESCRIPTOR.message_types_by_name['WirelessConfig'] = _WIRELESSCONFIG
 DESCRIPTOR.message_types_by_name['CellularConfig'] = _CELLULARCONFIG
 DESCRIPTOR.message_types_by_name['CellularConnectivityProbe'] = _CELLULARCONNECTIVITYPROBE
@@ -469,6 +517,13 @@ NetworkAdapter = _reflection.GeneratedProtocolMessageType('NetworkAdapter', (_me
   })
 _sym_db.RegisterMessage(NetworkAdapter)
 
+AdapterBandwidth = _reflection.GeneratedProtocolMessageType('AdapterBandwidth', (_message.Message,), {
+  'DESCRIPTOR' : _ADAPTERBANDWIDTH,
+  '__module__' : 'config.netconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AdapterBandwidth)
+  })
+_sym_db.RegisterMessage(AdapterBandwidth)
+
 WirelessConfig = _reflection.GeneratedProtocolMessageType('WirelessConfig', (_message.Message,), {
   'DESCRIPTOR' : _WIRELESSCONFIG,
   '__module__' : 'config.netconfig_pb2'
@@ -12,6 +12,7 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from config import acipherinfo_pb2 as config_dot_acipherinfo__pb2
 from config import devcommon_pb2 as config_dot_devcommon__pb2
 from config import netcmn_pb2 as config_dot_netcmn__pb2
 
@@ -22,9 +23,9 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x14\x63onfig/netinst.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x13\x63onfig/netcmn.proto\"\xb3\x01\n\x1bNetworkInstanceOpaqueConfig\x12\x0f\n\x07oconfig\x18\x01 \x01(\t\x12\x44\n\nlispConfig\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.NetworkInstanceLispConfig\x12=\n\x04type\x18\x03 \x01(\x0e\x32/.org.lfedge.eve.config.ZNetworkOpaqueConfigType\"l\n\x0eZcServicePoint\x12\x34\n\x06zsType\x18\x03 \x01(\x0e\x32$.org.lfedge.eve.config.ZcServiceType\x12\x10\n\x08NameOrIp\x18\x01 \x01(\t\x12\x12\n\nCredential\x18\x02 \x01(\t\"\xe1\x01\n\x19NetworkInstanceLispConfig\x12\x36\n\x07LispMSs\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.ZcServicePoint\x12\x16\n\x0eLispInstanceId\x18\x02 \x01(\r\x12\x10\n\x08\x61llocate\x18\x03 \x01(\x08\x12\x15\n\rexportprivate\x18\x04 \x01(\x08\x12\x18\n\x10\x61llocationprefix\x18\x05 \x01(\x0c\x12\x1b\n\x13\x61llocationprefixlen\x18\x06 \x01(\r\x12\x14\n\x0c\x65xperimental\x18\x14 \x01(\x08\"\xbe\x03\n\x15NetworkInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x39\n\x08instType\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.ZNetworkInstType\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12,\n\x04port\x18\x14 \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12?\n\x03\x63\x66g\x18\x1e \x01(\x0b\x32\x32.org.lfedge.eve.config.NetworkInstanceOpaqueConfig\x12\x32\n\x06ipType\x18\' \x01(\x0e\x32\".org.lfedge.eve.config.AddressType\x12)\n\x02ip\x18( \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18) \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry*\xb3\x01\n\x10ZNetworkInstType\x12\x11\n\rZNetInstFirst\x10\x00\x12\x12\n\x0eZnetInstSwitch\x10\x01\x12\x11\n\rZnetInstLocal\x10\x02\x12\x11\n\rZnetInstCloud\x10\x03\x12\x10\n\x0cZnetInstMesh\x10\x04\x12\x14\n\x10ZnetInstHoneyPot\x10\x05\x12\x17\n\x13ZnetInstTransparent\x10\x06\x12\x11\n\x0cZNetInstLast\x10\xff\x01*W\n\x0b\x41\x64\x64ressType\x12\t\n\x05\x46irst\x10\x00\x12\x08\n\x04IPV4\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x0e\n\nCryptoIPV4\x10\x03\x12\x0e\n\nCryptoIPV6\x10\x04\x12\t\n\x04Last\x10\xff\x01*C\n\x18ZNetworkOpaqueConfigType\x12\x12\n\x0eZNetOConfigVPN\x10\x00\x12\x13\n\x0fZNetOConfigLisp\x10\x01*G\n\rZcServiceType\x12\x14\n\x10zcloudInvalidSrv\x10\x00\x12\r\n\tmapServer\x10\x01\x12\x11\n\rsupportServer\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x14\x63onfig/netinst.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x13\x63onfig/netcmn.proto\"\xeb\x01\n\x1bNetworkInstanceOpaqueConfig\x12\x0f\n\x07oconfig\x18\x01 \x01(\t\x12\x44\n\nlispConfig\x18\x02 \x01(\x0b\x32\x30.org.lfedge.eve.config.NetworkInstanceLispConfig\x12=\n\x04type\x18\x03 \x01(\x0e\x32/.org.lfedge.eve.config.ZNetworkOpaqueConfigType\x12\x36\n\ncipherData\x18\x04 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\"l\n\x0eZcServicePoint\x12\x34\n\x06zsType\x18\x03 \x01(\x0e\x32$.org.lfedge.eve.config.ZcServiceType\x12\x10\n\x08NameOrIp\x18\x01 \x01(\t\x12\x12\n\nCredential\x18\x02 \x01(\t\"\xe1\x01\n\x19NetworkInstanceLispConfig\x12\x36\n\x07LispMSs\x18\x01 \x03(\x0b\x32%.org.lfedge.eve.config.ZcServicePoint\x12\x16\n\x0eLispInstanceId\x18\x02 \x01(\r\x12\x10\n\x08\x61llocate\x18\x03 \x01(\x08\x12\x15\n\rexportprivate\x18\x04 \x01(\x08\x12\x18\n\x10\x61llocationprefix\x18\x05 \x01(\x0c\x12\x1b\n\x13\x61llocationprefixlen\x18\x06 \x01(\r\x12\x14\n\x0c\x65xperimental\x18\x14 \x01(\x08\"\xe5\x04\n\x15NetworkInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x39\n\x08instType\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.ZNetworkInstType\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12,\n\x04port\x18\x14 \x01(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12?\n\x03\x63\x66g\x18\x1e \x01(\x0b\x32\x32.org.lfedge.eve.config.NetworkInstanceOpaqueConfig\x12\x32\n\x06ipType\x18\' \x01(\x0e\x32\".org.lfedge.eve.config.AddressType\x12)\n\x02ip\x18( \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12\x36\n\x03\x64ns\x18) \x03(\x0b\x32).org.lfedge.eve.config.ZnetStaticDNSEntry\x12\x39\n\x0c\x66lowExporter\x18* \x01(\x0b\x32#.org.lfedge.eve.config.FlowExporter\x12+\n\x04ipv6\x18+ \x01(\x0b\x32\x1d.org.lfedge.eve.config.ipspec\x12=\n\x0eipv6UplinkMode\x18, \x01(\x0e\x32%.org.lfedge.eve.config.IPv6UplinkMode\"y\n\x0c\x46lowExporter\x12\x11\n\tcollector\x18\x01 \x01(\t\x12;\n\x08protocol\x18\x02 \x01(\x0e\x32).org.lfedge.eve.config.FlowExportProtocol\x12\x19\n\x11\x65nterprise_number\x18\x03 \x01(\r*\xb3\x01\n\x10ZNetworkInstType\x12\x11\n\rZNetInstFirst\x10\x00\x12\x12\n\x0eZnetInstSwitch\x10\x01\x12\x11\n\rZnetInstLocal\x10\x02\x12\x11\n\rZnetInstCloud\x10\x03\x12\x10\n\x0cZnetInstMesh\x10\x04\x12\x14\n\x10ZnetInstHoneyPot\x10\x05\x12\x17\n\x13ZnetInstTransparent\x10\x06\x12\x11\n\x0cZNetInstLast\x10\xff\x01*W\n\x0b\x41\x64\x64ressType\x12\t\n\x05\x46irst\x10\x00\x12\x08\n\x04IPV4\x10\x01\x12\x08\n\x04IPV6\x10\x02\x12\x0e\n\nCryptoIPV4\x10\x03\x12\x0e\n\nCryptoIPV6\x10\x04\x12\t\n\x04Last\x10\xff\x01*r\n\x18ZNetworkOpaqueConfigType\x12\x12\n\x0eZNetOConfigVPN\x10\x00\x12\x13\n\x0fZNetOConfigLisp\x10\x01\x12\x18\n\x14ZNetOConfigWireGuard\x10\x02\x12\x13\n\x0fZNetOConfigMesh\x10\x03*G\n\rZcServiceType\x12\x14\n\x10zcloudInvalidSrv\x10\x00\x12\r\n\tmapServer\x10\x01\x12\x11\n\rsupportServer\x10\x02*I\n\x0eIPv6UplinkMode\x12\x1a\n\x16IPV6_UPLINK_MODE_NAT66\x10\x00\x12\x1b\n\x17IPV6_UPLINK_MODE_ROUTED\x10\x01*Y\n\x12\x46lowExportProtocol\x12\x1e\n\x1a\x46LOW_EXPORT_PROTOCOL_IPFIX\x10\x00\x12#\n\x1f\x46LOW_EXPORT_PROTOCOL_NETFLOW_V9\x10\x01\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
-  dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
+  dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_netcmn__pb2.DESCRIPTOR,])
 
 _ZNETWORKINSTTYPE = _descriptor.EnumDescriptor(
   name='ZNetworkInstType',
@@ -76,8 +77,8 @@ _ZNETWORKINSTTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1062,
-  serialized_end=1241,
+  serialized_start=1434,
+  serialized_end=1613,
 )
 _sym_db.RegisterEnumDescriptor(_ZNETWORKINSTTYPE)
 
@@ -122,8 +123,8 @@ _ADDRESSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1243,
-  serialized_end=1330,
+  serialized_start=1615,
+  serialized_end=1702,
 )
 _sym_db.RegisterEnumDescriptor(_ADDRESSTYPE)
 
@@ -145,11 +146,21 @@ _ZNETWORKOPAQUECONFIGTYPE = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ZNetOConfigWireGuard', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='ZNetOConfigMesh', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1332,
-  serialized_end=1399,
+  serialized_start=1704,
+  serialized_end=1818,
 )
 _sym_db.RegisterEnumDescriptor(_ZNETWORKOPAQUECONFIGTYPE)
 
@@ -179,12 +190,64 @@ _ZCSERVICETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1401,
-  serialized_end=1472,
+  serialized_start=1820,
+  serialized_end=1891,
 )
 _sym_db.RegisterEnumDescriptor(_ZCSERVICETYPE)
 
 ZcServiceType = enum_type_wrapper.EnumTypeWrapper(_ZCSERVICETYPE)
+_IPV6UPLINKMODE = _descriptor.EnumDescriptor(
+  name='IPv6UplinkMode',
+  full_name='org.lfedge.eve.config.IPv6UplinkMode',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='IPV6_UPLINK_MODE_NAT66', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='IPV6_UPLINK_MODE_ROUTED', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1893,
+  serialized_end=1966,
+)
+_sym_db.RegisterEnumDescriptor(_IPV6UPLINKMODE)
+
+IPv6UplinkMode = enum_type_wrapper.EnumTypeWrapper(_IPV6UPLINKMODE)
+_FLOWEXPORTPROTOCOL = _descriptor.EnumDescriptor(
+  name='FlowExportProtocol',
+  full_name='org.lfedge.eve.config.FlowExportProtocol',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='FLOW_EXPORT_PROTOCOL_IPFIX', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='FLOW_EXPORT_PROTOCOL_NETFLOW_V9', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1968,
+  serialized_end=2057,
+)
+_sym_db.RegisterEnumDescriptor(_FLOWEXPORTPROTOCOL)
+
+FlowExportProtocol = enum_type_wrapper.EnumTypeWrapper(_FLOWEXPORTPROTOCOL)
 ZNetInstFirst = 0
 ZnetInstSwitch = 1
 ZnetInstLocal = 2
@@ -201,9 +264,15 @@ CryptoIPV6 = 4
 Last = 255
 ZNetOConfigVPN = 0
 ZNetOConfigLisp = 1
+ZNetOConfigWireGuard = 2
+ZNetOConfigMesh = 3
 zcloudInvalidSrv = 0
 mapServer = 1
 supportServer = 2
+IPV6_UPLINK_MODE_NAT66 = 0
+IPV6_UPLINK_MODE_ROUTED = 1
+FLOW_EXPORT_PROTOCOL_IPFIX = 0
+FLOW_EXPORT_PROTOCOL_NETFLOW_V9 = 1
 
 
 
@@ -236,6 +305,13 @@ _NETWORKINSTANCEOPAQUECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='cipherData', full_name='org.lfedge.eve.config.NetworkInstanceOpaqueConfig.cipherData', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -248,8 +324,8 @@ _NETWORKINSTANCEOPAQUECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=93,
-  serialized_end=272,
+  serialized_start=119,
+  serialized_end=354,
 )
 
 
@@ -294,8 +370,8 @@ _ZCSERVICEPOINT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=274,
-  serialized_end=382,
+  serialized_start=356,
+  serialized_end=464,
 )
 
 
@@ -368,8 +444,8 @@ _NETWORKINSTANCELISPCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=385,
-  serialized_end=610,
+  serialized_start=467,
+  serialized_end=692,
 )
 
 
@@ -444,6 +520,73 @@ _NETWORKINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='flowExporter', full_name='org.lfedge.eve.config.NetworkInstanceConfig.flowExporter', index=9,
+      number=42, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ipv6', full_name='org.lfedge.eve.config.NetworkInstanceConfig.ipv6', index=10,
+      number=43, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ipv6UplinkMode', full_name='org.lfedge.eve.config.NetworkInstanceConfig.ipv6UplinkMode', index=11,
+      number=44, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=695,
+  serialized_end=1308,
+)
+
+
+_FLOWEXPORTER = _descriptor.Descriptor(
+  name='FlowExporter',
+  full_name='org.lfedge.eve.config.FlowExporter',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='collector', full_name='org.lfedge.eve.config.FlowExporter.collector', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='protocol', full_name='org.lfedge.eve.config.FlowExporter.protocol', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='enterprise_number', full_name='org.lfedge.eve.config.FlowExporter.enterprise_number', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -456,12 +599,13 @@ _NETWORKINSTANCECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=613,
-  serialized_end=1059,
+  serialized_start=1310,
+  serialized_end=1431,
 )
 
 _NETWORKINSTANCEOPAQUECONFIG.fields_by_name['lispConfig'].message_type = _NETWORKINSTANCELISPCONFIG
 _NETWORKINSTANCEOPAQUECONFIG.fields_by_name['type'].enum_type = _ZNETWORKOPAQUECONFIGTYPE
+_NETWORKINSTANCEOPAQUECONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _ZCSERVICEPOINT.fields_by_name['zsType'].enum_type = _ZCSERVICETYPE
 _NETWORKINSTANCELISPCONFIG.fields_by_name['LispMSs'].message_type = _ZCSERVICEPOINT
 _NETWORKINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
@@ -471,14 +615,21 @@ _NETWORKINSTANCECONFIG.fields_by_name['cfg'].message_type = _NETWORKINSTANCEOPAQ
 _NETWORKINSTANCECONFIG.fields_by_name['ipType'].enum_type = _ADDRESSTYPE
 _NETWORKINSTANCECONFIG.fields_by_name['ip'].message_type = config_dot_netcmn__pb2._IPSPEC
 _NETWORKINSTANCECONFIG.fields_by_name['dns'].message_type = config_dot_netcmn__pb2._ZNETSTATICDNSENTRY
+_NETWORKINSTANCECONFIG.fields_by_name['flowExporter'].message_type = _FLOWEXPORTER
+_NETWORKINSTANCECONFIG.fields_by_name['ipv6'].message_type = config_dot_netcmn__pb2._IPSPEC
+_NETWORKINSTANCECONFIG.fields_by_name['ipv6UplinkMode'].enum_type = _IPV6UPLINKMODE
+_FLOWEXPORTER.fields_by_name['protocol'].enum_type = _FLOWEXPORTPROTOCOL
 DESCRIPTOR.message_types_by_name['NetworkInstanceOpaqueConfig'] = _NETWORKINSTANCEOPAQUECONFIG
 DESCRIPTOR.message_types_by_name['ZcServicePoint'] = _ZCSERVICEPOINT
 DESCRIPTOR.message_types_by_name['NetworkInstanceLispConfig'] = _NETWORKINSTANCELISPCONFIG
 DESCRIPTOR.message_types_by_name['NetworkInstanceConfig'] = _NETWORKINSTANCECONFIG
+DESCRIPTOR.message_types_by_name['FlowExporter'] = _FLOWEXPORTER
 DESCRIPTOR.enum_types_by_name['ZNetworkInstType'] = _ZNETWORKINSTTYPE
 DESCRIPTOR.enum_types_by_name['AddressType'] = _ADDRESSTYPE
 DESCRIPTOR.enum_types_by_name['ZNetworkOpaqueConfigType'] = _ZNETWORKOPAQUECONFIGTYPE
 DESCRIPTOR.enum_types_by_name['ZcServiceType'] = _ZCSERVICETYPE
+DESCRIPTOR.enum_types_by_name['IPv6UplinkMode'] = _IPV6UPLINKMODE
+DESCRIPTOR.enum_types_by_name['FlowExportProtocol'] = _FLOWEXPORTPROTOCOL
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 NetworkInstanceOpaqueConfig = _reflection.GeneratedProtocolMessageType('NetworkInstanceOpaqueConfig', (_message.Message,), {
@@ -509,6 +660,13 @@ NetworkInstanceConfig = _reflection.GeneratedProtocolMessageType('NetworkInstanc
   })
 _sym_db.RegisterMessage(NetworkInstanceConfig)
 
+FlowExporter = _reflection.GeneratedProtocolMessageType('FlowExporter', (_message.Message,), {
+  'DESCRIPTOR' : _FLOWEXPORTER,
+  '__module__' : 'config.netinst_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.FlowExporter)
+  })
+_sym_db.RegisterMessage(FlowExporter)
+
 
 DESCRIPTOR._options = None
 # @@protoc_insertion_point(module_scope)
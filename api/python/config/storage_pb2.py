@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x14\x63onfig/storage.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x18\x63onfig/acipherinfo.proto\x1a\x19\x65vecommon/evecommon.proto\"P\n\rSignatureInfo\x12\x15\n\rintercertsurl\x18\x01 \x01(\t\x12\x15\n\rsignercerturl\x18\x02 \x01(\t\x12\x11\n\tsignature\x18\x03 \x01(\x0c\"\xe5\x01\n\x0f\x44\x61tastoreConfig\x12\n\n\x02id\x18\x64 \x01(\t\x12,\n\x05\x64Type\x18\x01 \x01(\x0e\x32\x1d.org.lfedge.eve.config.DsType\x12\x0c\n\x04\x66qdn\x18\x02 \x01(\t\x12\x0e\n\x06\x61piKey\x18\x03 \x01(\t\x12\x10\n\x08password\x18\x04 \x01(\t\x12\r\n\x05\x64path\x18\x05 \x01(\t\x12\x0e\n\x06region\x18\x06 \x01(\t\x12\x36\n\ncipherData\x18\x07 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x11\n\tdsCertPEM\x18\x08 \x03(\x0c\"\xec\x01\n\x05Image\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x35\n\x07siginfo\x18\x05 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x0c\n\x04\x64sId\x18\x06 \x01(\t\x12\x11\n\tsizeBytes\x18\x08 \x01(\x03\"\xd0\x01\n\x05\x44rive\x12+\n\x05image\x18\x01 \x01(\x0b\x32\x1c.org.lfedge.eve.config.Image\x12\x10\n\x08readonly\x18\x05 \x01(\x08\x12\x10\n\x08preserve\x18\x06 \x01(\x08\x12\x31\n\x07\x64rvtype\x18\x08 \x01(\x0e\x32 .org.lfedge.eve.config.DriveType\x12-\n\x06target\x18\t \x01(\x0e\x32\x1d.org.lfedge.eve.config.Target\x12\x14\n\x0cmaxsizebytes\x18\n \x01(\x03\"\xf2\x01\n\x0b\x43ontentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04\x64sId\x18\x02 \x01(\t\x12\x0b\n\x03URL\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x0e\n\x06sha256\x18\x05 \x01(\t\x12\x14\n\x0cmaxSizeBytes\x18\x06 \x01(\x04\x12\x35\n\x07siginfo\x18\x07 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x13\n\x0b\x64isplayName\x18\x08 \x01(\t\x12\x18\n\x10generation_count\x18\t \x01(\x03\"r\n\x13VolumeContentOrigin\x12<\n\x04type\x18\x01 \x01(\x0e\x32..org.lfedge.eve.config.VolumeContentOriginType\x12\x1d\n\x15\x64ownloadContentTreeID\x18\x02 \x01(\t\"\xfd\x01\n\x06Volume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12:\n\x06origin\x18\x02 \x01(\x0b\x32*.org.lfedge.eve.config.VolumeContentOrigin\x12?\n\tprotocols\x18\x03 \x03(\x0e\x32,.org.lfedge.eve.config.VolumeAccessProtocols\x12\x17\n\x0fgenerationCount\x18\x04 \x01(\x03\x12\x14\n\x0cmaxsizebytes\x18\x05 \x01(\x03\x12\x10\n\x08readonly\x18\x06 \x01(\x08\x12\x13\n\x0b\x64isplayName\x18\x07 \x01(\t\x12\x12\n\nclear_text\x18\x08 \x01(\x08\"\xb8\x01\n\nDiskConfig\x12\x34\n\x04\x64isk\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x38\n\x08old_disk\x18\x02 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12:\n\x0b\x64isk_config\x18\x03 \x01(\x0e\x32%.org.lfedge.eve.config.DiskConfigType\"\xb0\x01\n\x0b\x44isksConfig\x12\x30\n\x05\x64isks\x18\x01 \x03(\x0b\x32!.org.lfedge.eve.config.DiskConfig\x12\x39\n\narray_type\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.config.DisksArrayType\x12\x34\n\x08\x63hildren\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.config.DisksConfig*\x85\x01\n\x06\x44sType\x12\r\n\tDsUnknown\x10\x00\x12\n\n\x06\x44sHttp\x10\x01\x12\x0b\n\x07\x44sHttps\x10\x02\x12\x08\n\x04\x44sS3\x10\x03\x12\n\n\x06\x44sSFTP\x10\x04\x12\x17\n\x13\x44sContainerRegistry\x10\x05\x12\x0f\n\x0b\x44sAzureBlob\x10\x06\x12\x13\n\x0f\x44sGoogleStorage\x10\x07*k\n\x06\x46ormat\x12\x0e\n\nFmtUnknown\x10\x00\x12\x07\n\x03RAW\x10\x01\x12\x08\n\x04QCOW\x10\x02\x12\t\n\x05QCOW2\x10\x03\x12\x07\n\x03VHD\x10\x04\x12\x08\n\x04VMDK\x10\x05\x12\x07\n\x03OVA\x10\x06\x12\x08\n\x04VHDX\x10\x07\x12\r\n\tCONTAINER\x10\x08*G\n\x06Target\x12\x0e\n\nTgtUnknown\x10\x00\x12\x08\n\x04\x44isk\x10\x01\x12\n\n\x06Kernel\x10\x02\x12\n\n\x06Initrd\x10\x03\x12\x0b\n\x07RamDisk\x10\x04*I\n\tDriveType\x12\x10\n\x0cUnclassified\x10\x00\x12\t\n\x05\x43\x44ROM\x10\x01\x12\x07\n\x03HDD\x10\x02\x12\x07\n\x03NET\x10\x03\x12\r\n\tHDD_EMPTY\x10\x04*1\n\x15VolumeAccessProtocols\x12\x0c\n\x08VAP_NONE\x10\x00\x12\n\n\x06VAP_9P\x10\x01*N\n\x17VolumeContentOriginType\x12\x10\n\x0cVCOT_UNKNOWN\x10\x00\x12\x0e\n\nVCOT_BLANK\x10\x01\x12\x11\n\rVCOT_DOWNLOAD\x10\x02*\xec\x01\n\x0e\x44iskConfigType\x12 \n\x1c\x44ISK_CONFIG_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISK_CONFIG_TYPE_EVEOS\x10\x01\x12\x1c\n\x18\x44ISK_CONFIG_TYPE_PERSIST\x10\x02\x12\x1f\n\x1b\x44ISK_CONFIG_TYPE_ZFS_ONLINE\x10\x03\x12 \n\x1c\x44ISK_CONFIG_TYPE_ZFS_OFFLINE\x10\x04\x12\x1e\n\x1a\x44ISK_CONFIG_TYPE_APPDIRECT\x10\x05\x12\x1b\n\x17\x44ISK_CONFIG_TYPE_UNUSED\x10\x06*\xa2\x01\n\x0e\x44isksArrayType\x12 \n\x1c\x44ISKS_ARRAY_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID0\x10\x01\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID1\x10\x02\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID5\x10\x03\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID6\x10\x04\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x14\x63onfig/storage.proto\x12\x15org.lfedge.eve.config\x1a\x16\x63onfig/devcommon.proto\x1a\x18\x63onfig/acipherinfo.proto\x1a\x19\x65vecommon/evecommon.proto\"P\n\rSignatureInfo\x12\x15\n\rintercertsurl\x18\x01 \x01(\t\x12\x15\n\rsignercerturl\x18\x02 \x01(\t\x12\x11\n\tsignature\x18\x03 \x01(\x0c\"\xe5\x01\n\x0f\x44\x61tastoreConfig\x12\n\n\x02id\x18\x64 \x01(\t\x12,\n\x05\x64Type\x18\x01 \x01(\x0e\x32\x1d.org.lfedge.eve.config.DsType\x12\x0c\n\x04\x66qdn\x18\x02 \x01(\t\x12\x0e\n\x06\x61piKey\x18\x03 \x01(\t\x12\x10\n\x08password\x18\x04 \x01(\t\x12\r\n\x05\x64path\x18\x05 \x01(\t\x12\x0e\n\x06region\x18\x06 \x01(\t\x12\x36\n\ncipherData\x18\x07 \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x11\n\tdsCertPEM\x18\x08 \x03(\x0c\"\xec\x01\n\x05Image\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x35\n\x07siginfo\x18\x05 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x0c\n\x04\x64sId\x18\x06 \x01(\t\x12\x11\n\tsizeBytes\x18\x08 \x01(\x03\"\xd0\x01\n\x05\x44rive\x12+\n\x05image\x18\x01 \x01(\x0b\x32\x1c.org.lfedge.eve.config.Image\x12\x10\n\x08readonly\x18\x05 \x01(\x08\x12\x10\n\x08preserve\x18\x06 \x01(\x08\x12\x31\n\x07\x64rvtype\x18\x08 \x01(\x0e\x32 .org.lfedge.eve.config.DriveType\x12-\n\x06target\x18\t \x01(\x0e\x32\x1d.org.lfedge.eve.config.Target\x12\x14\n\x0cmaxsizebytes\x18\n \x01(\x03\"\xf2\x01\n\x0b\x43ontentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04\x64sId\x18\x02 \x01(\t\x12\x0b\n\x03URL\x18\x03 \x01(\t\x12.\n\x07iformat\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.config.Format\x12\x0e\n\x06sha256\x18\x05 \x01(\t\x12\x14\n\x0cmaxSizeBytes\x18\x06 \x01(\x04\x12\x35\n\x07siginfo\x18\x07 \x01(\x0b\x32$.org.lfedge.eve.config.SignatureInfo\x12\x13\n\x0b\x64isplayName\x18\x08 \x01(\t\x12\x18\n\x10generation_count\x18\t \x01(\x03\"r\n\x13VolumeContentOrigin\x12<\n\x04type\x18\x01 \x01(\x0e\x32..org.lfedge.eve.config.VolumeContentOriginType\x12\x1d\n\x15\x64ownloadContentTreeID\x18\x02 \x01(\t\"\xb7\x02\n\x06Volume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12:\n\x06origin\x18\x02 \x01(\x0b\x32*.org.lfedge.eve.config.VolumeContentOrigin\x12?\n\tprotocols\x18\x03 \x03(\x0e\x32,.org.lfedge.eve.config.VolumeAccessProtocols\x12\x17\n\x0fgenerationCount\x18\x04 \x01(\x03\x12\x14\n\x0cmaxsizebytes\x18\x05 \x01(\x03\x12\x10\n\x08readonly\x18\x06 \x01(\x08\x12\x13\n\x0b\x64isplayName\x18\x07 \x01(\t\x12\x12\n\nclear_text\x18\x08 \x01(\x08\x12\x38\n\tsnapshots\x18\t \x03(\x0b\x32%.org.lfedge.eve.config.VolumeSnapshot\"L\n\x0eVolumeSnapshot\x12\n\n\x02id\x18\x01 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x02 \x01(\t\x12\x18\n\x10rollback_counter\x18\x03 \x01(\r\"\xb8\x01\n\nDiskConfig\x12\x34\n\x04\x64isk\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x38\n\x08old_disk\x18\x02 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12:\n\x0b\x64isk_config\x18\x03 \x01(\x0e\x32%.org.lfedge.eve.config.DiskConfigType\"\xb0\x01\n\x0b\x44isksConfig\x12\x30\n\x05\x64isks\x18\x01 \x03(\x0b\x32!.org.lfedge.eve.config.DiskConfig\x12\x39\n\narray_type\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.config.DisksArrayType\x12\x34\n\x08\x63hildren\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.config.DisksConfig*\x85\x01\n\x06\x44sType\x12\r\n\tDsUnknown\x10\x00\x12\n\n\x06\x44sHttp\x10\x01\x12\x0b\n\x07\x44sHttps\x10\x02\x12\x08\n\x04\x44sS3\x10\x03\x12\n\n\x06\x44sSFTP\x10\x04\x12\x17\n\x13\x44sContainerRegistry\x10\x05\x12\x0f\n\x0b\x44sAzureBlob\x10\x06\x12\x13\n\x0f\x44sGoogleStorage\x10\x07*k\n\x06\x46ormat\x12\x0e\n\nFmtUnknown\x10\x00\x12\x07\n\x03RAW\x10\x01\x12\x08\n\x04QCOW\x10\x02\x12\t\n\x05QCOW2\x10\x03\x12\x07\n\x03VHD\x10\x04\x12\x08\n\x04VMDK\x10\x05\x12\x07\n\x03OVA\x10\x06\x12\x08\n\x04VHDX\x10\x07\x12\r\n\tCONTAINER\x10\x08*G\n\x06Target\x12\x0e\n\nTgtUnknown\x10\x00\x12\x08\n\x04\x44isk\x10\x01\x12\n\n\x06Kernel\x10\x02\x12\n\n\x06Initrd\x10\x03\x12\x0b\n\x07RamDisk\x10\x04*I\n\tDriveType\x12\x10\n\x0cUnclassified\x10\x00\x12\t\n\x05\x43\x44ROM\x10\x01\x12\x07\n\x03HDD\x10\x02\x12\x07\n\x03NET\x10\x03\x12\r\n\tHDD_EMPTY\x10\x04*1\n\x15VolumeAccessProtocols\x12\x0c\n\x08VAP_NONE\x10\x00\x12\n\n\x06VAP_9P\x10\x01*N\n\x17VolumeContentOriginType\x12\x10\n\x0cVCOT_UNKNOWN\x10\x00\x12\x0e\n\nVCOT_BLANK\x10\x01\x12\x11\n\rVCOT_DOWNLOAD\x10\x02*\xec\x01\n\x0e\x44iskConfigType\x12 \n\x1c\x44ISK_CONFIG_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISK_CONFIG_TYPE_EVEOS\x10\x01\x12\x1c\n\x18\x44ISK_CONFIG_TYPE_PERSIST\x10\x02\x12\x1f\n\x1b\x44ISK_CONFIG_TYPE_ZFS_ONLINE\x10\x03\x12 \n\x1c\x44ISK_CONFIG_TYPE_ZFS_OFFLINE\x10\x04\x12\x1e\n\x1a\x44ISK_CONFIG_TYPE_APPDIRECT\x10\x05\x12\x1b\n\x17\x44ISK_CONFIG_TYPE_UNUSED\x10\x06*\xa2\x01\n\x0e\x44isksArrayType\x12 \n\x1c\x44ISKS_ARRAY_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID0\x10\x01\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID1\x10\x02\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID5\x10\x03\x12\x1a\n\x16\x44ISKS_ARRAY_TYPE_RAID6\x10\x04\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_devcommon__pb2.DESCRIPTOR,config_dot_acipherinfo__pb2.DESCRIPTOR,evecommon_dot_evecommon__pb2.DESCRIPTOR,])
 
@@ -77,8 +77,8 @@ _DSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1872,
-  serialized_end=2005,
+  serialized_start=2008,
+  serialized_end=2141,
 )
 _sym_db.RegisterEnumDescriptor(_DSTYPE)
 
@@ -138,8 +138,8 @@ _FORMAT = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2007,
-  serialized_end=2114,
+  serialized_start=2143,
+  serialized_end=2250,
 )
 _sym_db.RegisterEnumDescriptor(_FORMAT)
 
@@ -179,8 +179,8 @@ _TARGET = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2116,
-  serialized_end=2187,
+  serialized_start=2252,
+  serialized_end=2323,
 )
 _sym_db.RegisterEnumDescriptor(_TARGET)
 
@@ -220,8 +220,8 @@ _DRIVETYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2189,
-  serialized_end=2262,
+  serialized_start=2325,
+  serialized_end=2398,
 )
 _sym_db.RegisterEnumDescriptor(_DRIVETYPE)
 
@@ -246,8 +246,8 @@ _VOLUMEACCESSPROTOCOLS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2264,
-  serialized_end=2313,
+  serialized_start=2400,
+  serialized_end=2449,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMEACCESSPROTOCOLS)
 
@@ -277,8 +277,8 @@ _VOLUMECONTENTORIGINTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2315,
-  serialized_end=2393,
+  serialized_start=2451,
+  serialized_end=2529,
 )
 _sym_db.RegisterEnumDescriptor(_VOLUMECONTENTORIGINTYPE)
 
@@ -328,8 +328,8 @@ _DISKCONFIGTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2396,
-  serialized_end=2632,
+  serialized_start=2532,
+  serialized_end=2768,
 )
 _sym_db.RegisterEnumDescriptor(_DISKCONFIGTYPE)
 
@@ -369,8 +369,8 @@ _DISKSARRAYTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=2635,
-  serialized_end=2797,
+  serialized_start=2771,
+  serialized_end=2933,
 )
 _sym_db.RegisterEnumDescriptor(_DISKSARRAYTYPE)
 
@@ -888,6 +888,13 @@ _VOLUME = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='snapshots', full_name='org.lfedge.eve.config.Volume.snapshots', index=8,
+      number=9, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -901,7 +908,53 @@ _VOLUME = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=1250,
-  serialized_end=1503,
+  serialized_end=1561,
+)
+
+
+_VOLUMESNAPSHOT = _descriptor.Descriptor(
+  name='VolumeSnapshot',
+  full_name='org.lfedge.eve.config.VolumeSnapshot',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.config.VolumeSnapshot.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='display_name', full_name='org.lfedge.eve.config.VolumeSnapshot.display_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='rollback_counter', full_name='org.lfedge.eve.config.VolumeSnapshot.rollback_counter', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1563,
+  serialized_end=1639,
 )
 
 
@@ -946,8 +999,8 @@ _DISKCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1506,
-  serialized_end=1690,
+  serialized_start=1642,
+  serialized_end=1826,
 )
 
 
@@ -992,8 +1045,8 @@ _DISKSCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=1693,
-  serialized_end=1869,
+  serialized_start=1829,
+  serialized_end=2005,
 )
 
 _DATASTORECONFIG.fields_by_name['dType'].enum_type = _DSTYPE
@@ -1009,6 +1062,7 @@ _CONTENTTREE.fields_by_name['siginfo'].message_type = _SIGNATUREINFO
 _VOLUMECONTENTORIGIN.fields_by_name['type'].enum_type = _VOLUMECONTENTORIGINTYPE
 _VOLUME.fields_by_name['origin'].message_type = _VOLUMECONTENTORIGIN
 _VOLUME.fields_by_name['protocols'].enum_type = _VOLUMEACCESSPROTOCOLS
+_VOLUME.fields_by_name['snapshots'].message_type = _VOLUMESNAPSHOT
 _DISKCONFIG.fields_by_name['disk'].message_type = evecommon_dot_evecommon__pb2._DISKDESCRIPTION
 _DISKCONFIG.fields_by_name['old_disk'].message_type = evecommon_dot_evecommon__pb2._DISKDESCRIPTION
 _DISKCONFIG.fields_by_name['disk_config'].enum_type = _DISKCONFIGTYPE
@@ -1022,6 +1076,7 @@ DESCRIPTOR.message_types_by_name['Drive'] = _DRIVE
 DESCRIPTOR.message_types_by_name['ContentTree'] = _CONTENTTREE
 DESCRIPTOR.message_types_by_name['VolumeContentOrigin'] = _VOLUMECONTENTORIGIN
 DESCRIPTOR.message_types_by_name['Volume'] = _VOLUME
+DESCRIPTOR.message_types_by_name['VolumeSnapshot'] = _VOLUMESNAPSHOT
 DESCRIPTOR.message_types_by_name['DiskConfig'] = _DISKCONFIG
 DESCRIPTOR.message_types_by_name['DisksConfig'] = _DISKSCONFIG
 DESCRIPTOR.enum_types_by_name['DsType'] = _DSTYPE
@@ -1083,6 +1138,13 @@ Volume = _reflection.GeneratedProtocolMessageType('Volume', (_message.Message,),
   })
 _sym_db.RegisterMessage(Volume)
 
+VolumeSnapshot = _reflection.GeneratedProtocolMessageType('VolumeSnapshot', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMESNAPSHOT,
+  '__module__' : 'config.storage_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.VolumeSnapshot)
+  })
+_sym_db.RegisterMessage(VolumeSnapshot)
+
 DiskConfig = _reflection.GeneratedProtocolMessageType('DiskConfig', (_message.Message,), {
   'DESCRIPTOR' : _DISKCONFIG,
   '__module__' : 'config.storage_pb2'
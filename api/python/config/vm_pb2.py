@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x0f\x63onfig/vm.proto\x12\x15org.lfedge.eve.config\"\xfb\x02\n\x08VmConfig\x12\x0e\n\x06kernel\x18\x01 \x01(\t\x12\x0f\n\x07ramdisk\x18\x02 \x01(\t\x12\x0e\n\x06memory\x18\x03 \x01(\r\x12\x0e\n\x06maxmem\x18\x04 \x01(\r\x12\r\n\x05vcpus\x18\x05 \x01(\r\x12\x0f\n\x07maxcpus\x18\x06 \x01(\r\x12\x0f\n\x07rootdev\x18\x07 \x01(\t\x12\x11\n\textraargs\x18\x08 \x01(\t\x12\x12\n\nbootloader\x18\t \x01(\t\x12\x0c\n\x04\x63pus\x18\n \x01(\t\x12\x12\n\ndevicetree\x18\x0b \x01(\t\x12\r\n\x05\x64tdev\x18\x0c \x03(\t\x12\x0c\n\x04irqs\x18\r \x03(\r\x12\r\n\x05iomem\x18\x0e \x03(\t\x12\x39\n\x12virtualizationMode\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.config.VmMode\x12\x11\n\tenableVnc\x18\x10 \x01(\x08\x12\x12\n\nvncDisplay\x18\x11 \x01(\r\x12\x11\n\tvncPasswd\x18\x12 \x01(\t\x12\x13\n\x0b\x64isableLogs\x18\x13 \x01(\x08*G\n\x06VmMode\x12\x06\n\x02PV\x10\x00\x12\x07\n\x03HVM\x10\x01\x12\n\n\x06\x46iller\x10\x02\x12\x07\n\x03\x46ML\x10\x03\x12\x0b\n\x07NOHYPER\x10\x04\x12\n\n\x06LEGACY\x10\x05\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x0f\x63onfig/vm.proto\x12\x15org.lfedge.eve.config\"\xab\x03\n\x08VmConfig\x12\x0e\n\x06kernel\x18\x01 \x01(\t\x12\x0f\n\x07ramdisk\x18\x02 \x01(\t\x12\x0e\n\x06memory\x18\x03 \x01(\r\x12\x0e\n\x06maxmem\x18\x04 \x01(\r\x12\r\n\x05vcpus\x18\x05 \x01(\r\x12\x0f\n\x07maxcpus\x18\x06 \x01(\r\x12\x0f\n\x07rootdev\x18\x07 \x01(\t\x12\x11\n\textraargs\x18\x08 \x01(\t\x12\x12\n\nbootloader\x18\t \x01(\t\x12\x0c\n\x04\x63pus\x18\n \x01(\t\x12\x12\n\ndevicetree\x18\x0b \x01(\t\x12\r\n\x05\x64tdev\x18\x0c \x03(\t\x12\x0c\n\x04irqs\x18\r \x03(\r\x12\r\n\x05iomem\x18\x0e \x03(\t\x12\x39\n\x12virtualizationMode\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.config.VmMode\x12\x11\n\tenableVnc\x18\x10 \x01(\x08\x12\x12\n\nvncDisplay\x18\x11 \x01(\r\x12\x11\n\tvncPasswd\x18\x12 \x01(\t\x12\x13\n\x0b\x64isableLogs\x18\x13 \x01(\x08\x12.\n\x04vtpm\x18\x14 \x01(\x0e\x32 .org.lfedge.eve.config.VmTpmType*G\n\x06VmMode\x12\x06\n\x02PV\x10\x00\x12\x07\n\x03HVM\x10\x01\x12\n\n\x06\x46iller\x10\x02\x12\x07\n\x03\x46ML\x10\x03\x12\x0b\n\x07NOHYPER\x10\x04\x12\n\n\x06LEGACY\x10\x05*K\n\tVmTpmType\x12\x14\n\x10VM_TPM_TYPE_NONE\x10\x00\x12\x13\n\x0fVM_TPM_TYPE_CRB\x10\x01\x12\x13\n\x0fVM_TPM_TYPE_TIS\x10\x02\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
 )
 
 _VMMODE = _descriptor.EnumDescriptor(
@@ -63,18 +63,52 @@ _VMMODE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=424,
-  serialized_end=495,
+  serialized_start=472,
+  serialized_end=543,
 )
 _sym_db.RegisterEnumDescriptor(_VMMODE)
 
 VmMode = enum_type_wrapper.EnumTypeWrapper(_VMMODE)
+_VMTPMTYPE = _descriptor.EnumDescriptor(
+  name='VmTpmType',
+  full_name='org.lfedge.eve.config.VmTpmType',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='VM_TPM_TYPE_NONE', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VM_TPM_TYPE_CRB', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='VM_TPM_TYPE_TIS', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=545,
+  serialized_end=620,
+)
+_sym_db.RegisterEnumDescriptor(_VMTPMTYPE)
+
+VmTpmType = enum_type_wrapper.EnumTypeWrapper(_VMTPMTYPE)
 PV = 0
 HVM = 1
 Filler = 2
 FML = 3
 NOHYPER = 4
 LEGACY = 5
+VM_TPM_TYPE_NONE = 0
+VM_TPM_TYPE_CRB = 1
+VM_TPM_TYPE_TIS = 2
 
 
 
@@ -219,6 +253,13 @@ _VMCONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vtpm', full_name='org.lfedge.eve.config.VmConfig.vtpm', index=19,
+      number=20, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -232,12 +273,14 @@ _VMCONFIG = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=43,
-  serialized_end=422,
+  serialized_end=470,
 )
 
 _VMCONFIG.fields_by_name['virtualizationMode'].enum_type = _VMMODE
+_VMCONFIG.fields_by_name['vtpm'].enum_type = _VMTPMTYPE
 DESCRIPTOR.message_types_by_name['VmConfig'] = _VMCONFIG
 DESCRIPTOR.enum_types_by_name['VmMode'] = _VMMODE
+DESCRIPTOR.enum_types_by_name['VmTpmType'] = _VMTPMTYPE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 VmConfig = _reflection.GeneratedProtocolMessageType('VmConfig', (_message.Message,), {
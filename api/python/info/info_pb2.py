@@ -23,7 +23,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\023org.lfedge.eve.infoZ\"github.com/lf-edge/eve/api/go/info',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x0finfo/info.proto\x12\x13org.lfedge.eve.info\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1e\x65vecommon/devmodelcommon.proto\x1a\x19\x65vecommon/evecommon.proto\"\xdc\x01\n\x14\x64\x65precatedMetricItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x04type\x18\x02 \x01(\x0e\x32&.org.lfedge.eve.info.DepMetricItemType\x12\x13\n\tboolValue\x18\x03 \x01(\x08H\x00\x12\x15\n\x0buint32Value\x18\x04 \x01(\rH\x00\x12\x15\n\x0buint64Value\x18\x05 \x01(\x04H\x00\x12\x14\n\nfloatValue\x18\x06 \x01(\x02H\x00\x12\x15\n\x0bstringValue\x18\x07 \x01(\tH\x00\x42\x11\n\x0fmetricItemValue\">\n\x15ZmetIPAssignmentEntry\x12\x12\n\nmacAddress\x18\x01 \x01(\t\x12\x11\n\tipAddress\x18\x02 \x03(\t\"A\n\x0bZmetVifInfo\x12\x0f\n\x07vifName\x18\x01 \x01(\t\x12\x12\n\nmacAddress\x18\x02 \x01(\t\x12\r\n\x05\x61ppID\x18\x03 \x01(\t\"\xa5\x02\n\tZioBundle\x12.\n\x04type\x18\x01 \x01(\x0e\x32 .org.lfedge.eve.common.PhyIoType\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07members\x18\x03 \x03(\t\x12\x15\n\rusedByAppUUID\x18\x04 \x01(\t\x12\x14\n\x0cusedByBaseOS\x18\x05 \x01(\x08\x12\x37\n\rioAddressList\x18\x06 \x03(\x0b\x32 .org.lfedge.eve.info.IoAddresses\x12\x36\n\x05usage\x18\x07 \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"!\n\x0bIoAddresses\x12\x12\n\nmacAddress\x18\x01 \x01(\t\"\xc9\x01\n\x11ZInfoManufacturer\x12\x14\n\x0cmanufacturer\x18\x01 \x01(\t\x12\x13\n\x0bproductName\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x14\n\x0cserialNumber\x18\x04 \x01(\t\x12\x0c\n\x04UUID\x18\x05 \x01(\t\x12\x12\n\ncompatible\x18\x06 \x01(\t\x12\x12\n\nbiosVendor\x18\x07 \x01(\t\x12\x13\n\x0b\x62iosVersion\x18\x08 \x01(\t\x12\x17\n\x0f\x62iosReleaseDate\x18\t \x01(\t\"\x8c\x03\n\x0cZInfoNetwork\x12\x0f\n\x07macAddr\x18\x03 \x01(\t\x12\x0f\n\x07\x64\x65vName\x18\x04 \x01(\t\x12\r\n\x05\x61lias\x18( \x01(\t\x12\x0f\n\x07IPAddrs\x18\x05 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x06 \x03(\t\x12*\n\x03\x64ns\x18\x07 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x0f\n\x07ipv4_up\x18\x08 \x01(\x08\x12-\n\x08location\x18\t \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12\x0e\n\x06uplink\x18\n \x01(\x08\x12\x32\n\nnetworkErr\x18\x0b \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x11\n\tlocalName\x18\x0c \x01(\t\x12/\n\x05proxy\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x19\n\x11ip_addr_mis_match\x18\x0e \x01(\x08\x12\x13\n\x0bntp_servers\x18\x0f \x03(\t\"\x87\x01\n\x06GeoLoc\x12\x12\n\nUnderlayIP\x18\x01 \x01(\t\x12\x10\n\x08Hostname\x18\x02 \x01(\t\x12\x0c\n\x04\x43ity\x18\x03 \x01(\t\x12\x0e\n\x06Region\x18\x04 \x01(\t\x12\x0f\n\x07\x43ountry\x18\x05 \x01(\t\x12\x0b\n\x03Loc\x18\x06 \x01(\t\x12\x0b\n\x03Org\x18\x07 \x01(\t\x12\x0e\n\x06Postal\x18\x08 \x01(\t\"D\n\x08ZInfoDNS\x12\x12\n\nDNSservers\x18\x01 \x03(\t\x12\x11\n\tDNSdomain\x18\x02 \x01(\t\x12\x11\n\tDNSsearch\x18\x03 \x03(\t\"\xa5\x01\n\x07ZInfoSW\x12\x11\n\tswVersion\x18\x02 \x01(\t\x12\x0e\n\x06swHash\x18\x03 \x01(\t\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x0e\n\x06target\x18\x06 \x01(\t\x12\x0c\n\x04vdev\x18\x07 \x01(\t\x12\x18\n\x10\x64ownloadProgress\x18\x08 \x01(\r\x12\x11\n\timageName\x18\t \x01(\t\"\xce\x01\n\tErrorInfo\x12\x13\n\x0b\x64\x65scription\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x08severity\x18\x03 \x01(\x0e\x32\x1d.org.lfedge.eve.info.Severity\x12\x33\n\x08\x65ntities\x18\x04 \x03(\x0b\x32!.org.lfedge.eve.info.DeviceEntity\x12\x17\n\x0fretry_condition\x18\x05 \x01(\t\"N\n\x0c\x44\x65viceEntity\x12+\n\x06\x65ntity\x18\x01 \x01(\x0e\x32\x1b.org.lfedge.eve.info.Entity\x12\x11\n\tentity_id\x18\x02 \x01(\t\"\xb8\x01\n\tVaultInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x38\n\x06status\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x30\n\x08vaultErr\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x31\n\tpcrStatus\x18\x04 \x01(\x0e\x32\x1e.org.lfedge.eve.info.PCRStatus\"\x8a\x01\n\rDataSecAtRest\x12\x38\n\x06status\x18\x01 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x0c\n\x04info\x18\x02 \x01(\t\x12\x31\n\tvaultList\x18\x03 \x03(\x0b\x32\x1e.org.lfedge.eve.info.VaultInfo\"<\n\x0cSecurityInfo\x12\x13\n\x0bsha_root_ca\x18\x01 \x01(\x0c\x12\x17\n\x0fsha_tls_root_ca\x18\x02 \x01(\x0c\"/\n\x0fZInfoConfigItem\x12\r\n\x05value\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x84\x03\n\x15ZInfoConfigItemStatus\x12P\n\x0b\x63onfigItems\x18\x01 \x03(\x0b\x32;.org.lfedge.eve.info.ZInfoConfigItemStatus.ConfigItemsEntry\x12^\n\x12unknownConfigItems\x18\x02 \x03(\x0b\x32\x42.org.lfedge.eve.info.ZInfoConfigItemStatus.UnknownConfigItemsEntry\x1aX\n\x10\x43onfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\x1a_\n\x17UnknownConfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\"B\n\x10ZInfoAppInstance\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x12\n\ndomainName\x18\x03 \x01(\t\"3\n\x10ZInfoDeviceTasks\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x86\x01\n\x0cZSimcardInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x18\n\x10\x63\x65ll_module_name\x18\x02 \x01(\t\x12\x0c\n\x04imsi\x18\x03 \x01(\t\x12\r\n\x05iccid\x18\x04 \x01(\t\x12\x31\n\x05state\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.info.ZSimcardState\"\xea\x01\n\x13ZCellularModuleInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04imei\x18\x02 \x01(\t\x12\x18\n\x10\x66irmware_version\x18\x03 \x01(\t\x12\r\n\x05model\x18\x04 \x01(\t\x12\x45\n\x0foperating_state\x18\x05 \x01(\x0e\x32,.org.lfedge.eve.info.ZCellularOperatingState\x12G\n\x10\x63ontrol_protocol\x18\x06 \x01(\x0e\x32-.org.lfedge.eve.info.ZCellularControlProtocol\"`\n\x11ZCellularProvider\x12\x0c\n\x04plmn\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x17\n\x0f\x63urrent_serving\x18\x03 \x01(\x08\x12\x0f\n\x07roaming\x18\x04 \x01(\x08\"\x81\x01\n\x10StorageDiskState\x12\x39\n\tdisk_name\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x32\n\x06status\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\"m\n\tSmartAttr\x12\n\n\x02id\x18\x01 \x01(\r\x12\r\n\x05value\x18\x02 \x01(\x04\x12\r\n\x05worst\x18\x03 \x01(\x04\x12\x0e\n\x06thresh\x18\x04 \x01(\x04\x12\x13\n\x0bwhen_failed\x18\x05 \x01(\t\x12\x11\n\traw_value\x18\x06 \x01(\x04\"\x8a\x03\n\x0bSmartMetric\x12=\n\x15reallocated_sector_ct\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x36\n\x0epower_on_hours\x18\x02 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x39\n\x11power_cycle_count\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12?\n\x17reallocated_event_count\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12>\n\x16\x63urrent_pending_sector\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x13\n\x0bneed_update\x18\x06 \x01(\x08\x12\x33\n\x0btemperature\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\"\xa7\x01\n\x0fStorageDiskInfo\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x34\n\nsmart_data\x18\x03 \x03(\x0b\x32 .org.lfedge.eve.info.SmartMetric\x12\x0b\n\x03wwn\x18\x04 \x01(\t\x12\x15\n\rserial_number\x18\x05 \x01(\t\x12\r\n\x05model\x18\x06 \x01(\t\x12\x18\n\x10\x63ollector_errors\x18\x07 \x01(\t\"\xbb\x01\n\x0fStorageChildren\x12:\n\x0c\x63urrent_raid\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x34\n\x05\x64isks\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x36\n\x08\x63hildren\x18\x03 \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\"\xb4\x03\n\x0bStorageInfo\x12\x11\n\tpool_name\x18\x01 \x01(\t\x12:\n\x0cstorage_type\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.info.StorageTypeInfo\x12\x13\n\x0bzfs_version\x18\x03 \x01(\t\x12:\n\x0c\x63urrent_raid\x18\x04 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x19\n\x11\x63ompression_ratio\x18\x05 \x01(\x01\x12\x12\n\nzpool_size\x18\x06 \x01(\x04\x12\x13\n\x0b\x63ount_zvols\x18\x07 \x01(\r\x12\x39\n\rstorage_state\x18\x08 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x34\n\x05\x64isks\x18\t \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x18\n\x10\x63ollector_errors\x18\n \x01(\t\x12\x36\n\x08\x63hildren\x18\x0b \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\"D\n\rZInfoHardware\x12\x33\n\x05\x64isks\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.info.StorageDiskInfo\"\xb5\x0e\n\x0bZInfoDevice\x12\x13\n\x0bmachineArch\x18\x04 \x01(\t\x12\x0f\n\x07\x63puArch\x18\x05 \x01(\t\x12\x10\n\x08platform\x18\x06 \x01(\t\x12\x0c\n\x04ncpu\x18\x07 \x01(\r\x12\x0e\n\x06memory\x18\x08 \x01(\x04\x12\x0f\n\x07storage\x18\t \x01(\x04\x12\x19\n\x11powerCycleCounter\x18\n \x01(\x03\x12\x35\n\x05minfo\x18\x0b \x01(\x0b\x32&.org.lfedge.eve.info.ZInfoManufacturer\x12\x32\n\x07network\x18\r \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12:\n\x12\x61ssignableAdapters\x18\x0f \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12*\n\x03\x64ns\x18\x10 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x36\n\x0bstorageList\x18\x11 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoStorage\x12,\n\x08\x62ootTime\x18\x12 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x06swList\x18\x13 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ZInfoDevSW\x12\x10\n\x08HostName\x18\x14 \x01(\t\x12>\n\x0bmetricItems\x18\x15 \x03(\x0b\x32).org.lfedge.eve.info.deprecatedMetricItem\x12\x18\n\x10lastRebootReason\x18\x16 \x01(\t\x12\x32\n\x0elastRebootTime\x18\x17 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12=\n\rsystemAdapter\x18\x18 \x01(\x0b\x32&.org.lfedge.eve.info.SystemAdapterInfo\x12\x16\n\x0erestartCounter\x18\x19 \x01(\r\x12>\n\tHSMStatus\x18\x1a \x01(\x0e\x32+.org.lfedge.eve.info.HwSecurityModuleStatus\x12\x0f\n\x07HSMInfo\x18\x1b \x01(\t\x12\x17\n\x0flastRebootStack\x18\x1c \x01(\t\x12=\n\x11\x64\x61taSecAtRestInfo\x18\x1d \x01(\x0b\x32\".org.lfedge.eve.info.DataSecAtRest\x12\x33\n\x08sec_info\x18\x1e \x01(\x0b\x32!.org.lfedge.eve.info.SecurityInfo\x12\x44\n\x10\x63onfigItemStatus\x18\x1f \x01(\x0b\x32*.org.lfedge.eve.info.ZInfoConfigItemStatus\x12;\n\x0c\x61ppInstances\x18  \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoAppInstance\x12\x1b\n\x13rebootConfigCounter\x18! \x01(\r\x12\x39\n\x10last_boot_reason\x18\" \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12=\n\x0b\x63\x65ll_radios\x18# \x03(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12/\n\x04sims\x18$ \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x34\n\x05tasks\x18% \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoDeviceTasks\x12\x18\n\x10maintenance_mode\x18& \x01(\x08\x12K\n\x17maintenance_mode_reason\x18\' \x01(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12!\n\x19hardware_watchdog_present\x18( \x01(\x08\x12\x19\n\x11reboot_inprogress\x18) \x01(\x08\x12\x37\n\x0c\x63\x61pabilities\x18* \x01(\x0b\x32!.org.lfedge.eve.info.Capabilities\x12\x1d\n\x15\x62\x61seos_update_counter\x18+ \x01(\r\x12\x30\n\x05state\x18, \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12\x15\n\rlocal_profile\x18- \x01(\t\x12L\n\x18maintenance_mode_reasons\x18. \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12\x18\n\x0c\x64ormant_time\x18/ \x01(\tB\x02\x18\x01\x12\x36\n\x0cstorage_info\x18\x30 \x03(\x0b\x32 .org.lfedge.eve.info.StorageInfo\"`\n\x11SystemAdapterInfo\x12\x14\n\x0c\x63urrentIndex\x18\x01 \x01(\r\x12\x35\n\x06status\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.DevicePortStatus\"\x88\x02\n\x10\x44\x65vicePortStatus\x12\x0f\n\x07version\x18\x01 \x01(\r\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\x30\n\x0ctimePriority\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nlastFailed\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\rlastSucceeded\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\x05ports\x18\x06 \x03(\x0b\x32\x1f.org.lfedge.eve.info.DevicePort\x12\x11\n\tlastError\x18\x07 \x01(\t\"\xfb\x04\n\nDevicePort\x12\x0e\n\x06ifname\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06isMgmt\x18\x03 \x01(\x08\x12\x0c\n\x04\x66ree\x18\x04 \x01(\x08\x12\x10\n\x08\x64hcpType\x18\x0b \x01(\r\x12\x0e\n\x06subnet\x18\x0c \x01(\t\x12\x0f\n\x07gateway\x18\r \x01(\t\x12\x12\n\ndomainname\x18\x0e \x01(\t\x12\x11\n\tntpServer\x18\x0f \x01(\t\x12\x12\n\ndnsServers\x18\x10 \x03(\t\x12\x14\n\x0c\x64hcpRangeLow\x18\x11 \x01(\t\x12\x15\n\rdhcpRangeHigh\x18\x12 \x01(\t\x12/\n\x05proxy\x18\x15 \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x0f\n\x07macAddr\x18\x16 \x01(\t\x12\x0f\n\x07IPAddrs\x18\x17 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x18 \x03(\t\x12*\n\x03\x64ns\x18\x19 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\n\n\x02up\x18\x1a \x01(\x08\x12-\n\x08location\x18\x1b \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12+\n\x03\x65rr\x18\x1d \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x36\n\x05usage\x18\x1e \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12\x13\n\x0bnetworkUUID\x18\x1f \x01(\t\x12\x0c\n\x04\x63ost\x18  \x01(\r\x12<\n\x0fwireless_status\x18! \x01(\x0b\x32#.org.lfedge.eve.info.WirelessStatus\"\xaa\x01\n\x0bProxyStatus\x12\x30\n\x07proxies\x18\x01 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ProxyEntry\x12\x12\n\nexceptions\x18\x02 \x01(\t\x12\x0f\n\x07pacfile\x18\x03 \x01(\t\x12\x1a\n\x12networkProxyEnable\x18\x04 \x01(\x08\x12\x17\n\x0fnetworkProxyURL\x18\x05 \x01(\t\x12\x0f\n\x07wpadURL\x18\x06 \x01(\t\"8\n\nProxyEntry\x12\x0c\n\x04type\x18\x01 \x01(\r\x12\x0e\n\x06server\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"y\n\x0eWirelessStatus\x12/\n\x04type\x18\x01 \x01(\x0e\x32!.org.lfedge.eve.info.WirelessType\x12\x36\n\x08\x63\x65llular\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZCellularStatus\"\xa3\x01\n\x0fZCellularStatus\x12\x17\n\x0f\x63\x65llular_module\x18\x01 \x01(\t\x12\x11\n\tsim_cards\x18\x02 \x03(\t\x12\x39\n\tproviders\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\"\xac\x03\n\nZInfoDevSW\x12\x11\n\tactivated\x18\x02 \x01(\x08\x12\x16\n\x0epartitionLabel\x18\x03 \x01(\t\x12\x17\n\x0fpartitionDevice\x18\x04 \x01(\t\x12\x16\n\x0epartitionState\x18\x05 \x01(\t\x12-\n\x06status\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x14\n\x0cshortVersion\x18\x07 \x01(\t\x12\x13\n\x0blongVersion\x18\x08 \x01(\t\x12-\n\x05swErr\x18\t \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x64ownloadProgress\x18\n \x01(\r\x12\x35\n\nuserStatus\x18\x0b \x01(\x0e\x32!.org.lfedge.eve.info.BaseOsStatus\x12\x14\n\x0csubStatusStr\x18\x0c \x01(\t\x12\x37\n\tsubStatus\x18\r \x01(\x0e\x32$.org.lfedge.eve.info.BaseOsSubStatus\x12\x19\n\x11subStatusProgress\x18\x0e \x01(\r\"Y\n\x0cZInfoStorage\x12\x0e\n\x06\x64\x65vice\x18\x01 \x01(\t\x12\x11\n\tmountPath\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x04\x12\x17\n\x0fstorageLocation\x18\x04 \x01(\x08\"\x93\x03\n\x08ZInfoApp\x12\r\n\x05\x41ppID\x18\x01 \x01(\t\x12\x12\n\nappVersion\x18\x02 \x01(\t\x12\x11\n\tsystemApp\x18\x06 \x01(\x08\x12\x0f\n\x07\x41ppName\x18\x07 \x01(\t\x12\x32\n\x0csoftwareList\x18\x08 \x03(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12,\n\x08\x62ootTime\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x38\n\x10\x61ssignedAdapters\x18\r \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x06\x61ppErr\x18\x0e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x32\n\x07network\x18\x10 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12\x12\n\nvolumeRefs\x18\x11 \x03(\t\"D\n\x10ZInfoVpnLinkInfo\x12\r\n\x05spiId\x18\x01 \x01(\t\x12\x0e\n\x06subNet\x18\x02 \x01(\t\x12\x11\n\tdirection\x18\x03 \x01(\x08\"\xf9\x01\n\x0cZInfoVpnLink\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05reqId\x18\x03 \x01(\t\x12\x10\n\x08instTime\x18\x04 \x01(\x04\x12\x0f\n\x07\x65spInfo\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\n \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\x12\x34\n\x05rInfo\x18\x0b \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\"<\n\x10ZInfoVpnEndPoint\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06ipAddr\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"\xa9\x02\n\x0cZInfoVpnConn\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x0f\n\x07\x65stTime\x18\x04 \x01(\x04\x12\x0c\n\x04ikes\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\x07 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x34\n\x05rInfo\x18\x08 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x30\n\x05links\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnLink\"z\n\x08ZInfoVpn\x12\x0e\n\x06upTime\x18\x01 \x01(\x04\x12\x13\n\x0bpolicyBased\x18\x02 \x01(\x08\x12\x18\n\x10listeningIpAddrs\x18\x03 \x03(\t\x12/\n\x04\x63onn\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnConn\"\xd6\x05\n\x14ZInfoNetworkInstance\x12\x11\n\tnetworkID\x18\x02 \x01(\t\x12\x16\n\x0enetworkVersion\x18\x03 \x01(\t\x12\x10\n\x08instType\x18\x05 \x01(\r\x12\x13\n\x0b\x64isplayname\x18\x06 \x01(\t\x12\x11\n\tactivated\x18\x07 \x01(\x08\x12/\n\x0bupTimeStamp\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0csoftwareList\x18\t \x01(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12\x19\n\x11\x43urrentUplinkIntf\x18\n \x01(\t\x12\x1a\n\x12\x43urrentUplinkAlias\x18\x0b \x01(\t\x12\x11\n\tbridgeNum\x18\x14 \x01(\r\x12\x12\n\nbridgeName\x18\x15 \x01(\t\x12\x14\n\x0c\x62ridgeIPAddr\x18\x16 \x01(\t\x12\x41\n\ripAssignments\x18\x17 \x03(\x0b\x32*.org.lfedge.eve.info.ZmetIPAssignmentEntry\x12\x14\n\x0c\x62ridgeIPSets\x18\x18 \x03(\t\x12.\n\x04vifs\x18\x19 \x03(\x0b\x32 .org.lfedge.eve.info.ZmetVifInfo\x12\x0f\n\x07ipv4Eid\x18\x1a \x01(\x08\x12\x38\n\x10\x61ssignedAdapters\x18\x1e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x05vinfo\x18\x1f \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoVpnH\x00\x12\x32\n\nnetworkErr\x18( \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x39\n\x05state\x18) \x01(\x0e\x32*.org.lfedge.eve.info.ZNetworkInstanceStateB\r\n\x0bInfoContent\"\x89\x01\n\tUsageInfo\x12.\n\ncreateTime\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08refCount\x18\x02 \x01(\r\x12:\n\x16lastRefcountChangeTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"=\n\x0fVolumeResources\x12\x14\n\x0cmaxSizeBytes\x18\x01 \x01(\x04\x12\x14\n\x0c\x63urSizeBytes\x18\x02 \x01(\x04\"\xaf\x02\n\x0bZInfoVolume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12\x37\n\tresources\x18\x04 \x01(\x0b\x32$.org.lfedge.eve.info.VolumeResources\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x06 \x01(\r\x12\x31\n\tvolumeErr\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10generation_count\x18\x08 \x01(\x03\"(\n\x10\x43ontentResources\x12\x14\n\x0c\x63urSizeBytes\x18\x01 \x01(\x04\"\xd9\x02\n\x10ZInfoContentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12\x38\n\tresources\x18\x04 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x07 \x01(\r\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x63omponentShaList\x18\t \x03(\t\x12\x18\n\x10generation_count\x18\n \x01(\x03\"\xfb\x01\n\tZInfoBlob\x12\x0e\n\x06sha256\x18\x01 \x01(\t\x12\x38\n\tresources\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x05 \x01(\r\x12+\n\x03\x65rr\x18\x06 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"=\n\rZInfoBlobList\x12,\n\x04\x62lob\x18\x01 \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZInfoBlob\"\xf3\x04\n\x08ZInfoMsg\x12.\n\x05ztype\x18\x01 \x01(\x0e\x32\x1f.org.lfedge.eve.info.ZInfoTypes\x12\r\n\x05\x64\x65vId\x18\x02 \x01(\t\x12\x31\n\x05\x64info\x18\x03 \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoDeviceH\x00\x12.\n\x05\x61info\x18\x05 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoAppH\x00\x12;\n\x06niinfo\x18\x0c \x01(\x0b\x32).org.lfedge.eve.info.ZInfoNetworkInstanceH\x00\x12\x31\n\x05vinfo\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoVolumeH\x00\x12\x36\n\x05\x63info\x18\x0e \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoContentTreeH\x00\x12\x33\n\x05\x62info\x18\x0f \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoBlobListH\x00\x12<\n\x07\x61mdinfo\x18\x10 \x01(\x0b\x32).org.lfedge.eve.info.ZInfoAppInstMetaDataH\x00\x12\x34\n\x06\x65vinfo\x18\x11 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoEdgeviewH\x00\x12\x34\n\x06hwinfo\x18\x12 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoHardwareH\x00\x12/\n\x0b\x61tTimeStamp\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\r\n\x0bInfoContent\"J\n\x0c\x43\x61pabilities\x12 \n\x18HWAssistedVirtualization\x18\x02 \x01(\x08\x12\x18\n\x10IOVirtualization\x18\x03 \x01(\x08\"j\n\x14ZInfoAppInstMetaData\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x36\n\x04type\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.AppInstMetaDataType\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"\x98\x01\n\rZInfoEdgeview\x12/\n\x0b\x65xpire_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x30\n\x0cstarted_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tcount_dev\x18\x03 \x01(\r\x12\x11\n\tcount_app\x18\x04 \x01(\r*u\n\x11\x44\x65pMetricItemType\x12\x16\n\x12\x44\x65pMetricItemOther\x10\x00\x12\x16\n\x12\x44\x65pMetricItemGauge\x10\x01\x12\x18\n\x14\x44\x65pMetricItemCounter\x10\x02\x12\x16\n\x12\x44\x65pMetricItemState\x10\x03*\x9f\x01\n\nZInfoTypes\x12\t\n\x05ZiNop\x10\x00\x12\x0c\n\x08ZiDevice\x10\x01\x12\t\n\x05ZiApp\x10\x03\x12\x15\n\x11ZiNetworkInstance\x10\x06\x12\x0c\n\x08ZiVolume\x10\x07\x12\x11\n\rZiContentTree\x10\x08\x12\x0e\n\nZiBlobList\x10\t\x12\x15\n\x11ZiAppInstMetaData\x10\n\x12\x0e\n\nZiHardware\x10\x0b*\xe1\x02\n\x08ZSwState\x12\x0b\n\x07INVALID\x10\x00\x12\x0b\n\x07INITIAL\x10\x01\x12\x14\n\x10\x44OWNLOAD_STARTED\x10\x02\x12\x0e\n\nDOWNLOADED\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\r\n\tINSTALLED\x10\x05\x12\x0b\n\x07\x42OOTING\x10\x06\x12\x0b\n\x07RUNNING\x10\x07\x12\x0b\n\x07HALTING\x10\x08\x12\n\n\x06HALTED\x10\t\x12\x0e\n\nRESTARTING\x10\n\x12\x0b\n\x07PURGING\x10\x0b\x12\x11\n\rRESOLVING_TAG\x10\x0c\x12\x10\n\x0cRESOLVED_TAG\x10\r\x12\x13\n\x0f\x43REATING_VOLUME\x10\x0e\x12\x12\n\x0e\x43REATED_VOLUME\x10\x0f\x12\r\n\tVERIFYING\x10\x10\x12\x0c\n\x08VERIFIED\x10\x11\x12\x0b\n\x07LOADING\x10\x12\x12\n\n\x06LOADED\x10\x13\x12\x18\n\x14\x41WAITNETWORKINSTANCE\x10\x14\x12\t\n\x05\x45RROR\x10\x15*\x99\x02\n\x06\x45ntity\x12\x16\n\x12\x45NTITY_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45NTITY_BASE_OS\x10\x01\x12\x19\n\x15\x45NTITY_SYSTEM_ADAPTER\x10\x02\x12\x10\n\x0c\x45NTITY_VAULT\x10\x03\x12\x16\n\x12\x45NTITY_ATTESTATION\x10\x04\x12\x17\n\x13\x45NTITY_APP_INSTANCE\x10\x05\x12\x0f\n\x0b\x45NTITY_PORT\x10\x06\x12\x12\n\x0e\x45NTITY_NETWORK\x10\x07\x12\x1b\n\x17\x45NTITY_NETWORK_INSTANCE\x10\x08\x12\x17\n\x13\x45NTITY_CONTENT_TREE\x10\t\x12\x17\n\x13\x45NTITY_CONTENT_BLOB\x10\n\x12\x11\n\rENTITY_VOLUME\x10\x0b*c\n\x08Severity\x12\x18\n\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x13\n\x0fSEVERITY_NOTICE\x10\x01\x12\x14\n\x10SEVERITY_WARNING\x10\x02\x12\x12\n\x0eSEVERITY_ERROR\x10\x03*N\n\x16HwSecurityModuleStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08NOTFOUND\x10\x01\x12\x0c\n\x08\x44ISABLED\x10\x02\x12\x0b\n\x07\x45NABLED\x10\x03*\x88\x01\n\x13\x44\x61taSecAtRestStatus\x12\x1b\n\x17\x44\x41TASEC_AT_REST_UNKNOWN\x10\x00\x12\x1c\n\x18\x44\x41TASEC_AT_REST_DISABLED\x10\x01\x12\x1b\n\x17\x44\x41TASEC_AT_REST_ENABLED\x10\x02\x12\x19\n\x15\x44\x41TASEC_AT_REST_ERROR\x10\x04*?\n\tPCRStatus\x12\x0f\n\x0bPCR_UNKNOWN\x10\x00\x12\x0f\n\x0bPCR_ENABLED\x10\x01\x12\x10\n\x0cPCR_DISABLED\x10\x02*\xc5\x01\n\rZSimcardState\x12\x1b\n\x17Z_SIMCARD_STATE_INVALID\x10\x00\x12\x1c\n\x18Z_SIMCARD_STATE_ASSIGNED\x10\x01\x12\x1f\n\x1bZ_SIMCARD_STATE_PROVISIONED\x10\x02\x12\x1a\n\x16Z_SIMCARD_STATE_ACTIVE\x10\x03\x12\x1d\n\x19Z_SIMCARD_STATE_SUSPENDED\x10\x04\x12\x1d\n\x19Z_SIMCARD_STATE_CANCELLED\x10\x05*\xa0\x02\n\x17ZCellularOperatingState\x12*\n&Z_CELLULAR_OPERATING_STATE_UNSPECIFIED\x10\x00\x12&\n\"Z_CELLULAR_OPERATING_STATE_OFFLINE\x10\x01\x12(\n$Z_CELLULAR_OPERATING_STATE_RADIO_OFF\x10\x02\x12%\n!Z_CELLULAR_OPERATING_STATE_ONLINE\x10\x03\x12\x33\n/Z_CELLULAR_OPERATING_STATE_ONLINE_AND_CONNECTED\x10\x04\x12+\n\'Z_CELLULAR_OPERATING_STATE_UNRECOGNIZED\x10\x05*\x92\x01\n\x18ZCellularControlProtocol\x12+\n\'Z_CELLULAR_CONTROL_PROTOCOL_UNSPECIFIED\x10\x00\x12#\n\x1fZ_CELLULAR_CONTROL_PROTOCOL_QMI\x10\x01\x12$\n Z_CELLULAR_CONTROL_PROTOCOL_MBIM\x10\x02*\xc6\x01\n\x0cZDeviceState\x12\x1d\n\x19ZDEVICE_STATE_UNSPECIFIED\x10\x00\x12\x18\n\x14ZDEVICE_STATE_ONLINE\x10\x01\x12\x1b\n\x17ZDEVICE_STATE_REBOOTING\x10\x02\x12\"\n\x1eZDEVICE_STATE_MAINTENANCE_MODE\x10\x03\x12!\n\x1dZDEVICE_STATE_BASEOS_UPDATING\x10\x04\x12\x19\n\x15ZDEVICE_STATE_BOOTING\x10\x05*\xf5\x01\n\rStorageStatus\x12\x1e\n\x1aSTORAGE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n\x15STORAGE_STATUS_ONLINE\x10\x01\x12\x1b\n\x17STORAGE_STATUS_DEGRADED\x10\x02\x12\x1a\n\x16STORAGE_STATUS_FAULTED\x10\x03\x12\x1a\n\x16STORAGE_STATUS_OFFLINE\x10\x04\x12\x1a\n\x16STORAGE_STATUS_UNAVAIL\x10\x05\x12\x1a\n\x16STORAGE_STATUS_REMOVED\x10\x06\x12\x1c\n\x18STORAGE_STATUS_SUSPENDED\x10\x07*\xe3\x01\n\x0fStorageRaidType\x12!\n\x1dSTORAGE_RAID_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID0\x10\x01\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID1\x10\x02\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID5\x10\x03\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID6\x10\x04\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID7\x10\x05\x12\x1c\n\x18STORAGE_RAID_TYPE_NORAID\x10\x06*k\n\x0fStorageTypeInfo\x12!\n\x1dSTORAGE_TYPE_INFO_UNSPECIFIED\x10\x00\x12\x1a\n\x16STORAGE_TYPE_INFO_EXT4\x10\x01\x12\x19\n\x15STORAGE_TYPE_INFO_ZFS\x10\x02*\x9b\x03\n\nBootReason\x12\x1b\n\x17\x42OOT_REASON_UNSPECIFIED\x10\x00\x12\x15\n\x11\x42OOT_REASON_FIRST\x10\x01\x12\x1a\n\x16\x42OOT_REASON_REBOOT_CMD\x10\x02\x12\x16\n\x12\x42OOT_REASON_UPDATE\x10\x03\x12\x18\n\x14\x42OOT_REASON_FALLBACK\x10\x04\x12\x1a\n\x16\x42OOT_REASON_DISCONNECT\x10\x05\x12\x15\n\x11\x42OOT_REASON_FATAL\x10\x06\x12\x13\n\x0f\x42OOT_REASON_OOM\x10\x07\x12\x1d\n\x19\x42OOT_REASON_WATCHDOG_HUNG\x10\x08\x12\x1c\n\x18\x42OOT_REASON_WATCHDOG_PID\x10\t\x12\x16\n\x12\x42OOT_REASON_KERNEL\x10\n\x12\x1a\n\x16\x42OOT_REASON_POWER_FAIL\x10\x0b\x12\x17\n\x13\x42OOT_REASON_UNKNOWN\x10\x0c\x12\x1c\n\x18\x42OOT_REASON_VAULT_FAILED\x10\r\x12\x1b\n\x16\x42OOT_REASON_PARSE_FAIL\x10\xff\x01*\xbe\x01\n\x15MaintenanceModeReason\x12 \n\x1cMAINTENANCE_MODE_REASON_NONE\x10\x00\x12*\n&MAINTENANCE_MODE_REASON_USER_REQUESTED\x10\x01\x12+\n\'MAINTENANCE_MODE_REASON_VAULT_LOCKED_UP\x10\x02\x12*\n&MAINTENANCE_MODE_REASON_LOW_DISK_SPACE\x10\x03*`\n\x13\x41ppInstMetaDataType\x12 \n\x1c\x41PP_INST_META_DATA_TYPE_NONE\x10\x00\x12\'\n#APP_INST_META_DATA_TYPE_KUBE_CONFIG\x10\x01*a\n\x0cWirelessType\x12\x1d\n\x19WIRELESS_TYPE_UNSPECIFIED\x10\x00\x12\x16\n\x12WIRELESS_TYPE_WIFI\x10\x01\x12\x1a\n\x16WIRELESS_TYPE_CELLULAR\x10\x02*q\n\x0c\x42\x61seOsStatus\x12\x08\n\x04NONE\x10\x00\x12\x0f\n\x0b\x44OWNLOADING\x10\x01\x12\x11\n\rDOWNLOAD_DONE\x10\x02\x12\x0c\n\x08UPDATING\x10\x03\x12\x0b\n\x07UPDATED\x10\x04\x12\x0c\n\x08\x46\x41LLBACK\x10\x05\x12\n\n\x06\x46\x41ILED\x10\x06*\xcb\x01\n\x0f\x42\x61seOsSubStatus\x12\x12\n\x0eNONE_SUBSTATUS\x10\x00\x12\x17\n\x13\x44OWNLOAD_INPROGRESS\x10\x01\x12\x15\n\x11VERIFY_INPROGRESS\x10\x02\x12\x17\n\x13UPDATE_INITIALIZING\x10\x03\x12\x14\n\x10UPDATE_REBOOTING\x10\x04\x12\x12\n\x0eUPDATE_TESTING\x10\x05\x12\x1c\n\x18UPDATE_NEED_TEST_CONFIRM\x10\x06\x12\x13\n\x0fUPDATE_DEFERRED\x10\x07*\x8f\x01\n\rZInfoVpnState\x12\x0f\n\x0bVPN_INVALID\x10\x00\x12\x0f\n\x0bVPN_INITIAL\x10\x01\x12\x12\n\x0eVPN_CONNECTING\x10\x02\x12\x13\n\x0fVPN_ESTABLISHED\x10\x03\x12\x11\n\rVPN_INSTALLED\x10\x04\x12\x0f\n\x0bVPN_REKEYED\x10\x05\x12\x0f\n\x0bVPN_DELETED\x10\n*\x85\x01\n\x15ZNetworkInstanceState\x12\x1e\n\x1aZNETINST_STATE_UNSPECIFIED\x10\x00\x12\x17\n\x13ZNETINST_STATE_INIT\x10\x01\x12\x19\n\x15ZNETINST_STATE_ONLINE\x10\x02\x12\x18\n\x14ZNETINST_STATE_ERROR\x10\x03\x42\x39\n\x13org.lfedge.eve.infoZ\"github.com/lf-edge/eve/api/go/infob\x06proto3'
+  serialized_pb=b'\n\x0finfo/info.proto\x12\x13org.lfedge.eve.info\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1e\x65vecommon/devmodelcommon.proto\x1a\x19\x65vecommon/evecommon.proto\"\xdc\x01\n\x14\x64\x65precatedMetricItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x34\n\x04type\x18\x02 \x01(\x0e\x32&.org.lfedge.eve.info.DepMetricItemType\x12\x13\n\tboolValue\x18\x03 \x01(\x08H\x00\x12\x15\n\x0buint32Value\x18\x04 \x01(\rH\x00\x12\x15\n\x0buint64Value\x18\x05 \x01(\x04H\x00\x12\x14\n\nfloatValue\x18\x06 \x01(\x02H\x00\x12\x15\n\x0bstringValue\x18\x07 \x01(\tH\x00\x42\x11\n\x0fmetricItemValue\">\n\x15ZmetIPAssignmentEntry\x12\x12\n\nmacAddress\x18\x01 \x01(\t\x12\x11\n\tipAddress\x18\x02 \x03(\t\"A\n\x0bZmetVifInfo\x12\x0f\n\x07vifName\x18\x01 \x01(\t\x12\x12\n\nmacAddress\x18\x02 \x01(\t\x12\r\n\x05\x61ppID\x18\x03 \x01(\t\"\xa5\x02\n\tZioBundle\x12.\n\x04type\x18\x01 \x01(\x0e\x32 .org.lfedge.eve.common.PhyIoType\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07members\x18\x03 \x03(\t\x12\x15\n\rusedByAppUUID\x18\x04 \x01(\t\x12\x14\n\x0cusedByBaseOS\x18\x05 \x01(\x08\x12\x37\n\rioAddressList\x18\x06 \x03(\x0b\x32 .org.lfedge.eve.info.IoAddresses\x12\x36\n\x05usage\x18\x07 \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"!\n\x0bIoAddresses\x12\x12\n\nmacAddress\x18\x01 \x01(\t\"\xc9\x01\n\x11ZInfoManufacturer\x12\x14\n\x0cmanufacturer\x18\x01 \x01(\t\x12\x13\n\x0bproductName\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x14\n\x0cserialNumber\x18\x04 \x01(\t\x12\x0c\n\x04UUID\x18\x05 \x01(\t\x12\x12\n\ncompatible\x18\x06 \x01(\t\x12\x12\n\nbiosVendor\x18\x07 \x01(\t\x12\x13\n\x0b\x62iosVersion\x18\x08 \x01(\t\x12\x17\n\x0f\x62iosReleaseDate\x18\t \x01(\t\"\x8c\x03\n\x0cZInfoNetwork\x12\x0f\n\x07macAddr\x18\x03 \x01(\t\x12\x0f\n\x07\x64\x65vName\x18\x04 \x01(\t\x12\r\n\x05\x61lias\x18( \x01(\t\x12\x0f\n\x07IPAddrs\x18\x05 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x06 \x03(\t\x12*\n\x03\x64ns\x18\x07 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x0f\n\x07ipv4_up\x18\x08 \x01(\x08\x12-\n\x08location\x18\t \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12\x0e\n\x06uplink\x18\n \x01(\x08\x12\x32\n\nnetworkErr\x18\x0b \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x11\n\tlocalName\x18\x0c \x01(\t\x12/\n\x05proxy\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x19\n\x11ip_addr_mis_match\x18\x0e \x01(\x08\x12\x13\n\x0bntp_servers\x18\x0f \x03(\t\"\x87\x01\n\x06GeoLoc\x12\x12\n\nUnderlayIP\x18\x01 \x01(\t\x12\x10\n\x08Hostname\x18\x02 \x01(\t\x12\x0c\n\x04\x43ity\x18\x03 \x01(\t\x12\x0e\n\x06Region\x18\x04 \x01(\t\x12\x0f\n\x07\x43ountry\x18\x05 \x01(\t\x12\x0b\n\x03Loc\x18\x06 \x01(\t\x12\x0b\n\x03Org\x18\x07 \x01(\t\x12\x0e\n\x06Postal\x18\x08 \x01(\t\"D\n\x08ZInfoDNS\x12\x12\n\nDNSservers\x18\x01 \x03(\t\x12\x11\n\tDNSdomain\x18\x02 \x01(\t\x12\x11\n\tDNSsearch\x18\x03 \x03(\t\"\xa5\x01\n\x07ZInfoSW\x12\x11\n\tswVersion\x18\x02 \x01(\t\x12\x0e\n\x06swHash\x18\x03 \x01(\t\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x0e\n\x06target\x18\x06 \x01(\t\x12\x0c\n\x04vdev\x18\x07 \x01(\t\x12\x18\n\x10\x64ownloadProgress\x18\x08 \x01(\r\x12\x11\n\timageName\x18\t \x01(\t\"\xce\x01\n\tErrorInfo\x12\x13\n\x0b\x64\x65scription\x18\x01 \x01(\t\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x08severity\x18\x03 \x01(\x0e\x32\x1d.org.lfedge.eve.info.Severity\x12\x33\n\x08\x65ntities\x18\x04 \x03(\x0b\x32!.org.lfedge.eve.info.DeviceEntity\x12\x17\n\x0fretry_condition\x18\x05 \x01(\t\"N\n\x0c\x44\x65viceEntity\x12+\n\x06\x65ntity\x18\x01 \x01(\x0e\x32\x1b.org.lfedge.eve.info.Entity\x12\x11\n\tentity_id\x18\x02 \x01(\t\"\xb8\x01\n\tVaultInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x38\n\x06status\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x30\n\x08vaultErr\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x31\n\tpcrStatus\x18\x04 \x01(\x0e\x32\x1e.org.lfedge.eve.info.PCRStatus\"\x8a\x01\n\rDataSecAtRest\x12\x38\n\x06status\x18\x01 \x01(\x0e\x32(.org.lfedge.eve.info.DataSecAtRestStatus\x12\x0c\n\x04info\x18\x02 \x01(\t\x12\x31\n\tvaultList\x18\x03 \x03(\x0b\x32\x1e.org.lfedge.eve.info.VaultInfo\"<\n\x0cSecurityInfo\x12\x13\n\x0bsha_root_ca\x18\x01 \x01(\x0c\x12\x17\n\x0fsha_tls_root_ca\x18\x02 \x01(\x0c\"/\n\x0fZInfoConfigItem\x12\r\n\x05value\x18\x01 \x01(\t\x12\r\n\x05\x65rror\x18\x02 \x01(\t\"\x84\x03\n\x15ZInfoConfigItemStatus\x12P\n\x0b\x63onfigItems\x18\x01 \x03(\x0b\x32;.org.lfedge.eve.info.ZInfoConfigItemStatus.ConfigItemsEntry\x12^\n\x12unknownConfigItems\x18\x02 \x03(\x0b\x32\x42.org.lfedge.eve.info.ZInfoConfigItemStatus.UnknownConfigItemsEntry\x1aX\n\x10\x43onfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\x1a_\n\x17UnknownConfigItemsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x33\n\x05value\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZInfoConfigItem:\x02\x38\x01\"B\n\x10ZInfoAppInstance\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x12\n\ndomainName\x18\x03 \x01(\t\"3\n\x10ZInfoDeviceTasks\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tnamespace\x18\x02 \x01(\t\"\x86\x01\n\x0cZSimcardInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x18\n\x10\x63\x65ll_module_name\x18\x02 \x01(\t\x12\x0c\n\x04imsi\x18\x03 \x01(\t\x12\r\n\x05iccid\x18\x04 \x01(\t\x12\x31\n\x05state\x18\x05 \x01(\x0e\x32\".org.lfedge.eve.info.ZSimcardState\"\xea\x01\n\x13ZCellularModuleInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04imei\x18\x02 \x01(\t\x12\x18\n\x10\x66irmware_version\x18\x03 \x01(\t\x12\r\n\x05model\x18\x04 \x01(\t\x12\x45\n\x0foperating_state\x18\x05 \x01(\x0e\x32,.org.lfedge.eve.info.ZCellularOperatingState\x12G\n\x10\x63ontrol_protocol\x18\x06 \x01(\x0e\x32-.org.lfedge.eve.info.ZCellularControlProtocol\"`\n\x11ZCellularProvider\x12\x0c\n\x04plmn\x18\x01 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x02 \x01(\t\x12\x17\n\x0f\x63urrent_serving\x18\x03 \x01(\x08\x12\x0f\n\x07roaming\x18\x04 \x01(\x08\"\xc5\x01\n\x10StorageDiskState\x12\x39\n\tdisk_name\x18\x01 \x01(\x0b\x32&.org.lfedge.eve.common.DiskDescription\x12\x32\n\x06status\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x13\n\x0bread_errors\x18\x03 \x01(\x04\x12\x14\n\x0cwrite_errors\x18\x04 \x01(\x04\x12\x17\n\x0f\x63hecksum_errors\x18\x05 \x01(\x04\"\x98\x01\n\x10StorageVdevState\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x32\n\x06status\x18\x02 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x13\n\x0bread_errors\x18\x03 \x01(\x04\x12\x14\n\x0cwrite_errors\x18\x04 \x01(\x04\x12\x17\n\x0f\x63hecksum_errors\x18\x05 \x01(\x04\"\xc5\x02\n\x0fStorageScanInfo\x12\x32\n\x04\x66unc\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.StorageScanFunc\x12\x34\n\x05state\x18\x02 \x01(\x0e\x32%.org.lfedge.eve.info.StorageScanState\x12.\n\nstart_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x18\n\x10to_examine_bytes\x18\x05 \x01(\x04\x12\x16\n\x0e\x65xamined_bytes\x18\x06 \x01(\x04\x12\x10\n\x08progress\x18\x07 \x01(\r\x12\x16\n\x0erepaired_bytes\x18\x08 \x01(\x04\x12\x0e\n\x06\x65rrors\x18\t \x01(\x04\"m\n\tSmartAttr\x12\n\n\x02id\x18\x01 \x01(\r\x12\r\n\x05value\x18\x02 \x01(\x04\x12\r\n\x05worst\x18\x03 \x01(\x04\x12\x0e\n\x06thresh\x18\x04 \x01(\x04\x12\x13\n\x0bwhen_failed\x18\x05 \x01(\t\x12\x11\n\traw_value\x18\x06 \x01(\x04\"\x8a\x03\n\x0bSmartMetric\x12=\n\x15reallocated_sector_ct\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x36\n\x0epower_on_hours\x18\x02 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x39\n\x11power_cycle_count\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12?\n\x17reallocated_event_count\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12>\n\x16\x63urrent_pending_sector\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\x12\x13\n\x0bneed_update\x18\x06 \x01(\x08\x12\x33\n\x0btemperature\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.SmartAttr\"\xa7\x01\n\x0fStorageDiskInfo\x12\x11\n\tdisk_name\x18\x01 \x01(\t\x12\x34\n\nsmart_data\x18\x03 \x03(\x0b\x32 .org.lfedge.eve.info.SmartMetric\x12\x0b\n\x03wwn\x18\x04 \x01(\t\x12\x15\n\rserial_number\x18\x05 \x01(\t\x12\r\n\x05model\x18\x06 \x01(\t\x12\x18\n\x10\x63ollector_errors\x18\x07 \x01(\t\"\xbb\x01\n\x0fStorageChildren\x12:\n\x0c\x63urrent_raid\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x34\n\x05\x64isks\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x36\n\x08\x63hildren\x18\x03 \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\"\xd3\x04\n\x0bStorageInfo\x12\x11\n\tpool_name\x18\x01 \x01(\t\x12:\n\x0cstorage_type\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.info.StorageTypeInfo\x12\x13\n\x0bzfs_version\x18\x03 \x01(\t\x12:\n\x0c\x63urrent_raid\x18\x04 \x01(\x0e\x32$.org.lfedge.eve.info.StorageRaidType\x12\x19\n\x11\x63ompression_ratio\x18\x05 \x01(\x01\x12\x12\n\nzpool_size\x18\x06 \x01(\x04\x12\x13\n\x0b\x63ount_zvols\x18\x07 \x01(\r\x12\x39\n\rstorage_state\x18\x08 \x01(\x0e\x32\".org.lfedge.eve.info.StorageStatus\x12\x34\n\x05\x64isks\x18\t \x03(\x0b\x32%.org.lfedge.eve.info.StorageDiskState\x12\x18\n\x10\x63ollector_errors\x18\n \x01(\t\x12\x36\n\x08\x63hildren\x18\x0b \x03(\x0b\x32$.org.lfedge.eve.info.StorageChildren\x12\x32\n\x04scan\x18\x0c \x01(\x0b\x32$.org.lfedge.eve.info.StorageScanInfo\x12\x33\n\x0fnext_scrub_time\x18\r \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x34\n\x05vdevs\x18\x0e \x03(\x0b\x32%.org.lfedge.eve.info.StorageVdevState\"D\n\rZInfoHardware\x12\x33\n\x05\x64isks\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.info.StorageDiskInfo\"\xb5\x0e\n\x0bZInfoDevice\x12\x13\n\x0bmachineArch\x18\x04 \x01(\t\x12\x0f\n\x07\x63puArch\x18\x05 \x01(\t\x12\x10\n\x08platform\x18\x06 \x01(\t\x12\x0c\n\x04ncpu\x18\x07 \x01(\r\x12\x0e\n\x06memory\x18\x08 \x01(\x04\x12\x0f\n\x07storage\x18\t \x01(\x04\x12\x19\n\x11powerCycleCounter\x18\n \x01(\x03\x12\x35\n\x05minfo\x18\x0b \x01(\x0b\x32&.org.lfedge.eve.info.ZInfoManufacturer\x12\x32\n\x07network\x18\r \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12:\n\x12\x61ssignableAdapters\x18\x0f \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12*\n\x03\x64ns\x18\x10 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\x36\n\x0bstorageList\x18\x11 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoStorage\x12,\n\x08\x62ootTime\x18\x12 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12/\n\x06swList\x18\x13 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ZInfoDevSW\x12\x10\n\x08HostName\x18\x14 \x01(\t\x12>\n\x0bmetricItems\x18\x15 \x03(\x0b\x32).org.lfedge.eve.info.deprecatedMetricItem\x12\x18\n\x10lastRebootReason\x18\x16 \x01(\t\x12\x32\n\x0elastRebootTime\x18\x17 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12=\n\rsystemAdapter\x18\x18 \x01(\x0b\x32&.org.lfedge.eve.info.SystemAdapterInfo\x12\x16\n\x0erestartCounter\x18\x19 \x01(\r\x12>\n\tHSMStatus\x18\x1a \x01(\x0e\x32+.org.lfedge.eve.info.HwSecurityModuleStatus\x12\x0f\n\x07HSMInfo\x18\x1b \x01(\t\x12\x17\n\x0flastRebootStack\x18\x1c \x01(\t\x12=\n\x11\x64\x61taSecAtRestInfo\x18\x1d \x01(\x0b\x32\".org.lfedge.eve.info.DataSecAtRest\x12\x33\n\x08sec_info\x18\x1e \x01(\x0b\x32!.org.lfedge.eve.info.SecurityInfo\x12\x44\n\x10\x63onfigItemStatus\x18\x1f \x01(\x0b\x32*.org.lfedge.eve.info.ZInfoConfigItemStatus\x12;\n\x0c\x61ppInstances\x18  \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoAppInstance\x12\x1b\n\x13rebootConfigCounter\x18! \x01(\r\x12\x39\n\x10last_boot_reason\x18\" \x01(\x0e\x32\x1f.org.lfedge.eve.info.BootReason\x12=\n\x0b\x63\x65ll_radios\x18# \x03(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12/\n\x04sims\x18$ \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x34\n\x05tasks\x18% \x03(\x0b\x32%.org.lfedge.eve.info.ZInfoDeviceTasks\x12\x18\n\x10maintenance_mode\x18& \x01(\x08\x12K\n\x17maintenance_mode_reason\x18\' \x01(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12!\n\x19hardware_watchdog_present\x18( \x01(\x08\x12\x19\n\x11reboot_inprogress\x18) \x01(\x08\x12\x37\n\x0c\x63\x61pabilities\x18* \x01(\x0b\x32!.org.lfedge.eve.info.Capabilities\x12\x1d\n\x15\x62\x61seos_update_counter\x18+ \x01(\r\x12\x30\n\x05state\x18, \x01(\x0e\x32!.org.lfedge.eve.info.ZDeviceState\x12\x15\n\rlocal_profile\x18- \x01(\t\x12L\n\x18maintenance_mode_reasons\x18. \x03(\x0e\x32*.org.lfedge.eve.info.MaintenanceModeReason\x12\x18\n\x0c\x64ormant_time\x18/ \x01(\tB\x02\x18\x01\x12\x36\n\x0cstorage_info\x18\x30 \x03(\x0b\x32 .org.lfedge.eve.info.StorageInfo\"`\n\x11SystemAdapterInfo\x12\x14\n\x0c\x63urrentIndex\x18\x01 \x01(\r\x12\x35\n\x06status\x18\x02 \x03(\x0b\x32%.org.lfedge.eve.info.DevicePortStatus\"\x88\x02\n\x10\x44\x65vicePortStatus\x12\x0f\n\x07version\x18\x01 \x01(\r\x12\x0b\n\x03key\x18\x02 \x01(\t\x12\x30\n\x0ctimePriority\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nlastFailed\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x31\n\rlastSucceeded\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\x05ports\x18\x06 \x03(\x0b\x32\x1f.org.lfedge.eve.info.DevicePort\x12\x11\n\tlastError\x18\x07 \x01(\t\"\xfb\x04\n\nDevicePort\x12\x0e\n\x06ifname\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06isMgmt\x18\x03 \x01(\x08\x12\x0c\n\x04\x66ree\x18\x04 \x01(\x08\x12\x10\n\x08\x64hcpType\x18\x0b \x01(\r\x12\x0e\n\x06subnet\x18\x0c \x01(\t\x12\x0f\n\x07gateway\x18\r \x01(\t\x12\x12\n\ndomainname\x18\x0e \x01(\t\x12\x11\n\tntpServer\x18\x0f \x01(\t\x12\x12\n\ndnsServers\x18\x10 \x03(\t\x12\x14\n\x0c\x64hcpRangeLow\x18\x11 \x01(\t\x12\x15\n\rdhcpRangeHigh\x18\x12 \x01(\t\x12/\n\x05proxy\x18\x15 \x01(\x0b\x32 .org.lfedge.eve.info.ProxyStatus\x12\x0f\n\x07macAddr\x18\x16 \x01(\t\x12\x0f\n\x07IPAddrs\x18\x17 \x03(\t\x12\x16\n\x0e\x64\x65\x66\x61ultRouters\x18\x18 \x03(\t\x12*\n\x03\x64ns\x18\x19 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoDNS\x12\n\n\x02up\x18\x1a \x01(\x08\x12-\n\x08location\x18\x1b \x01(\x0b\x32\x1b.org.lfedge.eve.info.GeoLoc\x12+\n\x03\x65rr\x18\x1d \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x36\n\x05usage\x18\x1e \x01(\x0e\x32\'.org.lfedge.eve.common.PhyIoMemberUsage\x12\x13\n\x0bnetworkUUID\x18\x1f \x01(\t\x12\x0c\n\x04\x63ost\x18  \x01(\r\x12<\n\x0fwireless_status\x18! \x01(\x0b\x32#.org.lfedge.eve.info.WirelessStatus\"\xaa\x01\n\x0bProxyStatus\x12\x30\n\x07proxies\x18\x01 \x03(\x0b\x32\x1f.org.lfedge.eve.info.ProxyEntry\x12\x12\n\nexceptions\x18\x02 \x01(\t\x12\x0f\n\x07pacfile\x18\x03 \x01(\t\x12\x1a\n\x12networkProxyEnable\x18\x04 \x01(\x08\x12\x17\n\x0fnetworkProxyURL\x18\x05 \x01(\t\x12\x0f\n\x07wpadURL\x18\x06 \x01(\t\"8\n\nProxyEntry\x12\x0c\n\x04type\x18\x01 \x01(\r\x12\x0e\n\x06server\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"\xa9\x01\n\x0eWirelessStatus\x12/\n\x04type\x18\x01 \x01(\x0e\x32!.org.lfedge.eve.info.WirelessType\x12\x36\n\x08\x63\x65llular\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZCellularStatus\x12.\n\x04wifi\x18\x03 \x01(\x0b\x32 .org.lfedge.eve.info.ZWifiStatus\"\xa3\x01\n\x0fZCellularStatus\x12\x17\n\x0f\x63\x65llular_module\x18\x01 \x01(\t\x12\x11\n\tsim_cards\x18\x02 \x03(\t\x12\x39\n\tproviders\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\"g\n\x0cZWifiNetwork\x12\x0c\n\x04ssid\x18\x01 \x01(\t\x12\r\n\x05\x62ssid\x18\x02 \x01(\t\x12\x15\n\rfrequency_mhz\x18\x03 \x01(\r\x12\x14\n\x0csignal_level\x18\x04 \x01(\x05\x12\r\n\x05\x66lags\x18\x05 \x01(\t\"\xed\x02\n\x0bZWifiStatus\x12.\n\x05state\x18\x01 \x01(\x0e\x32\x1f.org.lfedge.eve.info.ZWifiState\x12\x0c\n\x04ssid\x18\x02 \x01(\t\x12\r\n\x05\x62ssid\x18\x03 \x01(\t\x12\x15\n\rfrequency_mhz\x18\x04 \x01(\r\x12\x10\n\x08key_mgmt\x18\x05 \x01(\t\x12\x15\n\rauth_failures\x18\x06 \x01(\r\x12\x19\n\x11last_auth_failure\x18\x07 \x01(\t\x12:\n\x16last_auth_failure_time\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x37\n\x0cscan_results\x18\t \x03(\x0b\x32!.org.lfedge.eve.info.ZWifiNetwork\x12\x32\n\x0elast_scan_time\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05\x65rror\x18\x0b \x01(\t\"\xac\x03\n\nZInfoDevSW\x12\x11\n\tactivated\x18\x02 \x01(\x08\x12\x16\n\x0epartitionLabel\x18\x03 \x01(\t\x12\x17\n\x0fpartitionDevice\x18\x04 \x01(\t\x12\x16\n\x0epartitionState\x18\x05 \x01(\t\x12-\n\x06status\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x14\n\x0cshortVersion\x18\x07 \x01(\t\x12\x13\n\x0blongVersion\x18\x08 \x01(\t\x12-\n\x05swErr\x18\t \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x64ownloadProgress\x18\n \x01(\r\x12\x35\n\nuserStatus\x18\x0b \x01(\x0e\x32!.org.lfedge.eve.info.BaseOsStatus\x12\x14\n\x0csubStatusStr\x18\x0c \x01(\t\x12\x37\n\tsubStatus\x18\r \x01(\x0e\x32$.org.lfedge.eve.info.BaseOsSubStatus\x12\x19\n\x11subStatusProgress\x18\x0e \x01(\r\"Y\n\x0cZInfoStorage\x12\x0e\n\x06\x64\x65vice\x18\x01 \x01(\t\x12\x11\n\tmountPath\x18\x02 \x01(\t\x12\r\n\x05total\x18\x03 \x01(\x04\x12\x17\n\x0fstorageLocation\x18\x04 \x01(\x08\"\xf9\x03\n\x08ZInfoApp\x12\r\n\x05\x41ppID\x18\x01 \x01(\t\x12\x12\n\nappVersion\x18\x02 \x01(\t\x12\x11\n\tsystemApp\x18\x06 \x01(\x08\x12\x0f\n\x07\x41ppName\x18\x07 \x01(\t\x12\x32\n\x0csoftwareList\x18\x08 \x03(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12,\n\x08\x62ootTime\x18\x0c \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x38\n\x10\x61ssignedAdapters\x18\r \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x06\x61ppErr\x18\x0e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x32\n\x07network\x18\x10 \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoNetwork\x12\x12\n\nvolumeRefs\x18\x11 \x03(\t\x12/\n\x06health\x18\x12 \x01(\x0b\x32\x1f.org.lfedge.eve.info.ZAppHealth\x12\x33\n\nguest_info\x18\x13 \x01(\x0b\x32\x1f.org.lfedge.eve.info.ZGuestInfo\"\x86\x01\n\nZGuestInfo\x12\x17\n\x0f\x61gent_connected\x18\x01 \x01(\x08\x12\x0f\n\x07os_name\x18\x02 \x01(\t\x12\x12\n\nos_version\x18\x03 \x01(\t\x12\x16\n\x0ekernel_release\x18\x04 \x01(\t\x12\x10\n\x08hostname\x18\x05 \x01(\t\x12\x10\n\x08ip_addrs\x18\x06 \x03(\t\"\xcd\x01\n\x0fZAppProbeStatus\x12\x34\n\x06result\x18\x01 \x01(\x0e\x32$.org.lfedge.eve.info.ZAppProbeResult\x12\x1c\n\x14\x63onsecutive_failures\x18\x02 \x01(\r\x12\x1d\n\x15\x63onsecutive_successes\x18\x03 \x01(\r\x12\x33\n\x0flast_probe_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x12\n\nlast_error\x18\x05 \x01(\t\"\xae\x02\n\nZAppHealth\x12\x36\n\x08liveness\x18\x01 \x01(\x0b\x32$.org.lfedge.eve.info.ZAppProbeStatus\x12\x37\n\treadiness\x18\x02 \x01(\x0b\x32$.org.lfedge.eve.info.ZAppProbeStatus\x12\r\n\x05ready\x18\x03 \x01(\x08\x12\x15\n\rrestart_count\x18\x04 \x01(\r\x12\x1b\n\x13last_restart_reason\x18\x05 \x01(\t\x12\x35\n\x11last_restart_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x35\n\x11next_restart_time\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"D\n\x10ZInfoVpnLinkInfo\x12\r\n\x05spiId\x18\x01 \x01(\t\x12\x0e\n\x06subNet\x18\x02 \x01(\t\x12\x11\n\tdirection\x18\x03 \x01(\x08\"\xf9\x01\n\x0cZInfoVpnLink\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05reqId\x18\x03 \x01(\t\x12\x10\n\x08instTime\x18\x04 \x01(\x04\x12\x0f\n\x07\x65spInfo\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\n \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\x12\x34\n\x05rInfo\x18\x0b \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnLinkInfo\"<\n\x10ZInfoVpnEndPoint\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06ipAddr\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\r\"\xa9\x02\n\x0cZInfoVpnConn\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x0f\n\x07\x65stTime\x18\x04 \x01(\x04\x12\x0c\n\x04ikes\x18\x05 \x01(\t\x12\x31\n\x05state\x18\x06 \x01(\x0e\x32\".org.lfedge.eve.info.ZInfoVpnState\x12\x34\n\x05lInfo\x18\x07 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x34\n\x05rInfo\x18\x08 \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoVpnEndPoint\x12\x30\n\x05links\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnLink\"z\n\x08ZInfoVpn\x12\x0e\n\x06upTime\x18\x01 \x01(\x04\x12\x13\n\x0bpolicyBased\x18\x02 \x01(\x08\x12\x18\n\x10listeningIpAddrs\x18\x03 \x03(\t\x12/\n\x04\x63onn\x18\n \x03(\x0b\x32!.org.lfedge.eve.info.ZInfoVpnConn\"\xee\x05\n\x14ZInfoNetworkInstance\x12\x11\n\tnetworkID\x18\x02 \x01(\t\x12\x16\n\x0enetworkVersion\x18\x03 \x01(\t\x12\x10\n\x08instType\x18\x05 \x01(\r\x12\x13\n\x0b\x64isplayname\x18\x06 \x01(\t\x12\x11\n\tactivated\x18\x07 \x01(\x08\x12/\n\x0bupTimeStamp\x18\x08 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x32\n\x0csoftwareList\x18\t \x01(\x0b\x32\x1c.org.lfedge.eve.info.ZInfoSW\x12\x19\n\x11\x43urrentUplinkIntf\x18\n \x01(\t\x12\x1a\n\x12\x43urrentUplinkAlias\x18\x0b \x01(\t\x12\x11\n\tbridgeNum\x18\x14 \x01(\r\x12\x12\n\nbridgeName\x18\x15 \x01(\t\x12\x14\n\x0c\x62ridgeIPAddr\x18\x16 \x01(\t\x12\x41\n\ripAssignments\x18\x17 \x03(\x0b\x32*.org.lfedge.eve.info.ZmetIPAssignmentEntry\x12\x14\n\x0c\x62ridgeIPSets\x18\x18 \x03(\t\x12.\n\x04vifs\x18\x19 \x03(\x0b\x32 .org.lfedge.eve.info.ZmetVifInfo\x12\x0f\n\x07ipv4Eid\x18\x1a \x01(\x08\x12\x16\n\x0e\x62ridgeIPv6Addr\x18\x1b \x01(\t\x12\x38\n\x10\x61ssignedAdapters\x18\x1e \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZioBundle\x12.\n\x05vinfo\x18\x1f \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoVpnH\x00\x12\x32\n\nnetworkErr\x18( \x03(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x39\n\x05state\x18) \x01(\x0e\x32*.org.lfedge.eve.info.ZNetworkInstanceStateB\r\n\x0bInfoContent\"\x89\x01\n\tUsageInfo\x12.\n\ncreateTime\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08refCount\x18\x02 \x01(\r\x12:\n\x16lastRefcountChangeTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"=\n\x0fVolumeResources\x12\x14\n\x0cmaxSizeBytes\x18\x01 \x01(\x04\x12\x14\n\x0c\x63urSizeBytes\x18\x02 \x01(\x04\"\xaf\x02\n\x0bZInfoVolume\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12\x37\n\tresources\x18\x04 \x01(\x0b\x32$.org.lfedge.eve.info.VolumeResources\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x06 \x01(\r\x12\x31\n\tvolumeErr\x18\x07 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10generation_count\x18\x08 \x01(\x03\"(\n\x10\x43ontentResources\x12\x14\n\x0c\x63urSizeBytes\x18\x01 \x01(\x04\"\xd9\x02\n\x10ZInfoContentTree\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayName\x18\x02 \x01(\t\x12\x0e\n\x06sha256\x18\x03 \x01(\t\x12\x38\n\tresources\x18\x04 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x05 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x06 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x07 \x01(\r\x12+\n\x03\x65rr\x18\x08 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12\x18\n\x10\x63omponentShaList\x18\t \x03(\t\x12\x18\n\x10generation_count\x18\n \x01(\x03\"\xfb\x01\n\tZInfoBlob\x12\x0e\n\x06sha256\x18\x01 \x01(\t\x12\x38\n\tresources\x18\x02 \x01(\x0b\x32%.org.lfedge.eve.info.ContentResources\x12-\n\x05usage\x18\x03 \x01(\x0b\x32\x1e.org.lfedge.eve.info.UsageInfo\x12,\n\x05state\x18\x04 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12progressPercentage\x18\x05 \x01(\r\x12+\n\x03\x65rr\x18\x06 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\"=\n\rZInfoBlobList\x12,\n\x04\x62lob\x18\x01 \x03(\x0b\x32\x1e.org.lfedge.eve.info.ZInfoBlob\"\xf3\x04\n\x08ZInfoMsg\x12.\n\x05ztype\x18\x01 \x01(\x0e\x32\x1f.org.lfedge.eve.info.ZInfoTypes\x12\r\n\x05\x64\x65vId\x18\x02 \x01(\t\x12\x31\n\x05\x64info\x18\x03 \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoDeviceH\x00\x12.\n\x05\x61info\x18\x05 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoAppH\x00\x12;\n\x06niinfo\x18\x0c \x01(\x0b\x32).org.lfedge.eve.info.ZInfoNetworkInstanceH\x00\x12\x31\n\x05vinfo\x18\r \x01(\x0b\x32 .org.lfedge.eve.info.ZInfoVolumeH\x00\x12\x36\n\x05\x63info\x18\x0e \x01(\x0b\x32%.org.lfedge.eve.info.ZInfoContentTreeH\x00\x12\x33\n\x05\x62info\x18\x0f \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoBlobListH\x00\x12<\n\x07\x61mdinfo\x18\x10 \x01(\x0b\x32).org.lfedge.eve.info.ZInfoAppInstMetaDataH\x00\x12\x34\n\x06\x65vinfo\x18\x11 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoEdgeviewH\x00\x12\x34\n\x06hwinfo\x18\x12 \x01(\x0b\x32\".org.lfedge.eve.info.ZInfoHardwareH\x00\x12/\n\x0b\x61tTimeStamp\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampB\r\n\x0bInfoContent\"J\n\x0c\x43\x61pabilities\x12 \n\x18HWAssistedVirtualization\x18\x02 \x01(\x08\x12\x18\n\x10IOVirtualization\x18\x03 \x01(\x08\"j\n\x14ZInfoAppInstMetaData\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x36\n\x04type\x18\x02 \x01(\x0e\x32(.org.lfedge.eve.info.AppInstMetaDataType\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"\x98\x01\n\rZInfoEdgeview\x12/\n\x0b\x65xpire_time\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x30\n\x0cstarted_time\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tcount_dev\x18\x03 \x01(\r\x12\x11\n\tcount_app\x18\x04 \x01(\r*u\n\x11\x44\x65pMetricItemType\x12\x16\n\x12\x44\x65pMetricItemOther\x10\x00\x12\x16\n\x12\x44\x65pMetricItemGauge\x10\x01\x12\x18\n\x14\x44\x65pMetricItemCounter\x10\x02\x12\x16\n\x12\x44\x65pMetricItemState\x10\x03*\x9f\x01\n\nZInfoTypes\x12\t\n\x05ZiNop\x10\x00\x12\x0c\n\x08ZiDevice\x10\x01\x12\t\n\x05ZiApp\x10\x03\x12\x15\n\x11ZiNetworkInstance\x10\x06\x12\x0c\n\x08ZiVolume\x10\x07\x12\x11\n\rZiContentTree\x10\x08\x12\x0e\n\nZiBlobList\x10\t\x12\x15\n\x11ZiAppInstMetaData\x10\n\x12\x0e\n\nZiHardware\x10\x0b*\xe1\x02\n\x08ZSwState\x12\x0b\n\x07INVALID\x10\x00\x12\x0b\n\x07INITIAL\x10\x01\x12\x14\n\x10\x44OWNLOAD_STARTED\x10\x02\x12\x0e\n\nDOWNLOADED\x10\x03\x12\r\n\tDELIVERED\x10\x04\x12\r\n\tINSTALLED\x10\x05\x12\x0b\n\x07\x42OOTING\x10\x06\x12\x0b\n\x07RUNNING\x10\x07\x12\x0b\n\x07HALTING\x10\x08\x12\n\n\x06HALTED\x10\t\x12\x0e\n\nRESTARTING\x10\n\x12\x0b\n\x07PURGING\x10\x0b\x12\x11\n\rRESOLVING_TAG\x10\x0c\x12\x10\n\x0cRESOLVED_TAG\x10\r\x12\x13\n\x0f\x43REATING_VOLUME\x10\x0e\x12\x12\n\x0e\x43REATED_VOLUME\x10\x0f\x12\r\n\tVERIFYING\x10\x10\x12\x0c\n\x08VERIFIED\x10\x11\x12\x0b\n\x07LOADING\x10\x12\x12\n\n\x06LOADED\x10\x13\x12\x18\n\x14\x41WAITNETWORKINSTANCE\x10\x14\x12\t\n\x05\x45RROR\x10\x15*\x99\x02\n\x06\x45ntity\x12\x16\n\x12\x45NTITY_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x45NTITY_BASE_OS\x10\x01\x12\x19\n\x15\x45NTITY_SYSTEM_ADAPTER\x10\x02\x12\x10\n\x0c\x45NTITY_VAULT\x10\x03\x12\x16\n\x12\x45NTITY_ATTESTATION\x10\x04\x12\x17\n\x13\x45NTITY_APP_INSTANCE\x10\x05\x12\x0f\n\x0b\x45NTITY_PORT\x10\x06\x12\x12\n\x0e\x45NTITY_NETWORK\x10\x07\x12\x1b\n\x17\x45NTITY_NETWORK_INSTANCE\x10\x08\x12\x17\n\x13\x45NTITY_CONTENT_TREE\x10\t\x12\x17\n\x13\x45NTITY_CONTENT_BLOB\x10\n\x12\x11\n\rENTITY_VOLUME\x10\x0b*c\n\x08Severity\x12\x18\n\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x13\n\x0fSEVERITY_NOTICE\x10\x01\x12\x14\n\x10SEVERITY_WARNING\x10\x02\x12\x12\n\x0eSEVERITY_ERROR\x10\x03*N\n\x16HwSecurityModuleStatus\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0c\n\x08NOTFOUND\x10\x01\x12\x0c\n\x08\x44ISABLED\x10\x02\x12\x0b\n\x07\x45NABLED\x10\x03*\x88\x01\n\x13\x44\x61taSecAtRestStatus\x12\x1b\n\x17\x44\x41TASEC_AT_REST_UNKNOWN\x10\x00\x12\x1c\n\x18\x44\x41TASEC_AT_REST_DISABLED\x10\x01\x12\x1b\n\x17\x44\x41TASEC_AT_REST_ENABLED\x10\x02\x12\x19\n\x15\x44\x41TASEC_AT_REST_ERROR\x10\x04*?\n\tPCRStatus\x12\x0f\n\x0bPCR_UNKNOWN\x10\x00\x12\x0f\n\x0bPCR_ENABLED\x10\x01\x12\x10\n\x0cPCR_DISABLED\x10\x02*\xc5\x01\n\rZSimcardState\x12\x1b\n\x17Z_SIMCARD_STATE_INVALID\x10\x00\x12\x1c\n\x18Z_SIMCARD_STATE_ASSIGNED\x10\x01\x12\x1f\n\x1bZ_SIMCARD_STATE_PROVISIONED\x10\x02\x12\x1a\n\x16Z_SIMCARD_STATE_ACTIVE\x10\x03\x12\x1d\n\x19Z_SIMCARD_STATE_SUSPENDED\x10\x04\x12\x1d\n\x19Z_SIMCARD_STATE_CANCELLED\x10\x05*\xa0\x02\n\x17ZCellularOperatingState\x12*\n&Z_CELLULAR_OPERATING_STATE_UNSPECIFIED\x10\x00\x12&\n\"Z_CELLULAR_OPERATING_STATE_OFFLINE\x10\x01\x12(\n$Z_CELLULAR_OPERATING_STATE_RADIO_OFF\x10\x02\x12%\n!Z_CELLULAR_OPERATING_STATE_ONLINE\x10\x03\x12\x33\n/Z_CELLULAR_OPERATING_STATE_ONLINE_AND_CONNECTED\x10\x04\x12+\n\'Z_CELLULAR_OPERATING_STATE_UNRECOGNIZED\x10\x05*\x92\x01\n\x18ZCellularControlProtocol\x12+\n\'Z_CELLULAR_CONTROL_PROTOCOL_UNSPECIFIED\x10\x00\x12#\n\x1fZ_CELLULAR_CONTROL_PROTOCOL_QMI\x10\x01\x12$\n Z_CELLULAR_CONTROL_PROTOCOL_MBIM\x10\x02*\xc6\x01\n\x0cZDeviceState\x12\x1d\n\x19ZDEVICE_STATE_UNSPECIFIED\x10\x00\x12\x18\n\x14ZDEVICE_STATE_ONLINE\x10\x01\x12\x1b\n\x17ZDEVICE_STATE_REBOOTING\x10\x02\x12\"\n\x1eZDEVICE_STATE_MAINTENANCE_MODE\x10\x03\x12!\n\x1dZDEVICE_STATE_BASEOS_UPDATING\x10\x04\x12\x19\n\x15ZDEVICE_STATE_BOOTING\x10\x05*\xf5\x01\n\rStorageStatus\x12\x1e\n\x1aSTORAGE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n\x15STORAGE_STATUS_ONLINE\x10\x01\x12\x1b\n\x17STORAGE_STATUS_DEGRADED\x10\x02\x12\x1a\n\x16STORAGE_STATUS_FAULTED\x10\x03\x12\x1a\n\x16STORAGE_STATUS_OFFLINE\x10\x04\x12\x1a\n\x16STORAGE_STATUS_UNAVAIL\x10\x05\x12\x1a\n\x16STORAGE_STATUS_REMOVED\x10\x06\x12\x1c\n\x18STORAGE_STATUS_SUSPENDED\x10\x07*q\n\x0fStorageScanFunc\x12!\n\x1dSTORAGE_SCAN_FUNC_UNSPECIFIED\x10\x00\x12\x1b\n\x17STORAGE_SCAN_FUNC_SCRUB\x10\x01\x12\x1e\n\x1aSTORAGE_SCAN_FUNC_RESILVER\x10\x02*\x99\x01\n\x10StorageScanState\x12\"\n\x1eSTORAGE_SCAN_STATE_UNSPECIFIED\x10\x00\x12\x1f\n\x1bSTORAGE_SCAN_STATE_SCANNING\x10\x01\x12\x1f\n\x1bSTORAGE_SCAN_STATE_FINISHED\x10\x02\x12\x1f\n\x1bSTORAGE_SCAN_STATE_CANCELED\x10\x03*\xe3\x01\n\x0fStorageRaidType\x12!\n\x1dSTORAGE_RAID_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID0\x10\x01\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID1\x10\x02\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID5\x10\x03\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID6\x10\x04\x12\x1b\n\x17STORAGE_RAID_TYPE_RAID7\x10\x05\x12\x1c\n\x18STORAGE_RAID_TYPE_NORAID\x10\x06*k\n\x0fStorageTypeInfo\x12!\n\x1dSTORAGE_TYPE_INFO_UNSPECIFIED\x10\x00\x12\x1a\n\x16STORAGE_TYPE_INFO_EXT4\x10\x01\x12\x19\n\x15STORAGE_TYPE_INFO_ZFS\x10\x02*\x9b\x03\n\nBootReason\x12\x1b\n\x17\x42OOT_REASON_UNSPECIFIED\x10\x00\x12\x15\n\x11\x42OOT_REASON_FIRST\x10\x01\x12\x1a\n\x16\x42OOT_REASON_REBOOT_CMD\x10\x02\x12\x16\n\x12\x42OOT_REASON_UPDATE\x10\x03\x12\x18\n\x14\x42OOT_REASON_FALLBACK\x10\x04\x12\x1a\n\x16\x42OOT_REASON_DISCONNECT\x10\x05\x12\x15\n\x11\x42OOT_REASON_FATAL\x10\x06\x12\x13\n\x0f\x42OOT_REASON_OOM\x10\x07\x12\x1d\n\x19\x42OOT_REASON_WATCHDOG_HUNG\x10\x08\x12\x1c\n\x18\x42OOT_REASON_WATCHDOG_PID\x10\t\x12\x16\n\x12\x42OOT_REASON_KERNEL\x10\n\x12\x1a\n\x16\x42OOT_REASON_POWER_FAIL\x10\x0b\x12\x17\n\x13\x42OOT_REASON_UNKNOWN\x10\x0c\x12\x1c\n\x18\x42OOT_REASON_VAULT_FAILED\x10\r\x12\x1b\n\x16\x42OOT_REASON_PARSE_FAIL\x10\xff\x01*\xbe\x01\n\x15MaintenanceModeReason\x12 \n\x1cMAINTENANCE_MODE_REASON_NONE\x10\x00\x12*\n&MAINTENANCE_MODE_REASON_USER_REQUESTED\x10\x01\x12+\n\'MAINTENANCE_MODE_REASON_VAULT_LOCKED_UP\x10\x02\x12*\n&MAINTENANCE_MODE_REASON_LOW_DISK_SPACE\x10\x03*`\n\x13\x41ppInstMetaDataType\x12 \n\x1c\x41PP_INST_META_DATA_TYPE_NONE\x10\x00\x12\'\n#APP_INST_META_DATA_TYPE_KUBE_CONFIG\x10\x01*a\n\x0cWirelessType\x12\x1d\n\x19WIRELESS_TYPE_UNSPECIFIED\x10\x00\x12\x16\n\x12WIRELESS_TYPE_WIFI\x10\x01\x12\x1a\n\x16WIRELESS_TYPE_CELLULAR\x10\x02*\xb8\x01\n\nZWifiState\x12\x1c\n\x18Z_WIFI_STATE_UNSPECIFIED\x10\x00\x12\x19\n\x15Z_WIFI_STATE_DISABLED\x10\x01\x12\x1d\n\x19Z_WIFI_STATE_DISCONNECTED\x10\x02\x12\x19\n\x15Z_WIFI_STATE_SCANNING\x10\x03\x12\x1b\n\x17Z_WIFI_STATE_CONNECTING\x10\x04\x12\x1a\n\x16Z_WIFI_STATE_CONNECTED\x10\x05*q\n\x0c\x42\x61seOsStatus\x12\x08\n\x04NONE\x10\x00\x12\x0f\n\x0b\x44OWNLOADING\x10\x01\x12\x11\n\rDOWNLOAD_DONE\x10\x02\x12\x0c\n\x08UPDATING\x10\x03\x12\x0b\n\x07UPDATED\x10\x04\x12\x0c\n\x08\x46\x41LLBACK\x10\x05\x12\n\n\x06\x46\x41ILED\x10\x06*\xcb\x01\n\x0f\x42\x61seOsSubStatus\x12\x12\n\x0eNONE_SUBSTATUS\x10\x00\x12\x17\n\x13\x44OWNLOAD_INPROGRESS\x10\x01\x12\x15\n\x11VERIFY_INPROGRESS\x10\x02\x12\x17\n\x13UPDATE_INITIALIZING\x10\x03\x12\x14\n\x10UPDATE_REBOOTING\x10\x04\x12\x12\n\x0eUPDATE_TESTING\x10\x05\x12\x1c\n\x18UPDATE_NEED_TEST_CONFIRM\x10\x06\x12\x13\n\x0fUPDATE_DEFERRED\x10\x07*u\n\x0fZAppProbeResult\x12\"\n\x1eZ_APP_PROBE_RESULT_UNSPECIFIED\x10\x00\x12\x1e\n\x1aZ_APP_PROBE_RESULT_SUCCESS\x10\x01\x12\x1e\n\x1aZ_APP_PROBE_RESULT_FAILURE\x10\x02*\x8f\x01\n\rZInfoVpnState\x12\x0f\n\x0bVPN_INVALID\x10\x00\x12\x0f\n\x0bVPN_INITIAL\x10\x01\x12\x12\n\x0eVPN_CONNECTING\x10\x02\x12\x13\n\x0fVPN_ESTABLISHED\x10\x03\x12\x11\n\rVPN_INSTALLED\x10\x04\x12\x0f\n\x0bVPN_REKEYED\x10\x05\x12\x0f\n\x0bVPN_DELETED\x10\n*\x85\x01\n\x15ZNetworkInstanceState\x12\x1e\n\x1aZNETINST_STATE_UNSPECIFIED\x10\x00\x12\x17\n\x13ZNETINST_STATE_INIT\x10\x01\x12\x19\n\x15ZNETINST_STATE_ONLINE\x10\x02\x12\x18\n\x14ZNETINST_STATE_ERROR\x10\x03\x42\x39\n\x13org.lfedge.eve.infoZ\"github.com/lf-edge/eve/api/go/infob\x06proto3'
   ,
   dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,evecommon_dot_devmodelcommon__pb2.DESCRIPTOR,evecommon_dot_evecommon__pb2.DESCRIPTOR,])
 
@@ -57,8 +57,8 @@ _DEPMETRICITEMTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13038,
-  serialized_end=13155,
+  serialized_start=15046,
+  serialized_end=15163,
 )
 _sym_db.RegisterEnumDescriptor(_DEPMETRICITEMTYPE)
 
@@ -118,8 +118,8 @@ _ZINFOTYPES = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13158,
-  serialized_end=13317,
+  serialized_start=15166,
+  serialized_end=15325,
 )
 _sym_db.RegisterEnumDescriptor(_ZINFOTYPES)
 
@@ -244,8 +244,8 @@ _ZSWSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13320,
-  serialized_end=13673,
+  serialized_start=15328,
+  serialized_end=15681,
 )
 _sym_db.RegisterEnumDescriptor(_ZSWSTATE)
 
@@ -320,8 +320,8 @@ _ENTITY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13676,
-  serialized_end=13957,
+  serialized_start=15684,
+  serialized_end=15965,
 )
 _sym_db.RegisterEnumDescriptor(_ENTITY)
 
@@ -356,8 +356,8 @@ _SEVERITY = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=13959,
-  serialized_end=14058,
+  serialized_start=15967,
+  serialized_end=16066,
 )
 _sym_db.RegisterEnumDescriptor(_SEVERITY)
 
@@ -392,8 +392,8 @@ _HWSECURITYMODULESTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14060,
-  serialized_end=14138,
+  serialized_start=16068,
+  serialized_end=16146,
 )
 _sym_db.RegisterEnumDescriptor(_HWSECURITYMODULESTATUS)
 
@@ -428,8 +428,8 @@ _DATASECATRESTSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14141,
-  serialized_end=14277,
+  serialized_start=16149,
+  serialized_end=16285,
 )
 _sym_db.RegisterEnumDescriptor(_DATASECATRESTSTATUS)
 
@@ -459,8 +459,8 @@ _PCRSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14279,
-  serialized_end=14342,
+  serialized_start=16287,
+  serialized_end=16350,
 )
 _sym_db.RegisterEnumDescriptor(_PCRSTATUS)
 
@@ -505,8 +505,8 @@ _ZSIMCARDSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14345,
-  serialized_end=14542,
+  serialized_start=16353,
+  serialized_end=16550,
 )
 _sym_db.RegisterEnumDescriptor(_ZSIMCARDSTATE)
 
@@ -551,8 +551,8 @@ _ZCELLULAROPERATINGSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14545,
-  serialized_end=14833,
+  serialized_start=16553,
+  serialized_end=16841,
 )
 _sym_db.RegisterEnumDescriptor(_ZCELLULAROPERATINGSTATE)
 
@@ -582,8 +582,8 @@ _ZCELLULARCONTROLPROTOCOL = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14836,
-  serialized_end=14982,
+  serialized_start=16844,
+  serialized_end=16990,
 )
 _sym_db.RegisterEnumDescriptor(_ZCELLULARCONTROLPROTOCOL)
 
@@ -628,8 +628,8 @@ _ZDEVICESTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=14985,
-  serialized_end=15183,
+  serialized_start=16993,
+  serialized_end=17191,
 )
 _sym_db.RegisterEnumDescriptor(_ZDEVICESTATE)
 
@@ -684,12 +684,79 @@ _STORAGESTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15186,
-  serialized_end=15431,
+  serialized_start=17194,
+  serialized_end=17439,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGESTATUS)
 
 StorageStatus = enum_type_wrapper.EnumTypeWrapper(_STORAGESTATUS)
+_STORAGESCANFUNC = _descriptor.EnumDescriptor(
+  name='StorageScanFunc',
+  full_name='org.lfedge.eve.info.StorageScanFunc',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_FUNC_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_FUNC_SCRUB', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_FUNC_RESILVER', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=17441,
+  serialized_end=17554,
+)
+_sym_db.RegisterEnumDescriptor(_STORAGESCANFUNC)
+
+StorageScanFunc = enum_type_wrapper.EnumTypeWrapper(_STORAGESCANFUNC)
+_STORAGESCANSTATE = _descriptor.EnumDescriptor(
+  name='StorageScanState',
+  full_name='org.lfedge.eve.info.StorageScanState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_SCANNING', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_FINISHED', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='STORAGE_SCAN_STATE_CANCELED', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=17557,
+  serialized_end=17710,
+)
+_sym_db.RegisterEnumDescriptor(_STORAGESCANSTATE)
+
+StorageScanState = enum_type_wrapper.EnumTypeWrapper(_STORAGESCANSTATE)
 _STORAGERAIDTYPE = _descriptor.EnumDescriptor(
   name='StorageRaidType',
   full_name='org.lfedge.eve.info.StorageRaidType',
@@ -735,8 +802,8 @@ _STORAGERAIDTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15434,
-  serialized_end=15661,
+  serialized_start=17713,
+  serialized_end=17940,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGERAIDTYPE)
 
@@ -766,8 +833,8 @@ _STORAGETYPEINFO = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15663,
-  serialized_end=15770,
+  serialized_start=17942,
+  serialized_end=18049,
 )
 _sym_db.RegisterEnumDescriptor(_STORAGETYPEINFO)
 
@@ -857,8 +924,8 @@ _BOOTREASON = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=15773,
-  serialized_end=16184,
+  serialized_start=18052,
+  serialized_end=18463,
 )
 _sym_db.RegisterEnumDescriptor(_BOOTREASON)
 
@@ -893,8 +960,8 @@ _MAINTENANCEMODEREASON = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16187,
-  serialized_end=16377,
+  serialized_start=18466,
+  serialized_end=18656,
 )
 _sym_db.RegisterEnumDescriptor(_MAINTENANCEMODEREASON)
 
@@ -919,8 +986,8 @@ _APPINSTMETADATATYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16379,
-  serialized_end=16475,
+  serialized_start=18658,
+  serialized_end=18754,
 )
 _sym_db.RegisterEnumDescriptor(_APPINSTMETADATATYPE)
 
@@ -950,12 +1017,58 @@ _WIRELESSTYPE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16477,
-  serialized_end=16574,
+  serialized_start=18756,
+  serialized_end=18853,
 )
 _sym_db.RegisterEnumDescriptor(_WIRELESSTYPE)
 
 WirelessType = enum_type_wrapper.EnumTypeWrapper(_WIRELESSTYPE)
+_ZWIFISTATE = _descriptor.EnumDescriptor(
+  name='ZWifiState',
+  full_name='org.lfedge.eve.info.ZWifiState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='Z_WIFI_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_WIFI_STATE_DISABLED', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_WIFI_STATE_DISCONNECTED', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_WIFI_STATE_SCANNING', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_WIFI_STATE_CONNECTING', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_WIFI_STATE_CONNECTED', index=5, number=5,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=18856,
+  serialized_end=19040,
+)
+_sym_db.RegisterEnumDescriptor(_ZWIFISTATE)
+
+ZWifiState = enum_type_wrapper.EnumTypeWrapper(_ZWIFISTATE)
 _BASEOSSTATUS = _descriptor.EnumDescriptor(
   name='BaseOsStatus',
   full_name='org.lfedge.eve.info.BaseOsStatus',
@@ -1001,8 +1114,8 @@ _BASEOSSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16576,
-  serialized_end=16689,
+  serialized_start=19042,
+  serialized_end=19155,
 )
 _sym_db.RegisterEnumDescriptor(_BASEOSSTATUS)
 
@@ -1057,12 +1170,43 @@ _BASEOSSUBSTATUS = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16692,
-  serialized_end=16895,
+  serialized_start=19158,
+  serialized_end=19361,
 )
 _sym_db.RegisterEnumDescriptor(_BASEOSSUBSTATUS)
 
 BaseOsSubStatus = enum_type_wrapper.EnumTypeWrapper(_BASEOSSUBSTATUS)
+_ZAPPPROBERESULT = _descriptor.EnumDescriptor(
+  name='ZAppProbeResult',
+  full_name='org.lfedge.eve.info.ZAppProbeResult',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='Z_APP_PROBE_RESULT_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_APP_PROBE_RESULT_SUCCESS', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='Z_APP_PROBE_RESULT_FAILURE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=19363,
+  serialized_end=19480,
+)
+_sym_db.RegisterEnumDescriptor(_ZAPPPROBERESULT)
+
+ZAppProbeResult = enum_type_wrapper.EnumTypeWrapper(_ZAPPPROBERESULT)
 _ZINFOVPNSTATE = _descriptor.EnumDescriptor(
   name='ZInfoVpnState',
   full_name='org.lfedge.eve.info.ZInfoVpnState',
@@ -1108,8 +1252,8 @@ _ZINFOVPNSTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=16898,
-  serialized_end=17041,
+  serialized_start=19483,
+  serialized_end=19626,
 )
 _sym_db.RegisterEnumDescriptor(_ZINFOVPNSTATE)
 
@@ -1144,8 +1288,8 @@ _ZNETWORKINSTANCESTATE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=17044,
-  serialized_end=17177,
+  serialized_start=19629,
+  serialized_end=19762,
 )
 _sym_db.RegisterEnumDescriptor(_ZNETWORKINSTANCESTATE)
 
@@ -1241,6 +1385,13 @@ STORAGE_STATUS_OFFLINE = 4
 STORAGE_STATUS_UNAVAIL = 5
 STORAGE_STATUS_REMOVED = 6
 STORAGE_STATUS_SUSPENDED = 7
+STORAGE_SCAN_FUNC_UNSPECIFIED = 0
+STORAGE_SCAN_FUNC_SCRUB = 1
+STORAGE_SCAN_FUNC_RESILVER = 2
+STORAGE_SCAN_STATE_UNSPECIFIED = 0
+STORAGE_SCAN_STATE_SCANNING = 1
+STORAGE_SCAN_STATE_FINISHED = 2
+STORAGE_SCAN_STATE_CANCELED = 3
 STORAGE_RAID_TYPE_UNSPECIFIED = 0
 STORAGE_RAID_TYPE_RAID0 = 1
 STORAGE_RAID_TYPE_RAID1 = 2
@@ -1275,6 +1426,12 @@ APP_INST_META_DATA_TYPE_KUBE_CONFIG = 1
 WIRELESS_TYPE_UNSPECIFIED = 0
 WIRELESS_TYPE_WIFI = 1
 WIRELESS_TYPE_CELLULAR = 2
+Z_WIFI_STATE_UNSPECIFIED = 0
+Z_WIFI_STATE_DISABLED = 1
+Z_WIFI_STATE_DISCONNECTED = 2
+Z_WIFI_STATE_SCANNING = 3
+Z_WIFI_STATE_CONNECTING = 4
+Z_WIFI_STATE_CONNECTED = 5
 NONE = 0
 DOWNLOADING = 1
 DOWNLOAD_DONE = 2
@@ -1290,6 +1447,9 @@ UPDATE_REBOOTING = 4
 UPDATE_TESTING = 5
 UPDATE_NEED_TEST_CONFIRM = 6
 UPDATE_DEFERRED = 7
+Z_APP_PROBE_RESULT_UNSPECIFIED = 0
+Z_APP_PROBE_RESULT_SUCCESS = 1
+Z_APP_PROBE_RESULT_FAILURE = 2
 VPN_INVALID = 0
 VPN_INITIAL = 1
 VPN_CONNECTING = 2
@@ -2671,6 +2831,27 @@ _STORAGEDISKSTATE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='read_errors', full_name='org.lfedge.eve.info.StorageDiskState.read_errors', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='write_errors', full_name='org.lfedge.eve.info.StorageDiskState.write_errors', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='checksum_errors', full_name='org.lfedge.eve.info.StorageDiskState.checksum_errors', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -2684,7 +2865,155 @@ _STORAGEDISKSTATE = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=3509,
-  serialized_end=3638,
+  serialized_end=3706,
+)
+
+
+_STORAGEVDEVSTATE = _descriptor.Descriptor(
+  name='StorageVdevState',
+  full_name='org.lfedge.eve.info.StorageVdevState',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.info.StorageVdevState.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='org.lfedge.eve.info.StorageVdevState.status', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='read_errors', full_name='org.lfedge.eve.info.StorageVdevState.read_errors', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='write_errors', full_name='org.lfedge.eve.info.StorageVdevState.write_errors', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='checksum_errors', full_name='org.lfedge.eve.info.StorageVdevState.checksum_errors', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3709,
+  serialized_end=3861,
+)
+
+
+_STORAGESCANINFO = _descriptor.Descriptor(
+  name='StorageScanInfo',
+  full_name='org.lfedge.eve.info.StorageScanInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='func', full_name='org.lfedge.eve.info.StorageScanInfo.func', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='org.lfedge.eve.info.StorageScanInfo.state', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='start_time', full_name='org.lfedge.eve.info.StorageScanInfo.start_time', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end_time', full_name='org.lfedge.eve.info.StorageScanInfo.end_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='to_examine_bytes', full_name='org.lfedge.eve.info.StorageScanInfo.to_examine_bytes', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='examined_bytes', full_name='org.lfedge.eve.info.StorageScanInfo.examined_bytes', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='progress', full_name='org.lfedge.eve.info.StorageScanInfo.progress', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='repaired_bytes', full_name='org.lfedge.eve.info.StorageScanInfo.repaired_bytes', index=7,
+      number=8, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='errors', full_name='org.lfedge.eve.info.StorageScanInfo.errors', index=8,
+      number=9, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3864,
+  serialized_end=4189,
 )
 
 
@@ -2750,8 +3079,8 @@ _SMARTATTR = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3640,
-  serialized_end=3749,
+  serialized_start=4191,
+  serialized_end=4300,
 )
 
 
@@ -2824,8 +3153,8 @@ _SMARTMETRIC = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=3752,
-  serialized_end=4146,
+  serialized_start=4303,
+  serialized_end=4697,
 )
 
 
@@ -2891,8 +3220,8 @@ _STORAGEDISKINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4149,
-  serialized_end=4316,
+  serialized_start=4700,
+  serialized_end=4867,
 )
 
 
@@ -2937,8 +3266,8 @@ _STORAGECHILDREN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4319,
-  serialized_end=4506,
+  serialized_start=4870,
+  serialized_end=5057,
 )
 
 
@@ -3027,22 +3356,43 @@ _STORAGEINFO = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
-  ],
-  extensions=[
-  ],
-  nested_types=[],
-  enum_types=[
-  ],
-  serialized_options=None,
-  is_extendable=False,
-  syntax='proto3',
-  extension_ranges=[],
-  oneofs=[
-  ],
-  serialized_start=4509,
-  serialized_end=4945,
-)
-
+    _descriptor.FieldDescriptor(
+      name='scan', full_name='org.lfedge.eve.info.StorageInfo.scan', index=11,
+      number=12, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_scrub_time', full_name='org.lfedge.eve.info.StorageInfo.next_scrub_time', index=12,
+      number=13, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vdevs', full_name='org.lfedge.eve.info.StorageInfo.vdevs', index=13,
+      number=14, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=5060,
+  serialized_end=5655,
+)
+
 
 _ZINFOHARDWARE = _descriptor.Descriptor(
   name='ZInfoHardware',
@@ -3071,8 +3421,8 @@ _ZINFOHARDWARE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=4947,
-  serialized_end=5015,
+  serialized_start=5657,
+  serialized_end=5725,
 )
 
 
@@ -3397,8 +3747,8 @@ _ZINFODEVICE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=5018,
-  serialized_end=6863,
+  serialized_start=5728,
+  serialized_end=7573,
 )
 
 
@@ -3436,8 +3786,8 @@ _SYSTEMADAPTERINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6865,
-  serialized_end=6961,
+  serialized_start=7575,
+  serialized_end=7671,
 )
 
 
@@ -3510,8 +3860,8 @@ _DEVICEPORTSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=6964,
-  serialized_end=7228,
+  serialized_start=7674,
+  serialized_end=7938,
 )
 
 
@@ -3703,8 +4053,8 @@ _DEVICEPORT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7231,
-  serialized_end=7866,
+  serialized_start=7941,
+  serialized_end=8576,
 )
 
 
@@ -3770,8 +4120,8 @@ _PROXYSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=7869,
-  serialized_end=8039,
+  serialized_start=8579,
+  serialized_end=8749,
 )
 
 
@@ -3816,8 +4166,8 @@ _PROXYENTRY = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8041,
-  serialized_end=8097,
+  serialized_start=8751,
+  serialized_end=8807,
 )
 
 
@@ -3843,6 +4193,13 @@ _WIRELESSSTATUS = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='wifi', full_name='org.lfedge.eve.info.WirelessStatus.wifi', index=2,
+      number=3, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -3855,8 +4212,8 @@ _WIRELESSSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8099,
-  serialized_end=8220,
+  serialized_start=8810,
+  serialized_end=8979,
 )
 
 
@@ -3915,8 +4272,170 @@ _ZCELLULARSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8223,
-  serialized_end=8386,
+  serialized_start=8982,
+  serialized_end=9145,
+)
+
+
+_ZWIFINETWORK = _descriptor.Descriptor(
+  name='ZWifiNetwork',
+  full_name='org.lfedge.eve.info.ZWifiNetwork',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='ssid', full_name='org.lfedge.eve.info.ZWifiNetwork.ssid', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='bssid', full_name='org.lfedge.eve.info.ZWifiNetwork.bssid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='frequency_mhz', full_name='org.lfedge.eve.info.ZWifiNetwork.frequency_mhz', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='signal_level', full_name='org.lfedge.eve.info.ZWifiNetwork.signal_level', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='flags', full_name='org.lfedge.eve.info.ZWifiNetwork.flags', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9147,
+  serialized_end=9250,
+)
+
+
+_ZWIFISTATUS = _descriptor.Descriptor(
+  name='ZWifiStatus',
+  full_name='org.lfedge.eve.info.ZWifiStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='state', full_name='org.lfedge.eve.info.ZWifiStatus.state', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ssid', full_name='org.lfedge.eve.info.ZWifiStatus.ssid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='bssid', full_name='org.lfedge.eve.info.ZWifiStatus.bssid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='frequency_mhz', full_name='org.lfedge.eve.info.ZWifiStatus.frequency_mhz', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='key_mgmt', full_name='org.lfedge.eve.info.ZWifiStatus.key_mgmt', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='auth_failures', full_name='org.lfedge.eve.info.ZWifiStatus.auth_failures', index=5,
+      number=6, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_auth_failure', full_name='org.lfedge.eve.info.ZWifiStatus.last_auth_failure', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_auth_failure_time', full_name='org.lfedge.eve.info.ZWifiStatus.last_auth_failure_time', index=7,
+      number=8, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='scan_results', full_name='org.lfedge.eve.info.ZWifiStatus.scan_results', index=8,
+      number=9, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_scan_time', full_name='org.lfedge.eve.info.ZWifiStatus.last_scan_time', index=9,
+      number=10, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='org.lfedge.eve.info.ZWifiStatus.error', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=9253,
+  serialized_end=9618,
 )
 
 
@@ -4031,8 +4550,8 @@ _ZINFODEVSW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8389,
-  serialized_end=8817,
+  serialized_start=9621,
+  serialized_end=10049,
 )
 
 
@@ -4084,8 +4603,8 @@ _ZINFOSTORAGE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8819,
-  serialized_end=8908,
+  serialized_start=10051,
+  serialized_end=10140,
 )
 
 
@@ -4174,6 +4693,87 @@ _ZINFOAPP = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health', full_name='org.lfedge.eve.info.ZInfoApp.health', index=11,
+      number=18, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='guest_info', full_name='org.lfedge.eve.info.ZInfoApp.guest_info', index=12,
+      number=19, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10143,
+  serialized_end=10648,
+)
+
+
+_ZGUESTINFO = _descriptor.Descriptor(
+  name='ZGuestInfo',
+  full_name='org.lfedge.eve.info.ZGuestInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='agent_connected', full_name='org.lfedge.eve.info.ZGuestInfo.agent_connected', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='os_name', full_name='org.lfedge.eve.info.ZGuestInfo.os_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='os_version', full_name='org.lfedge.eve.info.ZGuestInfo.os_version', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='kernel_release', full_name='org.lfedge.eve.info.ZGuestInfo.kernel_release', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hostname', full_name='org.lfedge.eve.info.ZGuestInfo.hostname', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ip_addrs', full_name='org.lfedge.eve.info.ZGuestInfo.ip_addrs', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -4186,8 +4786,142 @@ _ZINFOAPP = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=8911,
-  serialized_end=9314,
+  serialized_start=10651,
+  serialized_end=10785,
+)
+
+
+_ZAPPPROBESTATUS = _descriptor.Descriptor(
+  name='ZAppProbeStatus',
+  full_name='org.lfedge.eve.info.ZAppProbeStatus',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='result', full_name='org.lfedge.eve.info.ZAppProbeStatus.result', index=0,
+      number=1, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='consecutive_failures', full_name='org.lfedge.eve.info.ZAppProbeStatus.consecutive_failures', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='consecutive_successes', full_name='org.lfedge.eve.info.ZAppProbeStatus.consecutive_successes', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_probe_time', full_name='org.lfedge.eve.info.ZAppProbeStatus.last_probe_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_error', full_name='org.lfedge.eve.info.ZAppProbeStatus.last_error', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10788,
+  serialized_end=10993,
+)
+
+
+_ZAPPHEALTH = _descriptor.Descriptor(
+  name='ZAppHealth',
+  full_name='org.lfedge.eve.info.ZAppHealth',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='liveness', full_name='org.lfedge.eve.info.ZAppHealth.liveness', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readiness', full_name='org.lfedge.eve.info.ZAppHealth.readiness', index=1,
+      number=2, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='ready', full_name='org.lfedge.eve.info.ZAppHealth.ready', index=2,
+      number=3, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_count', full_name='org.lfedge.eve.info.ZAppHealth.restart_count', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_restart_reason', full_name='org.lfedge.eve.info.ZAppHealth.last_restart_reason', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_restart_time', full_name='org.lfedge.eve.info.ZAppHealth.last_restart_time', index=5,
+      number=6, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='next_restart_time', full_name='org.lfedge.eve.info.ZAppHealth.next_restart_time', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=10996,
+  serialized_end=11298,
 )
 
 
@@ -4232,8 +4966,8 @@ _ZINFOVPNLINKINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9316,
-  serialized_end=9384,
+  serialized_start=11300,
+  serialized_end=11368,
 )
 
 
@@ -4313,8 +5047,8 @@ _ZINFOVPNLINK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9387,
-  serialized_end=9636,
+  serialized_start=11371,
+  serialized_end=11620,
 )
 
 
@@ -4359,8 +5093,8 @@ _ZINFOVPNENDPOINT = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9638,
-  serialized_end=9698,
+  serialized_start=11622,
+  serialized_end=11682,
 )
 
 
@@ -4447,8 +5181,8 @@ _ZINFOVPNCONN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=9701,
-  serialized_end=9998,
+  serialized_start=11685,
+  serialized_end=11982,
 )
 
 
@@ -4500,8 +5234,8 @@ _ZINFOVPN = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10000,
-  serialized_end=10122,
+  serialized_start=11984,
+  serialized_end=12106,
 )
 
 
@@ -4626,28 +5360,35 @@ _ZINFONETWORKINSTANCE = _descriptor.Descriptor(
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='assignedAdapters', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.assignedAdapters', index=16,
+      name='bridgeIPv6Addr', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.bridgeIPv6Addr', index=16,
+      number=27, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='assignedAdapters', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.assignedAdapters', index=17,
       number=30, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='vinfo', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.vinfo', index=17,
+      name='vinfo', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.vinfo', index=18,
       number=31, type=11, cpp_type=10, label=1,
       has_default_value=False, default_value=None,
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='networkErr', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.networkErr', index=18,
+      name='networkErr', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.networkErr', index=19,
       number=40, type=11, cpp_type=10, label=3,
       has_default_value=False, default_value=[],
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
     _descriptor.FieldDescriptor(
-      name='state', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.state', index=19,
+      name='state', full_name='org.lfedge.eve.info.ZInfoNetworkInstance.state', index=20,
       number=41, type=14, cpp_type=8, label=1,
       has_default_value=False, default_value=0,
       message_type=None, enum_type=None, containing_type=None,
@@ -4670,8 +5411,8 @@ _ZINFONETWORKINSTANCE = _descriptor.Descriptor(
       create_key=_descriptor._internal_create_key,
     fields=[]),
   ],
-  serialized_start=10125,
-  serialized_end=10851,
+  serialized_start=12109,
+  serialized_end=12859,
 )
 
 
@@ -4716,8 +5457,8 @@ _USAGEINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10854,
-  serialized_end=10991,
+  serialized_start=12862,
+  serialized_end=12999,
 )
 
 
@@ -4755,8 +5496,8 @@ _VOLUMERESOURCES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=10993,
-  serialized_end=11054,
+  serialized_start=13001,
+  serialized_end=13062,
 )
 
 
@@ -4836,8 +5577,8 @@ _ZINFOVOLUME = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11057,
-  serialized_end=11360,
+  serialized_start=13065,
+  serialized_end=13368,
 )
 
 
@@ -4868,8 +5609,8 @@ _CONTENTRESOURCES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11362,
-  serialized_end=11402,
+  serialized_start=13370,
+  serialized_end=13410,
 )
 
 
@@ -4963,8 +5704,8 @@ _ZINFOCONTENTTREE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11405,
-  serialized_end=11750,
+  serialized_start=13413,
+  serialized_end=13758,
 )
 
 
@@ -5030,8 +5771,8 @@ _ZINFOBLOB = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=11753,
-  serialized_end=12004,
+  serialized_start=13761,
+  serialized_end=14012,
 )
 
 
@@ -5062,8 +5803,8 @@ _ZINFOBLOBLIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12006,
-  serialized_end=12067,
+  serialized_start=14014,
+  serialized_end=14075,
 )
 
 
@@ -5176,8 +5917,8 @@ _ZINFOMSG = _descriptor.Descriptor(
       create_key=_descriptor._internal_create_key,
     fields=[]),
   ],
-  serialized_start=12070,
-  serialized_end=12697,
+  serialized_start=14078,
+  serialized_end=14705,
 )
 
 
@@ -5215,8 +5956,8 @@ _CAPABILITIES = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12699,
-  serialized_end=12773,
+  serialized_start=14707,
+  serialized_end=14781,
 )
 
 
@@ -5261,8 +6002,8 @@ _ZINFOAPPINSTMETADATA = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12775,
-  serialized_end=12881,
+  serialized_start=14783,
+  serialized_end=14889,
 )
 
 
@@ -5314,8 +6055,8 @@ _ZINFOEDGEVIEW = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=12884,
-  serialized_end=13036,
+  serialized_start=14892,
+  serialized_end=15044,
 )
 
 _DEPRECATEDMETRICITEM.fields_by_name['type'].enum_type = _DEPMETRICITEMTYPE
@@ -5363,6 +6104,11 @@ _ZCELLULARMODULEINFO.fields_by_name['operating_state'].enum_type = _ZCELLULAROPE
 _ZCELLULARMODULEINFO.fields_by_name['control_protocol'].enum_type = _ZCELLULARCONTROLPROTOCOL
 _STORAGEDISKSTATE.fields_by_name['disk_name'].message_type = evecommon_dot_evecommon__pb2._DISKDESCRIPTION
 _STORAGEDISKSTATE.fields_by_name['status'].enum_type = _STORAGESTATUS
+_STORAGEVDEVSTATE.fields_by_name['status'].enum_type = _STORAGESTATUS
+_STORAGESCANINFO.fields_by_name['func'].enum_type = _STORAGESCANFUNC
+_STORAGESCANINFO.fields_by_name['state'].enum_type = _STORAGESCANSTATE
+_STORAGESCANINFO.fields_by_name['start_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_STORAGESCANINFO.fields_by_name['end_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _SMARTMETRIC.fields_by_name['reallocated_sector_ct'].message_type = _SMARTATTR
 _SMARTMETRIC.fields_by_name['power_on_hours'].message_type = _SMARTATTR
 _SMARTMETRIC.fields_by_name['power_cycle_count'].message_type = _SMARTATTR
@@ -5378,6 +6124,9 @@ _STORAGEINFO.fields_by_name['current_raid'].enum_type = _STORAGERAIDTYPE
 _STORAGEINFO.fields_by_name['storage_state'].enum_type = _STORAGESTATUS
 _STORAGEINFO.fields_by_name['disks'].message_type = _STORAGEDISKSTATE
 _STORAGEINFO.fields_by_name['children'].message_type = _STORAGECHILDREN
+_STORAGEINFO.fields_by_name['scan'].message_type = _STORAGESCANINFO
+_STORAGEINFO.fields_by_name['next_scrub_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_STORAGEINFO.fields_by_name['vdevs'].message_type = _STORAGEVDEVSTATE
 _ZINFOHARDWARE.fields_by_name['disks'].message_type = _STORAGEDISKINFO
 _ZINFODEVICE.fields_by_name['minfo'].message_type = _ZINFOMANUFACTURER
 _ZINFODEVICE.fields_by_name['network'].message_type = _ZINFONETWORK
@@ -5417,7 +6166,12 @@ _DEVICEPORT.fields_by_name['wireless_status'].message_type = _WIRELESSSTATUS
 _PROXYSTATUS.fields_by_name['proxies'].message_type = _PROXYENTRY
 _WIRELESSSTATUS.fields_by_name['type'].enum_type = _WIRELESSTYPE
 _WIRELESSSTATUS.fields_by_name['cellular'].message_type = _ZCELLULARSTATUS
+_WIRELESSSTATUS.fields_by_name['wifi'].message_type = _ZWIFISTATUS
 _ZCELLULARSTATUS.fields_by_name['providers'].message_type = _ZCELLULARPROVIDER
+_ZWIFISTATUS.fields_by_name['state'].enum_type = _ZWIFISTATE
+_ZWIFISTATUS.fields_by_name['last_auth_failure_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZWIFISTATUS.fields_by_name['scan_results'].message_type = _ZWIFINETWORK
+_ZWIFISTATUS.fields_by_name['last_scan_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _ZINFODEVSW.fields_by_name['status'].enum_type = _ZSWSTATE
 _ZINFODEVSW.fields_by_name['swErr'].message_type = _ERRORINFO
 _ZINFODEVSW.fields_by_name['userStatus'].enum_type = _BASEOSSTATUS
@@ -5428,6 +6182,14 @@ _ZINFOAPP.fields_by_name['assignedAdapters'].message_type = _ZIOBUNDLE
 _ZINFOAPP.fields_by_name['appErr'].message_type = _ERRORINFO
 _ZINFOAPP.fields_by_name['state'].enum_type = _ZSWSTATE
 _ZINFOAPP.fields_by_name['network'].message_type = _ZINFONETWORK
+_ZINFOAPP.fields_by_name['health'].message_type = _ZAPPHEALTH
+_ZINFOAPP.fields_by_name['guest_info'].message_type = _ZGUESTINFO
+_ZAPPPROBESTATUS.fields_by_name['result'].enum_type = _ZAPPPROBERESULT
+_ZAPPPROBESTATUS.fields_by_name['last_probe_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZAPPHEALTH.fields_by_name['liveness'].message_type = _ZAPPPROBESTATUS
+_ZAPPHEALTH.fields_by_name['readiness'].message_type = _ZAPPPROBESTATUS
+_ZAPPHEALTH.fields_by_name['last_restart_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_ZAPPHEALTH.fields_by_name['next_restart_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
 _ZINFOVPNLINK.fields_by_name['state'].enum_type = _ZINFOVPNSTATE
 _ZINFOVPNLINK.fields_by_name['lInfo'].message_type = _ZINFOVPNLINKINFO
 _ZINFOVPNLINK.fields_by_name['rInfo'].message_type = _ZINFOVPNLINKINFO
@@ -5526,6 +6288,8 @@ DESCRIPTOR.message_types_by_name['ZSimcardInfo'] = _ZSIMCARDINFO
 DESCRIPTOR.message_types_by_name['ZCellularModuleInfo'] = _ZCELLULARMODULEINFO
 DESCRIPTOR.message_types_by_name['ZCellularProvider'] = _ZCELLULARPROVIDER
 DESCRIPTOR.message_types_by_name['StorageDiskState'] = _STORAGEDISKSTATE
+DESCRIPTOR.message_types_by_name['StorageVdevState'] = _STORAGEVDEVSTATE
+DESCRIPTOR.message_types_by_name['StorageScanInfo'] = _STORAGESCANINFO
 DESCRIPTOR.message_types_by_name['SmartAttr'] = _SMARTATTR
 DESCRIPTOR.message_types_by_name['SmartMetric'] = _SMARTMETRIC
 DESCRIPTOR.message_types_by_name['StorageDiskInfo'] = _STORAGEDISKINFO
@@ -5540,9 +6304,14 @@ DESCRIPTOR.message_types_by_name['ProxyStatus'] = _PROXYSTATUS
 DESCRIPTOR.message_types_by_name['ProxyEntry'] = _PROXYENTRY
 DESCRIPTOR.message_types_by_name['WirelessStatus'] = _WIRELESSSTATUS
 DESCRIPTOR.message_types_by_name['ZCellularStatus'] = _ZCELLULARSTATUS
+DESCRIPTOR.message_types_by_name['ZWifiNetwork'] = _ZWIFINETWORK
+DESCRIPTOR.message_types_by_name['ZWifiStatus'] = _ZWIFISTATUS
 DESCRIPTOR.message_types_by_name['ZInfoDevSW'] = _ZINFODEVSW
 DESCRIPTOR.message_types_by_name['ZInfoStorage'] = _ZINFOSTORAGE
 DESCRIPTOR.message_types_by_name['ZInfoApp'] = _ZINFOAPP
+DESCRIPTOR.message_types_by_name['ZGuestInfo'] = _ZGUESTINFO
+DESCRIPTOR.message_types_by_name['ZAppProbeStatus'] = _ZAPPPROBESTATUS
+DESCRIPTOR.message_types_by_name['ZAppHealth'] = _ZAPPHEALTH
 DESCRIPTOR.message_types_by_name['ZInfoVpnLinkInfo'] = _ZINFOVPNLINKINFO
 DESCRIPTOR.message_types_by_name['ZInfoVpnLink'] = _ZINFOVPNLINK
 DESCRIPTOR.message_types_by_name['ZInfoVpnEndPoint'] = _ZINFOVPNENDPOINT
@@ -5573,14 +6342,18 @@ DESCRIPTOR.enum_types_by_name['ZCellularOperatingState'] = _ZCELLULAROPERATINGST
 DESCRIPTOR.enum_types_by_name['ZCellularControlProtocol'] = _ZCELLULARCONTROLPROTOCOL
 DESCRIPTOR.enum_types_by_name['ZDeviceState'] = _ZDEVICESTATE
 DESCRIPTOR.enum_types_by_name['StorageStatus'] = _STORAGESTATUS
+DESCRIPTOR.enum_types_by_name['StorageScanFunc'] = _STORAGESCANFUNC
+DESCRIPTOR.enum_types_by_name['StorageScanState'] = _STORAGESCANSTATE
 DESCRIPTOR.enum_types_by_name['StorageRaidType'] = _STORAGERAIDTYPE
 DESCRIPTOR.enum_types_by_name['StorageTypeInfo'] = _STORAGETYPEINFO
 DESCRIPTOR.enum_types_by_name['BootReason'] = _BOOTREASON
 DESCRIPTOR.enum_types_by_name['MaintenanceModeReason'] = _MAINTENANCEMODEREASON
 DESCRIPTOR.enum_types_by_name['AppInstMetaDataType'] = _APPINSTMETADATATYPE
 DESCRIPTOR.enum_types_by_name['WirelessType'] = _WIRELESSTYPE
+DESCRIPTOR.enum_types_by_name['ZWifiState'] = _ZWIFISTATE
 DESCRIPTOR.enum_types_by_name['BaseOsStatus'] = _BASEOSSTATUS
 DESCRIPTOR.enum_types_by_name['BaseOsSubStatus'] = _BASEOSSUBSTATUS
+DESCRIPTOR.enum_types_by_name['ZAppProbeResult'] = _ZAPPPROBERESULT
 DESCRIPTOR.enum_types_by_name['ZInfoVpnState'] = _ZINFOVPNSTATE
 DESCRIPTOR.enum_types_by_name['ZNetworkInstanceState'] = _ZNETWORKINSTANCESTATE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
@@ -5762,6 +6535,20 @@ StorageDiskState = _reflection.GeneratedProtocolMessageType('StorageDiskState',
   })
 _sym_db.RegisterMessage(StorageDiskState)
 
+StorageVdevState = _reflection.GeneratedProtocolMessageType('StorageVdevState', (_message.Message,), {
+  'DESCRIPTOR' : _STORAGEVDEVSTATE,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.StorageVdevState)
+  })
+_sym_db.RegisterMessage(StorageVdevState)
+
+StorageScanInfo = _reflection.GeneratedProtocolMessageType('StorageScanInfo', (_message.Message,), {
+  'DESCRIPTOR' : _STORAGESCANINFO,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.StorageScanInfo)
+  })
+_sym_db.RegisterMessage(StorageScanInfo)
+
 SmartAttr = _reflection.GeneratedProtocolMessageType('SmartAttr', (_message.Message,), {
   'DESCRIPTOR' : _SMARTATTR,
   '__module__' : 'info.info_pb2'
@@ -5860,6 +6647,20 @@ ZCellularStatus = _reflection.GeneratedProtocolMessageType('ZCellularStatus', (_
   })
 _sym_db.RegisterMessage(ZCellularStatus)
 
+ZWifiNetwork = _reflection.GeneratedProtocolMessageType('ZWifiNetwork', (_message.Message,), {
+  'DESCRIPTOR' : _ZWIFINETWORK,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZWifiNetwork)
+  })
+_sym_db.RegisterMessage(ZWifiNetwork)
+
+ZWifiStatus = _reflection.GeneratedProtocolMessageType('ZWifiStatus', (_message.Message,), {
+  'DESCRIPTOR' : _ZWIFISTATUS,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZWifiStatus)
+  })
+_sym_db.RegisterMessage(ZWifiStatus)
+
 ZInfoDevSW = _reflection.GeneratedProtocolMessageType('ZInfoDevSW', (_message.Message,), {
   'DESCRIPTOR' : _ZINFODEVSW,
   '__module__' : 'info.info_pb2'
@@ -5881,6 +6682,27 @@ ZInfoApp = _reflection.GeneratedProtocolMessageType('ZInfoApp', (_message.Messag
   })
 _sym_db.RegisterMessage(ZInfoApp)
 
+ZGuestInfo = _reflection.GeneratedProtocolMessageType('ZGuestInfo', (_message.Message,), {
+  'DESCRIPTOR' : _ZGUESTINFO,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZGuestInfo)
+  })
+_sym_db.RegisterMessage(ZGuestInfo)
+
+ZAppProbeStatus = _reflection.GeneratedProtocolMessageType('ZAppProbeStatus', (_message.Message,), {
+  'DESCRIPTOR' : _ZAPPPROBESTATUS,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZAppProbeStatus)
+  })
+_sym_db.RegisterMessage(ZAppProbeStatus)
+
+ZAppHealth = _reflection.GeneratedProtocolMessageType('ZAppHealth', (_message.Message,), {
+  'DESCRIPTOR' : _ZAPPHEALTH,
+  '__module__' : 'info.info_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.info.ZAppHealth)
+  })
+_sym_db.RegisterMessage(ZAppHealth)
+
 ZInfoVpnLinkInfo = _reflection.GeneratedProtocolMessageType('ZInfoVpnLinkInfo', (_message.Message,), {
   'DESCRIPTOR' : _ZINFOVPNLINKINFO,
   '__module__' : 'info.info_pb2'
@@ -2,6 +2,7 @@
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: profile/local_profile.proto
 """Generated protocol buffer code."""
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import message as _message
 from google.protobuf import reflection as _reflection
@@ -11,7 +12,9 @@ from google.protobuf import symbol_database as _symbol_database
 _sym_db = _symbol_database.Default()
 
 
+from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 from info import info_pb2 as info_dot_info__pb2
+from metrics import metrics_pb2 as metrics_dot_metrics__pb2
 
 
 DESCRIPTOR = _descriptor.FileDescriptor(
@@ -20,10 +23,62 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\026org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profile',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x1bprofile/local_profile.proto\x12\x16org.lfedge.eve.profile\x1a\x0finfo/info.proto\";\n\x0cLocalProfile\x12\x15\n\rlocal_profile\x18\x01 \x01(\t\x12\x14\n\x0cserver_token\x18\x02 \x01(\t\"{\n\x0bRadioStatus\x12\x15\n\rradio_silence\x18\x01 \x01(\x08\x12\x14\n\x0c\x63onfig_error\x18\x02 \x01(\t\x12?\n\x0f\x63\x65llular_status\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.CellularStatus\"\xfc\x01\n\x0e\x43\x65llularStatus\x12\x14\n\x0clogicallabel\x18\x01 \x01(\t\x12\x38\n\x06module\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12\x34\n\tsim_cards\x18\x03 \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x39\n\tproviders\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\":\n\x0bRadioConfig\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rradio_silence\x18\x02 \x01(\x08\"K\n\x10LocalAppInfoList\x12\x37\n\tapps_info\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.profile.LocalAppInfo\"\xb0\x01\n\x0cLocalAppInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12+\n\x03\x65rr\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12last_cmd_timestamp\x18\x06 \x01(\x04\"a\n\x0fLocalAppCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x38\n\x0c\x61pp_commands\x18\x02 \x03(\x0b\x32\".org.lfedge.eve.profile.AppCommand\"\xc9\x01\n\nAppCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12;\n\x07\x63ommand\x18\x04 \x01(\x0e\x32*.org.lfedge.eve.profile.AppCommand.Command\"J\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x43OMMAND_RESTART\x10\x01\x12\x11\n\rCOMMAND_PURGE\x10\x02\x42?\n\x16org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profileb\x06proto3'
+  serialized_pb=b'\n\x1bprofile/local_profile.proto\x12\x16org.lfedge.eve.profile\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x0finfo/info.proto\x1a\x15metrics/metrics.proto\";\n\x0cLocalProfile\x12\x15\n\rlocal_profile\x18\x01 \x01(\t\x12\x14\n\x0cserver_token\x18\x02 \x01(\t\"{\n\x0bRadioStatus\x12\x15\n\rradio_silence\x18\x01 \x01(\x08\x12\x14\n\x0c\x63onfig_error\x18\x02 \x01(\t\x12?\n\x0f\x63\x65llular_status\x18\x03 \x03(\x0b\x32&.org.lfedge.eve.profile.CellularStatus\"\xfc\x01\n\x0e\x43\x65llularStatus\x12\x14\n\x0clogicallabel\x18\x01 \x01(\t\x12\x38\n\x06module\x18\x02 \x01(\x0b\x32(.org.lfedge.eve.info.ZCellularModuleInfo\x12\x34\n\tsim_cards\x18\x03 \x03(\x0b\x32!.org.lfedge.eve.info.ZSimcardInfo\x12\x39\n\tproviders\x18\x04 \x03(\x0b\x32&.org.lfedge.eve.info.ZCellularProvider\x12\x14\n\x0c\x63onfig_error\x18\n \x01(\t\x12\x13\n\x0bprobe_error\x18\x0b \x01(\t\":\n\x0bRadioConfig\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x15\n\rradio_silence\x18\x02 \x01(\x08\"K\n\x10LocalAppInfoList\x12\x37\n\tapps_info\x18\x01 \x03(\x0b\x32$.org.lfedge.eve.profile.LocalAppInfo\"\xe1\x01\n\x0cLocalAppInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12+\n\x03\x65rr\x18\x04 \x01(\x0b\x32\x1e.org.lfedge.eve.info.ErrorInfo\x12,\n\x05state\x18\x05 \x01(\x0e\x32\x1d.org.lfedge.eve.info.ZSwState\x12\x1a\n\x12last_cmd_timestamp\x18\x06 \x01(\x04\x12/\n\x06health\x18\x07 \x01(\x0b\x32\x1f.org.lfedge.eve.info.ZAppHealth\"a\n\x0fLocalAppCmdList\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x38\n\x0c\x61pp_commands\x18\x02 \x03(\x0b\x32\".org.lfedge.eve.profile.AppCommand\"\xc9\x01\n\nAppCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12;\n\x07\x63ommand\x18\x04 \x01(\x0e\x32*.org.lfedge.eve.profile.AppCommand.Command\"J\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x43OMMAND_RESTART\x10\x01\x12\x11\n\rCOMMAND_PURGE\x10\x02\"\xda\x01\n\x0cLocalDevInfo\x12+\n\x04info\x18\x01 \x01(\x0b\x32\x1d.org.lfedge.eve.info.ZInfoMsg\x12\x1a\n\x12last_cmd_timestamp\x18\x02 \x01(\x04\x12=\n\x0cvolumes_info\x18\x03 \x03(\x0b\x32\'.org.lfedge.eve.profile.LocalVolumeInfo\x12\x42\n\x0fpacket_captures\x18\x04 \x03(\x0b\x32).org.lfedge.eve.profile.PacketCaptureInfo\"N\n\x0fLocalVolumeInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x1a\n\x12last_cmd_timestamp\x18\x03 \x01(\x04\"\xf1\x02\n\x0bLocalDevCmd\x12\x14\n\x0cserver_token\x18\x01 \x01(\t\x12\x11\n\ttimestamp\x18\x02 \x01(\x04\x12<\n\x07\x63ommand\x18\x03 \x01(\x0e\x32+.org.lfedge.eve.profile.LocalDevCmd.Command\x12>\n\x0fvolume_commands\x18\x04 \x03(\x0b\x32%.org.lfedge.eve.profile.VolumeCommand\x12\x45\n\x0fpacket_captures\x18\x05 \x03(\x0b\x32,.org.lfedge.eve.profile.PacketCaptureRequest\"t\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x12\n\x0e\x43OMMAND_REBOOT\x10\x01\x12\x1b\n\x17\x43OMMAND_BASEOS_FALLBACK\x10\x02\x12\x1f\n\x1b\x43OMMAND_COLLECT_DIAGNOSTICS\x10\x03\"\xd5\x01\n\x14PacketCaptureRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12\x18\n\x10network_instance\x18\x02 \x01(\t\x12\x14\n\x0c\x61pp_instance\x18\x03 \x01(\t\x12\x1b\n\x13\x61pp_interface_index\x18\x04 \x01(\r\x12\x0c\n\x04port\x18\x05 \x01(\t\x12\x0e\n\x06\x66ilter\x18\x06 \x01(\t\x12\x14\n\x0c\x64uration_sec\x18\x07 \x01(\r\x12\x10\n\x08max_size\x18\x08 \x01(\x04\x12\x10\n\x08snap_len\x18\t \x01(\r\x12\x0c\n\x04stop\x18\n \x01(\x08\"\x8c\x02\n\x11PacketCaptureInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x39\n\x05state\x18\x02 \x01(\x0e\x32*.org.lfedge.eve.profile.PacketCaptureState\x12\x11\n\tinterface\x18\x03 \x01(\t\x12.\n\nstart_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12,\n\x08\x65nd_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07packets\x18\x06 \x01(\x04\x12\x0c\n\x04size\x18\x07 \x01(\x04\x12\x11\n\ttruncated\x18\x08 \x01(\x08\x12\r\n\x05\x65rror\x18\t \x01(\t\"C\n\x12LocalPacketCapture\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08\x66ilename\x18\x02 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\x0c\"\xbf\x01\n\rVolumeCommand\x12\n\n\x02id\x18\x01 \x01(\t\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x11\n\ttimestamp\x18\x03 \x01(\x04\x12>\n\x07\x63ommand\x18\x04 \x01(\x0e\x32-.org.lfedge.eve.profile.VolumeCommand.Command\":\n\x07\x43ommand\x12\x17\n\x13\x43OMMAND_UNSPECIFIED\x10\x00\x12\x16\n\x12\x43OMMAND_REDOWNLOAD\x10\x01\"L\n\x10LocalDiagnostics\x12\x15\n\rcmd_timestamp\x18\x01 \x01(\x04\x12\x10\n\x08\x66ilename\x18\x02 \x01(\t\x12\x0f\n\x07\x63ontent\x18\x03 \x01(\x0c\"F\n\x0fLocalDevMetrics\x12\x33\n\x07metrics\x18\x01 \x01(\x0b\x32\".org.lfedge.eve.metrics.ZMetricMsg*\xe6\x01\n\x12PacketCaptureState\x12$\n PACKET_CAPTURE_STATE_UNSPECIFIED\x10\x00\x12 \n\x1cPACKET_CAPTURE_STATE_PENDING\x10\x01\x12 \n\x1cPACKET_CAPTURE_STATE_RUNNING\x10\x02\x12\"\n\x1ePACKET_CAPTURE_STATE_COMPLETED\x10\x03\x12!\n\x1dPACKET_CAPTURE_STATE_UPLOADED\x10\x04\x12\x1f\n\x1bPACKET_CAPTURE_STATE_FAILED\x10\x05\x42?\n\x16org.lfedge.eve.profileZ%github.com/lf-edge/eve/api/go/profileb\x06proto3'
   ,
-  dependencies=[info_dot_info__pb2.DESCRIPTOR,])
+  dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,info_dot_info__pb2.DESCRIPTOR,metrics_dot_metrics__pb2.DESCRIPTOR,])
 
+_PACKETCAPTURESTATE = _descriptor.EnumDescriptor(
+  name='PacketCaptureState',
+  full_name='org.lfedge.eve.profile.PacketCaptureState',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='PACKET_CAPTURE_STATE_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PACKET_CAPTURE_STATE_PENDING', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PACKET_CAPTURE_STATE_RUNNING', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PACKET_CAPTURE_STATE_COMPLETED', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PACKET_CAPTURE_STATE_UPLOADED', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='PACKET_CAPTURE_STATE_FAILED', index=5, number=5,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2811,
+  serialized_end=3041,
+)
+_sym_db.RegisterEnumDescriptor(_PACKETCAPTURESTATE)
+
+PacketCaptureState = enum_type_wrapper.EnumTypeWrapper(_PACKETCAPTURESTATE)
+PACKET_CAPTURE_STATE_UNSPECIFIED = 0
+PACKET_CAPTURE_STATE_PENDING = 1
+PACKET_CAPTURE_STATE_RUNNING = 2
+PACKET_CAPTURE_STATE_COMPLETED = 3
+PACKET_CAPTURE_STATE_UPLOADED = 4
+PACKET_CAPTURE_STATE_FAILED = 5
 
 
 _APPCOMMAND_COMMAND = _descriptor.EnumDescriptor(
@@ -51,11 +106,71 @@ _APPCOMMAND_COMMAND = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=1056,
-  serialized_end=1130,
+  serialized_start=1161,
+  serialized_end=1235,
 )
 _sym_db.RegisterEnumDescriptor(_APPCOMMAND_COMMAND)
 
+_LOCALDEVCMD_COMMAND = _descriptor.EnumDescriptor(
+  name='Command',
+  full_name='org.lfedge.eve.profile.LocalDevCmd.Command',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_REBOOT', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_BASEOS_FALLBACK', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_COLLECT_DIAGNOSTICS', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1792,
+  serialized_end=1908,
+)
+_sym_db.RegisterEnumDescriptor(_LOCALDEVCMD_COMMAND)
+
+_VOLUMECOMMAND_COMMAND = _descriptor.EnumDescriptor(
+  name='Command',
+  full_name='org.lfedge.eve.profile.VolumeCommand.Command',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='COMMAND_REDOWNLOAD', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=2600,
+  serialized_end=2658,
+)
+_sym_db.RegisterEnumDescriptor(_VOLUMECOMMAND_COMMAND)
+
 
 _LOCALPROFILE = _descriptor.Descriptor(
   name='LocalProfile',
@@ -91,8 +206,8 @@ _LOCALPROFILE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=72,
-  serialized_end=131,
+  serialized_start=128,
+  serialized_end=187,
 )
 
 
@@ -137,8 +252,8 @@ _RADIOSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=133,
-  serialized_end=256,
+  serialized_start=189,
+  serialized_end=312,
 )
 
 
@@ -204,8 +319,8 @@ _CELLULARSTATUS = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=259,
-  serialized_end=511,
+  serialized_start=315,
+  serialized_end=567,
 )
 
 
@@ -243,8 +358,8 @@ _RADIOCONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=513,
-  serialized_end=571,
+  serialized_start=569,
+  serialized_end=627,
 )
 
 
@@ -275,8 +390,8 @@ _LOCALAPPINFOLIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=573,
-  serialized_end=648,
+  serialized_start=629,
+  serialized_end=704,
 )
 
 
@@ -330,6 +445,13 @@ _LOCALAPPINFO = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health', full_name='org.lfedge.eve.profile.LocalAppInfo.health', index=6,
+      number=7, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -342,8 +464,8 @@ _LOCALAPPINFO = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=651,
-  serialized_end=827,
+  serialized_start=707,
+  serialized_end=932,
 )
 
 
@@ -381,8 +503,8 @@ _LOCALAPPCMDLIST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=829,
-  serialized_end=926,
+  serialized_start=934,
+  serialized_end=1031,
 )
 
 
@@ -435,67 +557,612 @@ _APPCOMMAND = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=929,
-  serialized_end=1130,
+  serialized_start=1034,
+  serialized_end=1235,
 )
 
-_RADIOSTATUS.fields_by_name['cellular_status'].message_type = _CELLULARSTATUS
-_CELLULARSTATUS.fields_by_name['module'].message_type = info_dot_info__pb2._ZCELLULARMODULEINFO
-_CELLULARSTATUS.fields_by_name['sim_cards'].message_type = info_dot_info__pb2._ZSIMCARDINFO
-_CELLULARSTATUS.fields_by_name['providers'].message_type = info_dot_info__pb2._ZCELLULARPROVIDER
-_LOCALAPPINFOLIST.fields_by_name['apps_info'].message_type = _LOCALAPPINFO
-_LOCALAPPINFO.fields_by_name['err'].message_type = info_dot_info__pb2._ERRORINFO
-_LOCALAPPINFO.fields_by_name['state'].enum_type = info_dot_info__pb2._ZSWSTATE
-_LOCALAPPCMDLIST.fields_by_name['app_commands'].message_type = _APPCOMMAND
-_APPCOMMAND.fields_by_name['command'].enum_type = _APPCOMMAND_COMMAND
-_APPCOMMAND_COMMAND.containing_type = _APPCOMMAND
-DESCRIPTOR.message_types_by_name['LocalProfile'] = _LOCALPROFILE
-DESCRIPTOR.message_types_by_name['RadioStatus'] = _RADIOSTATUS
-DESCRIPTOR.message_types_by_name['CellularStatus'] = _CELLULARSTATUS
-DESCRIPTOR.message_types_by_name['RadioConfig'] = _RADIOCONFIG
-DESCRIPTOR.message_types_by_name['LocalAppInfoList'] = _LOCALAPPINFOLIST
-DESCRIPTOR.message_types_by_name['LocalAppInfo'] = _LOCALAPPINFO
-DESCRIPTOR.message_types_by_name['LocalAppCmdList'] = _LOCALAPPCMDLIST
-DESCRIPTOR.message_types_by_name['AppCommand'] = _APPCOMMAND
-_sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
-LocalProfile = _reflection.GeneratedProtocolMessageType('LocalProfile', (_message.Message,), {
-  'DESCRIPTOR' : _LOCALPROFILE,
-  '__module__' : 'profile.local_profile_pb2'
-  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalProfile)
-  })
-_sym_db.RegisterMessage(LocalProfile)
+_LOCALDEVINFO = _descriptor.Descriptor(
+  name='LocalDevInfo',
+  full_name='org.lfedge.eve.profile.LocalDevInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='info', full_name='org.lfedge.eve.profile.LocalDevInfo.info', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_cmd_timestamp', full_name='org.lfedge.eve.profile.LocalDevInfo.last_cmd_timestamp', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='volumes_info', full_name='org.lfedge.eve.profile.LocalDevInfo.volumes_info', index=2,
+      number=3, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='packet_captures', full_name='org.lfedge.eve.profile.LocalDevInfo.packet_captures', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1238,
+  serialized_end=1456,
+)
 
-RadioStatus = _reflection.GeneratedProtocolMessageType('RadioStatus', (_message.Message,), {
-  'DESCRIPTOR' : _RADIOSTATUS,
-  '__module__' : 'profile.local_profile_pb2'
-  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.RadioStatus)
-  })
-_sym_db.RegisterMessage(RadioStatus)
 
-CellularStatus = _reflection.GeneratedProtocolMessageType('CellularStatus', (_message.Message,), {
-  'DESCRIPTOR' : _CELLULARSTATUS,
-  '__module__' : 'profile.local_profile_pb2'
-  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.CellularStatus)
-  })
-_sym_db.RegisterMessage(CellularStatus)
+_LOCALVOLUMEINFO = _descriptor.Descriptor(
+  name='LocalVolumeInfo',
+  full_name='org.lfedge.eve.profile.LocalVolumeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.LocalVolumeInfo.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='displayname', full_name='org.lfedge.eve.profile.LocalVolumeInfo.displayname', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='last_cmd_timestamp', full_name='org.lfedge.eve.profile.LocalVolumeInfo.last_cmd_timestamp', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1458,
+  serialized_end=1536,
+)
 
-RadioConfig = _reflection.GeneratedProtocolMessageType('RadioConfig', (_message.Message,), {
-  'DESCRIPTOR' : _RADIOCONFIG,
-  '__module__' : 'profile.local_profile_pb2'
-  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.RadioConfig)
-  })
-_sym_db.RegisterMessage(RadioConfig)
 
-LocalAppInfoList = _reflection.GeneratedProtocolMessageType('LocalAppInfoList', (_message.Message,), {
-  'DESCRIPTOR' : _LOCALAPPINFOLIST,
-  '__module__' : 'profile.local_profile_pb2'
-  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalAppInfoList)
-  })
-_sym_db.RegisterMessage(LocalAppInfoList)
+_LOCALDEVCMD = _descriptor.Descriptor(
+  name='LocalDevCmd',
+  full_name='org.lfedge.eve.profile.LocalDevCmd',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='server_token', full_name='org.lfedge.eve.profile.LocalDevCmd.server_token', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='org.lfedge.eve.profile.LocalDevCmd.timestamp', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='org.lfedge.eve.profile.LocalDevCmd.command', index=2,
+      number=3, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='volume_commands', full_name='org.lfedge.eve.profile.LocalDevCmd.volume_commands', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='packet_captures', full_name='org.lfedge.eve.profile.LocalDevCmd.packet_captures', index=4,
+      number=5, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _LOCALDEVCMD_COMMAND,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1539,
+  serialized_end=1908,
+)
 
-LocalAppInfo = _reflection.GeneratedProtocolMessageType('LocalAppInfo', (_message.Message,), {
-  'DESCRIPTOR' : _LOCALAPPINFO,
+
+_PACKETCAPTUREREQUEST = _descriptor.Descriptor(
+  name='PacketCaptureRequest',
+  full_name='org.lfedge.eve.profile.PacketCaptureRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.PacketCaptureRequest.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='network_instance', full_name='org.lfedge.eve.profile.PacketCaptureRequest.network_instance', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='app_instance', full_name='org.lfedge.eve.profile.PacketCaptureRequest.app_instance', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='app_interface_index', full_name='org.lfedge.eve.profile.PacketCaptureRequest.app_interface_index', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='org.lfedge.eve.profile.PacketCaptureRequest.port', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='filter', full_name='org.lfedge.eve.profile.PacketCaptureRequest.filter', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='duration_sec', full_name='org.lfedge.eve.profile.PacketCaptureRequest.duration_sec', index=6,
+      number=7, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='max_size', full_name='org.lfedge.eve.profile.PacketCaptureRequest.max_size', index=7,
+      number=8, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='snap_len', full_name='org.lfedge.eve.profile.PacketCaptureRequest.snap_len', index=8,
+      number=9, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='stop', full_name='org.lfedge.eve.profile.PacketCaptureRequest.stop', index=9,
+      number=10, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1911,
+  serialized_end=2124,
+)
+
+
+_PACKETCAPTUREINFO = _descriptor.Descriptor(
+  name='PacketCaptureInfo',
+  full_name='org.lfedge.eve.profile.PacketCaptureInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.PacketCaptureInfo.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='state', full_name='org.lfedge.eve.profile.PacketCaptureInfo.state', index=1,
+      number=2, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='interface', full_name='org.lfedge.eve.profile.PacketCaptureInfo.interface', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='start_time', full_name='org.lfedge.eve.profile.PacketCaptureInfo.start_time', index=3,
+      number=4, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='end_time', full_name='org.lfedge.eve.profile.PacketCaptureInfo.end_time', index=4,
+      number=5, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='packets', full_name='org.lfedge.eve.profile.PacketCaptureInfo.packets', index=5,
+      number=6, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='size', full_name='org.lfedge.eve.profile.PacketCaptureInfo.size', index=6,
+      number=7, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='truncated', full_name='org.lfedge.eve.profile.PacketCaptureInfo.truncated', index=7,
+      number=8, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='error', full_name='org.lfedge.eve.profile.PacketCaptureInfo.error', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2127,
+  serialized_end=2395,
+)
+
+
+_LOCALPACKETCAPTURE = _descriptor.Descriptor(
+  name='LocalPacketCapture',
+  full_name='org.lfedge.eve.profile.LocalPacketCapture',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.LocalPacketCapture.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='filename', full_name='org.lfedge.eve.profile.LocalPacketCapture.filename', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='content', full_name='org.lfedge.eve.profile.LocalPacketCapture.content', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2397,
+  serialized_end=2464,
+)
+
+
+_VOLUMECOMMAND = _descriptor.Descriptor(
+  name='VolumeCommand',
+  full_name='org.lfedge.eve.profile.VolumeCommand',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='org.lfedge.eve.profile.VolumeCommand.id', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='displayname', full_name='org.lfedge.eve.profile.VolumeCommand.displayname', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timestamp', full_name='org.lfedge.eve.profile.VolumeCommand.timestamp', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='command', full_name='org.lfedge.eve.profile.VolumeCommand.command', index=3,
+      number=4, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+    _VOLUMECOMMAND_COMMAND,
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2467,
+  serialized_end=2658,
+)
+
+
+_LOCALDIAGNOSTICS = _descriptor.Descriptor(
+  name='LocalDiagnostics',
+  full_name='org.lfedge.eve.profile.LocalDiagnostics',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='cmd_timestamp', full_name='org.lfedge.eve.profile.LocalDiagnostics.cmd_timestamp', index=0,
+      number=1, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='filename', full_name='org.lfedge.eve.profile.LocalDiagnostics.filename', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='content', full_name='org.lfedge.eve.profile.LocalDiagnostics.content', index=2,
+      number=3, type=12, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"",
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2660,
+  serialized_end=2736,
+)
+
+
+_LOCALDEVMETRICS = _descriptor.Descriptor(
+  name='LocalDevMetrics',
+  full_name='org.lfedge.eve.profile.LocalDevMetrics',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='metrics', full_name='org.lfedge.eve.profile.LocalDevMetrics.metrics', index=0,
+      number=1, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2738,
+  serialized_end=2808,
+)
+
+_RADIOSTATUS.fields_by_name['cellular_status'].message_type = _CELLULARSTATUS
+_CELLULARSTATUS.fields_by_name['module'].message_type = info_dot_info__pb2._ZCELLULARMODULEINFO
+_CELLULARSTATUS.fields_by_name['sim_cards'].message_type = info_dot_info__pb2._ZSIMCARDINFO
+_CELLULARSTATUS.fields_by_name['providers'].message_type = info_dot_info__pb2._ZCELLULARPROVIDER
+_LOCALAPPINFOLIST.fields_by_name['apps_info'].message_type = _LOCALAPPINFO
+_LOCALAPPINFO.fields_by_name['err'].message_type = info_dot_info__pb2._ERRORINFO
+_LOCALAPPINFO.fields_by_name['state'].enum_type = info_dot_info__pb2._ZSWSTATE
+_LOCALAPPINFO.fields_by_name['health'].message_type = info_dot_info__pb2._ZAPPHEALTH
+_LOCALAPPCMDLIST.fields_by_name['app_commands'].message_type = _APPCOMMAND
+_APPCOMMAND.fields_by_name['command'].enum_type = _APPCOMMAND_COMMAND
+_APPCOMMAND_COMMAND.containing_type = _APPCOMMAND
+_LOCALDEVINFO.fields_by_name['info'].message_type = info_dot_info__pb2._ZINFOMSG
+_LOCALDEVINFO.fields_by_name['volumes_info'].message_type = _LOCALVOLUMEINFO
+_LOCALDEVINFO.fields_by_name['packet_captures'].message_type = _PACKETCAPTUREINFO
+_LOCALDEVCMD.fields_by_name['command'].enum_type = _LOCALDEVCMD_COMMAND
+_LOCALDEVCMD.fields_by_name['volume_commands'].message_type = _VOLUMECOMMAND
+_LOCALDEVCMD.fields_by_name['packet_captures'].message_type = _PACKETCAPTUREREQUEST
+_LOCALDEVCMD_COMMAND.containing_type = _LOCALDEVCMD
+_PACKETCAPTUREINFO.fields_by_name['state'].enum_type = _PACKETCAPTURESTATE
+_PACKETCAPTUREINFO.fields_by_name['start_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_PACKETCAPTUREINFO.fields_by_name['end_time'].message_type = google_dot_protobuf_dot_timestamp__pb2._TIMESTAMP
+_VOLUMECOMMAND.fields_by_name['command'].enum_type = _VOLUMECOMMAND_COMMAND
+_VOLUMECOMMAND_COMMAND.containing_type = _VOLUMECOMMAND
+_LOCALDEVMETRICS.fields_by_name['metrics'].message_type = metrics_dot_metrics__pb2._ZMETRICMSG
+DESCRIPTOR.message_types_by_name['LocalProfile'] = _LOCALPROFILE
+DESCRIPTOR.message_types_by_name['RadioStatus'] = _RADIOSTATUS
+DESCRIPTOR.message_types_by_name['CellularStatus'] = _CELLULARSTATUS
+DESCRIPTOR.message_types_by_name['RadioConfig'] = _RADIOCONFIG
+DESCRIPTOR.message_types_by_name['LocalAppInfoList'] = _LOCALAPPINFOLIST
+DESCRIPTOR.message_types_by_name['LocalAppInfo'] = _LOCALAPPINFO
+DESCRIPTOR.message_types_by_name['LocalAppCmdList'] = _LOCALAPPCMDLIST
+DESCRIPTOR.message_types_by_name['AppCommand'] = _APPCOMMAND
+DESCRIPTOR.message_types_by_name['LocalDevInfo'] = _LOCALDEVINFO
+DESCRIPTOR.message_types_by_name['LocalVolumeInfo'] = _LOCALVOLUMEINFO
+DESCRIPTOR.message_types_by_name['LocalDevCmd'] = _LOCALDEVCMD
+DESCRIPTOR.message_types_by_name['PacketCaptureRequest'] = _PACKETCAPTUREREQUEST
+DESCRIPTOR.message_types_by_name['PacketCaptureInfo'] = _PACKETCAPTUREINFO
+DESCRIPTOR.message_types_by_name['LocalPacketCapture'] = _LOCALPACKETCAPTURE
+DESCRIPTOR.message_types_by_name['VolumeCommand'] = _VOLUMECOMMAND
+DESCRIPTOR.message_types_by_name['LocalDiagnostics'] = _LOCALDIAGNOSTICS
+DESCRIPTOR.message_types_by_name['LocalDevMetrics'] = _LOCALDEVMETRICS
+DESCRIPTOR.enum_types_by_name['PacketCaptureState'] = _PACKETCAPTURESTATE
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+LocalProfile = _reflection.GeneratedProtocolMessageType('LocalProfile', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALPROFILE,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalProfile)
+  })
+_sym_db.RegisterMessage(LocalProfile)
+
+RadioStatus = _reflection.GeneratedProtocolMessageType('RadioStatus', (_message.Message,), {
+  'DESCRIPTOR' : _RADIOSTATUS,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.RadioStatus)
+  })
+_sym_db.RegisterMessage(RadioStatus)
+
+CellularStatus = _reflection.GeneratedProtocolMessageType('CellularStatus', (_message.Message,), {
+  'DESCRIPTOR' : _CELLULARSTATUS,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.CellularStatus)
+  })
+_sym_db.RegisterMessage(CellularStatus)
+
+RadioConfig = _reflection.GeneratedProtocolMessageType('RadioConfig', (_message.Message,), {
+  'DESCRIPTOR' : _RADIOCONFIG,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.RadioConfig)
+  })
+_sym_db.RegisterMessage(RadioConfig)
+
+LocalAppInfoList = _reflection.GeneratedProtocolMessageType('LocalAppInfoList', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALAPPINFOLIST,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalAppInfoList)
+  })
+_sym_db.RegisterMessage(LocalAppInfoList)
+
+LocalAppInfo = _reflection.GeneratedProtocolMessageType('LocalAppInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALAPPINFO,
   '__module__' : 'profile.local_profile_pb2'
   # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalAppInfo)
   })
@@ -515,6 +1182,69 @@ AppCommand = _reflection.GeneratedProtocolMessageType('AppCommand', (_message.Me
   })
 _sym_db.RegisterMessage(AppCommand)
 
+LocalDevInfo = _reflection.GeneratedProtocolMessageType('LocalDevInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALDEVINFO,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalDevInfo)
+  })
+_sym_db.RegisterMessage(LocalDevInfo)
+
+LocalVolumeInfo = _reflection.GeneratedProtocolMessageType('LocalVolumeInfo', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALVOLUMEINFO,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalVolumeInfo)
+  })
+_sym_db.RegisterMessage(LocalVolumeInfo)
+
+LocalDevCmd = _reflection.GeneratedProtocolMessageType('LocalDevCmd', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALDEVCMD,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalDevCmd)
+  })
+_sym_db.RegisterMessage(LocalDevCmd)
+
+PacketCaptureRequest = _reflection.GeneratedProtocolMessageType('PacketCaptureRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PACKETCAPTUREREQUEST,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.PacketCaptureRequest)
+  })
+_sym_db.RegisterMessage(PacketCaptureRequest)
+
+PacketCaptureInfo = _reflection.GeneratedProtocolMessageType('PacketCaptureInfo', (_message.Message,), {
+  'DESCRIPTOR' : _PACKETCAPTUREINFO,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.PacketCaptureInfo)
+  })
+_sym_db.RegisterMessage(PacketCaptureInfo)
+
+LocalPacketCapture = _reflection.GeneratedProtocolMessageType('LocalPacketCapture', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALPACKETCAPTURE,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalPacketCapture)
+  })
+_sym_db.RegisterMessage(LocalPacketCapture)
+
+VolumeCommand = _reflection.GeneratedProtocolMessageType('VolumeCommand', (_message.Message,), {
+  'DESCRIPTOR' : _VOLUMECOMMAND,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.VolumeCommand)
+  })
+_sym_db.RegisterMessage(VolumeCommand)
+
+LocalDiagnostics = _reflection.GeneratedProtocolMessageType('LocalDiagnostics', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALDIAGNOSTICS,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalDiagnostics)
+  })
+_sym_db.RegisterMessage(LocalDiagnostics)
+
+LocalDevMetrics = _reflection.GeneratedProtocolMessageType('LocalDevMetrics', (_message.Message,), {
+  'DESCRIPTOR' : _LOCALDEVMETRICS,
+  '__module__' : 'profile.local_profile_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.profile.LocalDevMetrics)
+  })
+_sym_db.RegisterMessage(LocalDevMetrics)
+
 
 DESCRIPTOR._options = None
 # @@protoc_insertion_point(module_scope)
@@ -8,12 +8,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
 	"net/url"
 	"os"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/Azure/azure-pipeline-go/pipeline"
@@ -41,52 +39,6 @@ type UpdateStats struct {
 	DoneParts types.DownloadedParts //downloaded parts
 }
 
-type sectionWriter struct {
-	count    int64
-	offset   int64
-	position int64
-	part     *types.PartDefinition
-	writerAt io.WriterAt
-}
-
-func newSectionWriter(c io.WriterAt, off int64, count int64, part *types.PartDefinition) *sectionWriter {
-	return &sectionWriter{
-		count:    count,
-		offset:   off,
-		writerAt: c,
-		part:     part,
-	}
-}
-
-//Write implementation for sectionWriter
-func (c *sectionWriter) Write(p []byte) (int, error) {
-	remaining := c.count - c.position
-
-	if remaining <= 0 {
-		return 0, fmt.Errorf("end of section reached: %v", *c.part)
-	}
-
-	slice := p
-
-	if int64(len(slice)) > remaining {
-		slice = slice[:remaining]
-	}
-
-	n, err := c.writerAt.WriteAt(slice, c.offset+c.position)
-	c.position += int64(n)
-	if err != nil {
-		return n, err
-	}
-
-	if len(p) > n {
-		return n, fmt.Errorf("not enough space for %d bytes: %d", p, n)
-	}
-
-	c.part.Size = c.part.Size + int64(n)
-
-	return n, nil
-}
-
 // NotifChan is the uploading/downloading progress notification channel
 type NotifChan chan UpdateStats
 
@@ -185,19 +137,21 @@ func DeleteAzureBlob(accountURL, accountName, accountKey, containerName, remoteF
 	return err
 }
 
+// DownloadAzureBlob downloads the blob into the localFile
+// it skips parts from doneParts which are downloaded already and downloads
+// up to concurrency ranges in parallel (parallelism if not set)
 func DownloadAzureBlob(accountURL, accountName, accountKey, containerName, remoteFile, localFile string,
-	objMaxSize int64, httpClient *http.Client, doneParts types.DownloadedParts, prgNotify NotifChan) (types.DownloadedParts, error) {
+	objMaxSize int64, httpClient *http.Client, doneParts types.DownloadedParts, concurrency int,
+	prgNotify NotifChan) (types.DownloadedParts, error) {
 
-	var file *os.File
-	stats := &UpdateStats{DoneParts: doneParts}
 	p, err := newPipeline(accountName, accountKey, httpClient)
 	if err != nil {
-		return stats.DoneParts, fmt.Errorf("unable to create pipeline: %v", err)
+		return doneParts, fmt.Errorf("unable to create pipeline: %v", err)
 	}
 
 	URL, err := getURL(accountURL, accountName, containerName, "")
 	if err != nil {
-		return stats.DoneParts, fmt.Errorf("invalid URL for container name %s: %v", containerName, err)
+		return doneParts, fmt.Errorf("invalid URL for container name %s: %v", containerName, err)
 	}
 
 	// Create a ContainerURL object that wraps the container URL and a request
@@ -207,12 +161,13 @@ func DownloadAzureBlob(accountURL, accountName, accountKey, containerName, remot
 	ctx := context.Background()
 	properties, err := blobURL.GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
 	if err != nil {
-		return stats.DoneParts, fmt.Errorf("could not get properties for blob: %v", err)
+		return doneParts, fmt.Errorf("could not get properties for blob: %v", err)
 	}
 	objSize := properties.ContentLength()
+	etag := properties.ETag()
 
 	if objMaxSize != 0 && objSize > objMaxSize {
-		return types.DownloadedParts{PartSize: SingleMB},
+		return types.DownloadedParts{},
 			fmt.Errorf("configured image size (%d) is less than size of file (%d)", objMaxSize, objSize)
 	}
 
@@ -220,111 +175,47 @@ func DownloadAzureBlob(accountURL, accountName, accountKey, containerName, remot
 	index := strings.LastIndex(tempLocalFile, "/")
 	dir_err := os.MkdirAll(tempLocalFile[:index+1], 0755)
 	if dir_err != nil {
-		return stats.DoneParts, dir_err
+		return doneParts, dir_err
 	}
 
-	if _, err := os.Stat(localFile); err != nil && os.IsNotExist(err) {
-		//if file not exists clean doneParts
-		stats.DoneParts = types.DownloadedParts{
-			PartSize: SingleMB,
-		}
-	}
-
-	if len(doneParts.Parts) > 0 {
-		file, err = os.OpenFile(localFile, os.O_RDWR, 0666)
-		if err != nil {
-			return stats.DoneParts, err
-		}
-	} else {
-		// Create the local file
-		file, err = os.Create(localFile)
-		if err != nil {
-			return stats.DoneParts, err
-		}
-		stats.DoneParts.PartSize = SingleMB
+	// Setup the local file
+	file, err := os.OpenFile(localFile, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return doneParts, err
 	}
 	defer file.Close()
 
-	stats.Size = objSize
-	var progressReceiver pipeline.ProgressReceiver
-	if prgNotify != nil {
-		progressReceiver = func(bytesTransferred int64) {
-			stats.Asize = bytesTransferred
-			select {
-			case prgNotify <- *stats:
-			default: //ignore we cannot write
-			}
-		}
-	}
-	// calculate downloaded size based on parts
-	progress := int64(0)
-	for _, p := range stats.DoneParts.Parts {
-		progress += p.Size
-	}
-	progressLock := &sync.Mutex{}
-
-	err = azblob.DoBatchTransfer(ctx, azblob.BatchTransferOptions{
-		OperationName: "DownloadAzureBlob",
-		TransferSize:  objSize,
-		ChunkSize:     SingleMB,
-		Parallelism:   parallelism,
-		Operation: func(chunkStart int64, count int64, ctx context.Context) error {
-			offset := int64(0)
-			partNum := int64(0)
-			if chunkStart > 0 {
-				//calculate part number based on offset (chunkStart) in file
-				partNum = int64(math.Floor(float64(chunkStart) / float64(SingleMB)))
+	if concurrency <= 0 {
+		concurrency = parallelism
+	}
+	stats := UpdateStats{Size: objSize}
+	download := &types.RangeDownload{
+		File: file,
+		Size: objSize,
+		// ETag changes every time the blob is overwritten
+		Validator:   string(etag),
+		Concurrency: concurrency,
+		Open: func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+			dr, err := blobURL.Download(ctx, offset, count, azblob.BlobAccessConditions{
+				ModifiedAccessConditions: azblob.ModifiedAccessConditions{IfMatch: etag},
+			}, false, azblob.ClientProvidedKeyOptions{})
+			if err != nil {
+				return nil, err
 			}
-			var part *types.PartDefinition
-			progressLock.Lock()
-			for _, el := range stats.DoneParts.Parts {
-				if el.Ind == partNum {
-					offset = el.Size
-					part = el
-					break
+			return dr.Body(azblob.RetryReaderOptions{MaxRetryRequests: maxRetries}), nil
+		},
+		Progress: func(asize int64, doneParts types.DownloadedParts) {
+			stats.Asize = asize
+			stats.DoneParts = doneParts
+			if prgNotify != nil {
+				select {
+				case prgNotify <- stats:
+				default: //ignore we cannot write
 				}
 			}
-			if count-offset == 0 {
-				progressLock.Unlock()
-				// nothing to download
-				return nil
-			}
-			if part == nil {
-				// if no part found, append new
-				part = &types.PartDefinition{Ind: partNum}
-				stats.DoneParts.Parts = append(stats.DoneParts.Parts, part)
-			}
-			progressLock.Unlock()
-			dr, err := blobURL.Download(ctx, chunkStart+offset, count-offset, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
-			if err != nil {
-				return err
-			}
-			body := dr.Body(azblob.RetryReaderOptions{MaxRetryRequests: maxRetries})
-			rangeProgress := int64(0)
-			body = pipeline.NewResponseBodyProgress(
-				body,
-				func(bytesTransferred int64) {
-					diff := bytesTransferred - rangeProgress
-					rangeProgress = bytesTransferred
-					progressLock.Lock()
-					progress += diff
-					progressReceiver(progress)
-					progressLock.Unlock()
-				})
-			_, err = io.Copy(newSectionWriter(file, chunkStart+offset, count-offset, part), body)
-			body.Close()
-			return err
 		},
-	})
-	if err != nil {
-		return stats.DoneParts, err
-	}
-	// ensure we send the total; it is theoretically possible that it downloaded without error
-	// but the progress receiver did not get invoked at the end
-	if stats.Asize < stats.Size {
-		progressReceiver(stats.Size)
 	}
-	return stats.DoneParts, nil
+	return download.Run(ctx, doneParts)
 }
 
 // DownloadAzureBlobByChunks will process the blob download by chunks, i.e., chunks will be
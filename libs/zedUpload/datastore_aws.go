@@ -198,7 +198,7 @@ func (ep *AwsTransportMethod) processS3Download(req *DronaRequest) (error, int)
 						return
 					}
 				case <-ticker.C:
-					req.doneParts = stats.DoneParts
+					req.updateDoneParts(stats.DoneParts, false)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
@@ -212,8 +212,9 @@ func (ep *AwsTransportMethod) processS3Download(req *DronaRequest) (error, int)
 	if req.cancelContext != nil {
 		sc = sc.WithContext(req.cancelContext)
 	}
-	doneParts, err := sc.DownloadFile(req.objloc, ep.bucket, req.name, req.sizelimit, req.doneParts, prgChan)
-	req.doneParts = doneParts
+	doneParts, err := sc.DownloadFile(req.objloc, ep.bucket, req.name, req.sizelimit,
+		req.GetDoneParts(), req.concurrency, prgChan)
+	req.updateDoneParts(doneParts, true)
 	if err != nil {
 		return err, 0
 	}
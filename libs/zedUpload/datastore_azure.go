@@ -140,14 +140,15 @@ func (ep *AzureTransportMethod) processAzureDownload(req *DronaRequest) error {
 						return
 					}
 				case <-ticker.C:
-					req.doneParts = stats.DoneParts
+					req.updateDoneParts(stats.DoneParts, false)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
-	doneParts, err := azure.DownloadAzureBlob(ep.aurl, ep.acName, ep.acKey, ep.container, file, req.objloc, req.sizelimit, ep.hClient, req.doneParts, prgChan)
-	req.doneParts = doneParts
+	doneParts, err := azure.DownloadAzureBlob(ep.aurl, ep.acName, ep.acKey, ep.container, file, req.objloc,
+		req.sizelimit, ep.hClient, req.GetDoneParts(), req.concurrency, prgChan)
+	req.updateDoneParts(doneParts, true)
 	if err != nil {
 		return err
 	}
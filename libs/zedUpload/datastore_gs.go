@@ -184,13 +184,16 @@ func (ep *GsTransportMethod) processGSDownload(req *DronaRequest) (int, error) {
 						return
 					}
 				case <-ticker.C:
+					req.updateDoneParts(stats.DoneParts, false)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
 
-	err = s.DownloadFile(req.objloc, ep.bucket, req.name, req.sizelimit, prgChan)
+	doneParts, err := s.DownloadFile(req.objloc, ep.bucket, req.name, req.sizelimit,
+		req.GetDoneParts(), req.concurrency, prgChan)
+	req.updateDoneParts(doneParts, true)
 	if err != nil {
 		return 0, err
 	}
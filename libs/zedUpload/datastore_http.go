@@ -156,15 +156,15 @@ func (ep *HttpTransportMethod) processHttpDownload(req *DronaRequest) (error, in
 						return
 					}
 				case <-ticker.C:
+					req.updateDoneParts(stats.DoneParts, false)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
-	resp := zedHttp.ExecCmd(req.cancelContext, "get", file, "", req.objloc, req.sizelimit, prgChan, ep.hClient)
-	if resp.Error != nil {
-		return resp.Error, resp.BodyLength
-	}
+	resp := zedHttp.GetWithRanges(req.cancelContext, file, req.objloc, req.sizelimit,
+		req.GetDoneParts(), req.concurrency, prgChan, ep.hClient)
+	req.updateDoneParts(resp.DoneParts, true)
 	return resp.Error, resp.BodyLength
 }
 
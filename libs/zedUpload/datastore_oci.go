@@ -41,7 +41,7 @@ func (ep *OCITransportMethod) Action(req *DronaRequest) error {
 	var sha256, contentType string
 
 	switch req.operation {
-	case SyncOpDownload:
+	case SyncOpDownload, SyncOpDownloadWithSignature:
 		size, contentType, err = ep.processDownload(req)
 		req.contentType = contentType
 	case SyncOpUpload:
@@ -167,8 +167,19 @@ func (ep *OCITransportMethod) processDownload(req *DronaRequest) (int64, string,
 
 	// Pull down the blob as is and save it to a file named for the hash
 	size, contentType, err = ociutil.PullBlob(ep.registry, ep.path, req.ImageSha256, req.objloc, ep.uname, ep.apiKey, req.sizelimit, ep.hClient, prgChan)
-	// zedUpload's job is to download a blob from an OCI registry. Done.
-	return size, contentType, err
+	if err != nil || req.operation != SyncOpDownloadWithSignature {
+		// zedUpload's job is to download a blob from an OCI registry. Done.
+		return size, contentType, err
+	}
+	// Save the signatures of the blob next to it for the verifier
+	sigs, err := ociutil.PullSignatures(ep.registry, ep.path, req.ImageSha256, ep.uname, ep.apiKey, ep.hClient)
+	if err != nil {
+		return size, contentType, err
+	}
+	if err := ociutil.WriteSignatures(req.objloc+ociutil.SignaturesFileSuffix, sigs); err != nil {
+		return size, contentType, err
+	}
+	return size, contentType, nil
 }
 
 // processDelete Artifact delete from OCI registry
@@ -95,6 +95,11 @@ type DronaRequest struct {
 
 	//downloaded parts indexes
 	doneParts types.DownloadedParts
+	// set once the transport returned the final doneParts
+	donePartsFinal bool
+
+	// number of ranges downloaded in parallel if supported by the transport
+	concurrency int
 }
 
 // Return object local name
@@ -353,5 +358,29 @@ func (req *DronaRequest) WithDoneParts(doneParts types.DownloadedParts) *DronaRe
 
 // GetDoneParts returns already downloaded parts indexes
 func (req *DronaRequest) GetDoneParts() types.DownloadedParts {
+	req.Lock()
+	defer req.Unlock()
 	return req.doneParts
 }
+
+// updateDoneParts is called from the progress goroutines of the transports
+// and with final set once the download returned. Progress updates which
+// come after the final one are ignored.
+func (req *DronaRequest) updateDoneParts(doneParts types.DownloadedParts, final bool) {
+	req.Lock()
+	defer req.Unlock()
+	if req.donePartsFinal {
+		return
+	}
+	req.doneParts = doneParts
+	req.donePartsFinal = final
+}
+
+// WithConcurrency can be used to set the number of ranges of the object
+// downloaded in parallel by the transports which support range requests
+// (all except OCI registries)
+func (req *DronaRequest) WithConcurrency(concurrency int) *DronaRequest {
+
+	req.concurrency = concurrency
+	return req
+}
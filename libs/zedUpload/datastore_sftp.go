@@ -171,13 +171,16 @@ func (ep *SftpTransportMethod) processSftpDownload(req *DronaRequest) (error, in
 						return
 					}
 				case <-ticker.C:
+					req.updateDoneParts(stats.DoneParts, false)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
 
-	resp := sftp.ExecCmd("fetch", ep.surl, ep.uname, ep.passwd, file, req.objloc, req.sizelimit, prgChan)
+	resp := sftp.Fetch(ep.surl, ep.uname, ep.passwd, file, req.objloc, req.sizelimit,
+		req.GetDoneParts(), req.concurrency, prgChan)
+	req.updateDoneParts(resp.DoneParts, true)
 	return resp.Error, int(resp.Asize)
 }
 
@@ -5,20 +5,26 @@ package gsutil
 
 import (
 	"compress/gzip"
-	"google.golang.org/api/iterator"
+	"context"
+	"fmt"
 	"io"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"sync/atomic"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+	"google.golang.org/api/iterator"
 )
 
 //UpdateStats structure for stats update
 type UpdateStats struct {
-	Name  string   // always the remote key
-	Size  int64    // complete size to upload/download
-	Asize int64    // current size uploaded/downloaded
-	List  []string //list of images at given path
+	Name      string                // always the remote key
+	Size      int64                 // complete size to upload/download
+	Asize     int64                 // current size uploaded/downloaded
+	List      []string              //list of images at given path
+	DoneParts types.DownloadedParts //downloaded parts
 }
 
 //NotifChan to send updates
@@ -187,39 +193,54 @@ func (s *GSctx) UploadFile(fname, bname, bkey string, compression bool, prgNotif
 }
 
 //DownloadFile from Google Storage
-func (s *GSctx) DownloadFile(fname, bname, bkey string,
-	bsize int64, prgNotify NotifChan) error {
+//it skips parts from doneParts which are downloaded already and downloads
+//up to concurrency ranges in parallel
+func (s *GSctx) DownloadFile(fname, bname, bkey string, objMaxSize int64,
+	doneParts types.DownloadedParts, concurrency int, prgNotify NotifChan) (types.DownloadedParts, error) {
+
+	obj := s.gsClient.Bucket(bname).Object(bkey)
+	attrs, err := obj.Attrs(s.ctx)
+	if err != nil {
+		return doneParts, err
+	}
+	if objMaxSize != 0 && attrs.Size > objMaxSize {
+		return types.DownloadedParts{},
+			fmt.Errorf("configured image size (%d) is less than size of file (%d)", objMaxSize, attrs.Size)
+	}
 
 	if err := os.MkdirAll(filepath.Dir(fname), 0775); err != nil {
-		return err
+		return doneParts, err
 	}
 
 	// Setup the local file
-	fd, err := os.Create(fname)
+	fd, err := os.OpenFile(fname, os.O_RDWR|os.O_CREATE, 0666)
 	if err != nil {
-		return err
-	}
-
-	cWriter := &CustomWriter{
-		fp:        fd,
-		upSize:    UpdateStats{Size: bsize, Name: bkey},
-		prgNotify: prgNotify,
+		return doneParts, err
 	}
-
 	defer fd.Close()
 
-	obj := s.gsClient.Bucket(bname).Object(bkey)
-
-	r, err := obj.NewReader(s.ctx)
-	if err != nil {
-		return err
-	}
-	defer r.Close()
-	_, err = io.Copy(cWriter, r)
-	if err != nil {
-		return err
+	stats := UpdateStats{Size: attrs.Size, Name: bkey}
+	download := &types.RangeDownload{
+		File: fd,
+		Size: attrs.Size,
+		// generation changes every time the object is overwritten
+		Validator:   strconv.FormatInt(attrs.Generation, 10),
+		Concurrency: concurrency,
+		Open: func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+			return obj.Generation(attrs.Generation).NewRangeReader(ctx, offset, count)
+		},
+		Progress: func(asize int64, doneParts types.DownloadedParts) {
+			stats.Asize = asize
+			stats.DoneParts = doneParts
+			if prgNotify != nil {
+				select {
+				case prgNotify <- stats:
+				default: //ignore we cannot write
+				}
+			}
+		},
 	}
-	return nil
+	return download.Run(s.ctx, doneParts)
 }
 
 //ListImages in Google Storage
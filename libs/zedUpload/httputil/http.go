@@ -18,6 +18,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lf-edge/eve/libs/zedUpload/types"
 	logutils "github.com/lf-edge/eve/pkg/pillar/utils/logging"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/html"
@@ -38,6 +39,8 @@ type UpdateStats struct {
 	Error         error
 	BodyLength    int   // Body legth in http response
 	ContentLength int64 // Content length in http response
+
+	DoneParts types.DownloadedParts //downloaded parts
 }
 
 type NotifChan chan UpdateStats
@@ -0,0 +1,180 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+	"github.com/sirupsen/logrus"
+)
+
+// inactivityReader cancels the request if no data is received
+// for inactivityTimeout
+type inactivityReader struct {
+	body   io.ReadCloser
+	timer  *time.Timer
+	cancel context.CancelFunc
+}
+
+func (r *inactivityReader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	if n > 0 {
+		r.timer.Reset(inactivityTimeout)
+	}
+	return n, err
+}
+
+func (r *inactivityReader) Close() error {
+	r.timer.Stop()
+	r.cancel()
+	return r.body.Close()
+}
+
+// rangeValidator returns the version of the remote file from the response
+// headers to detect changes of the file between range requests
+func rangeValidator(resp *http.Response) string {
+	if etag := resp.Header.Get("ETag"); etag != "" {
+		return etag
+	}
+	return resp.Header.Get("Last-Modified")
+}
+
+// GetWithRanges downloads host into localFile using range requests if the server
+// supports them. The download continues from doneParts, which are updated
+// in the progress notifications and returned in UpdateStats, and up to
+// concurrency ranges are downloaded in parallel.
+// If the server does not support ranges, the file is downloaded using ExecCmd "get".
+func GetWithRanges(ctx context.Context, host, localFile string, objSize int64,
+	doneParts types.DownloadedParts, concurrency int, prgNotify NotifChan,
+	client *http.Client) UpdateStats {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if client == nil {
+		client = getHttpClient()
+	}
+	stats := UpdateStats{}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, host, nil)
+	if err != nil {
+		stats.Error = fmt.Errorf("request failed for head %s: %s", host, err)
+		return stats
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	if err != nil || resp.StatusCode != http.StatusOK ||
+		resp.Header.Get("Accept-Ranges") != "bytes" || resp.ContentLength <= 0 {
+		// the whole file will be downloaded again
+		logrus.Infof("GetWithRanges: ranges not available for %s, downloading whole file", host)
+		return ExecCmd(ctx, "get", host, "", localFile, objSize, prgNotify, client)
+	}
+	size := resp.ContentLength
+	validator := rangeValidator(resp)
+	stats.Size = size
+	stats.DoneParts = doneParts
+	if objSize != 0 && size > objSize {
+		stats.Error = fmt.Errorf("configured image size (%d) is less than size of file (%d)",
+			objSize, size)
+		return stats
+	}
+
+	if err := os.MkdirAll(filepath.Dir(localFile), 0755); err != nil {
+		stats.Error = err
+		return stats
+	}
+	local, err := os.OpenFile(localFile, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		stats.Error = err
+		return stats
+	}
+	defer local.Close()
+
+	open := func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+		// we need innerCtx cancel to call in case of inactivity
+		innerCtx, innerCtxCancel := context.WithCancel(ctx)
+		inactivityTimer := time.AfterFunc(inactivityTimeout, innerCtxCancel)
+		req, err := http.NewRequestWithContext(innerCtx, http.MethodGet, host, nil)
+		if err != nil {
+			inactivityTimer.Stop()
+			innerCtxCancel()
+			return nil, err
+		}
+		req.Header.Set("User-Agent", userAgent)
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+count-1))
+		resp, err := client.Do(req)
+		if err != nil {
+			inactivityTimer.Stop()
+			innerCtxCancel()
+			return nil, fmt.Errorf("client.Do failed: %s", err)
+		}
+		body := &inactivityReader{body: resp.Body, timer: inactivityTimer, cancel: innerCtxCancel}
+		if resp.StatusCode != http.StatusPartialContent {
+			body.Close()
+			return nil, fmt.Errorf("bad response code: %d", resp.StatusCode)
+		}
+		if newValidator := rangeValidator(resp); newValidator != validator {
+			body.Close()
+			return nil, fmt.Errorf("file changed during download (%s/%s)",
+				validator, newValidator)
+		}
+		return body, nil
+	}
+	download := &types.RangeDownload{
+		File:        local,
+		Size:        size,
+		Validator:   validator,
+		Concurrency: concurrency,
+		Open:        open,
+		Progress: func(asize int64, doneParts types.DownloadedParts) {
+			stats.Asize = asize
+			stats.DoneParts = doneParts
+			if prgNotify != nil {
+				select {
+				case prgNotify <- stats:
+				default: //ignore we cannot write
+				}
+			}
+		},
+	}
+
+	var errorList []string
+	delay := time.Second
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if ctx.Err() != nil {
+			errorList = append(errorList, fmt.Sprintf("(attempt %d/%d): %v",
+				attempt, maxRetries, ctx.Err()))
+			break
+		}
+		if attempt > 0 {
+			time.Sleep(delay)
+			if delay < maxDelay {
+				delay = delay * 2
+			}
+		}
+		doneParts, err = download.Run(ctx, stats.DoneParts)
+		stats.DoneParts = doneParts
+		if err == nil {
+			stats.Asize = size
+			stats.BodyLength = int(size)
+			return stats
+		}
+		errorList = append(errorList, fmt.Sprintf("(attempt %d/%d): %v",
+			attempt, maxRetries, err))
+		logrus.Warnf("GetWithRanges %s failed (attempt %d/%d): %v",
+			host, attempt, maxRetries, err)
+	}
+	stats.Error = fmt.Errorf("%s: %s", host, strings.Join(errorList, "; "))
+	return stats
+}
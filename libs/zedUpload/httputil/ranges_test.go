@@ -0,0 +1,107 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+func TestGetWithRanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "httpranges")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	content := make([]byte, 2*types.DefaultRangePartSize+100)
+	rand.Read(content)
+	var ranges int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			atomic.AddInt32(&ranges, 1)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader(content))
+	}))
+	defer srv.Close()
+
+	localFile := filepath.Join(dir, "blob")
+	// the first part is downloaded already
+	if err := ioutil.WriteFile(localFile, content[:types.DefaultRangePartSize], 0644); err != nil {
+		t.Fatal(err)
+	}
+	doneParts := types.DownloadedParts{
+		PartSize:  types.DefaultRangePartSize,
+		Validator: `"v1"`,
+		Parts:     []*types.PartDefinition{{Ind: 0, Size: types.DefaultRangePartSize}},
+	}
+	stats := GetWithRanges(context.Background(), srv.URL, localFile, 0, doneParts, 2, nil, nil)
+	if stats.Error != nil {
+		t.Fatal(stats.Error)
+	}
+	if ranges != 2 {
+		t.Errorf("expected 2 range requests, got %d", ranges)
+	}
+	if stats.Asize != int64(len(content)) || len(stats.DoneParts.Parts) != 3 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	result, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result, content) {
+		t.Error("downloaded content differs")
+	}
+}
+
+func TestGetWithRangesNotSupported(t *testing.T) {
+	dir, err := ioutil.TempDir("", "httpranges")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Range") != "" {
+			t.Errorf("unexpected range request")
+		}
+		w.Write([]byte("content"))
+	}))
+	defer srv.Close()
+
+	localFile := filepath.Join(dir, "blob")
+	if err := ioutil.WriteFile(localFile, []byte("stale content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	doneParts := types.DownloadedParts{
+		PartSize: types.DefaultRangePartSize,
+		Parts:    []*types.PartDefinition{{Ind: 0, Size: 5}},
+	}
+	stats := GetWithRanges(context.Background(), srv.URL, localFile, 0, doneParts, 1, nil, nil)
+	if stats.Error != nil {
+		t.Fatal(stats.Error)
+	}
+	if len(stats.DoneParts.Parts) != 0 {
+		t.Errorf("unexpected parts: %+v", stats.DoneParts)
+	}
+	result, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result) != "content" {
+		t.Errorf("unexpected content: %s", result)
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SignaturesFileSuffix is appended to the name of the downloaded blob
+	// to get the name of the file with its signatures
+	SignaturesFileSuffix = ".sig"
+
+	cosignSignatureTagSuffix   = ".sig"
+	cosignAttestationTagSuffix = ".att"
+	cosignSignatureAnnotation  = "dev.cosignproject.cosign/signature"
+	cosignCertAnnotation       = "dev.sigstore.cosign/certificate"
+	cosignChainAnnotation      = "dev.sigstore.cosign/chain"
+	notationArtifactType       = "application/vnd.cncf.notary.signature"
+	notationJWSMediaType       = "application/jose+json"
+
+	// maxSignatureSize limits the size of a signature layer or manifest
+	maxSignatureSize = 1024 * 1024
+)
+
+// CosignSignature is a signature layer of the cosign signature image
+type CosignSignature struct {
+	Payload     []byte // simple signing payload
+	Signature   string // base64 encoded signature of the payload
+	Certificate []byte `json:",omitempty"` // PEM of the signing certificate
+	Chain       []byte `json:",omitempty"` // PEM of the intermediate certificates
+}
+
+// CosignAttestation is a DSSE envelope layer of the cosign attestation image
+type CosignAttestation struct {
+	Envelope    []byte
+	Certificate []byte `json:",omitempty"`
+	Chain       []byte `json:",omitempty"`
+}
+
+// NotationSignature is a signature envelope of a Notation signature artifact
+type NotationSignature struct {
+	MediaType string
+	Envelope  []byte
+}
+
+// Signatures are the signatures and attestations of an image manifest
+// pulled from the registry together with it
+type Signatures struct {
+	Digest       string              // sha256:<hex> of the signed manifest
+	Cosign       []CosignSignature   `json:",omitempty"`
+	Attestations []CosignAttestation `json:",omitempty"`
+	Notation     []NotationSignature `json:",omitempty"`
+}
+
+// PullSignatures gets the signatures of the manifest with the given hash in repo
+// from the registry. The cosign signatures and attestations are pulled from
+// the sha256-<hex>.sig and .att tags, the Notation signatures listed in the
+// index at the sha256-<hex> tag (the referrers tag schema).
+// Missing signatures are not an error, an empty Signatures is returned.
+func PullSignatures(registry, repo, hash, username, apiKey string, client *http.Client) (*Signatures, error) {
+	image := fmt.Sprintf("%s/%s", registry, repo)
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return nil, fmt.Errorf("parsing reference %q: %v", image, err)
+	}
+	hash = checkAndCorrectHash(hash)
+	repository := ref.Context()
+	opts := options(username, apiKey, client)
+	tagPrefix := strings.Replace(hash, ":", "-", 1)
+	sigs := &Signatures{Digest: hash}
+
+	layers, err := pullLayers(repository.Tag(tagPrefix+cosignSignatureTagSuffix), opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range layers {
+		sigs.Cosign = append(sigs.Cosign, CosignSignature{
+			Payload:     l.content,
+			Signature:   l.annotations[cosignSignatureAnnotation],
+			Certificate: []byte(l.annotations[cosignCertAnnotation]),
+			Chain:       []byte(l.annotations[cosignChainAnnotation]),
+		})
+	}
+
+	layers, err = pullLayers(repository.Tag(tagPrefix+cosignAttestationTagSuffix), opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range layers {
+		sigs.Attestations = append(sigs.Attestations, CosignAttestation{
+			Envelope:    l.content,
+			Certificate: []byte(l.annotations[cosignCertAnnotation]),
+			Chain:       []byte(l.annotations[cosignChainAnnotation]),
+		})
+	}
+
+	sigs.Notation, err = pullNotationSignatures(repository, repository.Tag(tagPrefix), opts)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("PullSignatures(%s@%s): %d cosign signatures, %d attestations, %d notation signatures",
+		image, hash, len(sigs.Cosign), len(sigs.Attestations), len(sigs.Notation))
+	return sigs, nil
+}
+
+// signatureManifest is an image manifest or index including the fields
+// of the OCI artifacts, which are not parsed by go-containerregistry
+type signatureManifest struct {
+	MediaType    string `json:"mediaType"`
+	ArtifactType string `json:"artifactType"`
+	Config       struct {
+		MediaType string `json:"mediaType"`
+	} `json:"config"`
+	Layers []struct {
+		MediaType   string            `json:"mediaType"`
+		Digest      string            `json:"digest"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"layers"`
+	Manifests []struct {
+		Digest       string `json:"digest"`
+		ArtifactType string `json:"artifactType"`
+	} `json:"manifests"`
+}
+
+type signatureLayer struct {
+	mediaType   string
+	content     []byte
+	annotations map[string]string
+}
+
+// isNotFound returns true if the registry does not have the manifest
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
+
+// getSignatureManifest gets the manifest for ref, nil if it does not exist
+func getSignatureManifest(ref name.Reference, opts []remote.Option) (*signatureManifest, error) {
+	desc, err := remote.Get(ref, opts...)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting manifest %s: %v", ref, err)
+	}
+	if desc.Size > maxSignatureSize {
+		return nil, fmt.Errorf("manifest %s is too large: %d", ref, desc.Size)
+	}
+	var manifest signatureManifest
+	if err := json.Unmarshal(desc.Manifest, &manifest); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest %s: %v", ref, err)
+	}
+	return &manifest, nil
+}
+
+// pullLayers gets the layers of the manifest with their annotations
+func pullLayers(ref name.Reference, opts []remote.Option) ([]signatureLayer, error) {
+	manifest, err := getSignatureManifest(ref, opts)
+	if err != nil || manifest == nil {
+		return nil, err
+	}
+	var layers []signatureLayer
+	for _, l := range manifest.Layers {
+		layer, err := remote.Layer(ref.Context().Digest(l.Digest), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("could not pull layer %s of %s: %v", l.Digest, ref, err)
+		}
+		r, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf("could not get layer reader %s of %s: %v", l.Digest, ref, err)
+		}
+		content, err := ioutil.ReadAll(io.LimitReader(r, maxSignatureSize))
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read layer %s of %s: %v", l.Digest, ref, err)
+		}
+		layers = append(layers, signatureLayer{
+			mediaType:   l.MediaType,
+			content:     content,
+			annotations: l.Annotations,
+		})
+	}
+	return layers, nil
+}
+
+// pullNotationSignatures gets the envelopes of the Notation signatures
+// listed in the referrers index at the tag
+func pullNotationSignatures(repository name.Repository, tag name.Tag,
+	opts []remote.Option) ([]NotationSignature, error) {
+	index, err := getSignatureManifest(tag, opts)
+	if err != nil || index == nil {
+		return nil, err
+	}
+	var sigs []NotationSignature
+	for _, m := range index.Manifests {
+		if m.ArtifactType != "" && m.ArtifactType != notationArtifactType {
+			continue
+		}
+		ref := repository.Digest(m.Digest)
+		manifest, err := getSignatureManifest(ref, opts)
+		if err != nil {
+			return nil, err
+		}
+		if manifest == nil || (manifest.ArtifactType != notationArtifactType &&
+			manifest.Config.MediaType != notationArtifactType) {
+			continue
+		}
+		layers, err := pullLayers(ref, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range layers {
+			sigs = append(sigs, NotationSignature{
+				MediaType: l.mediaType,
+				Envelope:  l.content,
+			})
+		}
+	}
+	return sigs, nil
+}
+
+// WriteSignatures saves the signatures into the file
+func WriteSignatures(filename string, sigs *Signatures) error {
+	b, err := json.Marshal(sigs)
+	if err != nil {
+		return fmt.Errorf("unable to marshal signatures: %v", err)
+	}
+	if err := ioutil.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("unable to write signatures: %v", err)
+	}
+	return nil
+}
+
+// ReadSignatures loads the signatures saved by WriteSignatures
+func ReadSignatures(filename string) (*Signatures, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var sigs Signatures
+	if err := json.Unmarshal(b, &sigs); err != nil {
+		return nil, fmt.Errorf("unable to parse signatures %s: %v", filename, err)
+	}
+	return &sigs, nil
+}
@@ -0,0 +1,545 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociutil
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrNoSignatures is returned by Verify if the image has no signatures
+var ErrNoSignatures = errors.New("no signatures found")
+
+// trustedKey is a public key, which signs the images directly
+type trustedKey struct {
+	name string
+	key  crypto.PublicKey
+}
+
+// TrustAnchors are the public keys and the CA certificates the signatures
+// of the images are verified with
+type TrustAnchors struct {
+	keys   []trustedKey
+	roots  *x509.CertPool
+	nroots int
+}
+
+// ParseTrustAnchors parses the PEM bundle of PUBLIC KEY and CERTIFICATE blocks.
+// The signer of the key is reported using the Name header of the PEM block
+// if present, otherwise using the sha256 fingerprint of the key.
+func ParseTrustAnchors(pemData []byte) (*TrustAnchors, error) {
+	anchors := &TrustAnchors{roots: x509.NewCertPool()}
+	for {
+		var block *pem.Block
+		block, pemData = pem.Decode(pemData)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse public key: %v", err)
+			}
+			name := block.Headers["Name"]
+			if name == "" {
+				sum := sha256.Sum256(block.Bytes)
+				name = "sha256:" + hex.EncodeToString(sum[:])
+			}
+			anchors.keys = append(anchors.keys, trustedKey{name: name, key: key})
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse certificate: %v", err)
+			}
+			anchors.roots.AddCert(cert)
+			anchors.nroots++
+		default:
+			return nil, fmt.Errorf("unsupported PEM block %s", block.Type)
+		}
+	}
+	if len(bytes.TrimSpace(pemData)) != 0 {
+		return nil, fmt.Errorf("trailing data after the PEM blocks")
+	}
+	return anchors, nil
+}
+
+// Empty returns true if there are no trust anchors
+func (anchors *TrustAnchors) Empty() bool {
+	return anchors == nil || (len(anchors.keys) == 0 && anchors.nroots == 0)
+}
+
+// Verify checks the signatures against the trust anchors. Returns the
+// identities of the signers of the valid signatures and the number of
+// attestations with valid signatures. Returns an error if no signature is valid.
+func (sigs *Signatures) Verify(anchors *TrustAnchors) ([]string, int, error) {
+	if len(sigs.Cosign) == 0 && len(sigs.Notation) == 0 {
+		return nil, 0, ErrNoSignatures
+	}
+	if anchors.Empty() {
+		return nil, 0, fmt.Errorf("no trust anchors configured")
+	}
+	var signers, errList []string
+	addSigner := func(signer string) {
+		for _, s := range signers {
+			if s == signer {
+				return
+			}
+		}
+		signers = append(signers, signer)
+	}
+	for _, sig := range sigs.Cosign {
+		signer, err := sig.verify(sigs.Digest, anchors)
+		if err != nil {
+			errList = append(errList, "cosign: "+err.Error())
+			continue
+		}
+		addSigner(signer)
+	}
+	for _, sig := range sigs.Notation {
+		signer, err := sig.verify(sigs.Digest, anchors)
+		if err != nil {
+			errList = append(errList, "notation: "+err.Error())
+			continue
+		}
+		addSigner(signer)
+	}
+	if len(signers) == 0 {
+		return nil, 0, fmt.Errorf("no valid signature: %s", strings.Join(errList, "; "))
+	}
+	var attestations int
+	for _, att := range sigs.Attestations {
+		if err := att.verify(sigs.Digest, anchors); err == nil {
+			attestations++
+		}
+	}
+	return signers, attestations, nil
+}
+
+// signerCertificate verifies the certificate and its chain up to the CA
+// anchors and returns the certificate and its identity.
+func signerCertificate(certPEM, chainPEM []byte, anchors *TrustAnchors) (*x509.Certificate, string, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, "", fmt.Errorf("unable to decode certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to parse certificate: %v", err)
+	}
+	if isFulcioCertificate(cert) {
+		// Fulcio certificates are valid for 10 minutes only, the signature
+		// has to be verified at the time of the Rekor transparency log entry,
+		// which requires the Rekor public key and is not implemented.
+		return nil, "", fmt.Errorf("keyless signature (Fulcio certificate of %s) is not supported",
+			certificateIdentity(cert))
+	}
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM(chainPEM)
+	return verifyCertificate(cert, intermediates, anchors, time.Now())
+}
+
+// fulcioOIDPrefix is the prefix of the OIDs of the X.509 extensions
+// which the Sigstore Fulcio CA adds to the certificates it issues
+const fulcioOIDPrefix = "1.3.6.1.4.1.57264.1."
+
+// isFulcioCertificate returns true if the certificate was issued by Fulcio
+// for cosign keyless signing
+func isFulcioCertificate(cert *x509.Certificate) bool {
+	for _, ext := range cert.Extensions {
+		if strings.HasPrefix(ext.Id.String(), fulcioOIDPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyCertificate verifies the certificate and its chain as of the given time
+func verifyCertificate(cert *x509.Certificate, intermediates *x509.CertPool,
+	anchors *TrustAnchors, at time.Time) (*x509.Certificate, string, error) {
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:         anchors.roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("certificate of %s is not trusted: %v",
+			cert.Subject, err)
+	}
+	return cert, certificateIdentity(cert), nil
+}
+
+// certificateIdentity returns the first SAN of the certificate, or its subject
+func certificateIdentity(cert *x509.Certificate) string {
+	switch {
+	case len(cert.EmailAddresses) != 0:
+		return cert.EmailAddresses[0]
+	case len(cert.URIs) != 0:
+		return cert.URIs[0].String()
+	case len(cert.DNSNames) != 0:
+		return cert.DNSNames[0]
+	}
+	return cert.Subject.String()
+}
+
+// verifyWithAnchors verifies the signature of the message with the key
+// of the certificate if present, otherwise with any of the trusted keys.
+// Returns the identity of the signer.
+func verifyWithAnchors(certPEM, chainPEM, message, sig []byte, anchors *TrustAnchors) (string, error) {
+	if len(certPEM) != 0 {
+		cert, identity, err := signerCertificate(certPEM, chainPEM, anchors)
+		if err != nil {
+			return "", err
+		}
+		if err := verifySignature(cert.PublicKey, message, sig); err != nil {
+			return "", err
+		}
+		return identity, nil
+	}
+	for _, k := range anchors.keys {
+		if verifySignature(k.key, message, sig) == nil {
+			return k.name, nil
+		}
+	}
+	return "", fmt.Errorf("signature does not match any trusted key")
+}
+
+// verifySignature verifies the signature the way cosign creates it:
+// ECDSA (ASN.1) and RSA (PKCS #1 v1.5) of the sha256 of the message,
+// Ed25519 of the message itself.
+func verifySignature(pub crypto.PublicKey, message, sig []byte) error {
+	digest := sha256.Sum256(message)
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], sig) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+			return fmt.Errorf("invalid RSA signature: %v", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, message, sig) {
+			return fmt.Errorf("invalid Ed25519 signature")
+		}
+	default:
+		return fmt.Errorf("unsupported key type %T", pub)
+	}
+	return nil
+}
+
+// simpleSigningPayload is the payload of the cosign signature
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+func (sig CosignSignature) verify(digest string, anchors *TrustAnchors) (string, error) {
+	var payload simpleSigningPayload
+	if err := json.Unmarshal(sig.Payload, &payload); err != nil {
+		return "", fmt.Errorf("unable to parse payload: %v", err)
+	}
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return "", fmt.Errorf("signature is for %s", payload.Critical.Image.DockerManifestDigest)
+	}
+	rawSig, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode signature: %v", err)
+	}
+	return verifyWithAnchors(sig.Certificate, sig.Chain, sig.Payload, rawSig, anchors)
+}
+
+// dsseEnvelope is the envelope of the cosign attestation
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     string `json:"payload"`
+	Signatures  []struct {
+		Sig string `json:"sig"`
+	} `json:"signatures"`
+}
+
+// inTotoStatement is the payload of the attestation
+type inTotoStatement struct {
+	Subject []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+	PredicateType string `json:"predicateType"`
+}
+
+// dssePAE returns the pre-authentication encoding of the DSSE payload
+func dssePAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s",
+		len(payloadType), payloadType, len(payload), payload))
+}
+
+func (att CosignAttestation) verify(digest string, anchors *TrustAnchors) error {
+	var env dsseEnvelope
+	if err := json.Unmarshal(att.Envelope, &env); err != nil {
+		return fmt.Errorf("unable to parse envelope: %v", err)
+	}
+	payload, err := base64.StdEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return fmt.Errorf("unable to decode payload: %v", err)
+	}
+	var statement inTotoStatement
+	if err := json.Unmarshal(payload, &statement); err != nil {
+		return fmt.Errorf("unable to parse statement: %v", err)
+	}
+	var found bool
+	for _, s := range statement.Subject {
+		if "sha256:"+s.Digest["sha256"] == digest {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("attestation is not for %s", digest)
+	}
+	message := dssePAE(env.PayloadType, payload)
+	for _, s := range env.Signatures {
+		rawSig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		if _, err := verifyWithAnchors(att.Certificate, att.Chain, message, rawSig, anchors); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("no valid attestation signature")
+}
+
+// jwsEnvelope is the JWS JSON serialization of the Notation signature
+type jwsEnvelope struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		X5C []string `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type jwsProtectedHeader struct {
+	Alg                  string     `json:"alg"`
+	Cty                  string     `json:"cty"`
+	Crit                 []string   `json:"crit"`
+	SigningScheme        string     `json:"io.cncf.notary.signingScheme"`
+	SigningTime          *time.Time `json:"io.cncf.notary.signingTime"`
+	AuthenticSigningTime *time.Time `json:"io.cncf.notary.authenticSigningTime"`
+	Expiry               *time.Time `json:"io.cncf.notary.expiry"`
+}
+
+// Notation signing schemes
+const (
+	// notationSchemeX509 : the signing time is claimed by the signer,
+	// the certificates have to be valid now
+	notationSchemeX509 = "notary.x509"
+	// notationSchemeSigningAuthority : the signing time is authentic,
+	// the certificates have to be valid at the time of signing
+	notationSchemeSigningAuthority = "notary.x509.signingAuthority"
+)
+
+// notationCriticalHeaders are the protected headers which may be listed
+// as critical, i.e. which are understood by the verifier
+var notationCriticalHeaders = map[string]bool{
+	"io.cncf.notary.signingScheme":        true,
+	"io.cncf.notary.expiry":               true,
+	"io.cncf.notary.authenticSigningTime": true,
+}
+
+// validationTime checks the critical headers and the expiry of the signature
+// and returns the time at which the certificates have to be valid
+func (header jwsProtectedHeader) validationTime(now time.Time) (time.Time, error) {
+	crit := make(map[string]bool)
+	for _, name := range header.Crit {
+		if !notationCriticalHeaders[name] {
+			return now, fmt.Errorf("unsupported critical header %s", name)
+		}
+		crit[name] = true
+	}
+	if !crit["io.cncf.notary.signingScheme"] {
+		return now, fmt.Errorf("signing scheme is not a critical header")
+	}
+	if header.Expiry != nil {
+		if !crit["io.cncf.notary.expiry"] {
+			return now, fmt.Errorf("expiry is not a critical header")
+		}
+		if now.After(*header.Expiry) {
+			return now, fmt.Errorf("signature expired at %s",
+				header.Expiry.Format(time.RFC3339))
+		}
+	} else if crit["io.cncf.notary.expiry"] {
+		return now, fmt.Errorf("missing critical header io.cncf.notary.expiry")
+	}
+	switch header.SigningScheme {
+	case notationSchemeX509:
+		if header.SigningTime == nil {
+			return now, fmt.Errorf("missing signing time")
+		}
+		if crit["io.cncf.notary.authenticSigningTime"] {
+			return now, fmt.Errorf("authentic signing time with scheme %s",
+				header.SigningScheme)
+		}
+		// Timestamp countersignatures are not supported
+		return now, nil
+	case notationSchemeSigningAuthority:
+		if header.AuthenticSigningTime == nil ||
+			!crit["io.cncf.notary.authenticSigningTime"] {
+			return now, fmt.Errorf("missing critical authentic signing time")
+		}
+		return *header.AuthenticSigningTime, nil
+	default:
+		return now, fmt.Errorf("unsupported signing scheme %q", header.SigningScheme)
+	}
+}
+
+type notationPayload struct {
+	TargetArtifact struct {
+		Digest string `json:"digest"`
+	} `json:"targetArtifact"`
+}
+
+func (sig NotationSignature) verify(digest string, anchors *TrustAnchors) (string, error) {
+	if sig.MediaType != notationJWSMediaType {
+		return "", fmt.Errorf("unsupported envelope %s", sig.MediaType)
+	}
+	var env jwsEnvelope
+	if err := json.Unmarshal(sig.Envelope, &env); err != nil {
+		return "", fmt.Errorf("unable to parse envelope: %v", err)
+	}
+	protected, err := base64.RawURLEncoding.DecodeString(env.Protected)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode protected header: %v", err)
+	}
+	var header jwsProtectedHeader
+	if err := json.Unmarshal(protected, &header); err != nil {
+		return "", fmt.Errorf("unable to parse protected header: %v", err)
+	}
+	if header.Cty != "application/vnd.cncf.notary.payload.v1+json" {
+		return "", fmt.Errorf("unsupported payload %s", header.Cty)
+	}
+	validationTime, err := header.validationTime(time.Now())
+	if err != nil {
+		return "", err
+	}
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(env.Payload)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode payload: %v", err)
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return "", fmt.Errorf("unable to parse payload: %v", err)
+	}
+	if payload.TargetArtifact.Digest != digest {
+		return "", fmt.Errorf("signature is for %s", payload.TargetArtifact.Digest)
+	}
+	if len(env.Header.X5C) == 0 {
+		return "", fmt.Errorf("missing certificate chain")
+	}
+	var certs []*x509.Certificate
+	for _, c := range env.Header.X5C {
+		der, err := base64.StdEncoding.DecodeString(c)
+		if err != nil {
+			return "", fmt.Errorf("unable to decode certificate: %v", err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return "", fmt.Errorf("unable to parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	cert, identity, err := verifyCertificate(certs[0], intermediates, anchors,
+		validationTime)
+	if err != nil {
+		return "", err
+	}
+	rawSig, err := base64.RawURLEncoding.DecodeString(env.Signature)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode signature: %v", err)
+	}
+	signingInput := []byte(env.Protected + "." + env.Payload)
+	if err := verifyJWS(cert.PublicKey, header.Alg, signingInput, rawSig); err != nil {
+		return "", err
+	}
+	return identity, nil
+}
+
+// verifyJWS verifies the JWS signature with the algorithms allowed by Notation
+func verifyJWS(pub crypto.PublicKey, alg string, message, sig []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported algorithm %s", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported algorithm %s", alg)
+	}
+	var digest []byte
+	switch hash {
+	case crypto.SHA256:
+		sum := sha256.Sum256(message)
+		digest = sum[:]
+	case crypto.SHA384:
+		sum := sha512.Sum384(message)
+		digest = sum[:]
+	case crypto.SHA512:
+		sum := sha512.Sum512(message)
+		digest = sum[:]
+	}
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "PS") {
+			return fmt.Errorf("algorithm %s does not match RSA key", alg)
+		}
+		err := rsa.VerifyPSS(key, hash, digest, sig,
+			&rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		if err != nil {
+			return fmt.Errorf("invalid RSA signature: %v", err)
+		}
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			return fmt.Errorf("algorithm %s does not match ECDSA key", alg)
+		}
+		if len(sig)%2 != 0 {
+			return fmt.Errorf("invalid ECDSA signature length %d", len(sig))
+		}
+		r := new(big.Int).SetBytes(sig[:len(sig)/2])
+		s := new(big.Int).SetBytes(sig[len(sig)/2:])
+		if !ecdsa.Verify(key, digest, r, s) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+	default:
+		return fmt.Errorf("unsupported key type %T", pub)
+	}
+	return nil
+}
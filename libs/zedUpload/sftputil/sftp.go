@@ -4,14 +4,17 @@
 package sftp
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/lf-edge/eve/libs/zedUpload/types"
 	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 )
@@ -27,6 +30,8 @@ type UpdateStats struct {
 	List          []string //list of images at given path
 	Error         error
 	ContentLength int64
+
+	DoneParts types.DownloadedParts //downloaded parts
 }
 
 type NotifChan chan UpdateStats
@@ -67,6 +72,85 @@ func getSftpClient(host, user, pass string) (*sftp.Client, error) {
 	return session, nil
 }
 
+// Fetch downloads remoteFile into localFile. It skips parts from doneParts
+// which are downloaded already and downloads up to concurrency ranges
+// in parallel. The downloaded parts are returned in UpdateStats.
+func Fetch(host, user, pass, remoteFile, localFile string, objSize int64,
+	doneParts types.DownloadedParts, concurrency int, prgNotify NotifChan) UpdateStats {
+
+	client, err := getSftpClient(host, user, pass)
+	if err != nil {
+		return UpdateStats{
+			Error:     fmt.Errorf("sftpclient failed for %s: %s", host, err),
+			DoneParts: doneParts,
+		}
+	}
+	defer client.Close()
+	return fetch(client, remoteFile, localFile, objSize, doneParts, concurrency, prgNotify)
+}
+
+func fetch(client *sftp.Client, remoteFile, localFile string, objSize int64,
+	doneParts types.DownloadedParts, concurrency int, prgNotify NotifChan) UpdateStats {
+
+	stats := UpdateStats{DoneParts: doneParts}
+	fi, err := client.Stat(remoteFile)
+	if err != nil {
+		stats.Error = fmt.Errorf("stat failed for %s: %s",
+			remoteFile, err)
+		return stats
+	}
+	if objSize != 0 && fi.Size() > objSize {
+		stats.Error = fmt.Errorf("configured image size (%d) is less than size of file (%d)",
+			objSize, fi.Size())
+		return stats
+	}
+	if err := os.MkdirAll(filepath.Dir(localFile), 0755); err != nil {
+		stats.Error = err
+		return stats
+	}
+	fl, err := os.OpenFile(localFile, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		stats.Error = err
+		return stats
+	}
+	defer fl.Close()
+
+	stats.Size = fi.Size()
+	download := &types.RangeDownload{
+		File:        fl,
+		Size:        fi.Size(),
+		Validator:   fmt.Sprintf("%d-%d", fi.Size(), fi.ModTime().Unix()),
+		Concurrency: concurrency,
+		Open: func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+			fr, err := client.Open(remoteFile)
+			if err != nil {
+				return nil, fmt.Errorf("open failed for %s: %s",
+					remoteFile, err)
+			}
+			if _, err := fr.Seek(offset, io.SeekStart); err != nil {
+				fr.Close()
+				return nil, err
+			}
+			return struct {
+				io.Reader
+				io.Closer
+			}{io.LimitReader(fr, count), fr}, nil
+		},
+		Progress: func(asize int64, doneParts types.DownloadedParts) {
+			stats.Asize = asize
+			stats.DoneParts = doneParts
+			if prgNotify != nil {
+				select {
+				case prgNotify <- stats:
+				default: //ignore we cannot write
+				}
+			}
+		},
+	}
+	stats.DoneParts, stats.Error = download.Run(context.Background(), doneParts)
+	return stats
+}
+
 func ExecCmd(cmd, host, user, pass, remoteFile, localFile string,
 	objSize int64, prgNotify NotifChan) UpdateStats {
 
@@ -99,49 +183,8 @@ func ExecCmd(cmd, host, user, pass, remoteFile, localFile string,
 		}
 		return stats
 	case "fetch":
-		fr, err := client.Open(remoteFile)
-		if err != nil {
-			stats.Error = fmt.Errorf("open failed for %s: %s",
-				remoteFile, err)
-			return stats
-		}
-		tempLocalFile := localFile
-		index := strings.LastIndex(tempLocalFile, "/")
-		dir_err := os.MkdirAll(tempLocalFile[:index+1], 0755)
-		if dir_err != nil {
-			stats.Error = dir_err
-			return stats
-		}
-
-		fl, err := os.Create(localFile)
-		if err != nil {
-			stats.Error = err
-			return stats
-		}
-		defer fl.Close()
-
-		chunkSize := SingleMB
-		var written, copiedSize int64
-		stats.Size = objSize
-		for {
-			if written, err = io.CopyN(fl, fr, chunkSize); err != nil && err != io.EOF {
-				stats.Error = err
-				return stats
-			}
-			copiedSize += written
-			if written != chunkSize {
-				// Must have reached EOF
-				break
-			}
-			stats.Asize = copiedSize
-			if prgNotify != nil {
-				select {
-				case prgNotify <- stats:
-				default: //ignore we cannot write
-				}
-			}
-		}
-		return stats
+		return fetch(client, remoteFile, localFile, objSize,
+			types.DownloadedParts{}, 1, prgNotify)
 	case "put":
 		tempRemoteFile := remoteFile
 		index := strings.LastIndex(tempRemoteFile, "/")
@@ -17,8 +17,9 @@ type PartDefinition struct {
 
 // DownloadedParts keeps information about downloaded parts of blob
 type DownloadedParts struct {
-	PartSize int64             // the maximum partition size
-	Parts    []*PartDefinition // definition of downloaded parts
+	PartSize  int64             // the maximum partition size
+	Parts     []*PartDefinition // definition of downloaded parts
+	Validator string            `json:",omitempty"` // version of the blob the parts belong to
 }
 
 // Hash returns hash of DownloadedParts struct
@@ -0,0 +1,229 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRangePartSize is the size of part used by RangeDownload
+	// if not defined in DownloadedParts
+	DefaultRangePartSize int64 = 4 * 1024 * 1024
+	// DefaultRangeConcurrency is the number of parts downloaded in parallel
+	// by RangeDownload if not defined
+	DefaultRangeConcurrency = 1
+	// rangeProgressInterval is the minimum interval between progress
+	// notifications, except for the ones sent when a part is completed
+	rangeProgressInterval = time.Second
+)
+
+// RangeOpener returns reader of count bytes of the remote object
+// starting at offset
+type RangeOpener func(ctx context.Context, offset, count int64) (io.ReadCloser, error)
+
+// RangeProgress is called with the downloaded size and a copy
+// of the downloaded parts when a part of the object is completed,
+// at most once per second while the parts are being written
+// and once more when the download finishes or fails
+type RangeProgress func(asize int64, doneParts DownloadedParts)
+
+// RangeDownload downloads an object of known size into the local file
+// split into parts of DownloadedParts.PartSize. Parts, which are downloaded
+// already, are skipped, so the download can be resumed using the
+// DownloadedParts returned by the interrupted one, even after restart
+// if the caller persists them.
+type RangeDownload struct {
+	File        *os.File      // local file to write into
+	Size        int64         // size of the remote object
+	Validator   string        // identifies the version of the remote object
+	Concurrency int           // number of parts to download in parallel
+	Open        RangeOpener   // opens reader of the range of the remote object
+	Progress    RangeProgress // optional
+
+	lock         sync.Mutex
+	doneParts    DownloadedParts
+	asize        int64
+	lastProgress time.Time
+}
+
+// rangeSection keeps state of one part being downloaded
+type rangeSection struct {
+	d      *RangeDownload
+	part   *PartDefinition
+	offset int64 // offset in the file to write to
+	count  int64 // remaining bytes of the part
+}
+
+// Write writes into the file at the offset of the section and records
+// the progress in the part
+func (s *rangeSection) Write(p []byte) (int, error) {
+	if int64(len(p)) > s.count {
+		return 0, fmt.Errorf("received more than %d bytes for part %d",
+			s.count, s.part.Ind)
+	}
+	n, err := s.d.File.WriteAt(p, s.offset)
+	s.offset += int64(n)
+	s.count -= int64(n)
+	s.d.lock.Lock()
+	s.part.Size += int64(n)
+	s.d.asize += int64(n)
+	if s.d.Progress != nil && (s.count == 0 ||
+		time.Since(s.d.lastProgress) >= rangeProgressInterval) {
+		s.d.lastProgress = time.Now()
+		s.d.Progress(s.d.asize, s.d.doneParts.copy())
+	}
+	s.d.lock.Unlock()
+	return n, err
+}
+
+// copy returns deep copy of DownloadedParts
+func (dp *DownloadedParts) copy() DownloadedParts {
+	c := DownloadedParts{PartSize: dp.PartSize, Validator: dp.Validator}
+	for _, p := range dp.Parts {
+		c.Parts = append(c.Parts, &PartDefinition{Ind: p.Ind, Size: p.Size})
+	}
+	return c
+}
+
+// Prepare checks the downloaded parts against the remote object and returns
+// the ones to continue with. The download starts from the beginning
+// if the object changed or the parts do not fit its size.
+func (d *RangeDownload) Prepare(doneParts DownloadedParts) DownloadedParts {
+	valid := doneParts.PartSize > 0 && doneParts.Validator == d.Validator
+	if valid {
+		partsCount := (d.Size + doneParts.PartSize - 1) / doneParts.PartSize
+		for _, p := range doneParts.Parts {
+			if p.Ind < 0 || p.Ind >= partsCount || p.Size < 0 ||
+				p.Size > d.partSize(p.Ind, doneParts.PartSize) {
+				valid = false
+				break
+			}
+		}
+	}
+	if !valid {
+		return DownloadedParts{PartSize: DefaultRangePartSize, Validator: d.Validator}
+	}
+	return doneParts.copy()
+}
+
+func (d *RangeDownload) partSize(ind, partSize int64) int64 {
+	if (ind+1)*partSize > d.Size {
+		return d.Size - ind*partSize
+	}
+	return partSize
+}
+
+// Run downloads the parts of the object which are not in doneParts.
+// It returns the parts downloaded so far, also in case of error.
+// The local file is truncated if doneParts are not valid for the object
+// (see Prepare).
+func (d *RangeDownload) Run(ctx context.Context, doneParts DownloadedParts) (DownloadedParts, error) {
+	d.doneParts = d.Prepare(doneParts)
+	if len(d.doneParts.Parts) == 0 {
+		if err := d.File.Truncate(0); err != nil {
+			return d.doneParts, err
+		}
+	}
+	if err := d.File.Truncate(d.Size); err != nil {
+		return d.doneParts, err
+	}
+	d.asize = 0
+	var sections []*rangeSection
+	partSize := d.doneParts.PartSize
+	for ind := int64(0); ind*partSize < d.Size; ind++ {
+		var part *PartDefinition
+		for _, p := range d.doneParts.Parts {
+			if p.Ind == ind {
+				part = p
+				break
+			}
+		}
+		if part == nil {
+			part = &PartDefinition{Ind: ind}
+			d.doneParts.Parts = append(d.doneParts.Parts, part)
+		}
+		d.asize += part.Size
+		size := d.partSize(ind, partSize)
+		if part.Size < size {
+			sections = append(sections, &rangeSection{
+				d:      d,
+				part:   part,
+				offset: ind*partSize + part.Size,
+				count:  size - part.Size,
+			})
+		}
+	}
+
+	concurrency := d.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultRangeConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	ch := make(chan *rangeSection)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range ch {
+				if err := d.download(ctx, s); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for _, s := range sections {
+		select {
+		case ch <- s:
+			continue
+		case <-ctx.Done():
+		}
+		break
+	}
+	close(ch)
+	wg.Wait()
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	if d.Progress != nil {
+		// report the parts written since the last notification
+		d.Progress(d.asize, d.doneParts.copy())
+	}
+	return d.doneParts.copy(), firstErr
+}
+
+func (d *RangeDownload) download(ctx context.Context, s *rangeSection) error {
+	r, err := d.Open(ctx, s.offset, s.count)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	expected := s.count
+	written, err := io.Copy(s, r)
+	if err != nil {
+		return err
+	}
+	if written != expected {
+		return fmt.Errorf("received %d bytes instead of %d for part %d",
+			written, expected, s.part.Ind)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+// failingReader fails after limit bytes
+type failingReader struct {
+	r     io.Reader
+	limit int64
+}
+
+func (f *failingReader) Read(p []byte) (int, error) {
+	if f.limit <= 0 {
+		return 0, errors.New("connection reset")
+	}
+	if int64(len(p)) > f.limit {
+		p = p[:f.limit]
+	}
+	n, err := f.r.Read(p)
+	f.limit -= int64(n)
+	return n, err
+}
+
+func TestRangeDownloadResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rangedownload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	content := make([]byte, 3*DefaultRangePartSize+12345)
+	rand.Read(content)
+	var served, failAfter int64
+	open := func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+		r := io.Reader(bytes.NewReader(content[offset : offset+count]))
+		if limit := atomic.LoadInt64(&failAfter); limit > 0 {
+			r = &failingReader{r: r, limit: limit}
+		}
+		atomic.AddInt64(&served, count)
+		return ioutil.NopCloser(r), nil
+	}
+
+	fd, err := os.Create(filepath.Join(dir, "blob"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	var lastProgress int64
+	d := &RangeDownload{
+		File:        fd,
+		Size:        int64(len(content)),
+		Validator:   "v1",
+		Concurrency: 2,
+		Open:        open,
+		Progress: func(asize int64, doneParts DownloadedParts) {
+			lastProgress = asize
+		},
+	}
+	// interrupt every part in the middle
+	failAfter = DefaultRangePartSize / 2
+	doneParts, err := d.Run(context.Background(), DownloadedParts{})
+	if err == nil {
+		t.Fatal("expected the interrupted download to fail")
+	}
+	if len(doneParts.Parts) == 0 || doneParts.Validator != "v1" {
+		t.Fatalf("unexpected parts after interruption: %+v", doneParts)
+	}
+	var downloaded int64
+	for _, p := range doneParts.Parts {
+		downloaded += p.Size
+	}
+	if downloaded == 0 || lastProgress != downloaded {
+		t.Fatalf("downloaded %d, progress %d", downloaded, lastProgress)
+	}
+
+	// resume with the parts returned
+	failAfter = 0
+	served = 0
+	doneParts, err = d.Run(context.Background(), doneParts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if served != int64(len(content))-downloaded {
+		t.Errorf("served %d bytes on resume, expected %d",
+			served, int64(len(content))-downloaded)
+	}
+	result, err := ioutil.ReadFile(fd.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result, content) {
+		t.Error("downloaded content differs")
+	}
+
+	// changed object is downloaded from the beginning
+	served = 0
+	d.Validator = "v2"
+	if _, err = d.Run(context.Background(), doneParts); err != nil {
+		t.Fatal(err)
+	}
+	if served != int64(len(content)) {
+		t.Errorf("served %d bytes for changed object, expected %d",
+			served, len(content))
+	}
+}
+
+// chunkReader returns at most size bytes from every Read
+type chunkReader struct {
+	r    io.Reader
+	size int
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if len(p) > c.size {
+		p = p[:c.size]
+	}
+	return c.r.Read(p)
+}
+
+func TestRangeDownloadProgressThrottled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rangedownload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fd, err := os.Create(filepath.Join(dir, "blob"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	content := make([]byte, 2*DefaultRangePartSize+100)
+	rand.Read(content)
+	var notifications int
+	var lastProgress int64
+	d := &RangeDownload{
+		File:      fd,
+		Size:      int64(len(content)),
+		Validator: "v1",
+		Open: func(ctx context.Context, offset, count int64) (io.ReadCloser, error) {
+			r := bytes.NewReader(content[offset : offset+count])
+			return ioutil.NopCloser(&chunkReader{r: r, size: 4096}), nil
+		},
+		Progress: func(asize int64, doneParts DownloadedParts) {
+			notifications++
+			lastProgress = asize
+		},
+	}
+	if _, err := d.Run(context.Background(), DownloadedParts{}); err != nil {
+		t.Fatal(err)
+	}
+	if lastProgress != int64(len(content)) {
+		t.Errorf("progress %d, expected %d", lastProgress, len(content))
+	}
+	// one for every completed part and the final one, plus one per second
+	// at most, instead of one for each of the 2049 writes
+	if notifications > 20 {
+		t.Errorf("too many progress notifications: %d", notifications)
+	}
+}
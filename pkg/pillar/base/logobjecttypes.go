@@ -136,6 +136,8 @@ const (
 	NetworkMetricsLogType LogObjectType = "network_metrics"
 	// WwanMetricsLogType:
 	WwanMetricsLogType LogObjectType = "wwan_metrics"
+	// WlanMetricsLogType:
+	WlanMetricsLogType LogObjectType = "wlan_metrics"
 	// NetworkXObjectConfigLogType:
 	NetworkXObjectConfigLogType LogObjectType = "network_x_object"
 	// UUIDToNumLogType:
@@ -160,6 +162,10 @@ const (
 	EncryptedVaultKeyFromDeviceLogType LogObjectType = "encrypted_vault_key_from_device"
 	// EncryptedVaultKeyFromControllerLogType:
 	EncryptedVaultKeyFromControllerLogType LogObjectType = "encrypted_vault_key_from_controller"
+	// PacketCaptureConfigLogType:
+	PacketCaptureConfigLogType LogObjectType = "packet_capture_config"
+	// PacketCaptureStatusLogType:
+	PacketCaptureStatusLogType LogObjectType = "packet_capture_status"
 )
 
 // RelationObjectType :
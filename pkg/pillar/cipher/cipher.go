@@ -25,6 +25,7 @@ func getEncryptionBlock(
 	decBlock.WifiUserName = zconfigDecBlockPtr.WifiUserName
 	decBlock.WifiPassword = zconfigDecBlockPtr.WifiPassword
 	decBlock.ProtectedUserData = zconfigDecBlockPtr.ProtectedUserData
+	decBlock.WireGuardPrivateKey = zconfigDecBlockPtr.WireguardPrivateKey
 	return decBlock
 }
 
@@ -0,0 +1,300 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Liveness and readiness probes of application instances and automatic
+// restarts according to their restart policy.
+
+package domainmgr
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// How often runHandler checks if a probe or a restart is due
+	healthCheckInterval = time.Second
+	// Application running this long without failures is no longer
+	// considered to be in a crash loop and the back-off delay is reset
+	crashLoopResetTime = 10 * time.Minute
+)
+
+// appHealthChecker keeps the schedule of probes for one domain.
+// It is owned by the runHandler goroutine of the domain.
+type appHealthChecker struct {
+	bootTime      time.Time
+	nextLiveness  time.Time
+	nextReadiness time.Time
+}
+
+// checkAppHealth runs probes which are due, restarts the application
+// if its liveness probe failed and the restart policy allows it
+// and performs restarts scheduled with a back-off delay.
+func checkAppHealth(ctx *domainContext, status *types.DomainStatus,
+	checker *appHealthChecker) {
+
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil || !config.Activate {
+		return
+	}
+	healthConfig := effectiveHealthConfig(*config, status)
+	if !healthConfig.IsConfigured() {
+		// Without probes the application is ready once it is running,
+		// dependent application instances may wait for that.
+		ready := !status.Pending() && status.Activated &&
+			status.State == types.RUNNING
+		if status.Health.Ready != ready {
+			status.Health.Ready = ready
+			publishDomainStatus(ctx, status)
+		}
+		return
+	}
+	if !status.Health.NextRestartTime.IsZero() {
+		if status.BootFailed && !time.Now().Before(status.Health.NextRestartTime) {
+			maybeRetryBoot(ctx, status)
+		}
+		return
+	}
+	if status.Pending() || !status.Activated || status.State != types.RUNNING {
+		if status.Health.Ready {
+			status.Health.Ready = false
+			publishDomainStatus(ctx, status)
+		}
+		return
+	}
+	health := &status.Health
+	changed := false
+	now := time.Now()
+	if !checker.bootTime.Equal(status.BootTime) {
+		checker.bootTime = status.BootTime
+		checker.nextLiveness = status.BootTime.Add(healthConfig.Liveness.InitialDelay)
+		checker.nextReadiness = status.BootTime.Add(healthConfig.Readiness.InitialDelay)
+		health.Liveness = types.AppProbeStatus{}
+		health.Readiness = types.AppProbeStatus{}
+		changed = true
+	}
+	liveness := healthConfig.Liveness
+	if liveness.IsConfigured() && !now.Before(checker.nextLiveness) {
+		prevResult := health.Liveness.Result
+		err := runAppProbe(status, liveness)
+		updateProbeStatus(liveness, &health.Liveness, err, now)
+		checker.nextLiveness = now.Add(liveness.Period)
+		changed = true
+		if err != nil {
+			log.Warnf("checkAppHealth(%s) liveness probe failed: %v",
+				status.Key(), err)
+		}
+		if health.Liveness.Result == types.AppProbeResultFailure &&
+			prevResult != types.AppProbeResultFailure {
+			reason := fmt.Sprintf("liveness probe failed: %s",
+				health.Liveness.LastError)
+			// Without an explicit policy liveness failure still restarts.
+			policy := healthConfig.RestartPolicy
+			if policy == types.AppRestartPolicyUnspecified ||
+				shouldRestart(policy, true) {
+				killForRestart(ctx, *config, status, reason)
+				return
+			}
+			log.Warnf("checkAppHealth(%s) %s; restart policy %s",
+				status.Key(), reason, policy)
+		}
+	}
+	readiness := healthConfig.Readiness
+	if readiness.IsConfigured() && !now.Before(checker.nextReadiness) {
+		err := runAppProbe(status, readiness)
+		updateProbeStatus(readiness, &health.Readiness, err, now)
+		checker.nextReadiness = now.Add(readiness.Period)
+		changed = true
+	}
+	ready := true
+	if readiness.IsConfigured() {
+		ready = health.Readiness.Result == types.AppProbeResultSuccess
+	}
+	if health.Ready != ready {
+		log.Noticef("checkAppHealth(%s) ready changed to %t",
+			status.Key(), ready)
+		health.Ready = ready
+		changed = true
+	}
+	if health.CrashLoopCount != 0 &&
+		health.Liveness.Result != types.AppProbeResultFailure &&
+		now.Sub(status.BootTime) >= crashLoopResetTime {
+		health.CrashLoopCount = 0
+		changed = true
+	}
+	if changed {
+		publishDomainStatus(ctx, status)
+	}
+}
+
+// effectiveHealthConfig returns the health config of the domain where
+// the HEALTHCHECK of the container image is used as the readiness probe
+// unless the controller configured one.
+func effectiveHealthConfig(config types.DomainConfig,
+	status *types.DomainStatus) types.AppHealthConfig {
+
+	health := config.Health
+	healthcheck := status.ImageLifecycle.Healthcheck
+	if health.Readiness.IsConfigured() || len(healthcheck.Command) == 0 ||
+		!isNativeContainer(status) {
+		return health
+	}
+	health.Readiness = types.AppProbe{
+		Type:    types.AppProbeExec,
+		Command: healthcheck.Command,
+		// Failures during the start period are not counted by docker,
+		// we simply do not run the healthcheck until it expires.
+		InitialDelay:     healthcheck.StartPeriod,
+		Period:           healthcheck.Interval,
+		Timeout:          healthcheck.Timeout,
+		FailureThreshold: uint32(healthcheck.Retries),
+		SuccessThreshold: 1,
+	}
+	return health
+}
+
+// updateProbeStatus records the outcome of one probe run and applies
+// the success and failure thresholds.
+func updateProbeStatus(probe types.AppProbe, status *types.AppProbeStatus,
+	err error, now time.Time) {
+
+	status.LastProbeTime = now
+	if err == nil {
+		status.ConsecutiveSuccesses++
+		status.ConsecutiveFailures = 0
+		if status.ConsecutiveSuccesses >= probe.SuccessThreshold {
+			status.Result = types.AppProbeResultSuccess
+		}
+		return
+	}
+	status.ConsecutiveFailures++
+	status.ConsecutiveSuccesses = 0
+	status.LastError = err.Error()
+	if status.ConsecutiveFailures >= probe.FailureThreshold {
+		status.Result = types.AppProbeResultFailure
+	}
+}
+
+// shouldRestart returns true if the restart policy allows to restart
+// an application which stopped running or failed its liveness probe.
+func shouldRestart(policy types.AppRestartPolicy, failed bool) bool {
+	switch policy {
+	case types.AppRestartPolicyAlways:
+		return true
+	case types.AppRestartPolicyOnFailure:
+		return failed
+	default:
+		return false
+	}
+}
+
+// restartBackoff returns the delay before the next automatic restart.
+// The initial delay is doubled for every restart in the crash loop.
+func restartBackoff(config types.AppHealthConfig, crashLoopCount uint32) time.Duration {
+	delay := config.BackoffInitial
+	for i := uint32(0); i < crashLoopCount && delay < config.BackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > config.BackoffMax {
+		delay = config.BackoffMax
+	}
+	return delay
+}
+
+// scheduleRestart marks the halted domain to be booted again by maybeRetryBoot
+// once the back-off delay expires.
+func scheduleRestart(config types.DomainConfig, status *types.DomainStatus,
+	reason string) {
+
+	delay := restartBackoff(config.Health, status.Health.CrashLoopCount)
+	log.Noticef("scheduleRestart(%s) in %s: %s", status.Key(), delay, reason)
+	status.Health.LastRestartReason = reason
+	status.Health.NextRestartTime = time.Now().Add(delay)
+	status.Health.Ready = false
+	status.BootFailed = true
+	status.SetErrorDescription(types.ErrorDescription{
+		Error:               reason,
+		ErrorSeverity:       types.ErrorSeverityWarning,
+		ErrorRetryCondition: fmt.Sprintf("will restart in %s", delay),
+	})
+}
+
+// killForRestart stops the domain which failed its liveness probe
+// and schedules a restart.
+func killForRestart(ctx *domainContext, config types.DomainConfig,
+	status *types.DomainStatus, reason string) {
+
+	log.Warnf("killForRestart(%s): %s", status.Key(), reason)
+	if err := hyper.Task(status).Stop(status.DomainName, true); err != nil {
+		log.Errorf("failed to stop domain: %s (%v)", status.DomainName, err)
+	}
+	if err := hyper.Task(status).Delete(status.DomainName); err != nil {
+		log.Errorf("failed to delete domain: %s (%v)", status.DomainName, err)
+	}
+	if err := hyper.Task(status).Cleanup(status.DomainName); err != nil {
+		log.Errorf("failed to cleanup domain: %s (%v)", status.DomainName, err)
+	}
+	status.Activated = false
+	status.State = types.HALTED
+	status.DomainId = 0
+	scheduleRestart(config, status, reason)
+	publishDomainStatus(ctx, status)
+}
+
+// runAppProbe runs the probe once and returns nil on success.
+func runAppProbe(status *types.DomainStatus, probe types.AppProbe) error {
+	switch probe.Type {
+	case types.AppProbeHTTPGet:
+		if probe.TargetIP == nil {
+			return errors.New("application IP address is not known")
+		}
+		return probeHTTPGet(probe.TargetIP, probe.Port, probe.Path, probe.Timeout)
+	case types.AppProbeTCPSocket:
+		if probe.TargetIP == nil {
+			return errors.New("application IP address is not known")
+		}
+		return probeTCPSocket(probe.TargetIP, probe.Port, probe.Timeout)
+	case types.AppProbeExec:
+		executor, ok := hyper.Task(status).(types.TaskExecutor)
+		if !ok {
+			return fmt.Errorf("exec is not supported by hypervisor %s",
+				hyper.Name())
+		}
+		return executor.Exec(status.DomainName, probe.Command, probe.Timeout)
+	default:
+		return fmt.Errorf("unsupported probe type %s", probe.Type)
+	}
+}
+
+func probeHTTPGet(ip net.IP, port uint16, path string, timeout time.Duration) error {
+	client := &http.Client{
+		Transport: &http.Transport{DisableKeepAlives: true},
+		Timeout:   timeout,
+	}
+	url := fmt.Sprintf("http://%s%s",
+		net.JoinHostPort(ip.String(), strconv.Itoa(int(port))), path)
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("GET %s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+func probeTCPSocket(ip net.IP, port uint16, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp",
+		net.JoinHostPort(ip.String(), strconv.Itoa(int(port))), timeout)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
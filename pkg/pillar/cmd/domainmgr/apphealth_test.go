@@ -0,0 +1,106 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateProbeStatus(t *testing.T) {
+	probe := types.AppProbe{
+		Type:             types.AppProbeTCPSocket,
+		FailureThreshold: 3,
+		SuccessThreshold: 2,
+	}
+	var status types.AppProbeStatus
+	now := time.Now()
+	probeErr := errors.New("connection refused")
+
+	updateProbeStatus(probe, &status, nil, now)
+	assert.Equal(t, types.AppProbeResultUnknown, status.Result)
+	assert.Equal(t, now, status.LastProbeTime)
+	updateProbeStatus(probe, &status, nil, now)
+	assert.Equal(t, types.AppProbeResultSuccess, status.Result)
+
+	for i := 1; i < 3; i++ {
+		updateProbeStatus(probe, &status, probeErr, now)
+		assert.Equal(t, types.AppProbeResultSuccess, status.Result)
+		assert.Equal(t, uint32(i), status.ConsecutiveFailures)
+	}
+	updateProbeStatus(probe, &status, probeErr, now)
+	assert.Equal(t, types.AppProbeResultFailure, status.Result)
+	assert.Equal(t, uint32(0), status.ConsecutiveSuccesses)
+	assert.Equal(t, probeErr.Error(), status.LastError)
+
+	// Single success is not enough to recover
+	updateProbeStatus(probe, &status, nil, now)
+	assert.Equal(t, types.AppProbeResultFailure, status.Result)
+	assert.Equal(t, uint32(0), status.ConsecutiveFailures)
+	updateProbeStatus(probe, &status, nil, now)
+	assert.Equal(t, types.AppProbeResultSuccess, status.Result)
+}
+
+func TestShouldRestart(t *testing.T) {
+	assert.True(t, shouldRestart(types.AppRestartPolicyAlways, false))
+	assert.True(t, shouldRestart(types.AppRestartPolicyAlways, true))
+	assert.False(t, shouldRestart(types.AppRestartPolicyOnFailure, false))
+	assert.True(t, shouldRestart(types.AppRestartPolicyOnFailure, true))
+	assert.False(t, shouldRestart(types.AppRestartPolicyNever, true))
+	assert.False(t, shouldRestart(types.AppRestartPolicyUnspecified, true))
+}
+
+func TestRestartBackoff(t *testing.T) {
+	config := types.AppHealthConfig{
+		BackoffInitial: 10 * time.Second,
+		BackoffMax:     300 * time.Second,
+	}
+	expected := []time.Duration{10, 20, 40, 80, 160, 300, 300}
+	for count, delay := range expected {
+		assert.Equal(t, delay*time.Second, restartBackoff(config, uint32(count)),
+			"crash loop count %d", count)
+	}
+	// Does not overflow with a long crash loop
+	assert.Equal(t, config.BackoffMax, restartBackoff(config, 1000))
+}
+
+func TestProbeHTTPGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/healthz":
+				w.WriteHeader(http.StatusOK)
+			case "/moved":
+				http.Redirect(w, r, "/healthz", http.StatusFound)
+			default:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+	defer server.Close()
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.NoError(t, err)
+	ip := net.ParseIP(host)
+	port, _ := strconv.Atoi(portStr)
+
+	assert.NoError(t, probeHTTPGet(ip, uint16(port), "/healthz", time.Second))
+	assert.NoError(t, probeHTTPGet(ip, uint16(port), "/moved", time.Second))
+	assert.Error(t, probeHTTPGet(ip, uint16(port), "/", time.Second))
+}
+
+func TestProbeTCPSocket(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := listener.Addr().(*net.TCPAddr)
+	assert.NoError(t, probeTCPSocket(addr.IP, uint16(addr.Port), time.Second))
+	listener.Close()
+	assert.Error(t, probeTCPSocket(addr.IP, uint16(addr.Port), time.Second))
+}
@@ -88,6 +88,8 @@ type domainContext struct {
 	pubHostMemory          pubsub.Publication
 	pubProcessMetric       pubsub.Publication
 	pubCipherBlockStatus   pubsub.Publication
+	subGuestFsFreezeConfig pubsub.Subscription
+	pubGuestFsFreezeStatus pubsub.Publication
 	cipherMetrics          *cipher.AgentMetrics
 	usbAccess              bool
 	vgaAccess              bool
@@ -221,6 +223,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	domainCtx.pubDomainStatus = pubDomainStatus
 	pubDomainStatus.ClearRestarted()
 
+	pubGuestFsFreezeStatus, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.GuestFsFreezeStatus{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.pubGuestFsFreezeStatus = pubGuestFsFreezeStatus
+
 	pubAssignableAdapters, err := ps.NewPublication(
 		pubsub.PublicationOptions{
 			AgentName: agentName,
@@ -583,6 +595,26 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	domainCtx.subDomainConfig = subDomainConfig
 	subDomainConfig.Activate()
 
+	// Subscribe to GuestFsFreezeConfig from volumemgr
+	subGuestFsFreezeConfig, err := ps.NewSubscription(
+		pubsub.SubscriptionOptions{
+			AgentName:     "volumemgr",
+			MyAgentName:   agentName,
+			TopicImpl:     types.GuestFsFreezeConfig{},
+			Activate:      false,
+			Ctx:           &domainCtx,
+			CreateHandler: handleGuestFsFreezeCreate,
+			ModifyHandler: handleGuestFsFreezeModify,
+			DeleteHandler: handleGuestFsFreezeDelete,
+			WarningTime:   warningTime,
+			ErrorTime:     errorTime,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.subGuestFsFreezeConfig = subGuestFsFreezeConfig
+	subGuestFsFreezeConfig.Activate()
+
 	for {
 		select {
 		case change := <-subControllerCert.MsgChan():
@@ -600,6 +632,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subDomainConfig.MsgChan():
 			subDomainConfig.ProcessChange(change)
 
+		case change := <-subGuestFsFreezeConfig.MsgChan():
+			subGuestFsFreezeConfig.ProcessChange(change)
+
 		case change := <-subDeviceNetworkStatus.MsgChan():
 			subDeviceNetworkStatus.ProcessChange(change)
 
@@ -715,6 +750,7 @@ var handlerMap handlers
 
 func handlersInit() {
 	handlerMap = make(handlers)
+	guestFsFreezeHandlerMap = make(handlers)
 }
 
 // Wrappers around handleCreate, handleModify, and handleDelete
@@ -772,6 +808,7 @@ func handleDomainDelete(ctxArg interface{}, key string,
 	if config.IsCipher || config.CloudInitUserData != nil {
 		unpublishCipherBlockStatus(ctx, config.Key())
 	}
+	stopGuestFsFreezeHandler(key)
 	// Do we have a channel/goroutine?
 	h, ok := handlerMap[key]
 	if ok {
@@ -796,6 +833,10 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 	min := max * 0.3
 	ticker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
+	healthTicker := time.NewTicker(healthCheckInterval)
+	defer healthTicker.Stop()
+	var healthChecker appHealthChecker
+	var guestChecker guestAgentChecker
 
 	closed := false
 	for !closed {
@@ -829,6 +870,13 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 			if status != nil {
 				verifyStatus(ctx, status)
 				maybeRetry(ctx, status)
+				updateGuestInfo(ctx, status, &guestChecker)
+			}
+		case <-healthTicker.C:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				checkAppHealth(ctx, status, &healthChecker)
+				checkGuestClock(ctx, status, &guestChecker)
 			}
 		}
 	}
@@ -847,6 +895,8 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 	domainID, domainStatus, err := hyper.Task(status).Info(status.DomainName)
 	if err != nil || domainStatus == types.HALTED {
 		if status.Activated && configActivate {
+			// Clean exit is reported as HALTED without an error
+			failed := err != nil
 			if err == nil {
 				err = fmt.Errorf("unexpected state %s", domainStatus.String())
 			}
@@ -855,12 +905,27 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			log.Warnln(errStr)
 			status.Activated = false
 			status.State = types.HALTED
+			policy := config.Health.RestartPolicy
 
-			// check if task is in the BROKEN state and kill it (later on we may do some
-			// level of recovery or at least gather some intel on why and how it crashed)
-			// NOTE: we don't do anything for repairing tasks in the UNKNOWN state, for those
-			// the only remedy is an explicit user action (delete, restart, etc.)
-			if domainStatus == types.BROKEN {
+			if shouldRestart(policy, failed) {
+				reason := "application exited"
+				if failed {
+					reason = fmt.Sprintf("application crashed: %v", err)
+				}
+				scheduleRestart(*config, status, reason)
+			} else if policy == types.AppRestartPolicyNever ||
+				policy == types.AppRestartPolicyOnFailure {
+				if failed {
+					status.SetErrorNow(fmt.Sprintf("application crashed (%v); restart policy %s",
+						err, policy))
+					status.State = types.BROKEN
+				}
+				status.Health.Ready = false
+			} else if domainStatus == types.BROKEN {
+				// check if task is in the BROKEN state and kill it (later on we may do some
+				// level of recovery or at least gather some intel on why and how it crashed)
+				// NOTE: we don't do anything for repairing tasks in the UNKNOWN state, for those
+				// the only remedy is an explicit user action (delete, restart, etc.)
 				err := fmt.Errorf("one of the %s tasks has crashed (%v)", status.Key(), err)
 				log.Errorf(err.Error())
 				status.SetErrorNow("one of the application's tasks has crashed - please restart application instance")
@@ -954,7 +1019,15 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 	t := time.Now()
 	elapsed := t.Sub(status.ErrorTime)
 	timeLimit := time.Duration(ctx.domainBootRetryTime) * time.Second
-	if elapsed < timeLimit {
+	restart := !status.Health.NextRestartTime.IsZero()
+	if restart {
+		// Restart scheduled according to the restart policy
+		if t.Before(status.Health.NextRestartTime) {
+			log.Functionf("maybeRetryBoot(%s) restart in %s",
+				status.Key(), status.Health.NextRestartTime.Sub(t))
+			return
+		}
+	} else if elapsed < timeLimit {
 		log.Functionf("maybeRetryBoot(%s) %d remaining",
 			status.Key(),
 			(timeLimit-elapsed)/time.Second)
@@ -988,11 +1061,21 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 	ctx.createSema.V(1)
 	domainID, err := DomainCreate(ctx, *status)
 	ctx.createSema.P(1)
+	if restart {
+		status.Health.RestartCount++
+		status.Health.CrashLoopCount++
+		status.Health.LastRestartTime = t
+		status.Health.NextRestartTime = time.Time{}
+	}
 	if err != nil {
 		log.Errorf("maybeRetryBoot DomainCreate for %s: %s",
 			status.DomainName, err)
 		status.BootFailed = true
-		status.SetErrorNow(err.Error())
+		if restart {
+			scheduleRestart(*config, status, err.Error())
+		} else {
+			status.SetErrorNow(err.Error())
+		}
 		publishDomainStatus(ctx, status)
 		return
 	}
@@ -1281,6 +1364,12 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		}
 	}
 
+	if err := prepareVTPMState(config); err != nil {
+		log.Errorf("doActivate(%s): %v", config.Key(), err)
+		status.SetErrorNow(err.Error())
+		return
+	}
+
 	filename := xenCfgFilename(config.AppNum)
 	file, err := os.Create(filename)
 	if err != nil {
@@ -1573,6 +1662,14 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 		}
 	}
 
+	if status.DomainId != 0 && isNativeContainer(status) {
+		// Let the container exit after the stop signal of its image
+		// within the grace period before it is deleted
+		if err := DomainShutdown(*status, false); err != nil {
+			log.Warnf("DomainShutdown %s failed: %s",
+				status.DomainName, err)
+		}
+	}
 	if status.DomainId != 0 {
 		if err := hyper.Task(status).Delete(status.DomainName); err != nil {
 			log.Errorf("Failed to delete domain %s (%v)", status.DomainName, err)
@@ -1719,6 +1816,16 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 		ds.Vdev = fmt.Sprintf("xvd%c", int('a')+i)
 	}
 
+	status.ImageLifecycle = types.ImageLifecycle{}
+	if status.OCIConfigDir != "" {
+		lifecycle, err := containerd.GetImageLifecycle(status.OCIConfigDir)
+		if err != nil {
+			log.Warnf("configToStatus(%v) image lifecycle metadata: %v",
+				config.UUIDandVersion, err)
+		}
+		status.ImageLifecycle = lifecycle
+	}
+
 	//clean environment variables
 	status.EnvVariables = nil
 
@@ -1903,6 +2010,8 @@ func handleModify(ctx *domainContext, key string,
 	} else if !config.Activate {
 		log.Functionf("handleModify(%v) NOT activating for %s",
 			config.UUIDandVersion, config.DisplayName)
+		// Restart counts start over with the next activation
+		status.Health = types.AppHealthStatus{}
 		if status.HasError() {
 			log.Noticef("handleModify(%s) clearing existing error: %s",
 				status.Key(), status.Error)
@@ -2057,6 +2166,9 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 		log.Errorln(err)
 	}
 	deleteCloudInitISO(ctx, *status)
+	if err := removeVTPMState(status.UUIDandVersion.UUID); err != nil {
+		log.Error(err)
+	}
 
 	status.PendingDelete = false
 	publishDomainStatus(ctx, status)
@@ -2094,6 +2206,12 @@ func DomainCreate(ctx *domainContext, status types.DomainStatus) (int, error) {
 	return domainID, err
 }
 
+// isNativeContainer returns true if the domain runs directly as a containerd
+// task rather than inside a VM.
+func isNativeContainer(status *types.DomainStatus) bool {
+	return status.VirtualizationMode == types.NOHYPER || hyper.Name() == "containerd"
+}
+
 // DomainShutdown is a wrapper for domain shutdown
 func DomainShutdown(status types.DomainStatus, force bool) error {
 
@@ -2203,6 +2321,9 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 			ctx.metricInterval = gcp.GlobalValueInt(types.MetricInterval)
 		}
 		ctx.processCloudInitMultiPart = gcp.GlobalValueBool(types.ProcessCloudInitMultiPart)
+		// DomainShutdown of a native container blocks for the grace period
+		containerd.SetMaxStopTimeout(
+			time.Duration(gcp.GlobalValueInt(types.AppStopTimeoutMax)) * time.Second)
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s. "+
@@ -0,0 +1,263 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Guest OS information, clock synchronization and freezing of file systems
+// using the agent running inside VMs (QEMU guest agent).
+
+package domainmgr
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// Wall clock moving ahead of the monotonic clock by more than this
+	// means that the host was suspended (or its clock was stepped) and
+	// the guest clock needs to be set again.
+	clockJumpThreshold = 5 * time.Second
+	// Connecting to a guest without the agent blocks until a timeout,
+	// so we do not retry too often.
+	guestAgentRetryInterval = 2 * time.Minute
+)
+
+// guestAgentChecker keeps the state of the guest agent checks for one
+// domain. It is owned by the runHandler goroutine of the domain.
+type guestAgentChecker struct {
+	lastCheck time.Time
+	nextInfo  time.Time
+}
+
+// clockJumped returns true if the wall clock moved differently than
+// the monotonic clock since the previous call. The monotonic clock
+// does not advance while the host is suspended.
+func (checker *guestAgentChecker) clockJumped(now time.Time) bool {
+	last := checker.lastCheck
+	checker.lastCheck = now
+	if last.IsZero() {
+		return false
+	}
+	monotonic := now.Sub(last)
+	wall := now.Round(0).Sub(last.Round(0))
+	diff := wall - monotonic
+	return diff > clockJumpThreshold || diff < -clockJumpThreshold
+}
+
+// checkGuestClock sets the clock of the guest after a jump of the host clock
+func checkGuestClock(ctx *domainContext, status *types.DomainStatus,
+	checker *guestAgentChecker) {
+
+	now := time.Now()
+	if !checker.clockJumped(now) {
+		return
+	}
+	if !status.Activated || status.State != types.RUNNING {
+		return
+	}
+	agent, ok := hyper.Task(status).(types.GuestAgent)
+	if !ok {
+		return
+	}
+	log.Noticef("checkGuestClock(%s): host clock jumped, setting guest time",
+		status.Key())
+	if err := agent.GuestSetTime(status.DomainName, now); err != nil {
+		log.Warnf("checkGuestClock(%s): failed to set guest time: %v",
+			status.Key(), err)
+	}
+}
+
+// updateGuestInfo refreshes the information reported by the guest agent
+func updateGuestInfo(ctx *domainContext, status *types.DomainStatus,
+	checker *guestAgentChecker) {
+
+	var info types.GuestInfo
+	agent, ok := hyper.Task(status).(types.GuestAgent)
+	if ok && status.Activated && status.State == types.RUNNING {
+		now := time.Now()
+		if now.Before(checker.nextInfo) {
+			return
+		}
+		var err error
+		info, err = agent.GuestInfo(status.DomainName)
+		if err != nil {
+			// Most guests do not run the agent
+			log.Tracef("updateGuestInfo(%s): %v", status.Key(), err)
+			checker.nextInfo = now.Add(guestAgentRetryInterval)
+		}
+	} else {
+		checker.nextInfo = time.Time{}
+	}
+	if reflect.DeepEqual(info, status.GuestInfo) {
+		return
+	}
+	if info.AgentConnected != status.GuestInfo.AgentConnected {
+		log.Noticef("updateGuestInfo(%s): guest agent connected %t",
+			status.Key(), info.AgentConnected)
+	}
+	status.GuestInfo = info
+	publishDomainStatus(ctx, status)
+}
+
+// Freezing the file systems of a guest can take long (up to qgaFreezeTimeout),
+// therefore GuestFsFreezeConfig is processed by a goroutine of the domain
+// instead of the main loop. There is one goroutine per domain, created with
+// the first GuestFsFreezeConfig and notified the same way as runHandler.
+// It stops when the domain is deleted. The goroutine owns writing
+// GuestFsFreezeStatus of the domain.
+var guestFsFreezeHandlerMap handlers
+
+func handleGuestFsFreezeCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Functionf("handleGuestFsFreezeCreate(%s)", key)
+	notifyGuestFsFreezeHandler(ctxArg.(*domainContext), key)
+}
+
+func handleGuestFsFreezeModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+
+	log.Functionf("handleGuestFsFreezeModify(%s)", key)
+	notifyGuestFsFreezeHandler(ctxArg.(*domainContext), key)
+}
+
+func handleGuestFsFreezeDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Functionf("handleGuestFsFreezeDelete(%s)", key)
+	notifyGuestFsFreezeHandler(ctxArg.(*domainContext), key)
+}
+
+func notifyGuestFsFreezeHandler(ctx *domainContext, key string) {
+	h, ok := guestFsFreezeHandlerMap[key]
+	if !ok {
+		h1 := make(chan Notify, 1)
+		guestFsFreezeHandlerMap[key] = h1
+		go runGuestFsFreezeHandler(ctx, key, h1)
+		h = h1
+	}
+	select {
+	case h <- Notify{}:
+		log.Functionf("notifyGuestFsFreezeHandler(%s) sent notify", key)
+	default:
+		// The handler has not yet processed the previous notification,
+		// it reads the latest config anyway.
+		log.Functionf("notifyGuestFsFreezeHandler(%s) already notified", key)
+	}
+}
+
+// stopGuestFsFreezeHandler is called when the domain is deleted
+func stopGuestFsFreezeHandler(key string) {
+	if h, ok := guestFsFreezeHandlerMap[key]; ok {
+		close(h)
+		delete(guestFsFreezeHandlerMap, key)
+	}
+}
+
+// runGuestFsFreezeHandler freezes the file systems for every new request
+// (Counter) and thaws them when the request is deleted or replaced.
+func runGuestFsFreezeHandler(ctx *domainContext, key string, c <-chan Notify) {
+	log.Functionf("runGuestFsFreezeHandler(%s) starting", key)
+	var status *types.GuestFsFreezeStatus
+	for range c {
+		var config *types.GuestFsFreezeConfig
+		if item, err := ctx.subGuestFsFreezeConfig.Get(key); err == nil {
+			freezeConfig := item.(types.GuestFsFreezeConfig)
+			config = &freezeConfig
+		}
+		if status != nil && config != nil && status.Counter == config.Counter {
+			continue
+		}
+		if status != nil {
+			// Deleted or a new request, the agent fails to freeze frozen
+			// file systems
+			guestFsThaw(ctx, status)
+			if err := ctx.pubGuestFsFreezeStatus.Unpublish(key); err != nil {
+				log.Errorf("runGuestFsFreezeHandler(%s): %v", key, err)
+			}
+			status = nil
+		}
+		if config != nil {
+			status = guestFsFreeze(ctx, *config)
+		}
+	}
+	// The domain is deleted
+	if status != nil {
+		guestFsThaw(ctx, status)
+		if err := ctx.pubGuestFsFreezeStatus.Unpublish(key); err != nil {
+			log.Errorf("runGuestFsFreezeHandler(%s): %v", key, err)
+		}
+	}
+	log.Functionf("runGuestFsFreezeHandler(%s) DONE", key)
+}
+
+// guestFsFreeze freezes the file systems of the domain and publishes
+// the result. Volumemgr creates the snapshot in any case, if the file
+// systems are not frozen it is only crash-consistent.
+func guestFsFreeze(ctx *domainContext,
+	config types.GuestFsFreezeConfig) *types.GuestFsFreezeStatus {
+
+	status := types.GuestFsFreezeStatus{
+		UUIDandVersion: config.UUIDandVersion,
+		DomainName:     config.DomainName,
+		Counter:        config.Counter,
+	}
+	var agent types.GuestAgent
+	ds := lookupDomainStatus(ctx, config.Key())
+	if ds != nil {
+		agent, _ = hyper.Task(ds).(types.GuestAgent)
+	}
+	switch {
+	case ds == nil || !ds.Activated || ds.State != types.RUNNING:
+		status.Error = fmt.Sprintf("domain %s is not running", config.DomainName)
+	case agent == nil:
+		status.Error = fmt.Sprintf("domain %s has no guest agent support",
+			config.DomainName)
+	case !ds.GuestInfo.AgentConnected:
+		// Talking to the missing agent would block until the timeout
+		status.Error = fmt.Sprintf("guest agent of %s is not connected",
+			config.DomainName)
+	default:
+		count, err := agent.GuestFsFreeze(ds.DomainName)
+		if err != nil {
+			status.Error = err.Error()
+		} else {
+			status.Frozen = true
+			status.FrozenCount = count
+		}
+	}
+	if status.Frozen {
+		log.Noticef("guestFsFreeze(%s): froze %d file systems of %s",
+			config.Key(), status.FrozenCount, config.DomainName)
+	} else {
+		log.Noticef("guestFsFreeze(%s): cannot freeze %s: %s",
+			config.Key(), config.DomainName, status.Error)
+	}
+	if err := ctx.pubGuestFsFreezeStatus.Publish(status.Key(), status); err != nil {
+		log.Errorf("guestFsFreeze(%s): %v", config.Key(), err)
+	}
+	return &status
+}
+
+// guestFsThaw thaws the file systems frozen by guestFsFreeze
+func guestFsThaw(ctx *domainContext, status *types.GuestFsFreezeStatus) {
+	if !status.Frozen {
+		return
+	}
+	key := status.Key()
+	ds := lookupDomainStatus(ctx, key)
+	if ds == nil {
+		log.Noticef("guestFsThaw(%s): domain %s is gone", key, status.DomainName)
+		return
+	}
+	agent, ok := hyper.Task(ds).(types.GuestAgent)
+	if !ok {
+		return
+	}
+	if _, err := agent.GuestFsThaw(ds.DomainName); err != nil {
+		log.Errorf("guestFsThaw(%s): failed to thaw %s: %v",
+			key, ds.DomainName, err)
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Persistent state of the virtual TPMs of VMs. The hypervisor runs the TPM
+// emulator while the domain is active, domainmgr decides when the state is
+// discarded i.e. when the application instance is purged or deleted.
+
+package domainmgr
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// The modification time of this file in the state directory tells us
+// when the state was created
+const vtpmCreatedFile = ".created"
+
+// prepareVTPMState creates the state directory of the virtual TPM and
+// discards the state created before the application instance was purged
+func prepareVTPMState(config types.DomainConfig) error {
+	if config.VTPM == types.VTPMNone {
+		return nil
+	}
+	stateDir := hypervisor.VTPMStateDir(config.UUIDandVersion.UUID)
+	createdFile := filepath.Join(stateDir, vtpmCreatedFile)
+	info, err := os.Stat(createdFile)
+	if err == nil && info.ModTime().Before(config.PurgeStartedAt) {
+		log.Noticef("prepareVTPMState(%s): discarding state created at %v, purged at %v",
+			config.Key(), info.ModTime(), config.PurgeStartedAt)
+		if err := removeVTPMState(config.UUIDandVersion.UUID); err != nil {
+			return err
+		}
+	} else if err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return fmt.Errorf("failed to create vTPM state directory: %v", err)
+	}
+	file, err := os.Create(createdFile)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %v", createdFile, err)
+	}
+	return file.Close()
+}
+
+// removeVTPMState discards the state of the virtual TPM, the domain must
+// not be running
+func removeVTPMState(appUUID uuid.UUID) error {
+	stateDir := hypervisor.VTPMStateDir(appUUID)
+	if _, err := os.Stat(stateDir); os.IsNotExist(err) {
+		return nil
+	}
+	log.Noticef("removeVTPMState(%s): removing %s", appUUID, stateDir)
+	if err := os.RemoveAll(stateDir); err != nil {
+		return fmt.Errorf("failed to remove vTPM state %s: %v", stateDir, err)
+	}
+	return nil
+}
@@ -28,6 +28,9 @@ type downloaderContext struct {
 	cipherMetrics            *cipher.AgentMetrics
 	GCInitialized            bool
 	downloadMaxPortCost      uint8
+	downloadConcurrency      int
+	peerCachePort            int
+	imageSignaturePolicy     string
 }
 
 func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
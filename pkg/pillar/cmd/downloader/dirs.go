@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/lf-edge/eve/libs/zedUpload/ociutil"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
@@ -60,13 +61,13 @@ func clearInProgressDownloadDirs(ctx *downloaderContext) {
 			continue
 		}
 		filePath := filepath.Join(dirName, fi.Name())
-		// if progress file
-		if strings.HasSuffix(filePath, progressFileSuffix) {
-			//check if progress file points onto existing file
-			if _, err := os.Stat(strings.TrimSuffix(filePath, progressFileSuffix)); err == nil {
+		// if progress or signatures file
+		if suffix := auxFileSuffix(filePath); suffix != "" {
+			//check if the file points onto existing file
+			if _, err := os.Stat(strings.TrimSuffix(filePath, suffix)); err == nil {
 				continue
 			}
-			// if not exists, remove progress file
+			// if not exists, remove the file
 			err = os.RemoveAll(filePath)
 			if err != nil {
 				log.Fatal(err)
@@ -87,6 +88,17 @@ func clearInProgressDownloadDirs(ctx *downloaderContext) {
 	}
 }
 
+// auxFileSuffix returns the suffix if filePath is a file kept next to
+// the download
+func auxFileSuffix(filePath string) string {
+	for _, suffix := range []string{progressFileSuffix, ociutil.SignaturesFileSuffix} {
+		if strings.HasSuffix(filePath, suffix) {
+			return suffix
+		}
+	}
+	return ""
+}
+
 func getPendingDir() string {
 	return path.Join(downloaderBasePath, "pending")
 }
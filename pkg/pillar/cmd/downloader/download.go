@@ -128,10 +128,10 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	// create Request
 	req := dEndPoint.NewRequest(syncOp, filename, locFilename,
 		int64(maxsize), true, respChan)
-	req = req.WithDoneParts(downloadedParts)
 	if req == nil {
 		return "", cancel, errors.New("NewRequest failed")
 	}
+	req = req.WithDoneParts(downloadedParts).WithConcurrency(ctx.downloadConcurrency)
 
 	req = req.WithCancel(context.Background())
 	defer req.Cancel()
@@ -190,7 +190,8 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 			}
 			continue
 		}
-		if syncOp == zedUpload.SyncOpDownload {
+		if syncOp == zedUpload.SyncOpDownload ||
+			syncOp == zedUpload.SyncOpDownloadWithSignature {
 			err = resp.GetDnStatus()
 		} else {
 			_, err = resp.GetUpStatus()
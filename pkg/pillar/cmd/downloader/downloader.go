@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/libs/zedUpload/ociutil"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
@@ -438,6 +439,10 @@ func doDelete(ctx *downloaderContext, key string, filename string,
 			log.Errorf("Failed to remove %s: err %s",
 				filename, err)
 		}
+		if err := os.RemoveAll(filename + ociutil.SignaturesFileSuffix); err != nil {
+			log.Errorf("Failed to remove %s: err %s",
+				filename, err)
+		}
 	}
 
 	status.State = types.INITIAL
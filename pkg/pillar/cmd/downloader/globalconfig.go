@@ -40,6 +40,9 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 			maxStalledTime = time.Duration(gcp.GlobalValueInt(types.DownloadStalledTime)) * time.Second
 		}
 		ctx.downloadMaxPortCost = uint8(gcp.GlobalValueInt(types.DownloadMaxPortCost))
+		ctx.downloadConcurrency = int(gcp.GlobalValueInt(types.DownloadConcurrency))
+		ctx.peerCachePort = int(gcp.GlobalValueInt(types.PeerCachePort))
+		ctx.imageSignaturePolicy = gcp.GlobalValueString(types.ImageSignaturePolicy)
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
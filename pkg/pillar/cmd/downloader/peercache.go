@@ -0,0 +1,226 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Download of the blobs from other EVE devices on the same LAN,
+// which serve them from their CAS, see docs/PEER-CACHE.md.
+
+package downloader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grandcat/zeroconf"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// suffix of the file the blob is downloaded into from a peer, to keep
+	// the partial download from the datastore untouched until verified
+	peerFileSuffix = ".peer"
+	// how long to wait for the mDNS responses of the peers
+	peerCacheBrowseTime = 3 * time.Second
+	// how long to wait for the response headers of a peer
+	peerCacheResponseTimeout = 10 * time.Second
+)
+
+// peer is an EVE device announcing PeerCacheService together with
+// the source address to connect to it
+type peer struct {
+	name   string
+	addr   string // host:port
+	ipSrc  net.IP
+	ifName string
+}
+
+// findPeers browses PeerCacheService on the management ports and returns
+// the peers reachable from the subnets of them, skipping this device.
+func findPeers(ctx *downloaderContext) ([]peer, error) {
+	var ifs []net.Interface
+	for _, p := range ctx.deviceNetworkStatus.Ports {
+		if !p.IsMgmt {
+			continue
+		}
+		intf, err := net.InterfaceByName(p.IfName)
+		if err != nil {
+			continue
+		}
+		ifs = append(ifs, *intf)
+	}
+	if len(ifs) == 0 {
+		return nil, fmt.Errorf("findPeers: no management port to browse")
+	}
+	resolver, err := zeroconf.NewResolver(zeroconf.SelectIfaces(ifs),
+		zeroconf.SelectIPTraffic(zeroconf.IPv4))
+	if err != nil {
+		return nil, fmt.Errorf("findPeers: failed to initialize resolver: %v", err)
+	}
+	hostname, _ := os.Hostname()
+	mctx, cancel := context.WithTimeout(context.Background(), peerCacheBrowseTime)
+	defer cancel()
+	entries := make(chan *zeroconf.ServiceEntry)
+	if err := resolver.Browse(mctx, types.PeerCacheService, "local.", entries); err != nil {
+		return nil, fmt.Errorf("findPeers: resolver error %v", err)
+	}
+	var peers []peer
+	for entry := range entries {
+		log.Functionf("findPeers: %v", entry)
+		if entry.Instance == hostname {
+			continue
+		}
+		for _, ip := range entry.AddrIPv4 {
+			ifName, ipSrc := findPeerSrc(ctx.deviceNetworkStatus, ip)
+			if ipSrc == nil {
+				continue
+			}
+			peers = append(peers, peer{
+				name:   entry.Instance,
+				addr:   net.JoinHostPort(ip.String(), strconv.Itoa(entry.Port)),
+				ipSrc:  ipSrc,
+				ifName: ifName,
+			})
+		}
+	}
+	return peers, nil
+}
+
+// findPeerSrc returns the management port and its address in the same subnet
+// as the peer. Peers behind a router are not used.
+func findPeerSrc(dns types.DeviceNetworkStatus, ip net.IP) (string, net.IP) {
+	for _, p := range dns.Ports {
+		if !p.IsMgmt || !p.Subnet.Contains(ip) {
+			continue
+		}
+		for _, ai := range p.AddrInfoList {
+			if ai.Addr.To4() != nil && p.Subnet.Contains(ai.Addr) {
+				return p.IfName, ai.Addr
+			}
+		}
+	}
+	return "", nil
+}
+
+// downloadFromPeers downloads the blob identified by config.ImageSha256 into
+// locFilename from the first peer which has it. Returns the content type
+// reported by the peer.
+func downloadFromPeers(ctx *downloaderContext, config types.DownloaderConfig,
+	locFilename string, st Status) (string, error) {
+	peers, err := findPeers(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(peers) == 0 {
+		return "", fmt.Errorf("no peers found")
+	}
+	var errList []string
+	peerFilename := locFilename + peerFileSuffix
+	for _, p := range peers {
+		url := "http://" + p.addr + types.PeerCacheBlobPath + config.ImageSha256
+		contentType, err := fetchFromPeer(peerClient(p.ipSrc), url,
+			config.ImageSha256, peerFilename, int64(config.Size), st)
+		if err == nil {
+			err = os.Rename(peerFilename, locFilename)
+		}
+		if err != nil {
+			log.Functionf("downloadFromPeers: %s from %s failed: %v", url, p.name, err)
+			errList = append(errList, fmt.Sprintf("%s: %v", p.name, err))
+			continue
+		}
+		log.Noticef("downloadFromPeers: downloaded %s from %s using %s",
+			config.ImageSha256, p.name, p.ifName)
+		return contentType, nil
+	}
+	return "", fmt.Errorf("%s", strings.Join(errList, "; "))
+}
+
+// peerClient returns the HTTP client connecting from ipSrc without proxies,
+// as the peers are on the same subnet.
+func peerClient(ipSrc net.IP) *http.Client {
+	dialer := &net.Dialer{
+		LocalAddr: &net.TCPAddr{IP: ipSrc},
+		Timeout:   peerCacheResponseTimeout,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			ResponseHeaderTimeout: peerCacheResponseTimeout,
+		},
+	}
+}
+
+// progressWriter reports the progress of the download to st
+// every time the percentage changes
+type progressWriter struct {
+	st          Status
+	percent     uint
+	currentSize int64
+	totalSize   int64
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.currentSize += int64(len(p))
+	percent := uint(100 * w.currentSize / w.totalSize)
+	if w.st != nil && percent != w.percent {
+		w.percent = percent
+		w.st.Progress(percent, w.currentSize, w.totalSize)
+	}
+	return len(p), nil
+}
+
+// fetchFromPeer downloads url into locFilename and verifies its sha256.
+// The file is removed if the download or the verification fails.
+func fetchFromPeer(client *http.Client, url, sha256Hex, locFilename string,
+	maxSize int64, st Status) (contentType string, err error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("bad response code: %d", resp.StatusCode)
+	}
+	if resp.ContentLength <= 0 {
+		return "", fmt.Errorf("missing content length")
+	}
+	if maxSize != 0 && resp.ContentLength > maxSize {
+		return "", fmt.Errorf("configured image size (%d) is less than size of file (%d)",
+			maxSize, resp.ContentLength)
+	}
+	local, err := os.Create(locFilename)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		local.Close()
+		if err != nil {
+			os.Remove(locFilename)
+		}
+	}()
+	hash := sha256.New()
+	progress := &progressWriter{st: st, totalSize: resp.ContentLength}
+	written, err := io.Copy(io.MultiWriter(local, hash, progress),
+		io.LimitReader(resp.Body, resp.ContentLength))
+	if err != nil {
+		return "", err
+	}
+	if written != resp.ContentLength {
+		return "", fmt.Errorf("received %d bytes instead of %d",
+			written, resp.ContentLength)
+	}
+	if got := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(got, sha256Hex) {
+		return "", fmt.Errorf("sha256 mismatch: received %s", got)
+	}
+	if err := local.Sync(); err != nil {
+		return "", err
+	}
+	return resp.Header.Get("Content-Type"), nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFetchFromPeer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "peercache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	content := []byte("blob served by the peer")
+	sum := sha256.Sum256(content)
+	sha := hex.EncodeToString(sum[:])
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+		w.Write(content)
+	}))
+	defer srv.Close()
+
+	locFilename := filepath.Join(dir, "blob")
+	contentType, err := fetchFromPeer(srv.Client(), srv.URL, sha, locFilename, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contentType != "application/vnd.oci.image.manifest.v1+json" {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	result, err := ioutil.ReadFile(locFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result) != string(content) {
+		t.Errorf("unexpected content: %s", result)
+	}
+
+	// the file is removed if the content does not match the sha256
+	otherSum := sha256.Sum256([]byte("other blob"))
+	_, err = fetchFromPeer(srv.Client(), srv.URL, hex.EncodeToString(otherSum[:]),
+		locFilename, 0, nil)
+	if err == nil {
+		t.Fatal("expected sha256 mismatch")
+	}
+	if _, err := os.Stat(locFilename); !os.IsNotExist(err) {
+		t.Errorf("file not removed after sha256 mismatch: %v", err)
+	}
+
+	// the blob larger than configured is not downloaded
+	_, err = fetchFromPeer(srv.Client(), srv.URL, sha, locFilename, 5, nil)
+	if err == nil {
+		t.Fatal("expected size check to fail")
+	}
+}
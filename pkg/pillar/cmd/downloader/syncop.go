@@ -44,6 +44,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 	// As of this writing, the file is downloaded directly to `config.Target`
 	locFilename = config.Target
 	locDirname = path.Dir(locFilename)
+	// cleared for the datastores which resume the partial download on retry
 	cleanOnError := true
 
 	// construct the datastore context
@@ -75,6 +76,31 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		}
 	}
 
+	// the peers do not have the signatures of the image
+	pullSignatures := config.PullSignatures &&
+		ctx.imageSignaturePolicy != types.ImageSignaturePolicyDisabled
+
+	// try other EVE devices on the same LAN before the datastore
+	if ctx.peerCachePort != 0 && config.ImageSha256 != "" && !pullSignatures {
+		st := &PublishStatus{
+			ctx:    ctx,
+			status: status,
+		}
+		contentType, err = downloadFromPeers(ctx, config, locFilename, st)
+		if err == nil {
+			info, err := os.Stat(locFilename)
+			if err == nil {
+				status.Size = uint64(info.Size())
+			}
+			status.ContentType = contentType
+			handleSyncOpResponse(ctx, config, status,
+				locFilename, key, "", cancelled, cleanOnError)
+			return
+		}
+		log.Functionf("Downloading <%s> from peers failed, using datastore: %v",
+			config.Name, err)
+	}
+
 	downloadMaxPortCost := ctx.downloadMaxPortCost
 	log.Functionf("Downloading <%s> to <%s> using %d downloadMaxPortCost",
 		config.Name, locFilename, downloadMaxPortCost)
@@ -89,6 +115,9 @@ func handleSyncOp(ctx *downloaderContext, key string,
 			Password: dsCtx.Password,
 		}
 		trType = zedUpload.SyncOCIRegistryTr
+		if pullSignatures {
+			syncOp = zedUpload.SyncOpDownloadWithSignature
+		}
 		// get the name of the repository and the URL for the registry
 		serverURL, remoteName, err = ociRepositorySplit(dsCtx.DownloadURL)
 		if err != nil {
@@ -153,6 +182,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		// does not contain the prefix of the relative path with '/'s
 		remoteName = config.Name
 		serverURL = dst.Fqdn
+		cleanOnError = false
 
 	case zconfig.DsType_DsHttp.String(), zconfig.DsType_DsHttps.String(), "":
 		auth = &zedUpload.AuthInput{
@@ -167,6 +197,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		if err != nil {
 			errStr = errStr + "\n" + err.Error()
 		}
+		cleanOnError = false
 
 	case zconfig.DsType_DsGoogleStorage.String():
 		auth = &zedUpload.AuthInput{
@@ -179,6 +210,7 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		// pass in the config.Name instead of 'filename' which
 		// does not contain the prefix of the relative path with '/'s
 		remoteName = config.Name
+		cleanOnError = false
 	default:
 		errStr = "unsupported transport method " + dsCtx.TransportMethod
 
@@ -11,6 +11,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -85,6 +87,7 @@ type nim struct {
 	subDevicePortConfigS  pubsub.Subscription
 	subZedAgentStatus     pubsub.Subscription
 	subAssignableAdapters pubsub.Subscription
+	subNetworkInstances   pubsub.Subscription
 
 	// Publications
 	pubDummyDevicePortConfig pubsub.Publication // For logging
@@ -95,6 +98,7 @@ type nim struct {
 	pubZedcloudMetrics       pubsub.Publication
 	pubCipherMetrics         pubsub.Publication
 	pubWwanMetrics           pubsub.Publication
+	pubWlanMetrics           pubsub.Publication
 
 	// Metrics
 	zedcloudMetrics *zedcloud.AgentMetrics
@@ -106,6 +110,7 @@ type nim struct {
 	assignableAdapters types.AssignableAdapters
 	enabledLastResort  bool
 	lastResort         *types.DevicePortConfig
+	wireGuardPorts     []uint16
 }
 
 // Run - Main function - invoked from zedbox.go
@@ -177,6 +182,7 @@ func (n *nim) init() (err error) {
 		PubDevicePortConfigList:  n.pubDevicePortConfigList,
 		PubDeviceNetworkStatus:   n.pubDeviceNetworkStatus,
 		PubWwanMetrics:           n.pubWwanMetrics,
+		PubWlanMetrics:           n.pubWlanMetrics,
 		ZedcloudMetrics:          n.zedcloudMetrics,
 	}
 	return nil
@@ -305,6 +311,9 @@ func (n *nim) run(ctx context.Context) (err error) {
 		case change := <-n.subZedAgentStatus.MsgChan():
 			n.subZedAgentStatus.ProcessChange(change)
 
+		case change := <-n.subNetworkInstances.MsgChan():
+			n.subNetworkInstances.ProcessChange(change)
+
 		case change := <-n.subAssignableAdapters.MsgChan():
 			n.subAssignableAdapters.ProcessChange(change)
 			if waitForAA && n.assignableAdapters.Initialized {
@@ -447,6 +456,15 @@ func (n *nim) initPublications() (err error) {
 	if err != nil {
 		return err
 	}
+
+	n.pubWlanMetrics, err = n.PubSub.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.WlanMetrics{},
+		})
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -591,6 +609,22 @@ func (n *nim) initSubscriptions() (err error) {
 		return err
 	}
 
+	// To determine the listen ports of WireGuard tunnels.
+	n.subNetworkInstances, err = n.PubSub.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedrouter",
+		MyAgentName:   agentName,
+		TopicImpl:     types.NetworkInstanceStatus{},
+		Activate:      true,
+		CreateHandler: n.handleNetworkInstanceCreate,
+		ModifyHandler: n.handleNetworkInstanceModify,
+		DeleteHandler: n.handleNetworkInstanceDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -709,6 +743,37 @@ func (n *nim) handleAssignableAdaptersDelete(_ interface{}, key string, _ interf
 	n.Log.Functionf("handleAssignableAdaptersDelete done for %s\n", key)
 }
 
+func (n *nim) handleNetworkInstanceCreate(_ interface{}, _ string, _ interface{}) {
+	n.updateWireGuardPorts()
+}
+
+func (n *nim) handleNetworkInstanceModify(_ interface{}, _ string, _, _ interface{}) {
+	n.updateWireGuardPorts()
+}
+
+func (n *nim) handleNetworkInstanceDelete(_ interface{}, _ string, _ interface{}) {
+	n.updateWireGuardPorts()
+}
+
+// updateWireGuardPorts passes the listen ports of WireGuard tunnels
+// to DpcManager to accept the traffic from the peers.
+func (n *nim) updateWireGuardPorts() {
+	var ports []uint16
+	for _, item := range n.subNetworkInstances.GetAll() {
+		status := item.(types.NetworkInstanceStatus)
+		if status.WireGuardListenPort != 0 {
+			ports = append(ports, status.WireGuardListenPort)
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	if reflect.DeepEqual(ports, n.wireGuardPorts) {
+		return
+	}
+	n.Log.Noticef("WireGuard listen ports changed to %v", ports)
+	n.wireGuardPorts = ports
+	n.dpcManager.UpdateWireGuardPorts(ports)
+}
+
 func (n *nim) handleZedAgentStatusCreate(_ interface{}, key string, statusArg interface{}) {
 	n.handleZedAgentStatusImpl(key, statusArg)
 }
@@ -0,0 +1,88 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Verification of the cosign and Notation signatures downloaded
+// together with the images from OCI registries, see docs/IMAGE-SIGNATURES.md.
+
+package verifier
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/zedUpload/ociutil"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// updateSignaturePolicy parses the trust anchors from the global config
+func updateSignaturePolicy(ctx *verifierContext, gcp *types.ConfigItemValueMap) {
+	ctx.signaturePolicy = gcp.GlobalValueString(types.ImageSignaturePolicy)
+	anchorsPEM := gcp.GlobalValueString(types.ImageSignatureTrustAnchors)
+	if anchorsPEM == ctx.trustAnchorsPEM && ctx.trustAnchors != nil {
+		return
+	}
+	ctx.trustAnchorsPEM = anchorsPEM
+	ctx.trustAnchors, ctx.trustAnchorsErr = ociutil.ParseTrustAnchors([]byte(anchorsPEM))
+	if ctx.trustAnchorsErr != nil {
+		log.Errorf("updateSignaturePolicy: invalid %s: %v",
+			types.ImageSignatureTrustAnchors, ctx.trustAnchorsErr)
+	}
+}
+
+// verifyObjectSignatures verifies the signatures saved next to the image by
+// the downloader and records the result in the status. Returns false if the
+// image has to fail because of the enforced policy.
+func verifyObjectSignatures(ctx *verifierContext, config *types.VerifyImageConfig,
+	sigFilename string, status *types.VerifyImageStatus) bool {
+
+	if !config.VerifySignature {
+		return true
+	}
+	defer os.Remove(sigFilename)
+	if ctx.signaturePolicy == types.ImageSignaturePolicyDisabled {
+		return true
+	}
+	signers, attestations, err := checkSignatures(ctx, config, sigFilename)
+	switch {
+	case err == nil:
+		status.SignatureState = types.SignatureVerified
+		status.Signers = signers
+		status.Attestations = attestations
+		log.Noticef("verifyObjectSignatures: %s signed by %v, %d attestations",
+			config.Name, signers, attestations)
+		return true
+	case errors.Is(err, ociutil.ErrNoSignatures):
+		status.SignatureState = types.SignatureMissing
+	default:
+		status.SignatureState = types.SignatureInvalid
+	}
+	status.SignatureError = err.Error()
+	if ctx.signaturePolicy != types.ImageSignaturePolicyEnforce {
+		log.Warnf("verifyObjectSignatures: %s: %v", config.Name, err)
+		return true
+	}
+	cerr := fmt.Sprintf("signature verification failed: %v", err)
+	log.Errorf("verifyObjectSignatures %s failed %s", config.Name, cerr)
+	updateVerifyErrStatus(ctx, status, cerr)
+	return false
+}
+
+func checkSignatures(ctx *verifierContext, config *types.VerifyImageConfig,
+	sigFilename string) ([]string, int, error) {
+	sigs, err := ociutil.ReadSignatures(sigFilename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, ociutil.ErrNoSignatures
+		}
+		return nil, 0, err
+	}
+	if sigs.Digest != "sha256:"+strings.ToLower(config.ImageSha256) {
+		return nil, 0, fmt.Errorf("signatures are for %s", sigs.Digest)
+	}
+	if ctx.trustAnchorsErr != nil {
+		return nil, 0, fmt.Errorf("invalid trust anchors: %v", ctx.trustAnchorsErr)
+	}
+	return sigs.Verify(ctx.trustAnchors)
+}
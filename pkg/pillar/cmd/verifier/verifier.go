@@ -6,7 +6,7 @@
 //
 // Move the file from DownloadDirname/pending/<sha> to
 // to DownloadDirname/verifier/<sha> and make RO,
-// then attempt to verify sum and optional signature.
+// then attempt to verify sum and optional signatures.
 // Once sum is verified, move to DownloadDirname/verified/<sha256>
 
 package verifier
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lf-edge/eve/libs/zedUpload/ociutil"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
@@ -56,6 +57,11 @@ type verifierContext struct {
 	subGlobalConfig      pubsub.Subscription
 
 	GCInitialized bool
+
+	signaturePolicy string
+	trustAnchorsPEM string
+	trustAnchors    *ociutil.TrustAnchors
+	trustAnchorsErr error
 }
 
 var debug = false
@@ -327,6 +333,8 @@ func handleCreate(ctx *verifierContext,
 		log.Errorf("NewV4 failed: %v", err)
 		return
 	}
+	// the signatures are left by the downloader next to the image
+	sigFilename := config.FileLocation + ociutil.SignaturesFileSuffix
 	ok, size := markObjectAsVerifying(ctx, config, &status, tmpID)
 	if !ok {
 		log.Errorf("handleCreate: markObjectAsVerifying failed for %s", config.Name)
@@ -339,6 +347,10 @@ func handleCreate(ctx *verifierContext,
 		log.Errorf("handleCreate: verifyObjectSha failed for %s", config.Name)
 		return
 	}
+	if !verifyObjectSignatures(ctx, config, sigFilename, &status) {
+		log.Errorf("handleCreate: verifyObjectSignatures failed for %s", config.Name)
+		return
+	}
 	publishVerifyImageStatus(ctx, &status)
 
 	markObjectAsVerified(config, &status, tmpID)
@@ -492,6 +504,7 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 	debug, gcp = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
 		debugOverride, logger)
 	if gcp != nil {
+		updateSignaturePolicy(ctx, gcp)
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
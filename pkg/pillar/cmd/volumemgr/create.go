@@ -251,6 +251,8 @@ func destroyVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool,
 		}
 		//Assume this is zfs device
 		zVolName := status.ZVolName()
+		// zvol with snapshots cannot be destroyed
+		destroyZVolSnapshots(zVolName)
 		if stdoutStderr, err := zfs.DestroyDataset(log, zVolName); err != nil {
 			errStr := fmt.Sprintf("Error destroying zfs zvol at %s, error=%v, output=%s",
 				zVolName, err, stdoutStderr)
@@ -188,6 +188,7 @@ func createContentTreeStatus(ctx *volumemgrContext, config types.ContentTreeConf
 					MediaType:              mediaType,
 					CreateTime:             time.Now(),
 					LastRefCountChangeTime: time.Now(),
+					VerifySignature:        status.IsOCIRegistry(),
 				}
 				publishBlobStatus(ctx, rootBlob)
 			}
@@ -62,6 +62,8 @@ func AddOrRefcountDownloaderConfig(ctx *volumemgrContext, blob types.BlobStatus)
 		Size:        size,
 		Target:      locFilename,
 		RefCount:    refCount,
+		// the signatures are of the root blob only
+		PullSignatures: blob.VerifySignature,
 	}
 	log.Functionf("AddOrRefcountDownloaderConfig: DownloaderConfig: %+v", n)
 	publishDownloaderConfig(ctx, &n)
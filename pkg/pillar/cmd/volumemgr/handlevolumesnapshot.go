@@ -0,0 +1,662 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+// Snapshots of the volumes. We use native snapshots of the zvol in case of
+// zfs and internal snapshots of qcow2 files otherwise.
+// All operations only touch metadata, so we run them synchronously.
+// Operations which can not be done while a domain uses the volume are
+// deferred until the domain is halted.
+// File systems of a running VM are frozen by its guest agent (if any)
+// while the snapshot is created. Domainmgr talks to the agent, the snapshot
+// waits until it reports the result in GuestFsFreezeStatus.
+// qcow2 files must not be modified by qemu-img while qemu has them open,
+// so their snapshots are created and deleted, and all rollbacks are done
+// once the domains using the volume are halted.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/diskmetrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+func handleVolumeSnapshotCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Functionf("handleVolumeSnapshotCreate(%s)", key)
+	config := configArg.(types.VolumeSnapshotConfig)
+	ctx := ctxArg.(*volumemgrContext)
+	if status := lookupVolumeSnapshotStatus(ctx, key); status != nil {
+		// Added back before the deferred deletion happened
+		log.Noticef("handleVolumeSnapshotCreate(%s) cancel deletion", key)
+		status.DisplayName = config.DisplayName
+		if status.State == types.VolumeSnapshotStateDeleting {
+			status.State = types.VolumeSnapshotStateCreated
+			status.ClearErrorWithSource()
+		}
+		publishVolumeSnapshotStatus(ctx, status)
+		return
+	}
+	status := types.VolumeSnapshotStatus{
+		SnapshotID:        config.SnapshotID,
+		VolumeID:          config.VolumeID,
+		GenerationCounter: config.GenerationCounter,
+		DisplayName:       config.DisplayName,
+		State:             types.VolumeSnapshotStateWaiting,
+		// We do not roll back just after creation
+		RollbackCounter: config.RollbackCounter,
+	}
+	doVolumeSnapshotCreate(ctx, &status)
+	publishVolumeSnapshotStatus(ctx, &status)
+	log.Functionf("handleVolumeSnapshotCreate(%s) Done", key)
+}
+
+func handleVolumeSnapshotModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+
+	log.Functionf("handleVolumeSnapshotModify(%s)", key)
+	config := configArg.(types.VolumeSnapshotConfig)
+	ctx := ctxArg.(*volumemgrContext)
+	status := lookupVolumeSnapshotStatus(ctx, key)
+	if status == nil {
+		log.Fatalf("status doesn't exist at handleVolumeSnapshotModify for %s", key)
+	}
+	status.DisplayName = config.DisplayName
+	if config.RollbackCounter != status.RollbackCounter {
+		if status.State == types.VolumeSnapshotStateWaiting {
+			// Nothing to roll back to yet; remember the counter
+			// to not roll back when the snapshot is created
+			status.RollbackCounter = config.RollbackCounter
+		} else {
+			log.Noticef("handleVolumeSnapshotModify(%s) rollback counter changed from %d to %d",
+				key, status.RollbackCounter, config.RollbackCounter)
+			doVolumeSnapshotRollback(ctx, status, config.RollbackCounter)
+		}
+	}
+	publishVolumeSnapshotStatus(ctx, status)
+	log.Functionf("handleVolumeSnapshotModify(%s) Done", key)
+}
+
+func handleVolumeSnapshotDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	log.Functionf("handleVolumeSnapshotDelete(%s)", key)
+	ctx := ctxArg.(*volumemgrContext)
+	status := lookupVolumeSnapshotStatus(ctx, key)
+	if status == nil {
+		log.Functionf("handleVolumeSnapshotDelete: unknown %s", key)
+		return
+	}
+	if !status.CreateTime.IsZero() {
+		deferred, err := doVolumeSnapshotDelete(ctx, status)
+		if deferred {
+			status.State = types.VolumeSnapshotStateDeleting
+			publishVolumeSnapshotStatus(ctx, status)
+			log.Functionf("handleVolumeSnapshotDelete(%s) deferred", key)
+			return
+		}
+		if err != nil {
+			log.Errorf("handleVolumeSnapshotDelete(%s): %s", key, err)
+		}
+	}
+	unpublishVolumeSnapshotStatus(ctx, status)
+	log.Functionf("handleVolumeSnapshotDelete(%s) Done", key)
+}
+
+// handleDomainStatusImpl retries the deferred snapshot operations on the
+// volumes of a domain once it is halted
+func handleDomainStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	status := statusArg.(types.DomainStatus)
+	if domainUsesVolumes(status) {
+		return
+	}
+	for _, disk := range status.DiskStatusList {
+		updateVolumeSnapshots(ctx, disk.VolumeKey)
+	}
+}
+
+func handleDomainStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleDomainStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleDomainStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleDomainStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleDomainStatusDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	status := statusArg.(types.DomainStatus)
+	for _, disk := range status.DiskStatusList {
+		updateVolumeSnapshots(ctx, disk.VolumeKey)
+	}
+}
+
+// updateVolumeSnapshots processes the snapshots of the volume with the
+// provided key which wait for the volume to be created or for the
+// application using it to halt
+func updateVolumeSnapshots(ctx *volumemgrContext, volumeKey string) {
+	log.Functionf("updateVolumeSnapshots(%s)", volumeKey)
+	for _, st := range ctx.pubVolumeSnapshotStatus.GetAll() {
+		status := st.(types.VolumeSnapshotStatus)
+		if status.VolumeKey() != volumeKey {
+			continue
+		}
+		before := status
+		switch status.State {
+		case types.VolumeSnapshotStateWaiting:
+			doVolumeSnapshotCreate(ctx, &status)
+		case types.VolumeSnapshotStateDeleting:
+			deferred, err := doVolumeSnapshotDelete(ctx, &status)
+			if !deferred {
+				if err != nil {
+					log.Errorf("updateVolumeSnapshots(%s): %s", status.Key(), err)
+				}
+				unpublishVolumeSnapshotStatus(ctx, &status)
+				continue
+			}
+		default:
+			config := lookupVolumeSnapshotConfig(ctx, status.Key())
+			if config != nil && config.RollbackCounter != status.RollbackCounter {
+				doVolumeSnapshotRollback(ctx, &status, config.RollbackCounter)
+			}
+		}
+		if !reflect.DeepEqual(before, status) {
+			publishVolumeSnapshotStatus(ctx, &status)
+		}
+	}
+}
+
+// doVolumeSnapshotCreate updates the status but does not publish it
+func doVolumeSnapshotCreate(ctx *volumemgrContext, status *types.VolumeSnapshotStatus) {
+	vs := lookupVolumeStatus(ctx, status.VolumeKey())
+	if vs == nil || vs.SubState != types.VolumeSubStateCreated {
+		log.Functionf("doVolumeSnapshotCreate(%s): volume is not created yet",
+			status.Key())
+		return
+	}
+	snapshotter, err := getSnapshotter(vs)
+	if err == nil && snapshotter.exists(status.SnapshotID) {
+		// Created before reboot; snapshots are kept in the volume
+		log.Noticef("doVolumeSnapshotCreate(%s): already exists", status.Key())
+	} else if err == nil {
+		domainName := domainUsingVolume(ctx, status.VolumeKey())
+		if domainName != "" && snapshotter.needsHalt() {
+			setSnapshotDeferred(status, "snapshot", domainName)
+			return
+		}
+		if !guestFsFrozen(ctx, status) {
+			// Retried once domainmgr reports the result
+			return
+		}
+		err = snapshotter.create(status.SnapshotID)
+	}
+	status.State = types.VolumeSnapshotStateCreated
+	if err != nil {
+		log.Errorf("doVolumeSnapshotCreate(%s): %s", status.Key(), err)
+		status.SetErrorWithSource(err.Error(), types.VolumeSnapshotStatus{}, time.Now())
+		return
+	}
+	status.ClearErrorWithSource()
+	status.CreateTime = time.Now()
+	log.Noticef("doVolumeSnapshotCreate(%s) done", status.Key())
+}
+
+// doVolumeSnapshotRollback updates the status but does not publish it.
+// The rollback is deferred while the volume is in use in which case
+// RollbackCounter is not updated.
+func doVolumeSnapshotRollback(ctx *volumemgrContext, status *types.VolumeSnapshotStatus,
+	rollbackCounter uint32) {
+
+	vs := lookupVolumeStatus(ctx, status.VolumeKey())
+	var err error
+	if vs == nil {
+		err = fmt.Errorf("volume %s not found", status.VolumeKey())
+	} else if status.CreateTime.IsZero() {
+		err = fmt.Errorf("snapshot %s was not created", status.SnapshotID)
+	} else {
+		var snapshotter volumeSnapshotter
+		snapshotter, err = getSnapshotter(vs)
+		if err == nil {
+			if domainName := domainUsingVolume(ctx, status.VolumeKey()); domainName != "" {
+				setSnapshotDeferred(status, "rollback", domainName)
+				return
+			}
+			err = snapshotter.rollback(status.SnapshotID)
+			if err == nil && snapshotter.rollbackDestroysNewer() {
+				markNewerSnapshotsDestroyed(ctx, status)
+			}
+		}
+	}
+	status.RollbackCounter = rollbackCounter
+	status.State = types.VolumeSnapshotStateRolledBack
+	status.RollbackTime = time.Now()
+	if err != nil {
+		log.Errorf("doVolumeSnapshotRollback(%s): %s", status.Key(), err)
+		status.SetErrorWithSource(err.Error(), types.VolumeSnapshotStatus{}, time.Now())
+		return
+	}
+	status.ClearErrorWithSource()
+	log.Noticef("doVolumeSnapshotRollback(%s) done", status.Key())
+}
+
+// doVolumeSnapshotDelete returns true if the deletion is deferred until
+// the application using the volume is halted
+func doVolumeSnapshotDelete(ctx *volumemgrContext, status *types.VolumeSnapshotStatus) (bool, error) {
+	vs := lookupVolumeStatus(ctx, status.VolumeKey())
+	if vs == nil || vs.SubState != types.VolumeSubStateCreated {
+		// snapshots are removed together with the volume
+		return false, nil
+	}
+	snapshotter, err := getSnapshotter(vs)
+	if err != nil {
+		return false, err
+	}
+	if domainName := domainUsingVolume(ctx, status.VolumeKey()); domainName != "" &&
+		snapshotter.needsHalt() {
+		setSnapshotDeferred(status, "deletion", domainName)
+		return true, nil
+	}
+	return false, snapshotter.delete(status.SnapshotID)
+}
+
+// setSnapshotDeferred records in the status that the operation waits for
+// the domain to halt unless it is already recorded
+func setSnapshotDeferred(status *types.VolumeSnapshotStatus, operation string,
+	domainName string) {
+
+	setSnapshotWaiting(status,
+		fmt.Sprintf("%s waiting for %s to halt", operation, domainName),
+		fmt.Sprintf("Will retry when %s is halted", domainName))
+}
+
+// setSnapshotWaiting records in the status why the operation waits
+// unless it is already recorded
+func setSnapshotWaiting(status *types.VolumeSnapshotStatus, errStr string,
+	retryCondition string) {
+
+	if status.Error == errStr {
+		return
+	}
+	log.Noticef("%s: %s", status.Key(), errStr)
+	status.SetErrorWithSourceAndDescription(types.ErrorDescription{
+		Error:               errStr,
+		ErrorSeverity:       types.ErrorSeverityNotice,
+		ErrorRetryCondition: retryCondition,
+	}, types.VolumeSnapshotStatus{})
+}
+
+// domainUsesVolumes returns true if the device model or the container
+// of the domain may have its volumes open
+func domainUsesVolumes(status types.DomainStatus) bool {
+	return status.Activated || status.DomainId != 0
+}
+
+// domainUsingVolume returns the name of the domain which uses the volume
+// or an empty string
+func domainUsingVolume(ctx *volumemgrContext, volumeKey string) string {
+	for _, st := range ctx.subDomainStatus.GetAll() {
+		ds := st.(types.DomainStatus)
+		if !domainUsesVolumes(ds) {
+			continue
+		}
+		for _, disk := range ds.DiskStatusList {
+			if disk.VolumeKey == volumeKey {
+				return ds.DomainName
+			}
+		}
+	}
+	return ""
+}
+
+// runningVMUsingVolume returns the running VM which can write to the volume
+func runningVMUsingVolume(ctx *volumemgrContext, volumeKey string) *types.DomainStatus {
+	for _, st := range ctx.subDomainStatus.GetAll() {
+		ds := st.(types.DomainStatus)
+		if !ds.Activated || ds.State != types.RUNNING ||
+			ds.VirtualizationMode == types.NOHYPER {
+			continue
+		}
+		for _, disk := range ds.DiskStatusList {
+			if disk.VolumeKey == volumeKey && !disk.ReadOnly {
+				return &ds
+			}
+		}
+	}
+	return nil
+}
+
+// guestFsFrozen asks domainmgr to freeze the file systems of a running VM
+// which uses the volume so that the snapshot is consistent, and returns true
+// once there is nothing to freeze or domainmgr reported the result.
+// Without the guest agent the snapshot is only crash-consistent.
+// The file systems are thawed when the config is unpublished by
+// handleGuestFsFreezeStatusImpl after the waiting snapshots are created.
+func guestFsFrozen(ctx *volumemgrContext, status *types.VolumeSnapshotStatus) bool {
+	ds := runningVMUsingVolume(ctx, status.VolumeKey())
+	if ds == nil {
+		return true
+	}
+	config := lookupGuestFsFreezeConfig(ctx, ds.Key())
+	if config == nil {
+		ctx.guestFsFreezeCounter++
+		config = &types.GuestFsFreezeConfig{
+			UUIDandVersion: ds.UUIDandVersion,
+			DomainName:     ds.DomainName,
+			Counter:        ctx.guestFsFreezeCounter,
+		}
+		publishGuestFsFreezeConfig(ctx, config)
+	}
+	freezeStatus := lookupGuestFsFreezeStatus(ctx, ds.Key())
+	if freezeStatus == nil || freezeStatus.Counter != config.Counter {
+		setSnapshotWaiting(status,
+			fmt.Sprintf("snapshot waiting for file systems of %s to freeze", ds.DomainName),
+			fmt.Sprintf("Will retry when domainmgr reports the freeze of %s", ds.DomainName))
+		return false
+	}
+	if freezeStatus.Frozen {
+		log.Noticef("guestFsFrozen(%s): %d file systems of %s are frozen",
+			status.Key(), freezeStatus.FrozenCount, ds.DomainName)
+	} else {
+		log.Noticef("guestFsFrozen(%s): cannot freeze %s, snapshot is crash-consistent: %s",
+			status.Key(), ds.DomainName, freezeStatus.Error)
+	}
+	return true
+}
+
+// handleGuestFsFreezeStatusImpl creates the snapshots waiting for the file
+// systems of the domain to freeze and lets domainmgr thaw them
+func handleGuestFsFreezeStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*volumemgrContext)
+	status := statusArg.(types.GuestFsFreezeStatus)
+	config := lookupGuestFsFreezeConfig(ctx, key)
+	if config == nil || config.Counter != status.Counter {
+		return
+	}
+	volumeKeys := make(map[string]bool)
+	for _, st := range ctx.pubVolumeSnapshotStatus.GetAll() {
+		snapshot := st.(types.VolumeSnapshotStatus)
+		if snapshot.State == types.VolumeSnapshotStateWaiting {
+			volumeKeys[snapshot.VolumeKey()] = true
+		}
+	}
+	for volumeKey := range volumeKeys {
+		updateVolumeSnapshots(ctx, volumeKey)
+	}
+	unpublishGuestFsFreezeConfig(ctx, key)
+}
+
+func handleGuestFsFreezeStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleGuestFsFreezeStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleGuestFsFreezeStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleGuestFsFreezeStatusImpl(ctxArg, key, statusArg)
+}
+
+func lookupGuestFsFreezeConfig(ctx *volumemgrContext, key string) *types.GuestFsFreezeConfig {
+	c, _ := ctx.pubGuestFsFreezeConfig.Get(key)
+	if c == nil {
+		return nil
+	}
+	config := c.(types.GuestFsFreezeConfig)
+	return &config
+}
+
+func lookupGuestFsFreezeStatus(ctx *volumemgrContext, key string) *types.GuestFsFreezeStatus {
+	st, _ := ctx.subGuestFsFreezeStatus.Get(key)
+	if st == nil {
+		return nil
+	}
+	status := st.(types.GuestFsFreezeStatus)
+	return &status
+}
+
+func publishGuestFsFreezeConfig(ctx *volumemgrContext, config *types.GuestFsFreezeConfig) {
+	log.Noticef("publishGuestFsFreezeConfig(%s): freeze %s",
+		config.Key(), config.DomainName)
+	if err := ctx.pubGuestFsFreezeConfig.Publish(config.Key(), *config); err != nil {
+		log.Errorf("publishGuestFsFreezeConfig(%s): %v", config.Key(), err)
+	}
+}
+
+func unpublishGuestFsFreezeConfig(ctx *volumemgrContext, key string) {
+	log.Noticef("unpublishGuestFsFreezeConfig(%s): thaw", key)
+	if err := ctx.pubGuestFsFreezeConfig.Unpublish(key); err != nil {
+		log.Errorf("unpublishGuestFsFreezeConfig(%s): %v", key, err)
+	}
+}
+
+// volumeSnapshotter implements the snapshot operations for a kind of volume
+type volumeSnapshotter interface {
+	create(snapshotID string) error
+	rollback(snapshotID string) error
+	delete(snapshotID string) error
+	exists(snapshotID string) bool
+	// needsHalt returns true if snapshots can not be created or deleted
+	// while the volume is in use
+	needsHalt() bool
+	// rollbackDestroysNewer returns true if the rollback destroys the
+	// snapshots created after the one we roll back to
+	rollbackDestroysNewer() bool
+}
+
+// zvolSnapshotter uses native zfs snapshots which are atomic
+type zvolSnapshotter struct {
+	zVolName string
+}
+
+func (s zvolSnapshotter) create(snapshotID string) error {
+	if output, err := zfs.CreateSnapshot(log, s.zVolName, snapshotID); err != nil {
+		return fmt.Errorf("zfs snapshot failed: %s, %s", err, output)
+	}
+	return nil
+}
+
+func (s zvolSnapshotter) rollback(snapshotID string) error {
+	if output, err := zfs.RollbackSnapshot(log, s.zVolName, snapshotID); err != nil {
+		return fmt.Errorf("zfs rollback failed: %s, %s", err, output)
+	}
+	return nil
+}
+
+func (s zvolSnapshotter) delete(snapshotID string) error {
+	if output, err := zfs.DestroySnapshot(log, s.zVolName, snapshotID); err != nil {
+		return fmt.Errorf("zfs destroy failed: %s, %s", err, output)
+	}
+	return nil
+}
+
+func (s zvolSnapshotter) exists(snapshotID string) bool {
+	snapshots, err := zfs.GetSnapshots(log, s.zVolName)
+	if err != nil {
+		log.Errorf("zvolSnapshotter.exists(%s): %s", s.zVolName, err)
+		return false
+	}
+	for _, snapshot := range snapshots {
+		if snapshot == snapshotID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s zvolSnapshotter) needsHalt() bool {
+	return false
+}
+
+func (s zvolSnapshotter) rollbackDestroysNewer() bool {
+	return true
+}
+
+// qcow2Snapshotter uses internal snapshots of qcow2 files. qemu-img must
+// not modify a file which qemu has open.
+type qcow2Snapshotter struct {
+	fileLocation string
+}
+
+func (s qcow2Snapshotter) create(snapshotID string) error {
+	return diskmetrics.CreateSnapshotImg(context.Background(), log,
+		s.fileLocation, snapshotID)
+}
+
+func (s qcow2Snapshotter) rollback(snapshotID string) error {
+	return diskmetrics.ApplySnapshotImg(context.Background(), log,
+		s.fileLocation, snapshotID)
+}
+
+func (s qcow2Snapshotter) delete(snapshotID string) error {
+	return diskmetrics.DeleteSnapshotImg(context.Background(), log,
+		s.fileLocation, snapshotID)
+}
+
+func (s qcow2Snapshotter) exists(snapshotID string) bool {
+	imgInfo, err := diskmetrics.GetImgInfo(log, s.fileLocation)
+	if err != nil {
+		log.Errorf("qcow2Snapshotter.exists(%s): %s", s.fileLocation, err)
+		return false
+	}
+	for _, snapshot := range imgInfo.Snapshots {
+		if snapshot.Name == snapshotID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s qcow2Snapshotter) needsHalt() bool {
+	return true
+}
+
+func (s qcow2Snapshotter) rollbackDestroysNewer() bool {
+	return false
+}
+
+// getSnapshotter returns the snapshotter for the volume or an error if the
+// volume does not support snapshots. It is a variable to be replaced in tests.
+var getSnapshotter = func(vs *types.VolumeStatus) (volumeSnapshotter, error) {
+	if vs.ContentFormat == zconfig.Format_CONTAINER {
+		return nil, fmt.Errorf("snapshots are not supported for container volumes")
+	}
+	info, err := os.Stat(vs.FileLocation)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeDevice != 0 {
+		return zvolSnapshotter{zVolName: vs.ZVolName()}, nil
+	}
+	imgInfo, err := diskmetrics.GetImgInfo(log, vs.FileLocation)
+	if err != nil {
+		return nil, err
+	}
+	if imgInfo.Format != "qcow2" {
+		return nil, fmt.Errorf("snapshots are not supported for %s format",
+			imgInfo.Format)
+	}
+	return qcow2Snapshotter{fileLocation: vs.FileLocation}, nil
+}
+
+// markNewerSnapshotsDestroyed sets an error for the snapshots of the same
+// volume created after the provided one
+func markNewerSnapshotsDestroyed(ctx *volumemgrContext, status *types.VolumeSnapshotStatus) {
+	for _, st := range ctx.pubVolumeSnapshotStatus.GetAll() {
+		other := st.(types.VolumeSnapshotStatus)
+		if other.VolumeKey() != status.VolumeKey() ||
+			other.SnapshotID == status.SnapshotID ||
+			other.CreateTime.IsZero() ||
+			!other.CreateTime.After(status.CreateTime) {
+			continue
+		}
+		errStr := fmt.Sprintf("snapshot destroyed by rollback to %s",
+			status.SnapshotID)
+		other.CreateTime = time.Time{}
+		other.SetErrorWithSource(errStr, types.VolumeSnapshotStatus{}, time.Now())
+		publishVolumeSnapshotStatus(ctx, &other)
+	}
+}
+
+// destroyZVolSnapshots removes all snapshots of the zvol to be able to destroy it
+func destroyZVolSnapshots(zVolName string) {
+	snapshots, err := zfs.GetSnapshots(log, zVolName)
+	if err != nil {
+		log.Errorf("destroyZVolSnapshots(%s): %s", zVolName, err)
+		return
+	}
+	for _, snapshot := range snapshots {
+		if output, err := zfs.DestroySnapshot(log, zVolName, snapshot); err != nil {
+			log.Errorf("destroyZVolSnapshots(%s): destroy %s failed: %s, %s",
+				zVolName, snapshot, err, output)
+		}
+	}
+}
+
+func publishVolumeSnapshotStatus(ctx *volumemgrContext,
+	status *types.VolumeSnapshotStatus) {
+
+	key := status.Key()
+	log.Tracef("publishVolumeSnapshotStatus(%s)", key)
+	pub := ctx.pubVolumeSnapshotStatus
+	pub.Publish(key, *status)
+	log.Tracef("publishVolumeSnapshotStatus(%s) Done", key)
+}
+
+func unpublishVolumeSnapshotStatus(ctx *volumemgrContext,
+	status *types.VolumeSnapshotStatus) {
+
+	key := status.Key()
+	log.Tracef("unpublishVolumeSnapshotStatus(%s)", key)
+	pub := ctx.pubVolumeSnapshotStatus
+	c, _ := pub.Get(key)
+	if c == nil {
+		log.Errorf("unpublishVolumeSnapshotStatus(%s) not found", key)
+		return
+	}
+	pub.Unpublish(key)
+	log.Tracef("unpublishVolumeSnapshotStatus(%s) Done", key)
+}
+
+func lookupVolumeSnapshotStatus(ctx *volumemgrContext,
+	key string) *types.VolumeSnapshotStatus {
+
+	log.Tracef("lookupVolumeSnapshotStatus(%s)", key)
+	pub := ctx.pubVolumeSnapshotStatus
+	c, _ := pub.Get(key)
+	if c == nil {
+		log.Tracef("lookupVolumeSnapshotStatus(%s) not found", key)
+		return nil
+	}
+	status := c.(types.VolumeSnapshotStatus)
+	log.Tracef("lookupVolumeSnapshotStatus(%s) Done", key)
+	return &status
+}
+
+func lookupVolumeSnapshotConfig(ctx *volumemgrContext,
+	key string) *types.VolumeSnapshotConfig {
+
+	sub := ctx.subVolumeSnapshotConfig
+	c, _ := sub.Get(key)
+	if c == nil {
+		log.Tracef("lookupVolumeSnapshotConfig(%s) not found", key)
+		return nil
+	}
+	config := c.(types.VolumeSnapshotConfig)
+	return &config
+}
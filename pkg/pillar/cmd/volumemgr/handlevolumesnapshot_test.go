@@ -0,0 +1,397 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSnapshotter records the snapshots instead of calling zfs or qemu-img
+type fakeSnapshotter struct {
+	zvol       bool
+	snapshots  map[string]bool
+	rolledBack []string
+}
+
+func (s *fakeSnapshotter) create(snapshotID string) error {
+	s.snapshots[snapshotID] = true
+	return nil
+}
+
+func (s *fakeSnapshotter) rollback(snapshotID string) error {
+	if !s.snapshots[snapshotID] {
+		return fmt.Errorf("no snapshot %s", snapshotID)
+	}
+	s.rolledBack = append(s.rolledBack, snapshotID)
+	return nil
+}
+
+func (s *fakeSnapshotter) delete(snapshotID string) error {
+	if !s.snapshots[snapshotID] {
+		return fmt.Errorf("no snapshot %s", snapshotID)
+	}
+	delete(s.snapshots, snapshotID)
+	return nil
+}
+
+func (s *fakeSnapshotter) exists(snapshotID string) bool {
+	return s.snapshots[snapshotID]
+}
+
+func (s *fakeSnapshotter) needsHalt() bool {
+	return !s.zvol
+}
+
+func (s *fakeSnapshotter) rollbackDestroysNewer() bool {
+	return s.zvol
+}
+
+type snapshotTestCtx struct {
+	t           *testing.T
+	ctx         volumemgrContext
+	snapshotter *fakeSnapshotter
+	volumeID    uuid.UUID
+}
+
+func initSnapshotTestCtx(t *testing.T, zvol bool) *snapshotTestCtx {
+	tc := &snapshotTestCtx{
+		t:           t,
+		ctx:         initStatusCtx(t),
+		snapshotter: &fakeSnapshotter{zvol: zvol, snapshots: map[string]bool{}},
+		volumeID:    uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+	}
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logrus.StandardLogger(), log)
+	pubVolumeSnapshotStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.VolumeSnapshotStatus{},
+	})
+	assert.Nil(t, err)
+	tc.ctx.pubVolumeSnapshotStatus = pubVolumeSnapshotStatus
+	tc.ctx.pubGuestFsFreezeConfig, err = ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.GuestFsFreezeConfig{},
+	})
+	assert.Nil(t, err)
+	tc.ctx.subVolumeSnapshotConfig, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleVolumeSnapshotCreate,
+		ModifyHandler: handleVolumeSnapshotModify,
+		DeleteHandler: handleVolumeSnapshotDelete,
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.VolumeSnapshotConfig{},
+		Ctx:           &tc.ctx,
+	})
+	assert.Nil(t, err)
+	tc.ctx.subDomainStatus, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleDomainStatusCreate,
+		ModifyHandler: handleDomainStatusModify,
+		DeleteHandler: handleDomainStatusDelete,
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.DomainStatus{},
+		Ctx:           &tc.ctx,
+	})
+	assert.Nil(t, err)
+	tc.ctx.subGuestFsFreezeStatus, err = ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleGuestFsFreezeStatusCreate,
+		ModifyHandler: handleGuestFsFreezeStatusModify,
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.GuestFsFreezeStatus{},
+		Ctx:           &tc.ctx,
+	})
+	assert.Nil(t, err)
+	origGetSnapshotter := getSnapshotter
+	getSnapshotter = func(vs *types.VolumeStatus) (volumeSnapshotter, error) {
+		return tc.snapshotter, nil
+	}
+	t.Cleanup(func() {
+		getSnapshotter = origGetSnapshotter
+	})
+	return tc
+}
+
+func (tc *snapshotTestCtx) volumeKey() string {
+	return fmt.Sprintf("%s#%d", tc.volumeID, 0)
+}
+
+func (tc *snapshotTestCtx) setVolumeCreated() {
+	publishVolumeStatus(&tc.ctx, &types.VolumeStatus{
+		VolumeID: tc.volumeID,
+		SubState: types.VolumeSubStateCreated,
+	})
+	updateVolumeSnapshots(&tc.ctx, tc.volumeKey())
+}
+
+func (tc *snapshotTestCtx) setSnapshotConfig(config types.VolumeSnapshotConfig,
+	op pubsub.Operation) {
+
+	b, err := json.Marshal(config)
+	assert.Nil(tc.t, err)
+	tc.ctx.subVolumeSnapshotConfig.ProcessChange(pubsub.Change{
+		Operation: op,
+		Key:       config.Key(),
+		Value:     b,
+	})
+}
+
+// setDomainStatus publishes a domain using the volume
+func (tc *snapshotTestCtx) setDomainStatus(activated bool, op pubsub.Operation) {
+	status := types.DomainStatus{
+		UUIDandVersion: types.UUIDandVersion{UUID: tc.volumeID},
+		DomainName:     "app.1",
+		Activated:      activated,
+		// see TestVolumeSnapshotCreateGuestFsFreeze for VMs
+		VirtualizationMode: types.NOHYPER,
+		DiskStatusList:     []types.DiskStatus{{VolumeKey: tc.volumeKey()}},
+	}
+	if activated {
+		status.DomainId = 1
+	}
+	b, err := json.Marshal(status)
+	assert.Nil(tc.t, err)
+	tc.ctx.subDomainStatus.ProcessChange(pubsub.Change{
+		Operation: op,
+		Key:       status.Key(),
+		Value:     b,
+	})
+}
+
+// setRunningVM publishes a running VM using the volume
+func (tc *snapshotTestCtx) setRunningVM() {
+	status := types.DomainStatus{
+		UUIDandVersion:     types.UUIDandVersion{UUID: tc.volumeID},
+		DomainName:         "app.1",
+		Activated:          true,
+		DomainId:           1,
+		State:              types.RUNNING,
+		VirtualizationMode: types.HVM,
+		DiskStatusList:     []types.DiskStatus{{VolumeKey: tc.volumeKey()}},
+	}
+	b, err := json.Marshal(status)
+	assert.Nil(tc.t, err)
+	tc.ctx.subDomainStatus.ProcessChange(pubsub.Change{
+		Operation: pubsub.Modify,
+		Key:       status.Key(),
+		Value:     b,
+	})
+}
+
+// setGuestFsFreezeStatus publishes the result of the freeze by domainmgr
+func (tc *snapshotTestCtx) setGuestFsFreezeStatus(status types.GuestFsFreezeStatus) {
+	b, err := json.Marshal(status)
+	assert.Nil(tc.t, err)
+	tc.ctx.subGuestFsFreezeStatus.ProcessChange(pubsub.Change{
+		Operation: pubsub.Modify,
+		Key:       status.Key(),
+		Value:     b,
+	})
+}
+
+func (tc *snapshotTestCtx) snapshotConfig(snapshotID string) types.VolumeSnapshotConfig {
+	return types.VolumeSnapshotConfig{
+		SnapshotID: snapshotID,
+		VolumeID:   tc.volumeID,
+	}
+}
+
+func (tc *snapshotTestCtx) snapshotStatus(snapshotID string) *types.VolumeSnapshotStatus {
+	return lookupVolumeSnapshotStatus(&tc.ctx,
+		tc.snapshotConfig(snapshotID).Key())
+}
+
+func TestVolumeSnapshotCreate(t *testing.T) {
+	tc := initSnapshotTestCtx(t, false)
+
+	// The volume is not created yet
+	tc.setSnapshotConfig(tc.snapshotConfig("s1"), pubsub.Modify)
+	status := tc.snapshotStatus("s1")
+	assert.NotNil(t, status)
+	assert.Equal(t, types.VolumeSnapshotStateWaiting, status.State)
+	assert.False(t, tc.snapshotter.exists("s1"))
+
+	tc.setVolumeCreated()
+	status = tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateCreated, status.State)
+	assert.False(t, status.CreateTime.IsZero())
+	assert.False(t, status.HasError())
+	assert.True(t, tc.snapshotter.exists("s1"))
+}
+
+func TestVolumeSnapshotCreateInUse(t *testing.T) {
+	tc := initSnapshotTestCtx(t, false)
+	tc.setVolumeCreated()
+	tc.setDomainStatus(true, pubsub.Modify)
+
+	// qcow2 snapshots wait for the domain to halt
+	tc.setSnapshotConfig(tc.snapshotConfig("s1"), pubsub.Modify)
+	status := tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateWaiting, status.State)
+	assert.True(t, status.HasError())
+	assert.False(t, tc.snapshotter.exists("s1"))
+
+	tc.setDomainStatus(false, pubsub.Modify)
+	status = tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateCreated, status.State)
+	assert.False(t, status.HasError())
+	assert.True(t, tc.snapshotter.exists("s1"))
+}
+
+func TestVolumeSnapshotCreateInUseZVol(t *testing.T) {
+	tc := initSnapshotTestCtx(t, true)
+	tc.setVolumeCreated()
+	tc.setDomainStatus(true, pubsub.Modify)
+
+	// zfs snapshots are atomic
+	tc.setSnapshotConfig(tc.snapshotConfig("s1"), pubsub.Modify)
+	status := tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateCreated, status.State)
+	assert.True(t, tc.snapshotter.exists("s1"))
+}
+
+func TestVolumeSnapshotRollback(t *testing.T) {
+	tc := initSnapshotTestCtx(t, false)
+	tc.setVolumeCreated()
+	config := tc.snapshotConfig("s1")
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	tc.setDomainStatus(true, pubsub.Modify)
+
+	config.RollbackCounter = 1
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	status := tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateCreated, status.State)
+	assert.Equal(t, uint32(0), status.RollbackCounter)
+	assert.True(t, status.HasError())
+	assert.Empty(t, tc.snapshotter.rolledBack)
+
+	tc.setDomainStatus(false, pubsub.Modify)
+	status = tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateRolledBack, status.State)
+	assert.Equal(t, uint32(1), status.RollbackCounter)
+	assert.False(t, status.HasError())
+	assert.Equal(t, []string{"s1"}, tc.snapshotter.rolledBack)
+
+	// Not rolled back again on an unrelated change
+	config.DisplayName = "renamed"
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	assert.Equal(t, []string{"s1"}, tc.snapshotter.rolledBack)
+	assert.Equal(t, "renamed", tc.snapshotStatus("s1").DisplayName)
+}
+
+func TestVolumeSnapshotRollbackZVol(t *testing.T) {
+	tc := initSnapshotTestCtx(t, true)
+	tc.setVolumeCreated()
+	config := tc.snapshotConfig("s1")
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	// make sure the creation times differ
+	status := tc.snapshotStatus("s1")
+	status.CreateTime = status.CreateTime.Add(-1)
+	publishVolumeSnapshotStatus(&tc.ctx, status)
+	tc.setSnapshotConfig(tc.snapshotConfig("s2"), pubsub.Modify)
+
+	config.RollbackCounter = 1
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	assert.Equal(t, types.VolumeSnapshotStateRolledBack,
+		tc.snapshotStatus("s1").State)
+	status = tc.snapshotStatus("s2")
+	assert.True(t, status.HasError())
+	assert.True(t, status.CreateTime.IsZero())
+}
+
+func TestVolumeSnapshotDelete(t *testing.T) {
+	tc := initSnapshotTestCtx(t, false)
+	tc.setVolumeCreated()
+	config := tc.snapshotConfig("s1")
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	tc.setDomainStatus(true, pubsub.Modify)
+
+	tc.setSnapshotConfig(config, pubsub.Delete)
+	status := tc.snapshotStatus("s1")
+	assert.NotNil(t, status)
+	assert.Equal(t, types.VolumeSnapshotStateDeleting, status.State)
+	assert.True(t, tc.snapshotter.exists("s1"))
+
+	tc.setDomainStatus(false, pubsub.Delete)
+	assert.Nil(t, tc.snapshotStatus("s1"))
+	assert.False(t, tc.snapshotter.exists("s1"))
+}
+
+func TestVolumeSnapshotDeleteCancel(t *testing.T) {
+	tc := initSnapshotTestCtx(t, false)
+	tc.setVolumeCreated()
+	config := tc.snapshotConfig("s1")
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	tc.setDomainStatus(true, pubsub.Modify)
+
+	tc.setSnapshotConfig(config, pubsub.Delete)
+	tc.setSnapshotConfig(config, pubsub.Modify)
+	status := tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateCreated, status.State)
+	assert.False(t, status.HasError())
+
+	tc.setDomainStatus(false, pubsub.Modify)
+	assert.NotNil(t, tc.snapshotStatus("s1"))
+	assert.True(t, tc.snapshotter.exists("s1"))
+}
+
+func TestVolumeSnapshotCreateGuestFsFreeze(t *testing.T) {
+	tc := initSnapshotTestCtx(t, true)
+	tc.setVolumeCreated()
+	tc.setRunningVM()
+
+	// Waits for domainmgr to freeze the file systems
+	tc.setSnapshotConfig(tc.snapshotConfig("s1"), pubsub.Modify)
+	status := tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateWaiting, status.State)
+	assert.False(t, tc.snapshotter.exists("s1"))
+	config := lookupGuestFsFreezeConfig(&tc.ctx, tc.volumeID.String())
+	if !assert.NotNil(t, config) {
+		return
+	}
+	assert.Equal(t, "app.1", config.DomainName)
+
+	// Result of an older request is ignored
+	tc.setGuestFsFreezeStatus(types.GuestFsFreezeStatus{
+		UUIDandVersion: config.UUIDandVersion,
+		Counter:        config.Counter - 1,
+		Frozen:         true,
+	})
+	assert.False(t, tc.snapshotter.exists("s1"))
+
+	tc.setGuestFsFreezeStatus(types.GuestFsFreezeStatus{
+		UUIDandVersion: config.UUIDandVersion,
+		Counter:        config.Counter,
+		Frozen:         true,
+		FrozenCount:    2,
+	})
+	status = tc.snapshotStatus("s1")
+	assert.Equal(t, types.VolumeSnapshotStateCreated, status.State)
+	assert.False(t, status.HasError())
+	assert.True(t, tc.snapshotter.exists("s1"))
+	// Unpublished config lets domainmgr thaw the file systems
+	assert.Nil(t, lookupGuestFsFreezeConfig(&tc.ctx, tc.volumeID.String()))
+
+	// Snapshot is created also if the file systems cannot be frozen
+	tc.setSnapshotConfig(tc.snapshotConfig("s2"), pubsub.Modify)
+	config = lookupGuestFsFreezeConfig(&tc.ctx, tc.volumeID.String())
+	if !assert.NotNil(t, config) {
+		return
+	}
+	tc.setGuestFsFreezeStatus(types.GuestFsFreezeStatus{
+		UUIDandVersion: config.UUIDandVersion,
+		Counter:        config.Counter,
+		Error:          "guest agent of app.1 is not connected",
+	})
+	assert.Equal(t, types.VolumeSnapshotStateCreated, tc.snapshotStatus("s2").State)
+	assert.True(t, tc.snapshotter.exists("s2"))
+	assert.Nil(t, lookupGuestFsFreezeConfig(&tc.ctx, tc.volumeID.String()))
+}
@@ -256,6 +256,8 @@ func processVolumeWorkResult(ctxPtr interface{}, res worker.WorkResult) error {
 			d.status.FileLocation = d.FileLocation
 			AddWorkDestroy(ctx, &d.status)
 		}
+	} else if d.create {
+		updateVolumeSnapshots(ctx, d.status.Key())
 	}
 	return nil
 }
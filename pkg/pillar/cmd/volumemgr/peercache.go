@@ -0,0 +1,208 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// HTTP server providing the loaded blobs from CAS to other EVE devices
+// on the same LAN. The devices discover it using mDNS and verify the sha256
+// of the received blobs, see docs/PEER-CACHE.md.
+
+package volumemgr
+
+import (
+	"io"
+	stdlog "log"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grandcat/zeroconf"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var sha256Regexp = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// peerCache keeps the listeners on the addresses of the management ports
+// and the mDNS announcement of them. It is only updated from the main loop.
+type peerCache struct {
+	ctx     *volumemgrContext
+	port    int
+	ifNames []string
+	servers map[string]*http.Server // Key is the listen address
+	mdns    *zeroconf.Server
+}
+
+func newPeerCache(ctx *volumemgrContext) *peerCache {
+	return &peerCache{
+		ctx:     ctx,
+		servers: make(map[string]*http.Server),
+	}
+}
+
+// ServeHTTP serves GET and HEAD of PeerCacheBlobPath/<sha256> for the blobs
+// which are verified and loaded into CAS.
+func (pc *peerCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, types.PeerCacheBlobPath) {
+		http.NotFound(w, r)
+		return
+	}
+	sha := strings.ToLower(strings.TrimPrefix(r.URL.Path, types.PeerCacheBlobPath))
+	if !sha256Regexp.MatchString(sha) {
+		http.NotFound(w, r)
+		return
+	}
+	blob := lookupBlobStatus(pc.ctx, sha)
+	if blob == nil || blob.State != types.LOADED {
+		http.NotFound(w, r)
+		return
+	}
+	blobHash := checkAndCorrectBlobHash(sha)
+	info, err := pc.ctx.casClient.GetBlobInfo(blobHash)
+	if err != nil {
+		log.Warnf("peerCache: %v", err)
+		http.NotFound(w, r)
+		return
+	}
+	ctrdCtx, done := pc.ctx.casClient.CtrNewUserServicesCtx()
+	defer done()
+	reader, err := pc.ctx.casClient.ReadBlob(ctrdCtx, blobHash)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+		return
+	}
+	contentType := blob.MediaType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	if r.Method == http.MethodHead {
+		return
+	}
+	log.Functionf("peerCache: serving %s to %s", sha, r.RemoteAddr)
+	if _, err := io.Copy(w, reader); err != nil {
+		log.Warnf("peerCache: sending %s to %s failed: %v", sha, r.RemoteAddr, err)
+	}
+}
+
+// peerCacheAddrs returns the management ports and the listen addresses on them
+func peerCacheAddrs(ctx *volumemgrContext, port int) ([]string, []string) {
+	var ifNames, addrs []string
+	if port == 0 {
+		return nil, nil
+	}
+	for _, p := range ctx.deviceNetworkStatus.Ports {
+		if !p.IsMgmt {
+			continue
+		}
+		var found bool
+		for _, ai := range p.AddrInfoList {
+			if ai.Addr.IsLinkLocalUnicast() {
+				continue
+			}
+			addrs = append(addrs, net.JoinHostPort(ai.Addr.String(), strconv.Itoa(port)))
+			found = true
+		}
+		if found {
+			ifNames = append(ifNames, p.IfName)
+		}
+	}
+	sort.Strings(ifNames)
+	sort.Strings(addrs)
+	return ifNames, addrs
+}
+
+// updatePeerCache starts and stops the listeners and the mDNS announcement
+// to match the global configuration and the addresses of the management ports.
+func updatePeerCache(ctx *volumemgrContext) {
+	pc := ctx.peerCache
+	if pc == nil {
+		return
+	}
+	port := int(ctx.globalConfig.GlobalValueInt(types.PeerCachePort))
+	ifNames, addrs := peerCacheAddrs(ctx, port)
+	wanted := make(map[string]bool)
+	for _, addr := range addrs {
+		wanted[addr] = true
+		if _, ok := pc.servers[addr]; ok {
+			continue
+		}
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			log.Errorf("updatePeerCache: listen on %s failed: %v", addr, err)
+			continue
+		}
+		w := logger.Writer()
+		// no write timeout as the blobs can be large
+		srv := &http.Server{
+			Addr:              addr,
+			Handler:           pc,
+			ReadHeaderTimeout: 10 * time.Second,
+			IdleTimeout:       time.Minute,
+			ErrorLog:          stdlog.New(w, "peer cache("+addr+"): ", 0),
+		}
+		pc.servers[addr] = srv
+		log.Noticef("updatePeerCache: serving blobs on %s", addr)
+		go func() {
+			defer w.Close()
+			if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+				log.Errorf("peer cache on %s failed: %v", srv.Addr, err)
+			}
+		}()
+	}
+	for addr, srv := range pc.servers {
+		if wanted[addr] {
+			continue
+		}
+		log.Noticef("updatePeerCache: stop serving blobs on %s", addr)
+		if err := srv.Close(); err != nil {
+			log.Errorf("updatePeerCache: close of %s failed: %v", addr, err)
+		}
+		delete(pc.servers, addr)
+	}
+
+	if port == pc.port && strings.Join(ifNames, ",") == strings.Join(pc.ifNames, ",") {
+		return
+	}
+	if pc.mdns != nil {
+		pc.mdns.Shutdown()
+		pc.mdns = nil
+	}
+	pc.port = port
+	pc.ifNames = ifNames
+	if len(ifNames) == 0 {
+		return
+	}
+	var ifs []net.Interface
+	for _, ifName := range ifNames {
+		intf, err := net.InterfaceByName(ifName)
+		if err != nil {
+			log.Warnf("updatePeerCache: %v", err)
+			continue
+		}
+		ifs = append(ifs, *intf)
+	}
+	instance, err := os.Hostname()
+	if err != nil {
+		log.Errorf("updatePeerCache: %v", err)
+		pc.ifNames = nil
+		return
+	}
+	pc.mdns, err = zeroconf.Register(instance, types.PeerCacheService, "local.",
+		port, []string{"path=" + types.PeerCacheBlobPath}, ifs)
+	if err != nil {
+		log.Errorf("updatePeerCache: mDNS registration failed: %v", err)
+		// retry on the next update
+		pc.ifNames = nil
+		return
+	}
+	log.Noticef("updatePeerCache: announced %s on %v", types.PeerCacheService, ifNames)
+}
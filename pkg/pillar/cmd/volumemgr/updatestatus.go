@@ -100,6 +100,7 @@ func doUpdateContentTree(ctx *volumemgrContext, status *types.ContentTreeStatus)
 					State:                  types.INITIAL,
 					CreateTime:             time.Now(),
 					LastRefCountChangeTime: time.Now(),
+					VerifySignature:        status.IsOCIRegistry(),
 				}
 				log.Functionf("doUpdateContentTree: publishing new root BlobStatus (%s) for content tree (%s)",
 					status.ContentSha256, status.ContentID)
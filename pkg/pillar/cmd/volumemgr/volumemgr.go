@@ -70,6 +70,13 @@ type volumemgrContext struct {
 	pubAppDiskMetric        pubsub.Publication
 	subDatastoreConfig      pubsub.Subscription
 	subZVolStatus           pubsub.Subscription
+	subVolumeSnapshotConfig pubsub.Subscription
+	pubVolumeSnapshotStatus pubsub.Publication
+	subDeviceNetworkStatus  pubsub.Subscription
+	subDomainStatus         pubsub.Subscription // Volumes in use by domains
+	pubGuestFsFreezeConfig  pubsub.Publication
+	subGuestFsFreezeStatus  pubsub.Subscription
+	guestFsFreezeCounter    uint32
 	diskMetricsTickerHandle interface{}
 	gc                      *time.Ticker
 	deferDelete             *time.Ticker
@@ -93,6 +100,9 @@ type volumemgrContext struct {
 	volumeConfigCreateDeferredMap map[string]*types.VolumeConfig
 
 	persistType types.PersistType
+
+	deviceNetworkStatus types.DeviceNetworkStatus
+	peerCache           *peerCache // Serves blobs to other EVE devices
 }
 
 var debug = false
@@ -240,6 +250,24 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	ctx.pubVolumeStatus = pubVolumeStatus
 
+	pubVolumeSnapshotStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.VolumeSnapshotStatus{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubVolumeSnapshotStatus = pubVolumeSnapshotStatus
+
+	pubGuestFsFreezeConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.GuestFsFreezeConfig{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubGuestFsFreezeConfig = pubGuestFsFreezeConfig
+
 	pubVolumeRefStatus, err := ps.NewPublication(pubsub.PublicationOptions{
 		AgentName:  agentName,
 		AgentScope: types.AppImgObj,
@@ -470,6 +498,23 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ctx.subZVolStatus = subZVolStatus
 	subZVolStatus.Activate()
 
+	subVolumeSnapshotConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleVolumeSnapshotCreate,
+		ModifyHandler: handleVolumeSnapshotModify,
+		DeleteHandler: handleVolumeSnapshotDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.VolumeSnapshotConfig{},
+		Ctx:           &ctx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subVolumeSnapshotConfig = subVolumeSnapshotConfig
+	subVolumeSnapshotConfig.Activate()
+
 	if ctx.casClient, err = cas.NewCAS(casClientType); err != nil {
 		err = fmt.Errorf("Run: exception while initializing CAS client: %s", err.Error())
 		log.Fatal(err)
@@ -478,6 +523,57 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	//casClient which is commonly used across volumemgr will be closed when volumemgr exits.
 	defer ctx.casClient.CloseClient()
 
+	ctx.peerCache = newPeerCache(&ctx)
+	subDeviceNetworkStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleDNSCreate,
+		ModifyHandler: handleDNSModify,
+		DeleteHandler: handleDNSDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "nim",
+		MyAgentName:   agentName,
+		TopicImpl:     types.DeviceNetworkStatus{},
+		Ctx:           &ctx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDeviceNetworkStatus = subDeviceNetworkStatus
+	subDeviceNetworkStatus.Activate()
+
+	subDomainStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleDomainStatusCreate,
+		ModifyHandler: handleDomainStatusModify,
+		DeleteHandler: handleDomainStatusDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.DomainStatus{},
+		Ctx:           &ctx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDomainStatus = subDomainStatus
+	subDomainStatus.Activate()
+
+	subGuestFsFreezeStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		CreateHandler: handleGuestFsFreezeStatusCreate,
+		ModifyHandler: handleGuestFsFreezeStatusModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.GuestFsFreezeStatus{},
+		Ctx:           &ctx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subGuestFsFreezeStatus = subGuestFsFreezeStatus
+	subGuestFsFreezeStatus.Activate()
+
 	populateInitBlobStatus(&ctx)
 
 	// First we process the verifierStatus to avoid triggering a download
@@ -564,6 +660,18 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-ctx.subZVolStatus.MsgChan():
 			ctx.subZVolStatus.ProcessChange(change)
 
+		case change := <-ctx.subVolumeSnapshotConfig.MsgChan():
+			ctx.subVolumeSnapshotConfig.ProcessChange(change)
+
+		case change := <-ctx.subDeviceNetworkStatus.MsgChan():
+			ctx.subDeviceNetworkStatus.ProcessChange(change)
+
+		case change := <-ctx.subDomainStatus.MsgChan():
+			ctx.subDomainStatus.ProcessChange(change)
+
+		case change := <-ctx.subGuestFsFreezeStatus.MsgChan():
+			ctx.subGuestFsFreezeStatus.ProcessChange(change)
+
 		case <-ctx.gc.C:
 			start := time.Now()
 			gcObjects(&ctx, volumeEncryptedDirName)
@@ -635,6 +743,7 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		maybeUpdateConfigItems(ctx, gcp)
 		ctx.globalConfig = gcp
 		ctx.GCInitialized = true
+		updatePeerCache(ctx)
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
 }
@@ -651,6 +760,7 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 	debug, _ = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
 		debugOverride, logger)
 	*ctx.globalConfig = *types.DefaultConfigItemValueMap()
+	updatePeerCache(ctx)
 	log.Functionf("handleGlobalConfigDelete done for %s", key)
 }
 
@@ -0,0 +1,158 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+// Collection of the diagnostics bundle requested by the local server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	diagMaxFileSize  = 1 << 20  // larger files are truncated
+	diagMaxTotalSize = 32 << 20 // files above the limit are skipped
+)
+
+// diagSource is a directory to include in the bundle,
+// only files with the given suffix are included if set
+type diagSource struct {
+	dir    string
+	suffix string
+}
+
+var diagSources = []diagSource{
+	// status published by the microservices
+	{dir: "/run", suffix: ".json"},
+	{dir: types.PersistStatusDir, suffix: ".json"},
+	// recently uploaded logs
+	{dir: types.NewlogKeepSentQueueDir},
+	{dir: types.NewlogUploadDevDir},
+}
+
+var diagFiles = []string{
+	types.EveVersionFile,
+	"/proc/cmdline",
+	"/proc/cpuinfo",
+	"/proc/meminfo",
+	"/proc/mounts",
+	"/proc/uptime",
+}
+
+var diagCommands = [][]string{
+	{"dmesg"},
+	{"ps", "-o", "pid,ppid,user,vsz,rss,stat,time,args"},
+	{"ip", "-d", "address"},
+	{"ip", "rule"},
+	{"ip", "route", "show", "table", "all"},
+	{"iptables-save", "-c"},
+	{"df", "-h"},
+	{"zpool", "status", "-v"},
+}
+
+// diagArchive writes files into a tar archive keeping its total size
+// below diagMaxTotalSize
+type diagArchive struct {
+	tw   *tar.Writer
+	size int64
+}
+
+func (a *diagArchive) addContent(name string, content []byte, modTime time.Time) error {
+	if len(content) > diagMaxFileSize {
+		content = content[len(content)-diagMaxFileSize:]
+	}
+	if a.size+int64(len(content)) > diagMaxTotalSize {
+		return fmt.Errorf("size limit reached, skipping %s", name)
+	}
+	hdr := &tar.Header{
+		Name:    strings.TrimLeft(name, "/"),
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	}
+	if err := a.tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if _, err := a.tw.Write(content); err != nil {
+		return err
+	}
+	a.size += int64(len(content))
+	return nil
+}
+
+func (a *diagArchive) addFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	// files in /proc report zero size, so we read them whole
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return a.addContent(path, content, info.ModTime())
+}
+
+func (a *diagArchive) addDir(src diagSource) {
+	err := filepath.Walk(src.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// skip what we cannot read
+			return nil
+		}
+		if !info.Mode().IsRegular() ||
+			(src.suffix != "" && !strings.HasSuffix(path, src.suffix)) {
+			return nil
+		}
+		if err := a.addFile(path); err != nil {
+			log.Warnf("collectDiagnostics: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warnf("collectDiagnostics: walk %s failed: %v", src.dir, err)
+	}
+}
+
+// collectDiagnostics returns a gzip-compressed tar archive with the status
+// of the microservices, recent logs and the output of a few commands
+func collectDiagnostics() ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	archive := &diagArchive{tw: tar.NewWriter(gw)}
+	for _, src := range diagSources {
+		archive.addDir(src)
+	}
+	for _, file := range diagFiles {
+		if err := archive.addFile(file); err != nil {
+			log.Warnf("collectDiagnostics: %v", err)
+		}
+	}
+	for _, cmd := range diagCommands {
+		output, err := base.Exec(log, cmd[0], cmd[1:]...).CombinedOutput()
+		if err != nil {
+			output = append(output, []byte(fmt.Sprintf("\n%s failed: %v\n", cmd[0], err))...)
+		}
+		name := "commands/" + strings.Join(cmd, "_")
+		if err := archive.addContent(name, output, time.Now()); err != nil {
+			log.Warnf("collectDiagnostics: %v", err)
+		}
+	}
+	if err := archive.tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
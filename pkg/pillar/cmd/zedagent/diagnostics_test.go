@@ -0,0 +1,67 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestDiagArchive(t *testing.T) {
+	var buf bytes.Buffer
+	archive := &diagArchive{tw: tar.NewWriter(&buf)}
+	small := []byte("small")
+	large := bytes.Repeat([]byte("a"), diagMaxFileSize)
+	large = append(large, []byte("tail")...)
+	if err := archive.addContent("/run/small.json", small, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.addContent("/run/large.json", large, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	// fill the archive up to the limit
+	for archive.size+diagMaxFileSize <= diagMaxTotalSize {
+		if err := archive.addContent("filler", large, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := archive.addContent("/run/skipped.json", large, time.Now()); err == nil {
+		t.Errorf("expected size limit error")
+	}
+	if err := archive.tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	tr := tar.NewReader(&buf)
+	contents := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents[hdr.Name] = content
+	}
+	if !bytes.Equal(contents["run/small.json"], small) {
+		t.Errorf("unexpected content of run/small.json: %s", contents["run/small.json"])
+	}
+	// large files keep their tail
+	content := contents["run/large.json"]
+	if len(content) != diagMaxFileSize || !bytes.HasSuffix(content, []byte("tail")) {
+		t.Errorf("run/large.json not truncated to the tail, size %d", len(content))
+	}
+	if _, ok := contents["run/skipped.json"]; ok {
+		t.Errorf("run/skipped.json included above the size limit")
+	}
+}
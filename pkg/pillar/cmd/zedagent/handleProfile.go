@@ -184,6 +184,7 @@ func parseProfile(ctx *getconfigContext, config *zconfig.EdgeDevConfig) {
 		ctx.globalProfile = config.GlobalProfile
 	}
 	ctx.profileServerToken = config.ProfileServerToken
+	ctx.zedagentCtx.metricsExporter.setToken(config.ProfileServerToken)
 	if ctx.localProfileServer != config.LocalProfileServer {
 		log.Noticef("parseProfile: LocalProfileServer changed from %s to %s",
 			ctx.localProfileServer, config.LocalProfileServer)
@@ -192,6 +193,10 @@ func parseProfile(ctx *getconfigContext, config *zconfig.EdgeDevConfig) {
 		triggerRadioPOST(ctx)
 		updateLocalAppInfoTicker(ctx, false)
 		triggerLocalAppInfoPOST(ctx)
+		updateLocalDevInfoTicker(ctx, false)
+		triggerLocalDevInfoPOST(ctx)
+		updateLocalMetricsTicker(ctx, false)
+		triggerLocalMetricsPOST(ctx)
 	}
 	profileStateMachine(ctx, true)
 	log.Functionf("parseProfile done globalProfile: %s currentProfile: %s",
@@ -17,6 +17,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/api/go/info"
+	"github.com/lf-edge/eve/api/go/metrics"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
 	"github.com/lf-edge/eve/pkg/pillar/hardware"
@@ -88,6 +90,7 @@ type getconfigContext struct {
 	pubContentTreeConfig     pubsub.Publication
 	subVolumeStatus          pubsub.Subscription
 	pubVolumeConfig          pubsub.Publication
+	pubVolumeSnapshotConfig  pubsub.Publication
 	pubDisksConfig           pubsub.Publication
 	NodeAgentStatus          *types.NodeAgentStatus
 	rebootFlag               bool
@@ -113,6 +116,26 @@ type getconfigContext struct {
 	localAppCommands       types.LocalAppCommands
 	localAppCommandsLock   sync.Mutex
 
+	// device info and metrics last published to the controller,
+	// posted also to the local server
+	localDevInfoPOSTTicker flextimer.FlexTickerHandle
+	localMetricsPOSTTicker flextimer.FlexTickerHandle
+	localDevInfo           *info.ZInfoMsg
+	localDevMetrics        *metrics.ZMetricMsg
+	localDevStateLock      sync.Mutex
+
+	// device and volume commands requested by the local server
+	localDevCommands     types.LocalDevCommands
+	localDevCommandsLock sync.Mutex
+	localDevCmdTriggered bool   // device command started, waiting for reboot
+	localDiagnostics     []byte // diagnostics bundle waiting to be posted
+	// packet captures requested by the local server
+	localPacketCaptures    map[string]*localPacketCapture
+	pubPacketCaptureConfig pubsub.Publication
+	subPacketCaptureStatus pubsub.Subscription
+	// content trees removed to download them again
+	removedContentTrees map[uuid.UUID]types.ContentTreeConfig
+
 	callProcessLocalProfileServerChange bool //did we already call processLocalProfileServerChange
 
 	configRetryUpdateCounter uint32 // received from config
@@ -634,6 +657,9 @@ func potentialUUIDUpdate(getconfigCtx *getconfigContext) {
 
 func publishZedAgentStatus(getconfigCtx *getconfigContext) {
 	ctx := getconfigCtx.zedagentCtx
+	getconfigCtx.localDevCommandsLock.Lock()
+	localForceFallbackCounter := getconfigCtx.localDevCommands.ForceFallbackCounter
+	getconfigCtx.localDevCommandsLock.Unlock()
 	status := types.ZedAgentStatus{
 		Name:                 agentName,
 		ConfigGetStatus:      getconfigCtx.configGetStatus,
@@ -641,7 +667,7 @@ func publishZedAgentStatus(getconfigCtx *getconfigContext) {
 		RebootReason:         ctx.currentRebootReason,
 		BootReason:           ctx.currentBootReason,
 		MaintenanceMode:      ctx.maintenanceMode,
-		ForceFallbackCounter: ctx.forceFallbackCounter,
+		ForceFallbackCounter: ctx.forceFallbackCounter + localForceFallbackCounter,
 		CurrentProfile:       getconfigCtx.currentProfile,
 		RadioSilence:         getconfigCtx.radioSilence,
 		LocalAppCommands:     getconfigCtx.localAppCommands,
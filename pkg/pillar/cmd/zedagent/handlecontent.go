@@ -39,6 +39,10 @@ func parseContentInfoConfig(ctx *getconfigContext,
 
 	contentInfoHash = newHash
 
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	ctx.removedContentTrees = make(map[uuid.UUID]types.ContentTreeConfig)
+
 	// First look for deleted ones
 	items := ctx.pubContentTreeConfig.GetAll()
 	for idStr := range items {
@@ -65,6 +69,11 @@ func parseContentInfoConfig(ctx *getconfigContext,
 		contentConfig.ContentSha256 = strings.ToLower(cfgContentTree.GetSha256())
 		contentConfig.MaxDownloadSize = cfgContentTree.GetMaxSizeBytes()
 		contentConfig.DisplayName = cfgContentTree.GetDisplayName()
+		if contentTreeRemovedLocally(ctx, contentConfig.ContentID) {
+			// Published once volumemgr removes the previous download
+			ctx.removedContentTrees[contentConfig.ContentID] = *contentConfig
+			continue
+		}
 		publishContentTreeConfig(ctx, *contentConfig)
 	}
 	ctx.pubContentTreeConfig.SignalRestarted()
@@ -121,4 +130,5 @@ func handleContentTreeStatusDelete(ctxArg interface{}, key string,
 	uuidStr := key
 	PublishContentInfoToZedCloud(ctx, uuidStr, nil, ctx.iteration)
 	ctx.iteration++
+	updateLocalVolumeCommands(ctx.getconfigCtx)
 }
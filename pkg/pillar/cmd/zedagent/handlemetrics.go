@@ -18,6 +18,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/lf-edge/eve/api/go/evecommon"
 	"github.com/lf-edge/eve/api/go/info"
 	"github.com/lf-edge/eve/api/go/metrics"
@@ -139,6 +140,57 @@ func encodeTestResults(tr types.TestResults) *info.ErrorInfo {
 	return errInfo
 }
 
+// encodeAppHealth returns nil if the application is not running and
+// health checking is not configured for it.
+func encodeAppHealth(health types.AppHealthStatus) *info.ZAppHealth {
+	if health == (types.AppHealthStatus{}) {
+		return nil
+	}
+	encodeTime := func(t time.Time) *timestamp.Timestamp {
+		if t.IsZero() {
+			return nil
+		}
+		protoTime, _ := ptypes.TimestampProto(t)
+		return protoTime
+	}
+	encodeProbe := func(probe types.AppProbeStatus) *info.ZAppProbeStatus {
+		return &info.ZAppProbeStatus{
+			Result:               info.ZAppProbeResult(probe.Result),
+			ConsecutiveFailures:  probe.ConsecutiveFailures,
+			ConsecutiveSuccesses: probe.ConsecutiveSuccesses,
+			LastProbeTime:        encodeTime(probe.LastProbeTime),
+			LastError:            probe.LastError,
+		}
+	}
+	return &info.ZAppHealth{
+		Liveness:          encodeProbe(health.Liveness),
+		Readiness:         encodeProbe(health.Readiness),
+		Ready:             health.Ready,
+		RestartCount:      health.RestartCount,
+		LastRestartReason: health.LastRestartReason,
+		LastRestartTime:   encodeTime(health.LastRestartTime),
+		NextRestartTime:   encodeTime(health.NextRestartTime),
+	}
+}
+
+// encodeGuestInfo returns nil unless the guest agent responded
+func encodeGuestInfo(guestInfo types.GuestInfo) *info.ZGuestInfo {
+	if !guestInfo.AgentConnected {
+		return nil
+	}
+	zGuestInfo := &info.ZGuestInfo{
+		AgentConnected: true,
+		OsName:         guestInfo.OSName,
+		OsVersion:      guestInfo.OSVersion,
+		KernelRelease:  guestInfo.KernelRelease,
+		Hostname:       guestInfo.Hostname,
+	}
+	for _, ip := range guestInfo.IPAddrs {
+		zGuestInfo.IpAddrs = append(zGuestInfo.IpAddrs, ip.String())
+	}
+	return zGuestInfo
+}
+
 // Run a periodic post of the metrics
 func metricsTimerTask(ctx *zedagentContext, handleChannel chan interface{}) {
 	iteration := 0
@@ -356,6 +408,7 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 	ReportDeviceMetric.Acl = aclMetric
 
 	ReportDeviceMetric.Cellular = getCellularMetrics(ctx)
+	ReportDeviceMetric.Wifi = getWifiMetrics(ctx)
 
 	zedboxStats := new(metrics.ZedboxStats)
 	zedboxStats.NumGoRoutines = uint32(runtime.NumGoroutine()) // number of zedbox goroutines
@@ -628,6 +681,7 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 		}
 	}
 	ReportDeviceMetric.DormantTimeInSeconds = getDormantTime(ctx)
+	ReportDeviceMetric.StorageMetrics = getStorageMetrics(ctx)
 
 	// Report flowlog metrics.
 	ctx.flowLogMetrics.Lock()
@@ -741,6 +795,20 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				networkDetails.TxAclRateLimitDrops = metric.RxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.TxAclRateLimitDrops
 			}
+			// Direction of traffic classes is relative to the application.
+			for _, class := range metric.TrafficClasses {
+				networkDetails.TrafficClasses = append(networkDetails.TrafficClasses,
+					&metrics.TrafficClassMetric{
+						AceId:      class.AceID,
+						Ingress:    class.Ingress,
+						Rate:       class.RateKbps,
+						Ceil:       class.CeilKbps,
+						TxBytes:    class.Bytes,
+						TxPkts:     class.Packets,
+						Drops:      class.Drops,
+						Overlimits: class.Overlimits,
+					})
+			}
 			ReportAppMetric.Network = append(ReportAppMetric.Network,
 				networkDetails)
 		}
@@ -822,6 +890,11 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 	createVolumeInstanceMetrics(ctx, ReportMetrics)
 	createProcessMetrics(ctx, ReportMetrics)
 
+	// Serve the same metrics on the local endpoint if enabled
+	ctx.metricsExporter.setMetrics(ReportMetrics)
+	setLocalDevMetrics(ctx.getconfigCtx, ReportMetrics)
+	updateMetricsExporter(ctx)
+
 	log.Tracef("PublishMetricsToZedCloud sending %s", ReportMetrics)
 	SendMetricsProtobuf(ctx.getconfigCtx, ReportMetrics, iteration)
 	log.Tracef("publishMetrics: after send, total elapse sec %v", time.Since(startPubTime).Seconds())
@@ -832,6 +905,52 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 	}
 }
 
+func getStorageMetrics(ctx *zedagentContext) (storageMetrics []*metrics.StorageMetric) {
+	for _, el := range ctx.subZFSPoolStatus.GetAll() {
+		zfsPoolStatus := el.(types.ZFSPoolStatus)
+		storageMetric := &metrics.StorageMetric{
+			PoolName:          zfsPoolStatus.PoolName,
+			ScanInProgress:    zfsPoolStatus.Scan.State == types.StorageScanStateScanning,
+			ScanProgress:      zfsPoolStatus.Scan.Progress(),
+			ScanRepairedBytes: zfsPoolStatus.Scan.Repaired,
+			ScanErrors:        zfsPoolStatus.Scan.Errors,
+		}
+		addDisks := func(disks []*types.StorageDiskState) {
+			for _, disk := range disks {
+				diskMetric := &metrics.StorageDiskMetric{
+					ReadErrors:     disk.ReadErrors,
+					WriteErrors:    disk.WriteErrors,
+					ChecksumErrors: disk.ChecksumErrors,
+				}
+				if disk.DiskName != nil {
+					diskMetric.DiskName = disk.DiskName.Name
+				}
+				storageMetric.ChecksumErrors += disk.ChecksumErrors
+				storageMetric.Disks = append(storageMetric.Disks, diskMetric)
+			}
+		}
+		addDisks(zfsPoolStatus.Disks)
+		var addChildren func(children []*types.StorageChildren)
+		addChildren = func(children []*types.StorageChildren) {
+			for _, child := range children {
+				addDisks(child.Disks)
+				addChildren(child.Children)
+			}
+		}
+		addChildren(zfsPoolStatus.Children)
+		for _, vdev := range zfsPoolStatus.Vdevs {
+			storageMetric.Vdevs = append(storageMetric.Vdevs, &metrics.StorageDiskMetric{
+				DiskName:       vdev.Name,
+				ReadErrors:     vdev.ReadErrors,
+				WriteErrors:    vdev.WriteErrors,
+				ChecksumErrors: vdev.ChecksumErrors,
+			})
+		}
+		storageMetrics = append(storageMetrics, storageMetric)
+	}
+	return storageMetrics
+}
+
 func getCellularMetrics(ctx *zedagentContext) (cellularMetrics []*metrics.CellularMetric) {
 	m, err := ctx.subWwanMetrics.Get("global")
 	if err != nil {
@@ -874,6 +993,36 @@ func getCellularMetrics(ctx *zedagentContext) (cellularMetrics []*metrics.Cellul
 	return cellularMetrics
 }
 
+func getWifiMetrics(ctx *zedagentContext) (wifiMetrics []*metrics.WifiMetric) {
+	m, err := ctx.subWlanMetrics.Get("global")
+	if err != nil {
+		log.Errorf("subWlanMetrics.Get failed: %v", err)
+		return
+	}
+	wlanMetrics, ok := m.(types.WlanMetrics)
+	if !ok {
+		log.Errorln("unexpected type of wlan metrics")
+		return
+	}
+	if wlanMetrics.Interface == "" {
+		// Not associated with any access point.
+		return
+	}
+	port := deviceNetworkStatus.GetPortByIfName(wlanMetrics.Interface)
+	if port == nil || port.Logicallabel == "" {
+		// skip unmanaged adapters
+		return
+	}
+	wifiMetrics = append(wifiMetrics, &metrics.WifiMetric{
+		Logicallabel:  port.Logicallabel,
+		Rssi:          wlanMetrics.RSSI,
+		Noise:         wlanMetrics.Noise,
+		TxBitrateKbps: wlanMetrics.TxBitrateKbps,
+		FrequencyMhz:  wlanMetrics.FrequencyMHz,
+	})
+	return wifiMetrics
+}
+
 func getDiskInfo(ctx *zedagentContext, vrs types.VolumeRefStatus, appDiskDetails *metrics.AppDiskMetric) error {
 	appDiskMetric := lookupAppDiskMetric(ctx, vrs.ActiveFileLocation)
 	if appDiskMetric == nil {
@@ -1150,6 +1299,8 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			ReportAppInfo.VolumeRefs = append(ReportAppInfo.VolumeRefs,
 				vr.VolumeID.String())
 		}
+		ReportAppInfo.Health = encodeAppHealth(aiStatus.Health)
+		ReportAppInfo.GuestInfo = encodeGuestInfo(aiStatus.GuestInfo)
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Ainfo)
@@ -1297,7 +1448,9 @@ func PublishVolumeToZedCloud(ctx *zedagentContext, uuid string,
 		}
 
 		ReportVolumeInfo.ProgressPercentage = uint32(volStatus.Progress)
-		ReportVolumeInfo.GenerationCount = volStatus.GenerationCounter
+		// Report the generation requested by the controller
+		ReportVolumeInfo.GenerationCount = volStatus.GenerationCounter -
+			volStatus.LocalGenerationCounter
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Vinfo)
@@ -1578,7 +1731,7 @@ func protoEncodeNetworkInstanceMetricProto(status types.NetworkInstanceMetrics)
 	vlanInfo.NumTrunkPorts = status.VlanMetrics.NumTrunkPorts
 	vlanInfo.VlanCounts = status.VlanMetrics.VlanCounts
 	switch status.Type {
-	case types.NetworkInstanceTypeCloud:
+	case types.NetworkInstanceTypeCloud, types.NetworkInstanceTypeMesh:
 		protoEncodeVpnInstanceMetric(status, metric)
 
 	default:
@@ -96,11 +96,21 @@ func prepareAndPublishNetworkInstanceInfoMsg(ctx *zedagentContext,
 		} else {
 			info.State = zinfo.ZNetworkInstanceState_ZNETINST_STATE_INIT
 		}
+		if status.ACLBackendError != "" {
+			// ACLs still work with the fallback backend, not an NI error
+			errInfo := new(zinfo.ErrorInfo)
+			errInfo.Description = status.ACLBackendError
+			errTime, _ := ptypes.TimestampProto(status.ACLBackendErrorTime)
+			errInfo.Timestamp = errTime
+			errInfo.Severity = zinfo.Severity_SEVERITY_WARNING
+			info.NetworkErr = append(info.NetworkErr, errInfo)
+		}
 		info.Activated = status.Activated
 
 		info.BridgeNum = uint32(status.BridgeNum)
 		info.BridgeName = status.BridgeName
 		info.BridgeIPAddr = status.BridgeIPAddr
+		info.BridgeIPv6Addr = status.BridgeIPv6Addr
 
 		for mac, addrs := range status.IPAssignments {
 			assignment := new(zinfo.ZmetIPAssignmentEntry)
@@ -317,6 +327,7 @@ func protoEncodeVpnInstanceFlowMetric(metrics types.NetworkInstanceMetrics,
 		flowStats.Name = connStats.Name
 		flowStats.Type = uint32(connStats.Type)
 		flowStats.EstTime = connStats.EstTime
+		flowStats.HandshakeAge = connStats.HandshakeAge
 
 		lEndPoint := protoEncodeVpnMetricEndPtIpAddr(connStats.LEndPoint)
 		lEndPoint.Stats = protoEncodeVpnMetricStats(connStats.LEndPoint.PktStats)
@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"regexp"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -50,7 +51,9 @@ func parseVolumeConfig(ctx *getconfigContext,
 		found := false
 		var cfgVolume *zconfig.Volume
 		for _, cfgVolume = range cfgVolumeList {
-			vKey := volumeKey(cfgVolume.GetUuid(), cfgVolume.GetGenerationCount())
+			volumeID, _ := uuid.FromString(cfgVolume.GetUuid())
+			vKey := volumeKey(cfgVolume.GetUuid(), cfgVolume.GetGenerationCount()+
+				localVolumeGenerationCounter(ctx, volumeID))
 			if vKey == idStr {
 				found = true
 				break
@@ -77,7 +80,11 @@ func parseVolumeConfig(ctx *getconfigContext,
 			volumeConfig.ContentID, _ = uuid.FromString(volumeOrigin.GetDownloadContentTreeID())
 		}
 		volumeConfig.MaxVolSize = uint64(cfgVolume.GetMaxsizebytes())
-		volumeConfig.GenerationCounter = cfgVolume.GetGenerationCount()
+		// Add generations re-created on request of the local server
+		volumeConfig.LocalGenerationCounter = localVolumeGenerationCounter(ctx,
+			volumeConfig.VolumeID)
+		volumeConfig.GenerationCounter = cfgVolume.GetGenerationCount() +
+			volumeConfig.LocalGenerationCounter
 		if cfgVolume.GetClearText() {
 			volumeConfig.Encrypted = false
 		} else {
@@ -89,12 +96,53 @@ func parseVolumeConfig(ctx *getconfigContext,
 		volumeConfig.HasNoAppReferences = checkVolumeHasNoAppReferences(ctx, cfgVolume, config)
 		publishVolumeConfig(ctx, *volumeConfig)
 	}
+	parseVolumeSnapshotConfig(ctx, cfgVolumeList)
 
 	//signal publisher restarted to apply deferred changes inside volumemgr
 	signalVolumeConfigRestarted(ctx)
 	log.Tracef("parsing volume config done\n")
 }
 
+// snapshotIDRegexp matches the names usable both for zfs and qcow2 snapshots
+var snapshotIDRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:-]+$`)
+
+// parseVolumeSnapshotConfig publishes VolumeSnapshotConfig for the snapshots
+// of the volumes and unpublishes the ones no longer in the config
+func parseVolumeSnapshotConfig(ctx *getconfigContext, cfgVolumeList []*zconfig.Volume) {
+	snapshots := make(map[string]types.VolumeSnapshotConfig)
+	for _, cfgVolume := range cfgVolumeList {
+		volumeID, _ := uuid.FromString(cfgVolume.GetUuid())
+		generationCounter := cfgVolume.GetGenerationCount() +
+			localVolumeGenerationCounter(ctx, volumeID)
+		for _, cfgSnapshot := range cfgVolume.GetSnapshots() {
+			if !snapshotIDRegexp.MatchString(cfgSnapshot.GetId()) {
+				log.Errorf("parseVolumeSnapshotConfig: invalid snapshot id %q of volume %s",
+					cfgSnapshot.GetId(), cfgVolume.GetUuid())
+				continue
+			}
+			snapshot := types.VolumeSnapshotConfig{
+				SnapshotID:        cfgSnapshot.GetId(),
+				VolumeID:          volumeID,
+				GenerationCounter: generationCounter,
+				DisplayName:       cfgSnapshot.GetDisplayName(),
+				RollbackCounter:   cfgSnapshot.GetRollbackCounter(),
+			}
+			snapshots[snapshot.Key()] = snapshot
+		}
+	}
+	pub := ctx.pubVolumeSnapshotConfig
+	for key := range pub.GetAll() {
+		if _, ok := snapshots[key]; !ok {
+			log.Functionf("parseVolumeSnapshotConfig: deleting %s", key)
+			pub.Unpublish(key)
+		}
+	}
+	for key, snapshot := range snapshots {
+		log.Tracef("parseVolumeSnapshotConfig: publishing %s", key)
+		pub.Publish(key, snapshot)
+	}
+}
+
 func signalVolumeConfigRestarted(ctx *getconfigContext) {
 	log.Trace("signalVolumeConfigRestarted")
 	pub := ctx.pubVolumeConfig
@@ -161,6 +209,7 @@ func handleVolumeStatusImpl(ctxArg interface{}, key string,
 	uuidStr := status.VolumeID.String()
 	PublishVolumeToZedCloud(ctx, uuidStr, &status, ctx.iteration)
 	ctx.iteration++
+	updateLocalVolumeCommands(ctx.getconfigCtx)
 }
 
 func handleVolumeStatusDelete(ctxArg interface{},
@@ -0,0 +1,487 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+// Device and volume commands requested by the local server in the response
+// to api/v1/devinfo.
+// The commands have the same timestamp semantics as the app commands and
+// are persisted together with the counters which zedagent adds to those
+// received from the controller:
+//  - base-OS fallback increments ForceFallbackCounter published in ZedAgentStatus
+//  - volume re-download increments the generation counter of the volume
+//    and the purge counter of the apps using it
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/profile"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	localDiagnosticsURLPath = "/api/v1/diagnostics"
+	savedDevCommandsFile    = "devcommands"
+)
+
+// initializeLocalDevCommands loads the saved device commands. It must run before
+// ZedAgentStatus and the saved config are published, since they include
+// the local counters.
+func initializeLocalDevCommands(ctx *getconfigContext) {
+	ctx.removedContentTrees = make(map[uuid.UUID]types.ContentTreeConfig)
+	ctx.localPacketCaptures = make(map[string]*localPacketCapture)
+	loadSavedDevCommands(ctx)
+	// zedagent starts once per boot, hence the reboot or fallback
+	// requested before has completed.
+	devCmd := &ctx.localDevCommands.Dev
+	if !devCmd.Completed && (devCmd.Command == types.DevCommandReboot ||
+		devCmd.Command == types.DevCommandBaseOsFallback) {
+		log.Noticef("Local device command completed by reboot: %+v", *devCmd)
+		devCmd.Completed = true
+		devCmd.LastCompletedTimestamp = devCmd.LocalServerTimestamp
+	}
+	// Write the initial content, or the content loaded and updated.
+	persistDevCommands(ctx.localDevCommands)
+}
+
+func processReceivedDevCommands(ctx *getconfigContext, devCmdReq *profile.LocalDevCmd) {
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	if devCmdReq == nil {
+		// Nothing requested by local server, just refresh the persisted commands.
+		touchDevCommands()
+		return
+	}
+	// Packet captures are not persisted, they do not survive reboot.
+	processReceivedPacketCaptures(ctx, devCmdReq.PacketCaptures)
+	var cmdChanges bool
+	if devCmdReq.Command != profile.LocalDevCmd_COMMAND_UNSPECIFIED {
+		command := types.DevCommand(devCmdReq.Command)
+		devCmd := &ctx.localDevCommands.Dev
+		if devCmd.Command != command ||
+			devCmd.LocalServerTimestamp != devCmdReq.Timestamp {
+			devCmd.Command = command
+			devCmd.LocalServerTimestamp = devCmdReq.Timestamp
+			devCmd.DeviceTimestamp = time.Now()
+			devCmd.Completed = false
+			ctx.localDevCmdTriggered = false
+			ctx.localDiagnostics = nil
+			cmdChanges = true
+		}
+	}
+	for _, volCmdReq := range devCmdReq.VolumeCommands {
+		var err error
+		volumeUUID := nilUUID
+		if volCmdReq.Id != "" {
+			volumeUUID, err = uuid.FromString(volCmdReq.Id)
+			if err != nil {
+				log.Warnf("Failed to parse UUID from volume command request: %v", err)
+				continue
+			}
+		}
+		displayName := volCmdReq.Displayname
+		if volumeUUID == nilUUID && displayName == "" {
+			log.Warnf("Volume command request is missing both UUID and display name: %+v",
+				volCmdReq)
+			continue
+		}
+		if volCmdReq.Command == profile.VolumeCommand_COMMAND_UNSPECIFIED {
+			log.Warnf("Volume command request is missing the command: %+v", volCmdReq)
+			continue
+		}
+		vc := findVolumeConfig(ctx, volumeUUID, displayName)
+		if vc == nil {
+			log.Warnf("Failed to find volume with UUID=%s, displayName=%s",
+				volumeUUID, displayName)
+			continue
+		}
+		command := types.VolumeCommand(volCmdReq.Command)
+		volCmd := ctx.localDevCommands.LookupByVolumeUUID(vc.VolumeID)
+		if volCmd == nil {
+			ctx.localDevCommands.Volumes = append(ctx.localDevCommands.Volumes,
+				types.LocalVolumeCommand{VolumeUUID: vc.VolumeID})
+			volCmd = &ctx.localDevCommands.Volumes[len(ctx.localDevCommands.Volumes)-1]
+		} else if volCmd.Command == command &&
+			volCmd.LocalServerTimestamp == volCmdReq.Timestamp {
+			// already accepted
+			continue
+		} else if !volCmd.Completed {
+			log.Warnf("Volume command %+v is still running, ignoring %+v",
+				*volCmd, volCmdReq)
+			continue
+		}
+		volCmd.Command = command
+		volCmd.LocalServerTimestamp = volCmdReq.Timestamp
+		volCmd.DeviceTimestamp = time.Now()
+		volCmd.State = types.VolumeCommandStateUnspecified
+		volCmd.Completed = false
+		cmdChanges = true
+	}
+	if cmdChanges {
+		persistDevCommands(ctx.localDevCommands)
+	} else {
+		// No new command to run, just refresh the persisted commands.
+		touchDevCommands()
+	}
+}
+
+// runLocalDevCommand starts the requested device command unless it has
+// completed already. Collection of diagnostics is retried until it is
+// delivered to the local server.
+func runLocalDevCommand(ctx *getconfigContext) {
+	ctx.localDevCommandsLock.Lock()
+	devCmd := ctx.localDevCommands.Dev
+	ctx.localDevCommandsLock.Unlock()
+	if devCmd.Completed || devCmd.Command == types.DevCommandUnspecified ||
+		ctx.localDevCmdTriggered {
+		return
+	}
+	switch devCmd.Command {
+	case types.DevCommandReboot:
+		log.Noticef("Triggering local reboot: %+v", devCmd)
+		ctx.localDevCmdTriggered = true
+		if ctx.updateInprogress {
+			// Wait until TestComplete
+			log.Warnf("Local reboot requested while testing inprogress; defer")
+			ctx.zedagentCtx.rebootCmdDeferred = true
+			return
+		}
+		// Completes once the device boots again.
+		handleRebootCmd(ctx.zedagentCtx, "NORMAL: local profile server requested reboot")
+
+	case types.DevCommandBaseOsFallback:
+		if err := checkBaseOsFallback(ctx.zedagentCtx); err != nil {
+			log.Warnf("Ignoring local base-OS fallback: %v", err)
+			completeLocalDevCommand(ctx)
+			return
+		}
+		log.Noticef("Triggering local base-OS fallback: %+v", devCmd)
+		ctx.localDevCmdTriggered = true
+		ctx.localDevCommandsLock.Lock()
+		ctx.localDevCommands.ForceFallbackCounter++
+		persistDevCommands(ctx.localDevCommands)
+		ctx.localDevCommandsLock.Unlock()
+		// baseosmgr marks the other partition as updating and
+		// nodeagent reboots the device.
+		publishZedAgentStatus(ctx)
+
+	case types.DevCommandCollectDiagnostics:
+		if err := postLocalDiagnostics(ctx, devCmd.LocalServerTimestamp); err != nil {
+			log.Errorf("postLocalDiagnostics: %v", err)
+			return
+		}
+		log.Noticef("Local diagnostics collection completed: %+v", devCmd)
+		ctx.localDiagnostics = nil
+		completeLocalDevCommand(ctx)
+	}
+}
+
+func completeLocalDevCommand(ctx *getconfigContext) {
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	devCmd := &ctx.localDevCommands.Dev
+	devCmd.Completed = true
+	devCmd.LastCompletedTimestamp = devCmd.LocalServerTimestamp
+	persistDevCommands(ctx.localDevCommands)
+}
+
+// checkBaseOsFallback checks the same conditions as baseosmgr does before
+// it falls back to the other partition.
+func checkBaseOsFallback(ctx *zedagentContext) error {
+	curPart := getZbootPartitionStatus(ctx, getZbootCurrentPartition(ctx))
+	if curPart == nil {
+		return fmt.Errorf("no current partition status")
+	}
+	if curPart.PartitionState != "active" {
+		return fmt.Errorf("current partition state %s not active",
+			curPart.PartitionState)
+	}
+	otherPart := getZbootPartitionStatus(ctx, getZbootOtherPartition(ctx))
+	if otherPart == nil {
+		return fmt.Errorf("no other partition status")
+	}
+	if otherPart.ShortVersion == "" {
+		return fmt.Errorf("other partition has no version")
+	}
+	if otherPart.PartitionState != "unused" {
+		return fmt.Errorf("other partition state %s not unused",
+			otherPart.PartitionState)
+	}
+	return nil
+}
+
+// postLocalDiagnostics collects the diagnostics bundle, unless collected
+// by the previous attempt, and posts it to the local server.
+func postLocalDiagnostics(ctx *getconfigContext, cmdTimestamp uint64) error {
+	if ctx.localDiagnostics == nil {
+		content, err := collectDiagnostics()
+		if err != nil {
+			return fmt.Errorf("collectDiagnostics: %v", err)
+		}
+		ctx.localDiagnostics = content
+	}
+	msg := &profile.LocalDiagnostics{
+		CmdTimestamp: cmdTimestamp,
+		Filename: fmt.Sprintf("eve-diag-%s-%s.tar.gz", devUUID,
+			time.Now().UTC().Format("20060102T150405Z")),
+		Content: ctx.localDiagnostics,
+	}
+	statusCode, err := postToLocalServer(ctx, localDiagnosticsURLPath, msg, nil)
+	if err != nil {
+		return err
+	}
+	switch statusCode {
+	case 0:
+		return fmt.Errorf("local server is not available")
+	case http.StatusNotFound:
+		log.Warnf("Local server does not implement %s, dropping diagnostics",
+			localDiagnosticsURLPath)
+	}
+	return nil
+}
+
+// updateLocalVolumeCommands moves the volume commands forward as volumemgr
+// removes the content trees and creates the volumes.
+func updateLocalVolumeCommands(ctx *getconfigContext) {
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	var changed bool
+	for i := range ctx.localDevCommands.Volumes {
+		if updateLocalVolumeCommand(ctx, &ctx.localDevCommands.Volumes[i]) {
+			changed = true
+		}
+	}
+	if changed {
+		persistDevCommands(ctx.localDevCommands)
+	}
+}
+
+// updateLocalVolumeCommand is called with localDevCommandsLock held.
+// Returns true if the command was updated.
+func updateLocalVolumeCommand(ctx *getconfigContext, volCmd *types.LocalVolumeCommand) bool {
+	if volCmd.Completed || volCmd.Command != types.VolumeCommandRedownload {
+		return false
+	}
+	vc := findVolumeConfig(ctx, volCmd.VolumeUUID, "")
+	if vc == nil {
+		log.Warnf("Volume of the local command %+v was removed", *volCmd)
+		if volCmd.State == types.VolumeCommandStateRemovingContent {
+			restoreContentTree(ctx, volCmd.ContentID)
+		}
+		volCmd.Completed = true
+		volCmd.LastCompletedTimestamp = volCmd.LocalServerTimestamp
+		return true
+	}
+	switch volCmd.State {
+	case types.VolumeCommandStateUnspecified:
+		volCmd.ContentID = vc.ContentID
+		if vc.ContentID != nilUUID && removeContentTree(ctx, vc.ContentID) {
+			log.Noticef("Local volume re-download: removing content tree %s of %s",
+				vc.ContentID, vc.Key())
+			volCmd.State = types.VolumeCommandStateRemovingContent
+			return true
+		}
+		recreateVolume(ctx, volCmd, *vc)
+		return true
+
+	case types.VolumeCommandStateRemovingContent:
+		contentKey := volCmd.ContentID.String()
+		if st, _ := ctx.subContentTreeStatus.Get(contentKey); st != nil {
+			if c, _ := ctx.pubContentTreeConfig.Get(contentKey); c == nil {
+				log.Functionf("Local volume re-download: waiting for removal of %s",
+					contentKey)
+				return false
+			}
+			// Restored for another volume using the same content tree.
+		} else {
+			restoreContentTree(ctx, volCmd.ContentID)
+		}
+		recreateVolume(ctx, volCmd, *vc)
+		return true
+
+	case types.VolumeCommandStateRecreating:
+		st, _ := ctx.subVolumeStatus.Get(vc.Key())
+		if st == nil {
+			return false
+		}
+		status := st.(types.VolumeStatus)
+		if status.SubState != types.VolumeSubStateCreated {
+			return false
+		}
+		log.Noticef("Local volume re-download completed: %+v", *volCmd)
+		volCmd.Completed = true
+		volCmd.LastCompletedTimestamp = volCmd.LocalServerTimestamp
+		return true
+	}
+	return false
+}
+
+// removeContentTree unpublishes the content tree config to make volumemgr
+// remove the downloaded content. Returns false if there is nothing to remove.
+func removeContentTree(ctx *getconfigContext, contentID uuid.UUID) bool {
+	c, _ := ctx.pubContentTreeConfig.Get(contentID.String())
+	if c == nil {
+		return false
+	}
+	ctx.removedContentTrees[contentID] = c.(types.ContentTreeConfig)
+	unpublishContentTreeConfig(ctx, contentID.String())
+	return true
+}
+
+// restoreContentTree publishes the content tree config again
+// to download the content.
+func restoreContentTree(ctx *getconfigContext, contentID uuid.UUID) {
+	config, ok := ctx.removedContentTrees[contentID]
+	if !ok {
+		// Removed by the controller or not parsed yet.
+		return
+	}
+	delete(ctx.removedContentTrees, contentID)
+	publishContentTreeConfig(ctx, config)
+}
+
+// contentTreeRemovedLocally returns true if the content tree is being removed
+// to download it again. Called with localDevCommandsLock held.
+func contentTreeRemovedLocally(ctx *getconfigContext, contentID uuid.UUID) bool {
+	for _, volCmd := range ctx.localDevCommands.Volumes {
+		if !volCmd.Completed && volCmd.ContentID == contentID &&
+			volCmd.State == types.VolumeCommandStateRemovingContent {
+			return true
+		}
+	}
+	return false
+}
+
+// recreateVolume publishes the next generation of the volume and purges
+// the apps using it to switch to the new volume.
+func recreateVolume(ctx *getconfigContext, volCmd *types.LocalVolumeCommand,
+	vc types.VolumeConfig) {
+
+	oldKey := vc.Key()
+	volCmd.LocalGenerationCounter++
+	volCmd.State = types.VolumeCommandStateRecreating
+	vc.GenerationCounter++
+	vc.LocalGenerationCounter = volCmd.LocalGenerationCounter
+	log.Noticef("Local volume re-download: re-creating %s as %s", oldKey, vc.Key())
+	unpublishVolumeConfig(ctx, oldKey)
+	publishVolumeConfig(ctx, vc)
+
+	for _, c := range ctx.pubAppInstanceConfig.GetAll() {
+		appInstance := c.(types.AppInstanceConfig)
+		var found bool
+		for i := range appInstance.VolumeRefConfigList {
+			vrc := &appInstance.VolumeRefConfigList[i]
+			if vrc.VolumeID == vc.VolumeID {
+				vrc.GenerationCounter++
+				found = true
+			}
+		}
+		if !found {
+			continue
+		}
+		appInstance.PurgeCmd.Counter++
+		log.Noticef("Local volume re-download: purging %s", appInstance.DisplayName)
+		ctx.pubAppInstanceConfig.Publish(appInstance.Key(), appInstance)
+	}
+}
+
+// localVolumeGenerationCounter returns the local generation counter
+// of the volume to add to the counter received from the controller.
+func localVolumeGenerationCounter(ctx *getconfigContext, volumeID uuid.UUID) int64 {
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	return ctx.localDevCommands.VolumeGenerationCounter(volumeID)
+}
+
+// addLocalVolumeGenerations adds the local generation counters of the volumes
+// to the volume references and to the purge counter of the app.
+func addLocalVolumeGenerations(ctx *getconfigContext, appInstance *types.AppInstanceConfig) {
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	for i := range appInstance.VolumeRefConfigList {
+		vrc := &appInstance.VolumeRefConfigList[i]
+		localGen := ctx.localDevCommands.VolumeGenerationCounter(vrc.VolumeID)
+		vrc.GenerationCounter += localGen
+		appInstance.PurgeCmd.Counter += uint32(localGen)
+	}
+}
+
+func findVolumeConfig(ctx *getconfigContext, volumeUUID uuid.UUID,
+	displayName string) *types.VolumeConfig {
+	for _, c := range ctx.pubVolumeConfig.GetAll() {
+		vc := c.(types.VolumeConfig)
+		if (volumeUUID == nilUUID || volumeUUID == vc.VolumeID) &&
+			(displayName == "" || displayName == vc.DisplayName) {
+			return &vc
+		}
+	}
+	return nil
+}
+
+func prepareLocalVolumesInfo(ctx *getconfigContext) []*profile.LocalVolumeInfo {
+	ctx.localDevCommandsLock.Lock()
+	defer ctx.localDevCommandsLock.Unlock()
+	var volumesInfo []*profile.LocalVolumeInfo
+	for _, volCmd := range ctx.localDevCommands.Volumes {
+		volumeInfo := &profile.LocalVolumeInfo{
+			Id:               volCmd.VolumeUUID.String(),
+			LastCmdTimestamp: volCmd.LastCompletedTimestamp,
+		}
+		if vc := findVolumeConfig(ctx, volCmd.VolumeUUID, ""); vc != nil {
+			volumeInfo.Displayname = vc.DisplayName
+		}
+		volumesInfo = append(volumesInfo, volumeInfo)
+	}
+	return volumesInfo
+}
+
+func readSavedDevCommands() (types.LocalDevCommands, error) {
+	devCommands := types.LocalDevCommands{}
+	// The local counters must not be lost, hence we ignore StaleConfigTime.
+	contents, ts, err := readSavedConfig(0,
+		filepath.Join(checkpointDirname, savedDevCommandsFile), true)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return devCommands, nil
+		}
+		return devCommands, err
+	}
+	err = json.Unmarshal(contents, &devCommands)
+	if err != nil {
+		return devCommands, err
+	}
+	log.Noticef("Using saved device commands dated %s",
+		ts.Format(time.RFC3339Nano))
+	return devCommands, nil
+}
+
+// loadSavedDevCommands reads saved device commands and sets it.
+func loadSavedDevCommands(ctx *getconfigContext) {
+	devCommands, err := readSavedDevCommands()
+	if err != nil {
+		log.Errorf("readSavedDevCommands failed: %v", err)
+		return
+	}
+	log.Noticef("Starting with device commands: %+v", devCommands)
+	ctx.localDevCommands = devCommands
+}
+
+func persistDevCommands(cmds types.LocalDevCommands) {
+	contents, err := json.Marshal(cmds)
+	if err != nil {
+		log.Fatalf("persistDevCommands: Marshalling failed: %v", err)
+	}
+	saveConfig(savedDevCommandsFile, contents)
+}
+
+// touchDevCommands is used to update the modification time of the persisted
+// device commands.
+func touchDevCommands() {
+	touchSavedConfig(savedDevCommandsFile)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lf-edge/eve/api/go/info"
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/lf-edge/eve/api/go/profile"
+	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
+)
+
+const (
+	localDevInfoURLPath              = "/api/v1/devinfo"
+	localMetricsURLPath              = "/api/v1/metrics"
+	localDevPOSTInterval             = time.Minute
+	localDevPOSTThrottledInterval    = time.Hour
+	localDevInfoWatchdogSuffix       = "-localdevinfo"
+	localMetricsWatchdogSuffix       = "-localmetrics"
+	localDevStillRunningTickInterval = 25 * time.Second
+)
+
+var (
+	throttledLocalDevInfo bool
+	throttledLocalMetrics bool
+)
+
+// updateLocalDevPOSTTicker sets ticker options to the initial value
+// if throttle set, will use localDevPOSTThrottledInterval as interval
+func updateLocalDevPOSTTicker(ticker flextimer.FlexTickerHandle, throttle bool) {
+	interval := float64(localDevPOSTInterval)
+	if throttle {
+		interval = float64(localDevPOSTThrottledInterval)
+	}
+	max := 1.1 * interval
+	min := 0.8 * max
+	ticker.UpdateRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func updateLocalDevInfoTicker(ctx *getconfigContext, throttle bool) {
+	throttledLocalDevInfo = throttle
+	updateLocalDevPOSTTicker(ctx.localDevInfoPOSTTicker, throttle)
+}
+
+func updateLocalMetricsTicker(ctx *getconfigContext, throttle bool) {
+	throttledLocalMetrics = throttle
+	updateLocalDevPOSTTicker(ctx.localMetricsPOSTTicker, throttle)
+}
+
+func initializeLocalDevInfo(ctx *getconfigContext) {
+	max := 1.1 * float64(localDevPOSTInterval)
+	min := 0.8 * max
+	ctx.localDevInfoPOSTTicker = flextimer.NewRangeTicker(time.Duration(min), time.Duration(max))
+	ctx.localMetricsPOSTTicker = flextimer.NewRangeTicker(time.Duration(min), time.Duration(max))
+}
+
+func triggerLocalDevInfoPOST(ctx *getconfigContext) {
+	log.Functionf("Triggering POST for %s to local server", localDevInfoURLPath)
+	if throttledLocalDevInfo {
+		log.Functionln("throttledLocalDevInfo flag set")
+		return
+	}
+	ctx.localDevInfoPOSTTicker.TickNow()
+}
+
+func triggerLocalMetricsPOST(ctx *getconfigContext) {
+	log.Functionf("Triggering POST for %s to local server", localMetricsURLPath)
+	if throttledLocalMetrics {
+		log.Functionln("throttledLocalMetrics flag set")
+		return
+	}
+	ctx.localMetricsPOSTTicker.TickNow()
+}
+
+// setLocalDevInfo remembers the device info last published to the controller
+// to be posted to the local server as well.
+func setLocalDevInfo(ctx *getconfigContext, devInfo *info.ZInfoMsg) {
+	ctx.localDevStateLock.Lock()
+	ctx.localDevInfo = devInfo
+	ctx.localDevStateLock.Unlock()
+	triggerLocalDevInfoPOST(ctx)
+}
+
+// setLocalDevMetrics remembers the metrics last published to the controller
+// to be posted to the local server on the next tick.
+func setLocalDevMetrics(ctx *getconfigContext, devMetrics *metrics.ZMetricMsg) {
+	ctx.localDevStateLock.Lock()
+	ctx.localDevMetrics = devMetrics
+	ctx.localDevStateLock.Unlock()
+}
+
+func getLocalDevInfo(ctx *getconfigContext) *info.ZInfoMsg {
+	ctx.localDevStateLock.Lock()
+	defer ctx.localDevStateLock.Unlock()
+	return ctx.localDevInfo
+}
+
+func getLocalDevMetrics(ctx *getconfigContext) *metrics.ZMetricMsg {
+	ctx.localDevStateLock.Lock()
+	defer ctx.localDevStateLock.Unlock()
+	return ctx.localDevMetrics
+}
+
+// Run a periodic POST request to send information message about the device
+// to local server and optionally receive device and volume commands to run
+// in the response.
+func localDevInfoPOSTTask(ctx *getconfigContext) {
+	localDevPOSTTask(ctx, ctx.localDevInfoPOSTTicker, localDevInfoWatchdogSuffix,
+		"localDevInfoPOSTTask", func(ctx *getconfigContext) {
+			devCmd := postLocalDevInfo(ctx)
+			processReceivedDevCommands(ctx, devCmd)
+			runLocalDevCommand(ctx)
+			updateLocalVolumeCommands(ctx)
+			uploadLocalPacketCaptures(ctx)
+		})
+}
+
+// Run a periodic POST request to send device and app metrics to local server.
+func localMetricsPOSTTask(ctx *getconfigContext) {
+	localDevPOSTTask(ctx, ctx.localMetricsPOSTTicker, localMetricsWatchdogSuffix,
+		"localMetricsPOSTTask", postLocalMetrics)
+}
+
+func localDevPOSTTask(ctx *getconfigContext, ticker flextimer.FlexTickerHandle,
+	wdSuffix, taskName string, post func(*getconfigContext)) {
+
+	log.Functionf("%s: waiting for the first tick", taskName)
+	// wait for the first trigger
+	<-ticker.C
+	log.Functionf("%s: waiting for the first tick done", taskName)
+	// trigger again to pass into the loop
+	ticker.TickNow()
+
+	wdName := agentName + wdSuffix
+
+	// Run a periodic timer so we always update StillRunning
+	stillRunning := time.NewTicker(localDevStillRunningTickInterval)
+	ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	ctx.zedagentCtx.ps.RegisterFileWatchdog(wdName)
+
+	for {
+		select {
+		case <-ticker.C:
+			start := time.Now()
+			post(ctx)
+			ctx.zedagentCtx.ps.CheckMaxTimeTopic(wdName, taskName, start,
+				warningTime, errorTime)
+		case <-stillRunning.C:
+		}
+		ctx.zedagentCtx.ps.StillRunning(wdName, warningTime, errorTime)
+	}
+}
+
+// Post the last device info published to the controller to the local server
+// and optionally receive device and volume commands to run in the response.
+func postLocalDevInfo(ctx *getconfigContext) *profile.LocalDevCmd {
+	devInfo := getLocalDevInfo(ctx)
+	if devInfo == nil {
+		return nil
+	}
+	ctx.localDevCommandsLock.Lock()
+	lastCmdTimestamp := ctx.localDevCommands.Dev.LastCompletedTimestamp
+	ctx.localDevCommandsLock.Unlock()
+	localDevInfo := &profile.LocalDevInfo{
+		Info:             devInfo,
+		LastCmdTimestamp: lastCmdTimestamp,
+		VolumesInfo:      prepareLocalVolumesInfo(ctx),
+		PacketCaptures:   prepareLocalPacketCapturesInfo(ctx),
+	}
+	devCmd := &profile.LocalDevCmd{}
+	statusCode, err := postToLocalServer(ctx, localDevInfoURLPath, localDevInfo, devCmd)
+	if err != nil {
+		log.Errorf("postLocalDevInfo: %v", err)
+		return nil
+	}
+	// Throttle sending to be about once per hour if not implemented.
+	updateLocalDevInfoTicker(ctx, statusCode == http.StatusNotFound)
+	if statusCode != http.StatusOK ||
+		(devCmd.Command == profile.LocalDevCmd_COMMAND_UNSPECIFIED &&
+			len(devCmd.VolumeCommands) == 0 && len(devCmd.PacketCaptures) == 0) {
+		return nil
+	}
+	if devCmd.GetServerToken() != ctx.profileServerToken {
+		log.Errorf("postLocalDevInfo: invalid token submitted by local server (%s)",
+			devCmd.GetServerToken())
+		return nil
+	}
+	return devCmd
+}
+
+// Post the last metrics published to the controller to the local server.
+func postLocalMetrics(ctx *getconfigContext) {
+	devMetrics := getLocalDevMetrics(ctx)
+	if devMetrics == nil {
+		return
+	}
+	statusCode, err := postToLocalServer(ctx, localMetricsURLPath,
+		&profile.LocalDevMetrics{Metrics: devMetrics}, nil)
+	if err != nil {
+		log.Errorf("postLocalMetrics: %v", err)
+		return
+	}
+	// Throttle sending to be about once per hour if not implemented.
+	updateLocalMetricsTicker(ctx, statusCode == http.StatusNotFound)
+}
+
+// postToLocalServer sends the request to the first local server address which
+// accepts it or reports that the API is not implemented, and returns
+// the response status code. The response is unmarshalled into resp unless nil.
+// Returns status code 0 and no error if the local server is not configured.
+func postToLocalServer(ctx *getconfigContext, urlPath string,
+	req, resp proto.Message) (int, error) {
+	localProfileServer := ctx.localProfileServer
+	if localProfileServer == "" {
+		return 0, nil
+	}
+	localServerURL, err := makeLocalServerBaseURL(localProfileServer)
+	if err != nil {
+		return 0, fmt.Errorf("makeLocalServerBaseURL: %v", err)
+	}
+	if !ctx.localServerMap.upToDate {
+		err := updateLocalServerMap(ctx, localServerURL)
+		if err != nil {
+			return 0, fmt.Errorf("updateLocalServerMap: %v", err)
+		}
+	}
+	srvMap := ctx.localServerMap.servers
+	if len(srvMap) == 0 {
+		log.Functionf("postToLocalServer: cannot find any configured apps for localServerURL: %s",
+			localServerURL)
+		return 0, nil
+	}
+
+	var errList []string
+	for bridgeName, servers := range srvMap {
+		for _, srv := range servers {
+			fullURL := srv.localServerAddr + urlPath
+			httpResp, err := zedcloud.SendLocalProto(
+				zedcloudCtx, fullURL, bridgeName, srv.bridgeIP, req, resp)
+			if err != nil {
+				errList = append(errList, fmt.Sprintf("SendLocalProto: %v", err))
+				continue
+			}
+			switch httpResp.StatusCode {
+			case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+				return httpResp.StatusCode, nil
+			default:
+				errList = append(errList, fmt.Sprintf("SendLocal: wrong response status code: %d",
+					httpResp.StatusCode))
+			}
+		}
+	}
+	return 0, fmt.Errorf("all attempts to POST %s failed: %s",
+		urlPath, strings.Join(errList, ";"))
+}
@@ -275,6 +275,7 @@ func prepareLocalInfo(ctx *getconfigContext) *profile.LocalAppInfoList {
 		zinfoAppInst.Name = ais.DisplayName
 		zinfoAppInst.Err = encodeErrorInfo(ais.ErrorAndTimeWithSource.ErrorDescription)
 		zinfoAppInst.State = ais.State.ZSwState()
+		zinfoAppInst.Health = encodeAppHealth(ais.Health)
 		ctx.localAppCommandsLock.Lock()
 		for _, appCmd := range ctx.localAppCommands.Cmds {
 			if appCmd.AppUUID == ais.UUIDandVersion.UUID {
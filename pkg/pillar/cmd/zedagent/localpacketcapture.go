@@ -0,0 +1,336 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+// Packet captures requested by the local server in the response
+// to api/v1/devinfo. Captures are run by zedrouter, their progress
+// is reported back in LocalDevInfo and the capture files are posted
+// to api/v1/packetcapture once completed.
+// All functions run from the localDevInfoPOSTTask goroutine.
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/lf-edge/eve/api/go/profile"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	localPacketCaptureURLPath = "/api/v1/packetcapture"
+	// Captures (including ended ones) remembered to report their state.
+	maxLocalPacketCaptures = 16
+)
+
+// Capture ID is used as the pubsub key and as the file name.
+var packetCaptureIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+
+// localPacketCapture : packet capture requested by the local server.
+type localPacketCapture struct {
+	config   types.PacketCaptureConfig
+	received time.Time
+	// Request was rejected by zedagent.
+	err string
+	// Set once the capture file is posted to the local server.
+	uploaded bool
+	// Set once the capture file is posted or dropped because the local server
+	// does not implement the API. The config is then removed, zedrouter
+	// removes the file and the status, and the last status is reported.
+	lastStatus *types.PacketCaptureStatus
+}
+
+func (c *localPacketCapture) ended(ctx *getconfigContext) bool {
+	if c.err != "" || c.lastStatus != nil {
+		return true
+	}
+	status := lookupPacketCaptureStatus(ctx, c.config.ID)
+	return status != nil && status.Ended()
+}
+
+func lookupPacketCaptureStatus(ctx *getconfigContext,
+	id string) *types.PacketCaptureStatus {
+	st, _ := ctx.subPacketCaptureStatus.Get(id)
+	if st == nil {
+		return nil
+	}
+	status := st.(types.PacketCaptureStatus)
+	return &status
+}
+
+// processReceivedPacketCaptures starts new captures and stops those
+// requested to stop.
+func processReceivedPacketCaptures(ctx *getconfigContext,
+	requests []*profile.PacketCaptureRequest) {
+	for _, req := range requests {
+		if !packetCaptureIDRegexp.MatchString(req.Id) {
+			log.Warnf("Invalid packet capture ID in request: %+v", req)
+			continue
+		}
+		if capture := ctx.localPacketCaptures[req.Id]; capture != nil {
+			if req.Stop && !capture.config.Stop && !capture.ended(ctx) {
+				log.Noticef("Local server requested to stop packet capture %s", req.Id)
+				capture.config.Stop = true
+				publishPacketCaptureConfig(ctx, capture.config)
+			}
+			continue
+		}
+		removeOldestPacketCapture(ctx)
+		capture := &localPacketCapture{
+			config: types.PacketCaptureConfig{
+				ID:       req.Id,
+				Filter:   req.Filter,
+				Duration: time.Duration(req.DurationSec) * time.Second,
+				MaxSize:  req.MaxSize,
+				SnapLen:  req.SnapLen,
+				Stop:     req.Stop,
+			},
+			received: time.Now(),
+		}
+		ctx.localPacketCaptures[req.Id] = capture
+		if err := parsePacketCaptureTarget(ctx, req, &capture.config); err != nil {
+			log.Warnf("Rejected packet capture request %+v: %v", req, err)
+			capture.err = err.Error()
+			continue
+		}
+		log.Noticef("Local server requested packet capture %s on %s",
+			req.Id, capture.config.Target())
+		publishPacketCaptureConfig(ctx, capture.config)
+	}
+}
+
+func parsePacketCaptureTarget(ctx *getconfigContext,
+	req *profile.PacketCaptureRequest, config *types.PacketCaptureConfig) error {
+	var targets int
+	if req.NetworkInstance != "" {
+		targets++
+		niConfig := findNetworkInstanceConfig(ctx, req.NetworkInstance)
+		if niConfig == nil {
+			return fmt.Errorf("network instance %s not found", req.NetworkInstance)
+		}
+		config.TargetType = types.PacketCaptureTargetNetworkInstance
+		config.NetworkInstance = niConfig.UUID
+	}
+	if req.AppInstance != "" {
+		targets++
+		appConfig := findAppInstanceConfig(ctx, req.AppInstance)
+		if appConfig == nil {
+			return fmt.Errorf("app instance %s not found", req.AppInstance)
+		}
+		config.TargetType = types.PacketCaptureTargetAppInterface
+		config.AppInstance = appConfig.UUIDandVersion.UUID
+		config.AppIntfIndex = int(req.AppInterfaceIndex)
+	}
+	if req.Port != "" {
+		targets++
+		config.TargetType = types.PacketCaptureTargetPort
+		config.Port = req.Port
+	}
+	switch targets {
+	case 0:
+		return fmt.Errorf("capture target is not specified")
+	case 1:
+		return nil
+	}
+	return fmt.Errorf("more than one capture target is specified")
+}
+
+// findNetworkInstanceConfig references network instance by UUID or displayname.
+func findNetworkInstanceConfig(ctx *getconfigContext,
+	ref string) *types.NetworkInstanceConfig {
+	refUUID, _ := uuid.FromString(ref)
+	for _, c := range ctx.pubNetworkInstanceConfig.GetAll() {
+		config := c.(types.NetworkInstanceConfig)
+		if (refUUID != nilUUID && config.UUID == refUUID) ||
+			config.DisplayName == ref {
+			return &config
+		}
+	}
+	return nil
+}
+
+// findAppInstanceConfig references app instance by UUID or displayname.
+func findAppInstanceConfig(ctx *getconfigContext,
+	ref string) *types.AppInstanceConfig {
+	refUUID, _ := uuid.FromString(ref)
+	for _, c := range ctx.pubAppInstanceConfig.GetAll() {
+		config := c.(types.AppInstanceConfig)
+		if (refUUID != nilUUID && config.UUIDandVersion.UUID == refUUID) ||
+			config.DisplayName == ref {
+			return &config
+		}
+	}
+	return nil
+}
+
+// removeOldestPacketCapture makes room for a new capture if the limit
+// of remembered captures is reached. Running captures are not removed.
+func removeOldestPacketCapture(ctx *getconfigContext) {
+	if len(ctx.localPacketCaptures) < maxLocalPacketCaptures {
+		return
+	}
+	var oldest *localPacketCapture
+	for _, capture := range ctx.localPacketCaptures {
+		if !capture.ended(ctx) {
+			continue
+		}
+		if oldest == nil || capture.received.Before(oldest.received) {
+			oldest = capture
+		}
+	}
+	if oldest == nil {
+		return
+	}
+	log.Noticef("Forgetting packet capture %s", oldest.config.ID)
+	unpublishPacketCaptureConfig(ctx, oldest.config.ID)
+	delete(ctx.localPacketCaptures, oldest.config.ID)
+}
+
+func publishPacketCaptureConfig(ctx *getconfigContext,
+	config types.PacketCaptureConfig) {
+	if err := ctx.pubPacketCaptureConfig.Publish(config.Key(), config); err != nil {
+		log.Errorf("Failed to publish packet capture config %s: %v", config.ID, err)
+	}
+}
+
+func unpublishPacketCaptureConfig(ctx *getconfigContext, id string) {
+	if c, _ := ctx.pubPacketCaptureConfig.Get(id); c == nil {
+		return
+	}
+	if err := ctx.pubPacketCaptureConfig.Unpublish(id); err != nil {
+		log.Errorf("Failed to unpublish packet capture config %s: %v", id, err)
+	}
+}
+
+// uploadLocalPacketCaptures posts files of completed captures to the local
+// server. Upload is retried until the server accepts the file or reports
+// that the API is not implemented.
+func uploadLocalPacketCaptures(ctx *getconfigContext) {
+	for _, capture := range ctx.localPacketCaptures {
+		if capture.uploaded || capture.err != "" {
+			continue
+		}
+		status := lookupPacketCaptureStatus(ctx, capture.config.ID)
+		if status == nil || status.State != types.PacketCaptureStateCompleted ||
+			status.Filename == "" {
+			continue
+		}
+		uploaded, err := postLocalPacketCapture(ctx, *status)
+		if err != nil {
+			log.Errorf("postLocalPacketCapture(%s): %v", status.ID, err)
+			continue
+		}
+		capture.lastStatus = status
+		if uploaded {
+			log.Noticef("Packet capture %s posted to local server", status.ID)
+			capture.uploaded = true
+		} else {
+			log.Warnf("Local server does not implement %s, dropping packet capture %s",
+				localPacketCaptureURLPath, status.ID)
+		}
+		// zedrouter removes the file together with the status.
+		unpublishPacketCaptureConfig(ctx, capture.config.ID)
+	}
+}
+
+// postLocalPacketCapture returns false if the local server does not
+// implement the API.
+func postLocalPacketCapture(ctx *getconfigContext,
+	status types.PacketCaptureStatus) (bool, error) {
+	// The file may be removed by zedrouter to make room for another capture,
+	// the status then changes to failed.
+	content, err := ioutil.ReadFile(status.Filename)
+	if err != nil {
+		return false, err
+	}
+	msg := &profile.LocalPacketCapture{
+		Id: status.ID,
+		Filename: fmt.Sprintf("eve-%s-%s-%s.pcapng", devUUID, status.ID,
+			status.StartTime.UTC().Format("20060102T150405Z")),
+		Content: content,
+	}
+	statusCode, err := postToLocalServer(ctx, localPacketCaptureURLPath, msg, nil)
+	if err != nil {
+		return false, err
+	}
+	switch statusCode {
+	case 0:
+		return false, fmt.Errorf("local server is not available")
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return true, nil
+}
+
+func prepareLocalPacketCapturesInfo(ctx *getconfigContext) []*profile.PacketCaptureInfo {
+	var captures []*localPacketCapture
+	for _, capture := range ctx.localPacketCaptures {
+		captures = append(captures, capture)
+	}
+	sort.Slice(captures, func(i, j int) bool {
+		return captures[i].received.Before(captures[j].received)
+	})
+	var infos []*profile.PacketCaptureInfo
+	for _, capture := range captures {
+		info := &profile.PacketCaptureInfo{
+			Id:    capture.config.ID,
+			State: profile.PacketCaptureState_PACKET_CAPTURE_STATE_PENDING,
+		}
+		infos = append(infos, info)
+		if capture.err != "" {
+			info.State = profile.PacketCaptureState_PACKET_CAPTURE_STATE_FAILED
+			info.Error = capture.err
+			continue
+		}
+		status := capture.lastStatus
+		if status == nil {
+			status = lookupPacketCaptureStatus(ctx, capture.config.ID)
+		}
+		if status == nil {
+			continue
+		}
+		info.State = profile.PacketCaptureState(status.State)
+		if capture.uploaded {
+			info.State = profile.PacketCaptureState_PACKET_CAPTURE_STATE_UPLOADED
+		}
+		info.Interface = status.Interface
+		if !status.StartTime.IsZero() {
+			info.StartTime, _ = ptypes.TimestampProto(status.StartTime)
+		}
+		if !status.EndTime.IsZero() {
+			info.EndTime, _ = ptypes.TimestampProto(status.EndTime)
+		}
+		info.Packets = status.Packets
+		info.Size = status.Size
+		info.Truncated = status.Truncated
+		info.Error = status.Error
+	}
+	return infos
+}
+
+// handlePacketCaptureStatusImpl triggers the POST of the device info
+// to report the end of a capture and to upload its file.
+func handlePacketCaptureStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	ctx := ctxArg.(*zedagentContext)
+	status := statusArg.(types.PacketCaptureStatus)
+	if status.Ended() {
+		triggerLocalDevInfoPOST(ctx.getconfigCtx)
+	}
+}
+
+func handlePacketCaptureStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handlePacketCaptureStatusImpl(ctxArg, key, statusArg)
+}
+
+func handlePacketCaptureStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handlePacketCaptureStatusImpl(ctxArg, key, statusArg)
+}
@@ -0,0 +1,466 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Local HTTP endpoint exposing the device and application metrics
+// in the OpenMetrics text format.
+
+package zedagent
+
+import (
+	"crypto/subtle"
+	stdlog "log"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsExporterURLPath = "/metrics"
+	bytesInMB              = 1024 * 1024
+)
+
+// metricsExporter serves the last metrics message built by publishMetrics.
+// The endpoint listens on the addresses of a single management port or
+// network instance bridge selected by the global configuration, and
+// requests must carry the profile server token as a bearer token.
+type metricsExporter struct {
+	sync.Mutex
+	lastMetrics *metrics.ZMetricMsg
+	token       string
+	servers     map[string]*http.Server // Key is the listen address
+	handler     http.Handler
+}
+
+func newMetricsExporter() *metricsExporter {
+	exp := &metricsExporter{
+		servers: make(map[string]*http.Server),
+	}
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(exp)
+	mux := http.NewServeMux()
+	mux.Handle(metricsExporterURLPath, exp.authorize(
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+			ErrorHandling:     promhttp.ContinueOnError,
+			EnableOpenMetrics: true,
+		})))
+	exp.handler = mux
+	return exp
+}
+
+func (exp *metricsExporter) setMetrics(msg *metrics.ZMetricMsg) {
+	exp.Lock()
+	exp.lastMetrics = msg
+	exp.Unlock()
+}
+
+func (exp *metricsExporter) setToken(token string) {
+	exp.Lock()
+	exp.token = token
+	exp.Unlock()
+}
+
+// authorize rejects requests without the profile server token.
+// Without the token configured by the controller nothing is served.
+func (exp *metricsExporter) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exp.Lock()
+		token := exp.token
+		exp.Unlock()
+		auth := r.Header.Get("Authorization")
+		const prefix = "Bearer "
+		if token == "" || !strings.HasPrefix(auth, prefix) ||
+			subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(token)) != 1 {
+			log.Functionf("metricsExporter: unauthorized request from %s", r.RemoteAddr)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// metricsExporterAddrs returns the listen addresses for the configured
+// interface, which is a logical label or interface name of a port or
+// a display name or bridge name of a network instance.
+func metricsExporterAddrs(ctx *zedagentContext) []string {
+	port := ctx.globalConfig.GlobalValueInt(types.MetricsExporterPort)
+	intf := ctx.globalConfig.GlobalValueString(types.MetricsExporterInterface)
+	if port == 0 || intf == "" {
+		return nil
+	}
+	var ips []net.IP
+	for _, p := range deviceNetworkStatus.Ports {
+		if p.Logicallabel != intf && p.IfName != intf {
+			continue
+		}
+		for _, ai := range p.AddrInfoList {
+			ips = append(ips, ai.Addr)
+		}
+	}
+	if len(ips) == 0 {
+		for _, item := range ctx.subNetworkInstanceStatus.GetAll() {
+			status := item.(types.NetworkInstanceStatus)
+			if status.DisplayName != intf && status.BridgeName != intf {
+				continue
+			}
+			if ip := net.ParseIP(status.BridgeIPAddr); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	var addrs []string
+	for _, ip := range ips {
+		if ip.IsLinkLocalUnicast() {
+			continue
+		}
+		addrs = append(addrs, net.JoinHostPort(ip.String(), strconv.Itoa(int(port))))
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// updateMetricsExporter starts and stops listeners to match the global
+// configuration and the current addresses of the selected interface.
+func updateMetricsExporter(ctx *zedagentContext) {
+	exp := ctx.metricsExporter
+	if exp == nil {
+		return
+	}
+	addrs := metricsExporterAddrs(ctx)
+	exp.Lock()
+	defer exp.Unlock()
+	wanted := make(map[string]bool)
+	for _, addr := range addrs {
+		wanted[addr] = true
+		if _, ok := exp.servers[addr]; ok {
+			continue
+		}
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			log.Errorf("updateMetricsExporter: listen on %s failed: %v", addr, err)
+			continue
+		}
+		w := logger.Writer()
+		srv := &http.Server{
+			Addr:         addr,
+			Handler:      exp.handler,
+			ReadTimeout:  10 * time.Second,
+			WriteTimeout: 30 * time.Second,
+			ErrorLog:     stdlog.New(w, "metrics exporter("+addr+"): ", 0),
+		}
+		exp.servers[addr] = srv
+		log.Noticef("updateMetricsExporter: serving metrics on %s", addr)
+		go func() {
+			defer w.Close()
+			if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+				log.Errorf("metrics exporter on %s failed: %v", srv.Addr, err)
+			}
+		}()
+	}
+	for addr, srv := range exp.servers {
+		if wanted[addr] {
+			continue
+		}
+		log.Noticef("updateMetricsExporter: stop serving metrics on %s", addr)
+		if err := srv.Close(); err != nil {
+			log.Errorf("updateMetricsExporter: close of %s failed: %v", addr, err)
+		}
+		delete(exp.servers, addr)
+	}
+}
+
+var (
+	devIntfLabels = []string{"interface", "ifname"}
+	devDiskLabels = []string{"disk", "mount_path"}
+	zcLabels      = []string{"interface"}
+	zcURLLabels   = []string{"interface", "url"}
+	poolLabels    = []string{"pool"}
+	poolDskLabels = []string{"pool", "disk"}
+	appLabels     = []string{"app_id", "app_name"}
+	appIntfLabels = []string{"app_id", "app_name", "interface"}
+	appDiskLabels = []string{"app_id", "app_name", "disk"}
+	niLabels      = []string{"network_instance_id", "network_instance_name"}
+	volLabels     = []string{"volume_id", "volume_name"}
+
+	metricsTimestampDesc = newMetricDesc("eve_metrics_timestamp_seconds",
+		"Time when the metrics were collected", nil)
+	devCPUDesc = newMetricDesc("eve_device_cpu_seconds_total",
+		"CPU time used by the device", nil)
+	devMemTotalDesc = newMetricDesc("eve_device_memory_bytes",
+		"Total memory of the device", nil)
+	devMemAppsDesc = newMetricDesc("eve_device_memory_allocated_apps_bytes",
+		"Memory allocated to the applications", nil)
+	devMemEveDesc = newMetricDesc("eve_device_memory_allocated_eve_bytes",
+		"Memory allocated to EVE", nil)
+	devMemEveUsedDesc = newMetricDesc("eve_device_memory_used_eve_bytes",
+		"Memory used by EVE", nil)
+	devDormantDesc = newMetricDesc("eve_device_dormant_time_seconds",
+		"Time to wait between metrics before the controller marks the device as inactive", nil)
+	devRxBytesDesc = newMetricDesc("eve_device_network_receive_bytes_total",
+		"Bytes received on the port", devIntfLabels)
+	devTxBytesDesc = newMetricDesc("eve_device_network_transmit_bytes_total",
+		"Bytes transmitted on the port", devIntfLabels)
+	devRxPktsDesc = newMetricDesc("eve_device_network_receive_packets_total",
+		"Packets received on the port", devIntfLabels)
+	devTxPktsDesc = newMetricDesc("eve_device_network_transmit_packets_total",
+		"Packets transmitted on the port", devIntfLabels)
+	devRxDropsDesc = newMetricDesc("eve_device_network_receive_drops_total",
+		"Received packets dropped on the port", devIntfLabels)
+	devTxDropsDesc = newMetricDesc("eve_device_network_transmit_drops_total",
+		"Transmitted packets dropped on the port", devIntfLabels)
+	devRxErrorsDesc = newMetricDesc("eve_device_network_receive_errors_total",
+		"Receive errors on the port", devIntfLabels)
+	devTxErrorsDesc = newMetricDesc("eve_device_network_transmit_errors_total",
+		"Transmit errors on the port", devIntfLabels)
+	devDiskReadDesc = newMetricDesc("eve_device_disk_read_bytes_total",
+		"Bytes read from the disk", devDiskLabels)
+	devDiskWriteDesc = newMetricDesc("eve_device_disk_written_bytes_total",
+		"Bytes written to the disk", devDiskLabels)
+	devDiskReadOpsDesc = newMetricDesc("eve_device_disk_reads_total",
+		"Read operations on the disk", devDiskLabels)
+	devDiskWriteOpsDesc = newMetricDesc("eve_device_disk_writes_total",
+		"Write operations on the disk", devDiskLabels)
+	devDiskTotalDesc = newMetricDesc("eve_device_disk_size_bytes",
+		"Size of the filesystem", devDiskLabels)
+	devDiskUsedDesc = newMetricDesc("eve_device_disk_used_bytes",
+		"Used space of the filesystem", devDiskLabels)
+	devDiskFreeDesc = newMetricDesc("eve_device_disk_free_bytes",
+		"Free space of the filesystem", devDiskLabels)
+	zcSuccessDesc = newMetricDesc("eve_zedcloud_success_total",
+		"Successful requests to the controller", zcLabels)
+	zcFailuresDesc = newMetricDesc("eve_zedcloud_failures_total",
+		"Failed requests to the controller", zcLabels)
+	zcAuthFailuresDesc = newMetricDesc("eve_zedcloud_auth_verify_failures_total",
+		"Responses from the controller failing the authentication", zcLabels)
+	zcSentMsgsDesc = newMetricDesc("eve_zedcloud_url_sent_messages_total",
+		"Messages sent to the controller URL", zcURLLabels)
+	zcSentBytesDesc = newMetricDesc("eve_zedcloud_url_sent_bytes_total",
+		"Bytes sent to the controller URL", zcURLLabels)
+	zcRecvMsgsDesc = newMetricDesc("eve_zedcloud_url_received_messages_total",
+		"Messages received from the controller URL", zcURLLabels)
+	zcRecvBytesDesc = newMetricDesc("eve_zedcloud_url_received_bytes_total",
+		"Bytes received from the controller URL", zcURLLabels)
+	poolScanDesc = newMetricDesc("eve_storage_pool_scan_in_progress",
+		"Scrub or resilver of the pool is running", poolLabels)
+	poolScanProgressDesc = newMetricDesc("eve_storage_pool_scan_progress_percent",
+		"Progress of the current or the last scrub or resilver", poolLabels)
+	poolScanRepairedDesc = newMetricDesc("eve_storage_pool_scan_repaired_bytes",
+		"Bytes repaired by the current or the last scrub or resilver", poolLabels)
+	poolScanErrorsDesc = newMetricDesc("eve_storage_pool_scan_errors",
+		"Errors of the current or the last scrub or resilver", poolLabels)
+	poolDiskReadErrDesc = newMetricDesc("eve_storage_pool_disk_read_errors",
+		"Read errors of the disk in the pool", poolDskLabels)
+	poolDiskWriteErrDesc = newMetricDesc("eve_storage_pool_disk_write_errors",
+		"Write errors of the disk in the pool", poolDskLabels)
+	poolDiskCksumErrDesc = newMetricDesc("eve_storage_pool_disk_checksum_errors",
+		"Checksum errors of the disk in the pool", poolDskLabels)
+	appCPUDesc = newMetricDesc("eve_app_cpu_seconds_total",
+		"CPU time used by the application", appLabels)
+	appMemAllocDesc = newMetricDesc("eve_app_memory_allocated_bytes",
+		"Memory allocated to the application", appLabels)
+	appMemUsedDesc = newMetricDesc("eve_app_memory_used_bytes",
+		"Memory used by the application", appLabels)
+	appRxBytesDesc = newMetricDesc("eve_app_network_receive_bytes_total",
+		"Bytes received by the application interface", appIntfLabels)
+	appTxBytesDesc = newMetricDesc("eve_app_network_transmit_bytes_total",
+		"Bytes transmitted by the application interface", appIntfLabels)
+	appRxPktsDesc = newMetricDesc("eve_app_network_receive_packets_total",
+		"Packets received by the application interface", appIntfLabels)
+	appTxPktsDesc = newMetricDesc("eve_app_network_transmit_packets_total",
+		"Packets transmitted by the application interface", appIntfLabels)
+	appRxDropsDesc = newMetricDesc("eve_app_network_receive_drops_total",
+		"Received packets dropped on the application interface", appIntfLabels)
+	appTxDropsDesc = newMetricDesc("eve_app_network_transmit_drops_total",
+		"Transmitted packets dropped on the application interface", appIntfLabels)
+	appRxACLDropsDesc = newMetricDesc("eve_app_network_receive_acl_drops_total",
+		"Received packets dropped by ACLs", appIntfLabels)
+	appTxACLDropsDesc = newMetricDesc("eve_app_network_transmit_acl_drops_total",
+		"Transmitted packets dropped by ACLs", appIntfLabels)
+	appDiskProvDesc = newMetricDesc("eve_app_disk_provisioned_bytes",
+		"Provisioned size of the application disk", appDiskLabels)
+	appDiskUsedDesc = newMetricDesc("eve_app_disk_used_bytes",
+		"Used space of the application disk", appDiskLabels)
+	niRxBytesDesc = newMetricDesc("eve_network_instance_receive_bytes_total",
+		"Bytes received on the network instance bridge", niLabels)
+	niTxBytesDesc = newMetricDesc("eve_network_instance_transmit_bytes_total",
+		"Bytes transmitted on the network instance bridge", niLabels)
+	niRxPktsDesc = newMetricDesc("eve_network_instance_receive_packets_total",
+		"Packets received on the network instance bridge", niLabels)
+	niTxPktsDesc = newMetricDesc("eve_network_instance_transmit_packets_total",
+		"Packets transmitted on the network instance bridge", niLabels)
+	niRxDropsDesc = newMetricDesc("eve_network_instance_receive_drops_total",
+		"Received packets dropped on the network instance bridge", niLabels)
+	niTxDropsDesc = newMetricDesc("eve_network_instance_transmit_drops_total",
+		"Transmitted packets dropped on the network instance bridge", niLabels)
+	niRxErrorsDesc = newMetricDesc("eve_network_instance_receive_errors_total",
+		"Receive errors on the network instance bridge", niLabels)
+	niTxErrorsDesc = newMetricDesc("eve_network_instance_transmit_errors_total",
+		"Transmit errors on the network instance bridge", niLabels)
+	volReadDesc = newMetricDesc("eve_volume_read_bytes_total",
+		"Bytes read from the volume", volLabels)
+	volWriteDesc = newMetricDesc("eve_volume_written_bytes_total",
+		"Bytes written to the volume", volLabels)
+	volReadOpsDesc = newMetricDesc("eve_volume_reads_total",
+		"Read operations on the volume", volLabels)
+	volWriteOpsDesc = newMetricDesc("eve_volume_writes_total",
+		"Write operations on the volume", volLabels)
+	volTotalDesc = newMetricDesc("eve_volume_size_bytes",
+		"Size of the volume", volLabels)
+	volUsedDesc = newMetricDesc("eve_volume_used_bytes",
+		"Used space of the volume", volLabels)
+)
+
+func newMetricDesc(name, help string, labels []string) *prometheus.Desc {
+	return prometheus.NewDesc(name, help, labels, nil)
+}
+
+// Describe implements prometheus.Collector.
+// The set of the metrics depends on the content of the last metrics
+// message thus we do not describe them upfront.
+func (exp *metricsExporter) Describe(chan<- *prometheus.Desc) {
+}
+
+// Collect implements prometheus.Collector.
+func (exp *metricsExporter) Collect(ch chan<- prometheus.Metric) {
+	exp.Lock()
+	msg := exp.lastMetrics
+	exp.Unlock()
+	if msg == nil {
+		return
+	}
+	counter := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value, labels...)
+	}
+	gauge := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labels...)
+	}
+	if ts := msg.GetAtTimeStamp(); ts != nil {
+		gauge(metricsTimestampDesc, float64(ts.AsTime().UnixNano())/float64(time.Second))
+	}
+
+	dm := msg.GetDm()
+	if dm != nil {
+		if cpu := dm.GetCpuMetric(); cpu != nil {
+			counter(devCPUDesc, float64(cpu.GetTotalNs())/float64(time.Second))
+		}
+		if mem := dm.GetDeviceMemory(); mem != nil {
+			gauge(devMemTotalDesc, float64(mem.GetMemoryMB())*bytesInMB)
+			gauge(devMemAppsDesc, float64(mem.GetAllocatedAppsMB())*bytesInMB)
+			gauge(devMemEveDesc, float64(mem.GetAllocatedEveMB())*bytesInMB)
+			gauge(devMemEveUsedDesc, float64(mem.GetUsedEveMB())*bytesInMB)
+		}
+		gauge(devDormantDesc, float64(dm.GetDormantTimeInSeconds()))
+		for _, nm := range dm.GetNetwork() {
+			labels := []string{nm.GetIName(), nm.GetLocalName()}
+			counter(devRxBytesDesc, float64(nm.GetRxBytes()), labels...)
+			counter(devTxBytesDesc, float64(nm.GetTxBytes()), labels...)
+			counter(devRxPktsDesc, float64(nm.GetRxPkts()), labels...)
+			counter(devTxPktsDesc, float64(nm.GetTxPkts()), labels...)
+			counter(devRxDropsDesc, float64(nm.GetRxDrops()), labels...)
+			counter(devTxDropsDesc, float64(nm.GetTxDrops()), labels...)
+			counter(devRxErrorsDesc, float64(nm.GetRxErrors()), labels...)
+			counter(devTxErrorsDesc, float64(nm.GetTxErrors()), labels...)
+		}
+		for _, disk := range dm.GetDisk() {
+			labels := []string{disk.GetDisk(), disk.GetMountPath()}
+			counter(devDiskReadDesc, float64(disk.GetReadBytes())*bytesInMB, labels...)
+			counter(devDiskWriteDesc, float64(disk.GetWriteBytes())*bytesInMB, labels...)
+			counter(devDiskReadOpsDesc, float64(disk.GetReadCount()), labels...)
+			counter(devDiskWriteOpsDesc, float64(disk.GetWriteCount()), labels...)
+			if disk.GetMountPath() != "" {
+				gauge(devDiskTotalDesc, float64(disk.GetTotal())*bytesInMB, labels...)
+				gauge(devDiskUsedDesc, float64(disk.GetUsed())*bytesInMB, labels...)
+				gauge(devDiskFreeDesc, float64(disk.GetFree())*bytesInMB, labels...)
+			}
+		}
+		for _, zc := range dm.GetZedcloud() {
+			counter(zcSuccessDesc, float64(zc.GetSuccess()), zc.GetIfName())
+			counter(zcFailuresDesc, float64(zc.GetFailures()), zc.GetIfName())
+			counter(zcAuthFailuresDesc, float64(zc.GetAuthVerifyFailure()), zc.GetIfName())
+			for _, um := range zc.GetUrlMetrics() {
+				labels := []string{zc.GetIfName(), um.GetUrl()}
+				counter(zcSentMsgsDesc, float64(um.GetSentMsgCount()), labels...)
+				counter(zcSentBytesDesc, float64(um.GetSentByteCount()), labels...)
+				counter(zcRecvMsgsDesc, float64(um.GetRecvMsgCount()), labels...)
+				counter(zcRecvBytesDesc, float64(um.GetRecvByteCount()), labels...)
+			}
+		}
+		for _, sm := range dm.GetStorageMetrics() {
+			scanInProgress := 0.0
+			if sm.GetScanInProgress() {
+				scanInProgress = 1
+			}
+			gauge(poolScanDesc, scanInProgress, sm.GetPoolName())
+			gauge(poolScanProgressDesc, float64(sm.GetScanProgress()), sm.GetPoolName())
+			gauge(poolScanRepairedDesc, float64(sm.GetScanRepairedBytes()), sm.GetPoolName())
+			gauge(poolScanErrorsDesc, float64(sm.GetScanErrors()), sm.GetPoolName())
+			for _, disk := range sm.GetDisks() {
+				labels := []string{sm.GetPoolName(), disk.GetDiskName()}
+				gauge(poolDiskReadErrDesc, float64(disk.GetReadErrors()), labels...)
+				gauge(poolDiskWriteErrDesc, float64(disk.GetWriteErrors()), labels...)
+				gauge(poolDiskCksumErrDesc, float64(disk.GetChecksumErrors()), labels...)
+			}
+		}
+	}
+
+	for _, am := range msg.GetAm() {
+		labels := []string{am.GetAppID(), am.GetAppName()}
+		if cpu := am.GetCpu(); cpu != nil {
+			counter(appCPUDesc, float64(cpu.GetTotalNs())/float64(time.Second), labels...)
+		}
+		if mem := am.GetAppMemory(); mem != nil {
+			gauge(appMemAllocDesc, float64(mem.GetAllocatedMB())*bytesInMB, labels...)
+			gauge(appMemUsedDesc, float64(mem.GetUsedMB())*bytesInMB, labels...)
+		}
+		for _, nm := range am.GetNetwork() {
+			intfLabels := append(labels[:len(labels):len(labels)], nm.GetIName())
+			counter(appRxBytesDesc, float64(nm.GetRxBytes()), intfLabels...)
+			counter(appTxBytesDesc, float64(nm.GetTxBytes()), intfLabels...)
+			counter(appRxPktsDesc, float64(nm.GetRxPkts()), intfLabels...)
+			counter(appTxPktsDesc, float64(nm.GetTxPkts()), intfLabels...)
+			counter(appRxDropsDesc, float64(nm.GetRxDrops()), intfLabels...)
+			counter(appTxDropsDesc, float64(nm.GetTxDrops()), intfLabels...)
+			counter(appRxACLDropsDesc, float64(nm.GetRxAclDrops()), intfLabels...)
+			counter(appTxACLDropsDesc, float64(nm.GetTxAclDrops()), intfLabels...)
+		}
+		for _, disk := range am.GetDisk() {
+			diskLabels := append(labels[:len(labels):len(labels)], disk.GetDisk())
+			gauge(appDiskProvDesc, float64(disk.GetProvisioned())*bytesInMB, diskLabels...)
+			gauge(appDiskUsedDesc, float64(disk.GetUsed())*bytesInMB, diskLabels...)
+		}
+	}
+
+	for _, nm := range msg.GetNm() {
+		stats := nm.GetNetworkStats()
+		if stats == nil {
+			continue
+		}
+		labels := []string{nm.GetNetworkID(), nm.GetDisplayname()}
+		counter(niRxBytesDesc, float64(stats.GetRx().GetTotalBytes()), labels...)
+		counter(niTxBytesDesc, float64(stats.GetTx().GetTotalBytes()), labels...)
+		counter(niRxPktsDesc, float64(stats.GetRx().GetTotalPackets()), labels...)
+		counter(niTxPktsDesc, float64(stats.GetTx().GetTotalPackets()), labels...)
+		counter(niRxDropsDesc, float64(stats.GetRx().GetDrops()), labels...)
+		counter(niTxDropsDesc, float64(stats.GetTx().GetDrops()), labels...)
+		counter(niRxErrorsDesc, float64(stats.GetRx().GetErrors()), labels...)
+		counter(niTxErrorsDesc, float64(stats.GetTx().GetErrors()), labels...)
+	}
+
+	for _, vm := range msg.GetVm() {
+		labels := []string{vm.GetUuid(), vm.GetDisplayName()}
+		counter(volReadDesc, float64(vm.GetReadBytes()), labels...)
+		counter(volWriteDesc, float64(vm.GetWriteBytes()), labels...)
+		counter(volReadOpsDesc, float64(vm.GetReadCount()), labels...)
+		counter(volWriteOpsDesc, float64(vm.GetWriteCount()), labels...)
+		gauge(volTotalDesc, float64(vm.GetTotalBytes()), labels...)
+		gauge(volUsedDesc, float64(vm.GetUsedBytes()), labels...)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/api/go/metrics"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMetricsExporter(t *testing.T) {
+	logger = logrus.StandardLogger()
+	log = base.NewSourceLogObject(logger, "zedagent", 1234)
+	exp := newMetricsExporter()
+	exp.setMetrics(&metrics.ZMetricMsg{
+		MetricContent: &metrics.ZMetricMsg_Dm{
+			Dm: &metrics.DeviceMetric{
+				Network: []*metrics.NetworkMetric{
+					{IName: "eth0", LocalName: "eth0", RxBytes: 1000},
+				},
+			},
+		},
+		Am: []*metrics.AppMetric{
+			{AppID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", AppName: "app1",
+				AppMemory: &metrics.AppMemoryMetric{UsedMB: 2}},
+		},
+	})
+	srv := httptest.NewServer(exp.handler)
+	defer srv.Close()
+
+	get := func(token string) (int, string) {
+		req, err := http.NewRequest(http.MethodGet, srv.URL+metricsExporterURLPath, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(body)
+	}
+
+	// no token from the controller, nothing is served
+	if code, _ := get(""); code != http.StatusUnauthorized {
+		t.Errorf("expected %d without token, got %d", http.StatusUnauthorized, code)
+	}
+	exp.setToken("secret")
+	if code, _ := get("wrong"); code != http.StatusUnauthorized {
+		t.Errorf("expected %d with wrong token, got %d", http.StatusUnauthorized, code)
+	}
+	code, body := get("secret")
+	if code != http.StatusOK {
+		t.Fatalf("expected %d, got %d", http.StatusOK, code)
+	}
+	for _, expected := range []string{
+		`eve_device_network_receive_bytes_total{ifname="eth0",interface="eth0"} 1000`,
+		`eve_app_memory_used_bytes{app_id="6ba7b810-9dad-11d1-80b4-00c04fd430c8",app_name="app1"} 2.097152e+06`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected %s in:\n%s", expected, body)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"io/ioutil"
 	"net"
 	"os"
+	"runtime"
 	"sort"
 	"strings"
 	"time"
@@ -328,7 +329,7 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 	log.Functionf("Publish NetworkInstance Config: %+v", networkInstances)
 
 	unpublishDeletedNetworkInstanceConfig(ctx, networkInstances)
-	// check we do not have more than one VPN network instance
+	// check we do not have more than one strongSwan VPN network instance
 	vpnCount := 0
 	for _, netInstApiCfg := range networkInstances {
 		if oCfg := netInstApiCfg.Cfg; oCfg != nil {
@@ -374,6 +375,13 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 			networkInstanceConfig.Logicallabel = apiConfigEntry.Port.Name
 		}
 		networkInstanceConfig.IpType = types.AddressType(apiConfigEntry.IpType)
+		if exporter := apiConfigEntry.GetFlowExporter(); exporter != nil {
+			networkInstanceConfig.FlowExporter = types.FlowExporterConfig{
+				Collector:        exporter.GetCollector(),
+				Protocol:         types.FlowExportProtocol(exporter.GetProtocol()),
+				EnterpriseNumber: exporter.GetEnterpriseNumber(),
+			}
+		}
 
 		switch networkInstanceConfig.Type {
 		case types.NetworkInstanceTypeSwitch:
@@ -402,7 +410,14 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 					networkInstanceConfig.IpType)
 			} else {
 				ocfg := apiConfigEntry.Cfg
-				if ocfg.Type != zconfig.ZNetworkOpaqueConfigType_ZNetOConfigVPN {
+				switch ocfg.Type {
+				case zconfig.ZNetworkOpaqueConfigType_ZNetOConfigVPN:
+					networkInstanceConfig.VpnType = types.VpnTypeStrongSwan
+				case zconfig.ZNetworkOpaqueConfigType_ZNetOConfigWireGuard:
+					networkInstanceConfig.VpnType = types.VpnTypeWireGuard
+					networkInstanceConfig.CipherBlockStatus = parseCipherBlock(ctx,
+						networkInstanceConfig.Key(), ocfg.GetCipherData())
+				default:
 					log.Errorf("Network instance %s %s, %v invalid config",
 						networkInstanceConfig.UUID.String(),
 						networkInstanceConfig.DisplayName,
@@ -417,6 +432,23 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 					networkInstanceConfig.DisplayName,
 					networkInstanceConfig.IpType)
 			}
+
+		case types.NetworkInstanceTypeMesh:
+			ocfg := apiConfigEntry.Cfg
+			if ocfg == nil || ocfg.Type != zconfig.ZNetworkOpaqueConfigType_ZNetOConfigMesh {
+				networkInstanceConfig.SetErrorNow(fmt.Sprintf(
+					"Network Instance %s: Mesh opaque config not set",
+					networkInstanceConfig.Key()))
+			} else {
+				networkInstanceConfig.OpaqueConfig = ocfg.Oconfig
+				networkInstanceConfig.CipherBlockStatus = parseCipherBlock(ctx,
+					networkInstanceConfig.Key(), ocfg.GetCipherData())
+			}
+			if networkInstanceConfig.IpType != types.AddressTypeIPV4 {
+				networkInstanceConfig.SetErrorNow(fmt.Sprintf(
+					"Network Instance %s: Mesh requires IPv4 type, got %v",
+					networkInstanceConfig.Key(), networkInstanceConfig.IpType))
+			}
 		}
 
 		// other than switch-type(l2)
@@ -435,6 +467,22 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 				&networkInstanceConfig)
 		}
 
+		// dual-stack Local network instance
+		if ipv6 := apiConfigEntry.GetIpv6(); ipv6 != nil &&
+			networkInstanceConfig.Type == types.NetworkInstanceTypeLocal &&
+			networkInstanceConfig.IpType == types.AddressTypeIPV4 &&
+			!networkInstanceConfig.HasError() {
+			err := parseIpspecIPv6(ipv6, &networkInstanceConfig)
+			if err != nil {
+				errStr := fmt.Sprintf("Network Instance %s IPv6 parameter parse failed: %s",
+					networkInstanceConfig.Key(), err)
+				log.Error(errStr)
+				networkInstanceConfig.SetErrorNow(errStr)
+			}
+			networkInstanceConfig.IPv6UplinkMode =
+				types.IPv6UplinkMode(apiConfigEntry.GetIpv6UplinkMode())
+		}
+
 		ctx.pubNetworkInstanceConfig.Publish(networkInstanceConfig.UUID.String(),
 			networkInstanceConfig)
 	}
@@ -488,6 +536,8 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appinstancePrevConfigHash, configHash, Apps)
 	appinstancePrevConfigHash = configHash
 
+	dependencyErrors := checkAppDependencies(Apps)
+
 	// First look for deleted ones
 	items := getconfigCtx.pubAppInstanceConfig.GetAll()
 	for uuidStr := range items {
@@ -527,6 +577,20 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.FixedResources.VncDisplay = cfgApp.Fixedresources.VncDisplay
 		appInstance.FixedResources.VncPasswd = cfgApp.Fixedresources.VncPasswd
 		appInstance.FixedResources.DisableLogs = cfgApp.Fixedresources.DisableLogs
+		appInstance.FixedResources.VTPM = types.VTPMType(cfgApp.Fixedresources.Vtpm)
+		if appInstance.FixedResources.VTPM != types.VTPMNone &&
+			appInstance.FixedResources.VirtualizationMode == types.NOHYPER {
+			err := errors.New("virtual TPM is supported only for VMs")
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		} else if appInstance.FixedResources.VTPM != types.VTPMNone &&
+			runtime.GOARCH == "arm64" {
+			// The arm64 UEFI firmware (ArmVirtQemu) is built without TPM 2.0
+			// support, hence it would not measure the boot into the TPM.
+			err := errors.New("virtual TPM is not supported on arm64")
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		}
 		appInstance.MetaDataType = types.MetaDataType(cfgApp.MetaDataType)
 
 		appInstance.VolumeRefConfigList = make([]types.VolumeRefConfig,
@@ -569,12 +633,236 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.CipherBlockStatus = parseCipherBlock(getconfigCtx, appInstance.Key(),
 			cfgApp.GetCipherData())
 		appInstance.ProfileList = cfgApp.ProfileList
+		parseAppHealthConfig(&appInstance, cfgApp.GetHealth())
+		parseAppDependencies(&appInstance, cfgApp.GetDependencies())
+		if err, ok := dependencyErrors[cfgApp.Uuidandversion.Uuid]; ok {
+			log.Error(err)
+			appInstance.Errors = append(appInstance.Errors, err.Error())
+		}
+
+		// Apply volumes re-created on request of the local server
+		addLocalVolumeGenerations(getconfigCtx, &appInstance)
 
 		// Verify that it fits and if not publish with error
 		checkAndPublishAppInstanceConfig(getconfigCtx, appInstance)
 	}
 }
 
+// Defaults for health probes and restart back-off
+const (
+	defaultProbePeriod           = 10 * time.Second
+	defaultProbeTimeout          = time.Second
+	defaultProbeFailureThreshold = 3
+	defaultProbeSuccessThreshold = 1
+	defaultRestartBackoffInitial = 10 * time.Second
+	defaultRestartBackoffMax     = 300 * time.Second
+)
+
+func parseAppHealthConfig(appInstance *types.AppInstanceConfig,
+	cfgHealth *zconfig.AppHealthConfig) {
+
+	if cfgHealth == nil {
+		return
+	}
+	health := &appInstance.Health
+	health.RestartPolicy = types.AppRestartPolicy(cfgHealth.RestartPolicy)
+	health.BackoffInitial = time.Duration(cfgHealth.BackoffInitial) * time.Second
+	if health.BackoffInitial == 0 {
+		health.BackoffInitial = defaultRestartBackoffInitial
+	}
+	health.BackoffMax = time.Duration(cfgHealth.BackoffMax) * time.Second
+	if health.BackoffMax == 0 {
+		health.BackoffMax = defaultRestartBackoffMax
+	}
+	if health.BackoffMax < health.BackoffInitial {
+		health.BackoffMax = health.BackoffInitial
+	}
+	var err error
+	if health.Liveness, err = parseAppProbe(appInstance, cfgHealth.GetLiveness()); err != nil {
+		err = fmt.Errorf("invalid liveness probe: %v", err)
+		log.Error(err)
+		appInstance.Errors = append(appInstance.Errors, err.Error())
+	}
+	if health.Readiness, err = parseAppProbe(appInstance, cfgHealth.GetReadiness()); err != nil {
+		err = fmt.Errorf("invalid readiness probe: %v", err)
+		log.Error(err)
+		appInstance.Errors = append(appInstance.Errors, err.Error())
+	}
+}
+
+func parseAppProbe(appInstance *types.AppInstanceConfig,
+	cfgProbe *zconfig.AppProbe) (probe types.AppProbe, err error) {
+
+	if cfgProbe == nil {
+		return probe, nil
+	}
+	switch action := cfgProbe.Action.(type) {
+	case *zconfig.AppProbe_HttpGet:
+		probe.Type = types.AppProbeHTTPGet
+		probe.Port = uint16(action.HttpGet.GetPort())
+		probe.Path = action.HttpGet.GetPath()
+		if probe.Path == "" {
+			probe.Path = "/"
+		}
+	case *zconfig.AppProbe_TcpSocket:
+		probe.Type = types.AppProbeTCPSocket
+		probe.Port = uint16(action.TcpSocket.GetPort())
+	case *zconfig.AppProbe_Exec:
+		probe.Type = types.AppProbeExec
+		probe.Command = action.Exec.GetCommand()
+		if len(probe.Command) == 0 {
+			return types.AppProbe{}, errors.New("missing command")
+		}
+		if appInstance.FixedResources.VirtualizationMode != types.NOHYPER {
+			return types.AppProbe{}, errors.New(
+				"exec is supported only for container applications")
+		}
+	default:
+		return probe, errors.New("missing probe action")
+	}
+	if probe.Type == types.AppProbeHTTPGet || probe.Type == types.AppProbeTCPSocket {
+		if probe.Port == 0 {
+			return types.AppProbe{}, errors.New("missing port")
+		}
+		probe.InterfaceIndex = int(cfgProbe.InterfaceIndex)
+		if probe.InterfaceIndex >= len(appInstance.UnderlayNetworkList) {
+			return types.AppProbe{}, fmt.Errorf("interface index %d out of range",
+				probe.InterfaceIndex)
+		}
+	}
+	probe.InitialDelay = time.Duration(cfgProbe.InitialDelay) * time.Second
+	probe.Period = time.Duration(cfgProbe.Period) * time.Second
+	if probe.Period == 0 {
+		probe.Period = defaultProbePeriod
+	}
+	probe.Timeout = time.Duration(cfgProbe.Timeout) * time.Second
+	if probe.Timeout == 0 {
+		probe.Timeout = defaultProbeTimeout
+	}
+	probe.FailureThreshold = cfgProbe.FailureThreshold
+	if probe.FailureThreshold == 0 {
+		probe.FailureThreshold = defaultProbeFailureThreshold
+	}
+	probe.SuccessThreshold = cfgProbe.SuccessThreshold
+	if probe.SuccessThreshold == 0 {
+		probe.SuccessThreshold = defaultProbeSuccessThreshold
+	}
+	return probe, nil
+}
+
+func parseAppDependencies(appInstance *types.AppInstanceConfig,
+	cfgDependencies []*zconfig.AppDependency) {
+
+	appInstance.Dependencies = nil
+	for _, cfgDep := range cfgDependencies {
+		depUUID, err := uuid.FromString(cfgDep.GetAppUuid())
+		if err != nil {
+			// Reported by checkAppDependencies
+			continue
+		}
+		var condition types.AppDependencyCondition
+		switch cfgDep.GetCondition() {
+		case zconfig.AppDependencyCondition_APP_DEPENDENCY_CONDITION_READY:
+			condition = types.AppDependencyReady
+		default:
+			condition = types.AppDependencyRunning
+		}
+		appInstance.Dependencies = append(appInstance.Dependencies,
+			types.AppDependency{AppUUID: depUUID, Condition: condition})
+	}
+}
+
+// checkAppDependencies verifies that dependencies refer to application
+// instances in the config and do not form cycles. Returns errors indexed
+// by the UUID of the application instance.
+func checkAppDependencies(apps []*zconfig.AppInstanceConfig) map[string]error {
+	errs := make(map[string]error)
+	graph := make(map[string][]string)
+	for _, app := range apps {
+		graph[app.Uuidandversion.Uuid] = nil
+	}
+	for _, app := range apps {
+		appUUID := app.Uuidandversion.Uuid
+		for _, dep := range app.GetDependencies() {
+			depUUID := dep.GetAppUuid()
+			if _, ok := graph[depUUID]; !ok {
+				errs[appUUID] = fmt.Errorf("unknown dependency %s", depUUID)
+				continue
+			}
+			graph[appUUID] = append(graph[appUUID], depUUID)
+		}
+	}
+	for _, cycle := range findDependencyCycles(graph) {
+		for _, appUUID := range cycle {
+			errs[appUUID] = fmt.Errorf("cyclic dependency between %s",
+				strings.Join(cycle, ", "))
+		}
+	}
+	return errs
+}
+
+// findDependencyCycles returns strongly connected components of the graph
+// which contain a cycle (Tarjan's algorithm).
+func findDependencyCycles(graph map[string][]string) [][]string {
+	var (
+		cycles  [][]string
+		stack   []string
+		index   = make(map[string]int)
+		lowlink = make(map[string]int)
+		onStack = make(map[string]bool)
+		visit   func(node string)
+	)
+	visit = func(node string) {
+		index[node] = len(index)
+		lowlink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+		selfLoop := false
+		for _, next := range graph[node] {
+			if next == node {
+				selfLoop = true
+			}
+			if _, visited := index[next]; !visited {
+				visit(next)
+				if lowlink[next] < lowlink[node] {
+					lowlink[node] = lowlink[next]
+				}
+			} else if onStack[next] && index[next] < lowlink[node] {
+				lowlink[node] = index[next]
+			}
+		}
+		if lowlink[node] != index[node] {
+			return
+		}
+		var component []string
+		for {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[last] = false
+			component = append(component, last)
+			if last == node {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+	// Visit in a stable order to report the same errors for the same config
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	for _, node := range nodes {
+		if _, visited := index[node]; !visited {
+			visit(node)
+		}
+	}
+	return cycles
+}
+
 var systemAdaptersPrevConfigHash []byte
 
 func parseSystemAdapterConfig(config *zconfig.EdgeDevConfig,
@@ -1959,6 +2247,104 @@ func parseIpspec(ipspec *zconfig.Ipspec,
 	return nil
 }
 
+// parseIpspecIPv6 parses the IPv6 part of a dual-stack network instance.
+// Only subnet, gateway and dhcpRange are used, DNS and NTP servers are
+// the same for both address families.
+func parseIpspecIPv6(ipspec *zconfig.Ipspec,
+	config *types.NetworkInstanceConfig) error {
+
+	s := ipspec.GetSubnet()
+	if s == "" {
+		return errors.New("IPv6 subnet is not set")
+	}
+	_, subnet, err := net.ParseCIDR(s)
+	if err != nil {
+		return fmt.Errorf("bad IPv6 subnet %s: %s", s, err)
+	}
+	if subnet.IP.To4() != nil {
+		return fmt.Errorf("subnet %s is not IPv6", s)
+	}
+	prefixLen, _ := subnet.Mask.Size()
+	hostBits := 128 - prefixLen
+	// Application addresses are allocated from the lower 64 bits.
+	if hostBits > 64 {
+		return fmt.Errorf("IPv6 subnet %s is larger than /64", s)
+	}
+	if hostBits < 2 {
+		return fmt.Errorf("IPv6 subnet %s is too small", s)
+	}
+	config.SubnetIPv6 = *subnet
+	parseAddr := func(addrStr, what string) (net.IP, error) {
+		addr := net.ParseIP(addrStr)
+		if addr == nil || addr.To4() != nil {
+			return nil, fmt.Errorf("bad IPv6 %s %s", what, addrStr)
+		}
+		if !subnet.Contains(addr) {
+			return nil, fmt.Errorf("IPv6 %s %s is outside of subnet %s",
+				what, addrStr, s)
+		}
+		return addr, nil
+	}
+	if g := ipspec.GetGateway(); g != "" {
+		if config.GatewayIPv6, err = parseAddr(g, "gateway"); err != nil {
+			return err
+		}
+	} else {
+		config.GatewayIPv6 = types.AddToIP(subnet.IP, 1)
+		log.Warnf("network(%s), No IPv6 Gateway, setting default(%s)",
+			config.Key(), config.GatewayIPv6)
+	}
+	if dr := ipspec.GetDhcpRange(); dr != nil && dr.GetStart() != "" {
+		if config.DhcpRangeIPv6.Start, err = parseAddr(dr.GetStart(),
+			"dhcp range start"); err != nil {
+			return err
+		}
+		if dr.GetEnd() != "" {
+			if config.DhcpRangeIPv6.End, err = parseAddr(dr.GetEnd(),
+				"dhcp range end"); err != nil {
+				return err
+			}
+		}
+	}
+	// if not set, take some default
+	if config.DhcpRangeIPv6.Start == nil {
+		// Leave the beginning of larger subnets for static addresses.
+		rangeOffset := 2
+		if hostBits > 8 {
+			rangeOffset = 0x100
+		} else if hostBits >= 4 {
+			rangeOffset = 1 << (hostBits - 1)
+		}
+		config.DhcpRangeIPv6.Start = types.AddToIP(subnet.IP, rangeOffset)
+		log.Warnf("network(%s), No IPv6 Dhcp Start, setting default(%s)",
+			config.Key(), config.DhcpRangeIPv6.Start)
+	}
+	if config.DhcpRangeIPv6.End == nil {
+		lastAddr := make(net.IP, net.IPv6len)
+		for i := range lastAddr {
+			lastAddr[i] = subnet.IP[i] | ^subnet.Mask[i]
+		}
+		end := types.AddToIP(config.DhcpRangeIPv6.Start, types.BitMapMax)
+		if bytes.Compare(end, config.DhcpRangeIPv6.Start) < 0 ||
+			bytes.Compare(end, lastAddr) > 0 {
+			end = lastAddr
+		}
+		config.DhcpRangeIPv6.End = end
+		log.Warnf("network(%s), No IPv6 Dhcp End, setting default(%s)",
+			config.Key(), config.DhcpRangeIPv6.End)
+	}
+	if bytes.Compare(config.DhcpRangeIPv6.Start, config.DhcpRangeIPv6.End) > 0 {
+		return fmt.Errorf("IPv6 dhcp range start(%s) is after end(%s)",
+			config.DhcpRangeIPv6.Start, config.DhcpRangeIPv6.End)
+	}
+	// Gateway should not be inside the DhcpRange
+	if config.DhcpRangeIPv6.Contains(config.GatewayIPv6) {
+		return fmt.Errorf("IPv6 gateway(%s) inside Dhcp Range",
+			config.GatewayIPv6)
+	}
+	return nil
+}
+
 func parseAppNetworkConfig(appInstance *types.AppInstanceConfig,
 	cfgApp *zconfig.AppInstanceConfig,
 	cfgNetworks []*zconfig.NetworkConfig,
@@ -2132,6 +2518,9 @@ func parseUnderlayNetworkConfigEntry(
 			actionCfg.PortMap = action.Portmap
 			actionCfg.TargetPort = int(action.AppPort)
 			actionCfg.Drop = action.Drop
+			actionCfg.Shape = action.Shape
+			actionCfg.ShapeRate = action.Shaperate
+			actionCfg.ShapeCeil = action.Shapeceil
 			aclCfg.Actions[actionIdx] = *actionCfg
 		}
 		ulCfg.ACLs[aclIdx] = *aclCfg
@@ -2139,6 +2528,10 @@ func parseUnderlayNetworkConfigEntry(
 	// XXX set ulCfg.IntfOrder from API once available
 	ulCfg.IntfOrder = intfOrder
 	ulCfg.AccessVlanID = intfEnt.AccessVlanId
+	if bandwidth := intfEnt.GetBandwidth(); bandwidth != nil {
+		ulCfg.Bandwidth.IngressLimit = bandwidth.IngressLimit
+		ulCfg.Bandwidth.EgressLimit = bandwidth.EgressLimit
+	}
 	return ulCfg
 }
 
@@ -1126,3 +1126,104 @@ func TestInvalidLowerLayerReferences(t *testing.T) {
 	g.Expect(dpc.HasError()).To(BeFalse())
 	g.Expect(dpc.Ports).To(HaveLen(2))
 }
+
+func TestAppDependencies(t *testing.T) {
+	g := NewGomegaWithT(t)
+	const (
+		broker    = "6ba7b810-9dad-11d1-80b4-00c04fd430c1"
+		historian = "6ba7b810-9dad-11d1-80b4-00c04fd430c2"
+		dashboard = "6ba7b810-9dad-11d1-80b4-00c04fd430c3"
+		other     = "6ba7b810-9dad-11d1-80b4-00c04fd430c4"
+		unknown   = "6ba7b810-9dad-11d1-80b4-00c04fd430c5"
+	)
+	app := func(appUUID string, deps ...string) *zconfig.AppInstanceConfig {
+		cfg := &zconfig.AppInstanceConfig{
+			Uuidandversion: &zconfig.UUIDandVersion{Uuid: appUUID},
+		}
+		for _, dep := range deps {
+			cfg.Dependencies = append(cfg.Dependencies,
+				&zconfig.AppDependency{AppUuid: dep})
+		}
+		return cfg
+	}
+
+	// Chain without cycles
+	errs := checkAppDependencies([]*zconfig.AppInstanceConfig{
+		app(broker), app(historian, broker), app(dashboard, historian, broker),
+	})
+	g.Expect(errs).To(BeEmpty())
+
+	// Unknown dependency
+	errs = checkAppDependencies([]*zconfig.AppInstanceConfig{
+		app(broker), app(historian, broker, unknown),
+	})
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs).To(HaveKey(historian))
+
+	// Cycle through three apps, one app depending on the cycle
+	// and one depending on itself
+	errs = checkAppDependencies([]*zconfig.AppInstanceConfig{
+		app(broker, dashboard), app(historian, broker), app(dashboard, historian),
+		app(other, other),
+	})
+	g.Expect(errs).To(HaveLen(4))
+	g.Expect(errs[broker].Error()).To(ContainSubstring(historian))
+	g.Expect(errs[other].Error()).To(ContainSubstring(other))
+
+	var appInstance types.AppInstanceConfig
+	parseAppDependencies(&appInstance, []*zconfig.AppDependency{
+		{AppUuid: broker},
+		{AppUuid: historian,
+			Condition: zconfig.AppDependencyCondition_APP_DEPENDENCY_CONDITION_READY},
+	})
+	g.Expect(appInstance.Dependencies).To(HaveLen(2))
+	g.Expect(appInstance.Dependencies[0].AppUUID.String()).To(Equal(broker))
+	g.Expect(appInstance.Dependencies[0].Condition).To(Equal(types.AppDependencyRunning))
+	g.Expect(appInstance.Dependencies[1].Condition).To(Equal(types.AppDependencyReady))
+}
+
+func TestParseVolumeSnapshots(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getconfigCtx := initGetConfigCtx(g)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	pubSnapshots, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.VolumeSnapshotConfig{},
+	})
+	g.Expect(err).To(BeNil())
+	getconfigCtx.pubVolumeSnapshotConfig = pubSnapshots
+
+	const volumeUUID = "a1b2c3d4-0000-4000-8000-000000000001"
+	volume := &zconfig.Volume{
+		Uuid:            volumeUUID,
+		GenerationCount: 2,
+		Snapshots: []*zconfig.VolumeSnapshot{
+			{Id: "before-upgrade", DisplayName: "v1"},
+			{Id: "bad/id"},
+		},
+	}
+	const key = volumeUUID + "#2@before-upgrade"
+
+	// create
+	parseVolumeSnapshotConfig(getconfigCtx, []*zconfig.Volume{volume})
+	items := pubSnapshots.GetAll()
+	g.Expect(items).To(HaveLen(1))
+	g.Expect(items).To(HaveKey(key))
+	snapshot := items[key].(types.VolumeSnapshotConfig)
+	g.Expect(snapshot.SnapshotID).To(Equal("before-upgrade"))
+	g.Expect(snapshot.VolumeID.String()).To(Equal(volumeUUID))
+	g.Expect(snapshot.GenerationCounter).To(BeEquivalentTo(2))
+	g.Expect(snapshot.DisplayName).To(Equal("v1"))
+	g.Expect(snapshot.RollbackCounter).To(BeEquivalentTo(0))
+
+	// rollback
+	volume.Snapshots[0].RollbackCounter = 1
+	parseVolumeSnapshotConfig(getconfigCtx, []*zconfig.Volume{volume})
+	snapshot = pubSnapshots.GetAll()[key].(types.VolumeSnapshotConfig)
+	g.Expect(snapshot.RollbackCounter).To(BeEquivalentTo(1))
+
+	// delete
+	volume.Snapshots = nil
+	parseVolumeSnapshotConfig(getconfigCtx, []*zconfig.Volume{volume})
+	g.Expect(pubSnapshots.GetAll()).To(BeEmpty())
+}
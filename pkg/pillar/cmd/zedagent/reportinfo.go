@@ -153,21 +153,49 @@ func objectInfoTask(ctxPtr *zedagentContext, triggerInfo <-chan infoForObjectKey
 	}
 }
 
+func fillStorageDiskState(disk *types.StorageDiskState) *info.StorageDiskState {
+	diskInfo := new(info.StorageDiskState)
+	diskInfo.Status = info.StorageStatus(disk.Status)
+	if disk.DiskName != nil {
+		diskInfo.DiskName = new(evecommon.DiskDescription)
+		diskInfo.DiskName.Name = disk.DiskName.Name
+		diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
+		diskInfo.DiskName.Serial = disk.DiskName.Serial
+	}
+	diskInfo.ReadErrors = disk.ReadErrors
+	diskInfo.WriteErrors = disk.WriteErrors
+	diskInfo.ChecksumErrors = disk.ChecksumErrors
+	return diskInfo
+}
+
+func fillStorageScanInfo(scan types.StorageScanStatus) *info.StorageScanInfo {
+	if scan.Func == types.StorageScanFuncUnspecified {
+		return nil
+	}
+	scanInfo := new(info.StorageScanInfo)
+	scanInfo.Func = info.StorageScanFunc(scan.Func)
+	scanInfo.State = info.StorageScanState(scan.State)
+	if !scan.StartTime.IsZero() {
+		scanInfo.StartTime, _ = ptypes.TimestampProto(scan.StartTime)
+	}
+	if !scan.EndTime.IsZero() {
+		scanInfo.EndTime, _ = ptypes.TimestampProto(scan.EndTime)
+	}
+	scanInfo.ToExamineBytes = scan.ToExamine
+	scanInfo.ExaminedBytes = scan.Examined
+	scanInfo.Progress = scan.Progress()
+	scanInfo.RepairedBytes = scan.Repaired
+	scanInfo.Errors = scan.Errors
+	return scanInfo
+}
+
 func fillStorageChildren(children []*types.StorageChildren) []*info.StorageChildren {
 	var infoChildren []*info.StorageChildren
 	for _, child := range children {
 		childInfo := new(info.StorageChildren)
 		childInfo.CurrentRaid = info.StorageRaidType(child.CurrentRaid)
 		for _, disk := range child.Disks {
-			diskInfo := new(info.StorageDiskState)
-			diskInfo.Status = info.StorageStatus(disk.Status)
-			if disk.DiskName != nil {
-				diskInfo.DiskName = new(evecommon.DiskDescription)
-				diskInfo.DiskName.Name = disk.DiskName.Name
-				diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
-				diskInfo.DiskName.Serial = disk.DiskName.Serial
-			}
-			childInfo.Disks = append(childInfo.Disks, diskInfo)
+			childInfo.Disks = append(childInfo.Disks, fillStorageDiskState(disk))
 		}
 		childInfo.Children = fillStorageChildren(child.Children)
 		infoChildren = append(infoChildren, childInfo)
@@ -375,17 +403,22 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 			storageInfo.CountZvols = zfsPoolStatus.CountZvols
 			storageInfo.CollectorErrors = zfsPoolStatus.CollectorErrors
 			for _, disk := range zfsPoolStatus.Disks {
-				diskInfo := new(info.StorageDiskState)
-				diskInfo.Status = info.StorageStatus(disk.Status)
-				if disk.DiskName != nil {
-					diskInfo.DiskName = new(evecommon.DiskDescription)
-					diskInfo.DiskName.Name = disk.DiskName.Name
-					diskInfo.DiskName.LogicalName = disk.DiskName.LogicalName
-					diskInfo.DiskName.Serial = disk.DiskName.Serial
-				}
-				storageInfo.Disks = append(storageInfo.Disks, diskInfo)
+				storageInfo.Disks = append(storageInfo.Disks, fillStorageDiskState(disk))
 			}
 			storageInfo.Children = fillStorageChildren(zfsPoolStatus.Children)
+			storageInfo.Scan = fillStorageScanInfo(zfsPoolStatus.Scan)
+			for _, vdev := range zfsPoolStatus.Vdevs {
+				storageInfo.Vdevs = append(storageInfo.Vdevs, &info.StorageVdevState{
+					Name:           vdev.Name,
+					Status:         info.StorageStatus(vdev.Status),
+					ReadErrors:     vdev.ReadErrors,
+					WriteErrors:    vdev.WriteErrors,
+					ChecksumErrors: vdev.ChecksumErrors,
+				})
+			}
+			if !zfsPoolStatus.NextScrubTime.IsZero() {
+				storageInfo.NextScrubTime, _ = ptypes.TimestampProto(zfsPoolStatus.NextScrubTime)
+			}
 			ReportDeviceInfo.StorageInfo = append(ReportDeviceInfo.StorageInfo, storageInfo)
 			log.Tracef("sending info for ZFS zpool %s", zfsPoolStatus.PoolName)
 		}
@@ -604,6 +637,9 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 	// is deleted.
 	createAppInstances(ctx, ReportDeviceInfo)
 
+	// Share the same information with the local server.
+	setLocalDevInfo(ctx.getconfigCtx, ReportInfo)
+
 	log.Tracef("PublishDeviceInfoToZedCloud sending %v", ReportInfo)
 	data, err := proto.Marshal(ReportInfo)
 	if err != nil {
@@ -883,6 +919,50 @@ func encodeCellProviders(wwanProviders []types.WwanProvider) (providers []*info.
 	return providers
 }
 
+func encodeWifiStatus(wifiStatus types.WifiStatus) *info.ZWifiStatus {
+	var state info.ZWifiState
+	switch wifiStatus.State {
+	case types.WifiStateDisabled:
+		state = info.ZWifiState_Z_WIFI_STATE_DISABLED
+	case types.WifiStateDisconnected:
+		state = info.ZWifiState_Z_WIFI_STATE_DISCONNECTED
+	case types.WifiStateScanning:
+		state = info.ZWifiState_Z_WIFI_STATE_SCANNING
+	case types.WifiStateConnecting:
+		state = info.ZWifiState_Z_WIFI_STATE_CONNECTING
+	case types.WifiStateConnected:
+		state = info.ZWifiState_Z_WIFI_STATE_CONNECTED
+	default:
+		state = info.ZWifiState_Z_WIFI_STATE_UNSPECIFIED
+	}
+	status := &info.ZWifiStatus{
+		State:           state,
+		Ssid:            wifiStatus.SSID,
+		Bssid:           wifiStatus.BSSID,
+		FrequencyMhz:    wifiStatus.FrequencyMHz,
+		KeyMgmt:         wifiStatus.KeyMgmt,
+		AuthFailures:    wifiStatus.AuthFailures,
+		LastAuthFailure: wifiStatus.LastAuthFailure,
+		Error:           wifiStatus.Error,
+	}
+	if !wifiStatus.LastAuthFailureTime.IsZero() {
+		status.LastAuthFailureTime, _ = ptypes.TimestampProto(wifiStatus.LastAuthFailureTime)
+	}
+	if !wifiStatus.LastScanTime.IsZero() {
+		status.LastScanTime, _ = ptypes.TimestampProto(wifiStatus.LastScanTime)
+	}
+	for _, result := range wifiStatus.ScanResults {
+		status.ScanResults = append(status.ScanResults, &info.ZWifiNetwork{
+			Ssid:         result.SSID,
+			Bssid:        result.BSSID,
+			FrequencyMhz: result.FrequencyMHz,
+			SignalLevel:  result.SignalLevel,
+			Flags:        result.Flags,
+		})
+	}
+	return status
+}
+
 func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 	dpcl := *ctx.DevicePortConfigList
 	sainfo := new(info.SystemAdapterInfo)
@@ -911,7 +991,11 @@ func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 				continue
 			}
 			dps.Ports[j] = encodeNetworkPortConfig(ctx, &p)
-			if i == dpcl.CurrentIndex && p.WirelessCfg.WType == types.WirelessTypeCellular {
+			if i != dpcl.CurrentIndex {
+				continue
+			}
+			switch p.WirelessCfg.WType {
+			case types.WirelessTypeCellular:
 				portStatus := deviceNetworkStatus.GetPortByLogicallabel(p.Logicallabel)
 				if portStatus == nil {
 					continue
@@ -931,6 +1015,16 @@ func encodeSystemAdapterInfo(ctx *zedagentContext) *info.SystemAdapterInfo {
 						ProbeError:     wwanStatus.ProbeError,
 					},
 				}
+			case types.WirelessTypeWifi:
+				portStatus := deviceNetworkStatus.GetPortByLogicallabel(p.Logicallabel)
+				if portStatus == nil ||
+					portStatus.WirelessStatus.WType != types.WirelessTypeWifi {
+					continue
+				}
+				dps.Ports[j].WirelessStatus = &info.WirelessStatus{
+					Type: info.WirelessType_WIRELESS_TYPE_WIFI,
+					Wifi: encodeWifiStatus(portStatus.WirelessStatus.Wifi),
+				}
 			}
 		}
 		sainfo.Status[i] = dps
@@ -53,6 +53,8 @@ const (
 	restartCounterFile = types.PersistStatusDir + "/restartcounter"
 	// checkpointDirname - location of config checkpoint
 	checkpointDirname = types.PersistDir + "/checkpoint"
+	// deferredDirname - location of info messages which could not be sent yet
+	deferredDirname = types.PersistStatusDir + "/" + agentName + "/deferred"
 	// Time limits for event loop handlers
 	errorTime   = 3 * time.Minute
 	warningTime = 40 * time.Second
@@ -130,9 +132,11 @@ type zedagentContext struct {
 	subCapabilities           pubsub.Subscription
 	subAppInstMetaData        pubsub.Subscription
 	subWwanMetrics            pubsub.Subscription
+	subWlanMetrics            pubsub.Subscription
 	subDeviceNetworkStatus    pubsub.Subscription
 	subZFSPoolStatus          pubsub.Subscription
 	zedcloudMetrics           *zedcloud.AgentMetrics
+	metricsExporter           *metricsExporter
 	rebootCmd                 bool
 	rebootCmdDeferred         bool
 	deviceReboot              bool
@@ -252,6 +256,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		TriggerHwInfo:     triggerHwInfo,
 		TriggerObjectInfo: triggerObjectInfo,
 		zedcloudMetrics:   zedcloud.NewAgentMetrics(),
+		metricsExporter:   newMetricsExporter(),
 	}
 	zedagentCtx.specMap = types.NewConfigItemSpecMap()
 	zedagentCtx.globalConfig = *types.DefaultConfigItemValueMap()
@@ -342,6 +347,8 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	getconfigCtx.pubZedAgentStatus = pubZedAgentStatus
 
 	// apply saved radio config ASAP
+	// Local device commands hold counters published in ZedAgentStatus
+	initializeLocalDevCommands(&getconfigCtx)
 	initializeRadioConfig(&getconfigCtx)
 
 	// Wait until we have been onboarded aka know our own UUID
@@ -378,6 +385,11 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		zedagentCtx.zedcloudMetrics)
 	// Timer for deferred sends of info messages
 	deferredChan := zedcloud.GetDeferredChan(zedcloudCtx, getDeferredSentHandlerFunction(&zedagentCtx), getDeferredPriorityFunctions()...)
+	// Replay what we failed to send before reboot/restart
+	err = zedcloud.EnableDeferredPersist(zedcloudCtx, getDeferredPersistConfig(&zedagentCtx))
+	if err != nil {
+		log.Errorf("EnableDeferredPersist failed: %v", err)
+	}
 
 	subAssignableAdapters, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "domainmgr",
@@ -455,6 +467,15 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	pubAppNetworkConfig.ClearRestarted()
 	getconfigCtx.pubAppNetworkConfig = pubAppNetworkConfig
 
+	pubPacketCaptureConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.PacketCaptureConfig{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubPacketCaptureConfig = pubPacketCaptureConfig
+
 	// XXX defer this until we have some config from cloud or saved copy
 	pubAppInstanceConfig.SignalRestarted()
 
@@ -537,6 +558,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	pubVolumeConfig.ClearRestarted()
 	getconfigCtx.pubVolumeConfig = pubVolumeConfig
 
+	pubVolumeSnapshotConfig, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.VolumeSnapshotConfig{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.pubVolumeSnapshotConfig = pubVolumeSnapshotConfig
+
 	// for disk config Publisher
 	pubDisksConfig, err := ps.NewPublication(
 		pubsub.PublicationOptions{
@@ -602,6 +633,23 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	getconfigCtx.subAppNetworkStatus = subAppNetworkStatus
 	subAppNetworkStatus.Activate()
 
+	subPacketCaptureStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedrouter",
+		MyAgentName:   agentName,
+		TopicImpl:     types.PacketCaptureStatus{},
+		Activate:      false,
+		Ctx:           &zedagentCtx,
+		CreateHandler: handlePacketCaptureStatusCreate,
+		ModifyHandler: handlePacketCaptureStatusModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	getconfigCtx.subPacketCaptureStatus = subPacketCaptureStatus
+	subPacketCaptureStatus.Activate()
+
 	subNetworkInstanceMetrics, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:   "zedrouter",
 		MyAgentName: agentName,
@@ -1049,6 +1097,21 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subWwanMetrics = subWwanMetrics
 	subWwanMetrics.Activate()
 
+	subWlanMetrics, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "nim",
+		MyAgentName: agentName,
+		TopicImpl:   types.WlanMetrics{},
+		Activate:    false,
+		Ctx:         &zedagentCtx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subWlanMetrics = subWlanMetrics
+	subWlanMetrics.Activate()
+
 	//initialize cipher processing block
 	cipherModuleInitialize(&zedagentCtx, ps)
 
@@ -1148,6 +1211,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subWwanMetrics.MsgChan():
 			subWwanMetrics.ProcessChange(change)
 
+		case change := <-subWlanMetrics.MsgChan():
+			subWlanMetrics.ProcessChange(change)
+
 		case change := <-deferredChan:
 			start := time.Now()
 			zedcloud.HandleDeferred(zedcloudCtx, change, 100*time.Millisecond, false)
@@ -1335,6 +1401,11 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	initializeLocalAppInfo(&getconfigCtx)
 	go localAppInfoPOSTTask(&getconfigCtx)
 
+	// initialize local device info and metrics
+	initializeLocalDevInfo(&getconfigCtx)
+	go localDevInfoPOSTTask(&getconfigCtx)
+	go localMetricsPOSTTask(&getconfigCtx)
+
 	// start the config fetch tasks, when zboot status is ready
 	log.Functionf("Creating %s at %s", "configTimerTask", agentlog.GetMyStack())
 	go configTimerTask(handleChannel, &getconfigCtx)
@@ -1399,6 +1470,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			getconfigCtx.localServerMap.upToDate = false
 			subAppNetworkStatus.ProcessChange(change)
 
+		case change := <-subPacketCaptureStatus.MsgChan():
+			subPacketCaptureStatus.ProcessChange(change)
+
 		case change := <-subDeviceNetworkStatus.MsgChan():
 			subDeviceNetworkStatus.ProcessChange(change)
 			if DNSctx.triggerGetConfig {
@@ -1597,6 +1671,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subWwanMetrics.MsgChan():
 			subWwanMetrics.ProcessChange(change)
 
+		case change := <-subWlanMetrics.MsgChan():
+			subWlanMetrics.ProcessChange(change)
+
 		case change := <-subZFSPoolStatus.MsgChan():
 			subZFSPoolStatus.ProcessChange(change)
 			triggerPublishDevInfo(&zedagentCtx)
@@ -2035,6 +2112,15 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		mergeMaintenanceMode(ctx)
 	}
 
+	if gcp != nil && zedcloudCtx != nil {
+		zedcloud.SetDeferredPersistLimits(zedcloudCtx,
+			int64(gcp.GlobalValueInt(types.DeferredOnDiskMaxKBytes))*1024,
+			time.Duration(gcp.GlobalValueInt(types.DeferredOnDiskMaxAge))*time.Second)
+	}
+	if gcp != nil {
+		updateMetricsExporter(ctx)
+	}
+
 	// XXX for testing edge-view
 	handleEdgeviewToken(gcp)
 
@@ -2255,6 +2341,32 @@ func getDeferredPriorityFunctions() []zedcloud.TypePriorityCheckFunction {
 	return functions
 }
 
+// getDeferredPersistConfig returns the config for keeping deferred info
+// messages on disk. Attestation requests are not persisted since they
+// are regenerated with a fresh nonce anyhow.
+func getDeferredPersistConfig(ctx *zedagentContext) zedcloud.DeferredPersistConfig {
+	return zedcloud.DeferredPersistConfig{
+		Dir: deferredDirname,
+		MaxSize: int64(ctx.globalConfig.GlobalValueInt(
+			types.DeferredOnDiskMaxKBytes)) * 1024,
+		MaxAge: time.Duration(ctx.globalConfig.GlobalValueInt(
+			types.DeferredOnDiskMaxAge)) * time.Second,
+		MarshalItemType: func(itemType interface{}) (string, bool) {
+			if el, ok := itemType.(info.ZInfoTypes); ok {
+				return el.String(), true
+			}
+			return "", false
+		},
+		UnmarshalItemType: func(itemType string) (interface{}, error) {
+			el, ok := info.ZInfoTypes_value[itemType]
+			if !ok {
+				return nil, fmt.Errorf("unknown info type %s", itemType)
+			}
+			return info.ZInfoTypes(el), nil
+		},
+	}
+}
+
 // Track the DeviceUUID
 func handleOnboardStatusCreate(ctxArg interface{}, key string,
 	statusArg interface{}) {
@@ -0,0 +1,151 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Start ordering of application instances. An application instance is
+// activated only after its dependencies are running (or ready) and when
+// both are deactivated a dependency is halted only after its dependents.
+
+package zedmanager
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// dependencySatisfied returns true if the application instance reached
+// the state required by the condition.
+func dependencySatisfied(status *types.AppInstanceStatus,
+	condition types.AppDependencyCondition) bool {
+
+	if status == nil || !status.Activated || status.State != types.RUNNING {
+		return false
+	}
+	if condition == types.AppDependencyReady {
+		return status.Health.Ready
+	}
+	return true
+}
+
+// appDisplayName returns the display name of the application instance
+// or its UUID if we do not know it
+func appDisplayName(ctx *zedmanagerContext, appUUID uuid.UUID) string {
+	config := lookupAppInstanceConfig(ctx, appUUID.String())
+	if config == nil || config.DisplayName == "" {
+		return appUUID.String()
+	}
+	return config.DisplayName
+}
+
+// checkStartDependencies returns an error description if the application
+// instance has to wait for one of its dependencies before it is activated.
+func checkStartDependencies(ctx *zedmanagerContext,
+	config types.AppInstanceConfig) (string, *types.ErrorDescription) {
+
+	for _, dep := range config.Dependencies {
+		depStatus := lookupAppInstanceStatus(ctx, dep.AppUUID.String())
+		if dependencySatisfied(depStatus, dep.Condition) {
+			continue
+		}
+		name := appDisplayName(ctx, dep.AppUUID)
+		return name, &types.ErrorDescription{
+			Error: fmt.Sprintf("waiting for dependency %s to be %s",
+				name, dep.Condition),
+			ErrorSeverity: types.ErrorSeverityNotice,
+			ErrorRetryCondition: fmt.Sprintf("Will start when %s is %s",
+				name, dep.Condition),
+			ErrorEntities: []*types.ErrorEntity{{
+				EntityID:   dep.AppUUID.String(),
+				EntityType: types.ErrorEntityAppInstance,
+			}},
+		}
+	}
+	return "", nil
+}
+
+// checkStopDependents returns an error description if the application
+// instance has to wait for one of its dependents, which is also being
+// halted, before it is halted itself. Dependents which stay active do not
+// delay the halt.
+func checkStopDependents(ctx *zedmanagerContext,
+	status *types.AppInstanceStatus) (string, *types.ErrorDescription) {
+
+	appUUID := status.UUIDandVersion.UUID
+	for _, c := range ctx.subAppInstanceConfig.GetAll() {
+		config := c.(types.AppInstanceConfig)
+		dependent := false
+		for _, dep := range config.Dependencies {
+			if uuid.Equal(dep.AppUUID, appUUID) {
+				dependent = true
+				break
+			}
+		}
+		if !dependent {
+			continue
+		}
+		depStatus := lookupAppInstanceStatus(ctx, config.Key())
+		if depStatus == nil || depStatus.EffectiveActivate ||
+			(!depStatus.Activated && !depStatus.ActivateInprogress) {
+			continue
+		}
+		name := config.DisplayName
+		if name == "" {
+			name = config.Key()
+		}
+		return name, &types.ErrorDescription{
+			Error:               fmt.Sprintf("waiting for dependent %s to halt", name),
+			ErrorSeverity:       types.ErrorSeverityNotice,
+			ErrorRetryCondition: fmt.Sprintf("Will halt when %s is halted", name),
+			ErrorEntities: []*types.ErrorEntity{{
+				EntityID:   config.Key(),
+				EntityType: types.ErrorEntityAppInstance,
+			}},
+		}
+	}
+	return "", nil
+}
+
+// setWaitingForDependency records in the status what we are waiting for.
+// Returns true if the status changed.
+func setWaitingForDependency(status *types.AppInstanceStatus, name string,
+	description types.ErrorDescription) bool {
+
+	if status.WaitingForDependency == name &&
+		status.IsErrorSource(types.AppDependency{}) &&
+		status.Error == description.Error {
+		return false
+	}
+	log.Noticef("%s: %s", status.Key(), description.Error)
+	status.WaitingForDependency = name
+	status.SetErrorWithSourceAndDescription(description, types.AppDependency{})
+	return true
+}
+
+// clearWaitingForDependency returns true if the status changed.
+func clearWaitingForDependency(status *types.AppInstanceStatus) bool {
+	if status.WaitingForDependency == "" {
+		return false
+	}
+	log.Noticef("%s: done waiting for %s", status.Key(),
+		status.WaitingForDependency)
+	status.WaitingForDependency = ""
+	if status.IsErrorSource(types.AppDependency{}) {
+		status.ClearErrorWithSource()
+	}
+	return true
+}
+
+// updateWaitingApps re-evaluates application instances which wait for
+// a dependency or a dependent after some AppInstanceStatus changed.
+func updateWaitingApps(ctx *zedmanagerContext, changedKey string) {
+	for _, st := range ctx.pubAppInstanceStatus.GetAll() {
+		status := st.(types.AppInstanceStatus)
+		if status.WaitingForDependency == "" || status.Key() == changedKey {
+			continue
+		}
+		log.Functionf("updateWaitingApps: %s waiting for %s",
+			status.Key(), status.WaitingForDependency)
+		updateAIStatusUUID(ctx, status.Key())
+	}
+}
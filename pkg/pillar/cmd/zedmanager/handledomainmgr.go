@@ -6,6 +6,7 @@ package zedmanager
 import (
 	"errors"
 	"fmt"
+	"net"
 	"runtime"
 
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -47,6 +48,8 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		CipherBlockStatus: aiConfig.CipherBlockStatus,
 		GPUConfig:         "legacy",
 		MetaDataType:      aiConfig.MetaDataType,
+		Health:            aiConfig.Health,
+		PurgeStartedAt:    aiStatus.PurgeStartedAt,
 	}
 
 	dc.DiskConfigList = make([]types.DiskConfig, 0, len(aiStatus.VolumeRefStatusList))
@@ -116,11 +119,37 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		for i, ul := range ns.UnderlayNetworkList {
 			dc.VifList[i] = ul.VifInfo
 		}
+		dc.Health.Liveness.TargetIP = probeTargetIP(dc.Health.Liveness, ns)
+		dc.Health.Readiness.TargetIP = probeTargetIP(dc.Health.Readiness, ns)
 	}
 	log.Functionf("MaybeAddDomainConfig done for %s", key)
 	return &dc, nil
 }
 
+// probeTargetIP returns the IP address of the application interface
+// probed over the network, IPv4 is preferred.
+func probeTargetIP(probe types.AppProbe, ns *types.AppNetworkStatus) net.IP {
+	switch probe.Type {
+	case types.AppProbeHTTPGet, types.AppProbeTCPSocket:
+	default:
+		return nil
+	}
+	if probe.InterfaceIndex >= len(ns.UnderlayNetworkList) {
+		return nil
+	}
+	ul := ns.UnderlayNetworkList[probe.InterfaceIndex]
+	if ip := net.ParseIP(ul.AllocatedIPv4Addr); ip != nil {
+		return ip
+	}
+	for _, addr := range ul.AllocatedIPv6List {
+		// Link-local address would require a zone.
+		if ip := net.ParseIP(addr); ip != nil && !ip.IsLinkLocalUnicast() {
+			return ip
+		}
+	}
+	return nil
+}
+
 func lookupDomainConfig(ctx *zedmanagerContext, key string) *types.DomainConfig {
 
 	pub := ctx.pubDomainConfig
@@ -144,9 +144,19 @@ func doUpdate(ctx *zedmanagerContext,
 
 	if !status.EffectiveActivate {
 		if status.Activated || status.ActivateInprogress {
-			c := doInactivateHalt(ctx, config, status)
+			// Dependents which are being halted go first
+			if name, description := checkStopDependents(ctx, status); description != nil {
+				c := setWaitingForDependency(status, name, *description)
+				changed = changed || c
+				return changed
+			}
+			c := clearWaitingForDependency(status)
+			changed = changed || c
+			c = doInactivateHalt(ctx, config, status)
 			changed = changed || c
 		} else {
+			c := clearWaitingForDependency(status)
+			changed = changed || c
 			// Since we are not activating we set the state to
 			// HALTED to indicate it is not running since it
 			// might have been halted before the device was rebooted
@@ -448,6 +458,18 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 		}
 	}
 
+	// Dependencies must be running (or ready) before we start
+	if !status.ActivateInprogress && !status.Activated {
+		if name, description := checkStartDependencies(ctx, config); description != nil {
+			c := setWaitingForDependency(status, name, *description)
+			changed = changed || c
+			return changed
+		}
+	}
+	if clearWaitingForDependency(status) {
+		changed = true
+	}
+
 	// Check that if we have sufficient memory
 	if !status.ActivateInprogress && !status.Activated &&
 		!ctx.globalConfig.GlobalValueBool(types.IgnoreMemoryCheckForApps) {
@@ -600,6 +622,14 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 	if c {
 		changed = true
 	}
+	if status.Health != ds.Health {
+		status.Health = ds.Health
+		changed = true
+	}
+	if !cmp.Equal(status.GuestInfo, ds.GuestInfo) {
+		status.GuestInfo = ds.GuestInfo
+		changed = true
+	}
 	// Are we doing a restart?
 	if status.RestartInprogress == types.BringDown {
 		if dc.Activate {
@@ -1003,6 +1033,14 @@ func doInactivateHalt(ctx *zedmanagerContext,
 	if c {
 		changed = true
 	}
+	if status.Health != ds.Health {
+		status.Health = ds.Health
+		changed = true
+	}
+	if !cmp.Equal(status.GuestInfo, ds.GuestInfo) {
+		status.GuestInfo = ds.GuestInfo
+		changed = true
+	}
 	if ds.State != status.State {
 		switch status.State {
 		case types.RESTARTING, types.PURGING:
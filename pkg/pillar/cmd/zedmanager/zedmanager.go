@@ -429,13 +429,16 @@ func handleAppInstanceStatusCreate(ctxArg interface{}, key string,
 	statusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateWaitingApps(ctx, key)
 }
 
 // handleAppInstanceStatusModify - Handle AIS modify. Publish AppStatusSummary to ledmanager
+// and re-evaluate app instances waiting for this one to start or halt
 func handleAppInstanceStatusModify(ctxArg interface{}, key string,
 	statusArg interface{}, oldStatusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateWaitingApps(ctx, key)
 }
 
 // handleAppInstanceStatusDelete - Handle AIS delete. Publish AppStatusSummary to ledmanager
@@ -443,6 +446,7 @@ func handleAppInstanceStatusDelete(ctxArg interface{}, key string,
 	statusArg interface{}) {
 	ctx := ctxArg.(*zedmanagerContext)
 	publishAppInstanceSummary(ctx)
+	updateWaitingApps(ctx, key)
 }
 
 func publishAppInstanceSummary(ctxPtr *zedmanagerContext) {
@@ -280,7 +280,8 @@ func compileOldAppInstanceIpsets(ctx *zedrouterContext,
 // Application Network Level ACL rule handling routines
 
 // For a shared bridge call aclToRules for each ifname, then aclDropRules,
-// then concat all the rules and pass to applyACLrules
+// then concat all the rules and apply rulePrefix to each of them.
+// Rules are applied by the NI reconciler (see updateAppVIFReconciler).
 // Note that only bridgeName is set with ifMgmt
 func createACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 	ACLs []types.ACE) (types.IPTablesRuleList, []types.ACLDepend, error) {
@@ -297,9 +298,26 @@ func createACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 		return rules, depend, err
 	}
 	rules = append(rules, dropRules...)
-	rules, err = applyACLRules(aclArgs, rules)
-	clearUDPFlows(aclArgs, ACLs)
-	return rules, depend, err
+	rules = prefixACLRules(aclArgs, rules)
+	if aclArgs.BridgeIPv6 == "" {
+		return rules, depend, nil
+	}
+	// Dual-stack network instance
+	rulesV6, dependV6, err := createACLConfiglet(ctx, ipv6ACLArgs(aclArgs), ACLs)
+	if err != nil {
+		return rules, depend, err
+	}
+	return append(rules, rulesV6...), append(depend, dependV6...), nil
+}
+
+// ipv6ACLArgs returns arguments for the IPv6 rules of a dual-stack
+// network instance.
+func ipv6ACLArgs(aclArgs types.AppNetworkACLArgs) types.AppNetworkACLArgs {
+	aclArgs.BridgeIP = aclArgs.BridgeIPv6
+	aclArgs.AppIP = aclArgs.AppIPv6
+	aclArgs.BridgeIPv6 = ""
+	aclArgs.AppIPv6 = ""
+	return aclArgs
 }
 
 // This function looks for any UDP port map rules among the ACLs and if so clears
@@ -328,7 +346,7 @@ func clearUDPFlows(aclArgs types.AppNetworkACLArgs, ACLs []types.ACE) {
 				continue
 			}
 			var family netlink.InetFamily = syscall.AF_INET
-			if aclArgs.IPVer != 4 {
+			if determineIPVer(aclArgs.IsMgmt, aclArgs.BridgeIP) != 4 {
 				family = syscall.AF_INET6
 			}
 			dport, err := strconv.ParseInt(port, 10, 32)
@@ -352,6 +370,9 @@ func clearUDPFlows(aclArgs types.AppNetworkACLArgs, ACLs []types.ACE) {
 				flowsDeleted, dport, targetPort)
 		}
 	}
+	if aclArgs.BridgeIPv6 != "" {
+		clearUDPFlows(ipv6ACLArgs(aclArgs), ACLs)
+	}
 }
 
 // If no valid bridgeIP we assume IPv4
@@ -374,36 +395,24 @@ func determineIPVer(isMgmt bool, bridgeIP string) int {
 	}
 }
 
-func applyACLRules(aclArgs types.AppNetworkACLArgs,
-	rules types.IPTablesRuleList) (types.IPTablesRuleList, error) {
-	var err error
+func prefixACLRules(aclArgs types.AppNetworkACLArgs,
+	rules types.IPTablesRuleList) types.IPTablesRuleList {
 	var activeRules types.IPTablesRuleList
-	log.Tracef("applyACLRules: ipVer %d, bridgeName %s appIP %s with %d rules\n",
+	log.Tracef("prefixACLRules: ipVer %d, bridgeName %s appIP %s with %d rules\n",
 		aclArgs.IPVer, aclArgs.BridgeName, aclArgs.AppIP, len(rules))
 
-	// the catch all log/drop rules are towards the end of the rule list
-	// hence we are inserting the rule in reverse order at
-	// the top of a target chain, to ensure the drop rules
-	// will be at the end of the rule stack, and the acl match
-	// rules will be at the top of the rule stack for an app
+	// the catch all log/drop rules are towards the end of the rule list,
+	// the NI reconciler keeps the order of the rules for an app
 	// network instance
-	numRules := len(rules)
-	for numRules > 0 {
-		numRules--
-		rule := rules[numRules]
+	for _, rule := range rules {
 		log.Tracef("createACLConfiglet: add rule %v\n", rule)
 		if err := rulePrefix(aclArgs, &rule); err != nil {
 			log.Tracef("createACLConfiglet: skipping rule %v\n", rule)
 			continue
 		}
-		err = executeIPTablesRule("-I", rule)
-		if err == nil {
-			activeRules = append(activeRules, rule)
-		} else {
-			return activeRules, err
-		}
+		activeRules = append(activeRules, rule)
 	}
-	return activeRules, err
+	return activeRules
 }
 
 // Returns a list of iptables commands, witout the initial "-A FORWARD"
@@ -469,13 +478,39 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 			aclRule4.Action = []string{"-j", "ACCEPT"}
 			rulesList = append(rulesList, aclRule1, aclRule2, aclRule3, aclRule4)
 
-			aclRule1.Rule = []string{"-i", aclArgs.BridgeName, "-d", "169.254.169.254",
-				"-p", "tcp", "--dport", "http"}
-			aclRule1.Action = []string{"-j", "ACCEPT"}
-			aclRule2.Rule = []string{"-i", aclArgs.BridgeName, "-s", "169.254.169.254",
-				"-p", "tcp", "--sport", "http"}
-			aclRule2.Action = []string{"-j", "ACCEPT"}
-			rulesList = append(rulesList, aclRule1, aclRule2)
+			// Mark flows towards dom0, otherwise they would be marked
+			// by the default drop rule. The meta-data server is IPv4 only.
+			aclRule5.Table = "mangle"
+			aclRule5.Chain = "PREROUTING"
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName, "-p", "ipv6-icmp"}
+			chainName := fmt.Sprintf("proto-%s-%s-%d",
+				aclArgs.BridgeName, aclArgs.VifName, 9)
+			aclRule5.ActionChainMark = 9
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
+
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName,
+				"-p", "udp", "--dport", "dhcpv6-server"}
+			chainName = fmt.Sprintf("proto-%s-%s-%d",
+				aclArgs.BridgeName, aclArgs.VifName, 6)
+			aclRule5.ActionChainMark = 6
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
+
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName, "-d", aclArgs.BridgeIP,
+				"-p", "udp", "--dport", "domain"}
+			chainName = fmt.Sprintf("proto-%s-%s-%d",
+				aclArgs.BridgeName, aclArgs.VifName, 7)
+			aclRule5.ActionChainMark = 7
+			aclRule5.Action = []string{"-j", chainName}
+			aclRule5.ActionChainName = chainName
+			rulesList = append(rulesList, aclRule5)
+
+			aclRule5.Rule = []string{"-i", aclArgs.BridgeName, "-d", aclArgs.BridgeIP,
+				"-p", "tcp", "--dport", "domain"}
+			rulesList = append(rulesList, aclRule5)
 		} else if aclArgs.NIType == types.NetworkInstanceTypeSwitch {
 			aclRule1.Rule = []string{"-i", aclArgs.BridgeName, "-m", "set",
 				"--match-set", "ipv6.local", "dst", "-p", "ipv6-icmp"}
@@ -585,7 +620,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "bootps"}
 			chainName := fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 6)
-			createMarkAndAcceptChain(aclArgs, chainName, 6)
+			aclRule5.ActionChainMark = 6
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -594,7 +629,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "domain"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 7)
-			createMarkAndAcceptChain(aclArgs, chainName, 7)
+			aclRule5.ActionChainMark = 7
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -612,7 +647,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "tcp", "--dport", "http"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 8)
-			createMarkAndAcceptChain(aclArgs, chainName, 8)
+			aclRule5.ActionChainMark = 8
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -652,7 +687,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "bootps:bootpc"}
 			chainName := fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 6)
-			createMarkAndAcceptChain(aclArgs, chainName, 6)
+			aclRule5.ActionChainMark = 6
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -662,7 +697,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "udp", "--dport", "domain"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 7)
-			createMarkAndAcceptChain(aclArgs, chainName, 7)
+			aclRule5.ActionChainMark = 7
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -680,7 +715,7 @@ func aclToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, ACLs []t
 				"-p", "tcp", "--dport", "http"}
 			chainName = fmt.Sprintf("proto-%s-%s-%d",
 				aclArgs.BridgeName, aclArgs.VifName, 8)
-			createMarkAndAcceptChain(aclArgs, chainName, 8)
+			aclRule5.ActionChainMark = 8
 			aclRule5.Action = []string{"-j", chainName}
 			aclRule5.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule5)
@@ -746,7 +781,7 @@ func aclDropRules(aclArgs types.AppNetworkACLArgs) (types.IPTablesRuleList, erro
 		aclRule3.ActionChainName = chainName
 		marking := iptables.GetConnmark(
 			uint8(aclArgs.AppNum), iptables.DefaultDropAceID, true)
-		createMarkAndAcceptChain(aclArgs, chainName, marking)
+		aclRule3.ActionChainMark = marking
 		aclRule3.Action = []string{"-j", chainName}
 		aclRule3.RuleID = iptables.DefaultDropAceID
 		aclRule3.IsDefaultDrop = true
@@ -838,14 +873,9 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 				log.Errorln(errStr)
 				return nil, nil, errors.New(errStr)
 			}
-			// Ensure the sets exists; create if not
-			// need to feed it into dnsmasq as well; restart
+			// The sets are created by the NI reconciler and fed
+			// into dnsmasq as part of the network instance config.
 			ipsetBasename := hostIpsetBasename(match.Value)
-			err := ipsetCreatePair(ipsetBasename, "hash:ip")
-			if err != nil {
-				log.Errorln("ipset create for ",
-					match.Value, err)
-			}
 			switch aclArgs.IPVer {
 			case 4:
 				ipsetName = "ipv4." + ipsetBasename
@@ -893,6 +923,13 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 		return nil, nil, errors.New(errStr)
 	}
 
+	if ip != "" && ipVerOfIPorCIDR(ip) != aclArgs.IPVer {
+		// Dual-stack network instance gets rules for both IP versions,
+		// the ACE applies only to one of them.
+		log.Functionf("aceToRules: skipping ACE %d with IPv%d match for IPv%d",
+			ace.RuleID, ipVerOfIPorCIDR(ip), aclArgs.IPVer)
+		return nil, nil, nil
+	}
 	if ip != "" {
 		outArgs = append(outArgs, "-d", ip)
 		inArgs = append(inArgs, "-s", ip)
@@ -923,7 +960,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 	unlimitedOutArgs := outArgs
 	actionCount := 0
 	for _, action := range ace.Actions {
-		// We check and reject combinations of Drop, Limit, and PortMap
+		// We check and reject combinations of Drop, Limit, Shape and PortMap
 		// At most one allowed
 		if action.Drop {
 			actionCount += 1
@@ -947,6 +984,17 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 			outArgs = append(outArgs, add...)
 			inArgs = append(inArgs, add...)
 		}
+		if action.Shape {
+			// Traffic is accepted by the ACE and shaped by tc using the connection
+			// mark assigned to the ACE (see getVIFShaping).
+			actionCount += 1
+			if action.ShapeCeil != 0 && action.ShapeRate > action.ShapeCeil {
+				errStr := fmt.Sprintf("Shape with rate %d above ceil %d: %+v",
+					action.ShapeRate, action.ShapeCeil, ace)
+				log.Errorln(errStr)
+				return nil, nil, errors.New(errStr)
+			}
+		}
 		if action.PortMap {
 			actionCount += 1
 			// Generate NAT and ACCEPT rules based on protocol,
@@ -964,7 +1012,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 				return nil, nil, errors.New(errStr)
 			}
 			targetPort := fmt.Sprintf("%d", action.TargetPort)
-			target := fmt.Sprintf("%s:%d", aclArgs.AppIP, action.TargetPort)
+			target := net.JoinHostPort(aclArgs.AppIP, targetPort)
 			// These rules are applied on the upLink interfaces,
 			// the uplink IP address, and port number.
 			// We add those to the dependList we return
@@ -980,16 +1028,22 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 					dependList = append(dependList, depend)
 					continue
 				}
-				// Pick first IPv4 address
+				// Pick first address of the IP version
 				var extIP net.IP
 				for _, ip := range extIPs {
-					if ip.To4() != nil {
+					if aclArgs.IPVer == 4 && ip.To4() != nil {
+						extIP = ip
+						break
+					}
+					if aclArgs.IPVer == 6 && ip.To4() == nil &&
+						ip.IsGlobalUnicast() {
 						extIP = ip
 						break
 					}
 				}
 				if len(extIP) == 0 {
-					log.Errorf("Can't add hairpin rule for %s: no IPv4 address", upLink)
+					log.Errorf("Can't add hairpin rule for %s: no IPv%d address",
+						upLink, aclArgs.IPVer)
 					depend := types.ACLDepend{Ifname: upLink}
 					dependList = append(dependList, depend)
 					continue
@@ -1005,6 +1059,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 				aclRule1.Chain = "PREROUTING"
 				aclRule1.RuleID = ace.RuleID
 				aclRule1.ActionChainName = ""
+				aclRule1.ActionChainMark = 0
 				aclRule1.Rule = []string{"-i", upLink, "-p", protocol,
 					"-d", extIP.String(), "--dport", lport}
 				aclRule1.Action = []string{"-j", "DNAT",
@@ -1024,7 +1079,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 					// Embed App id in marking value
 					markingValue := iptables.GetConnmark(
 						uint8(aclArgs.AppNum), uint32(aclRule1.RuleID), false)
-					createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+					aclRule1.ActionChainMark = markingValue
 					aclRule1.Action = []string{"-j", chainName}
 					aclRule1.ActionChainName = chainName
 					rulesList = append(rulesList, aclRule1)
@@ -1041,6 +1096,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 				aclRuleH.Chain = "PREROUTING"
 				aclRuleH.RuleID = ace.RuleID
 				aclRuleH.ActionChainName = ""
+				aclRuleH.ActionChainMark = 0
 				aclRuleH.Rule = []string{"-i", aclArgs.BridgeName, "-p", protocol,
 					"-d", extIP.String(), "--dport", lport}
 				aclRuleH.Action = []string{"-j", "DNAT",
@@ -1060,7 +1116,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 					// Embed App id in marking value
 					markingValue := iptables.GetConnmark(
 						uint8(aclArgs.AppNum), uint32(aclRuleH.RuleID), false)
-					createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+					aclRuleH.ActionChainMark = markingValue
 					aclRuleH.Action = []string{"-j", chainName}
 					aclRuleH.ActionChainName = chainName
 					rulesList = append(rulesList, aclRuleH)
@@ -1114,7 +1170,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 			// table for marking these connections.
 		}
 		if actionCount > 1 {
-			errStr := fmt.Sprintf("ACL with combination of Drop, Limit and/or PortMap rejected: %+v",
+			errStr := fmt.Sprintf("ACL with combination of Drop, Limit, Shape and/or PortMap rejected: %+v",
 				ace)
 			log.Errorln(errStr)
 			return nil, nil, errors.New(errStr)
@@ -1175,7 +1231,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 			// Embed App id in marking value
 			markingValue := iptables.GetConnmark(
 				uint8(aclArgs.AppNum), uint32(aclRule4.RuleID), foundDrop)
-			createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+			aclRule4.ActionChainMark = markingValue
 			aclRule4.Action = []string{"-j", chainName}
 			aclRule4.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule4)
@@ -1186,7 +1242,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 		}
 	case types.NetworkInstanceTypeCloud:
 		fallthrough
-	case types.NetworkInstanceTypeSwitch:
+	case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeMesh:
 		if aclRule4.RuleID != -1 {
 			aclRule4.Table = "mangle"
 			aclRule4.Chain = "PREROUTING"
@@ -1197,7 +1253,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 			// Embed App id in marking value
 			markingValue := iptables.GetConnmark(
 				uint8(aclArgs.AppNum), uint32(aclRule4.RuleID), foundDrop)
-			createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+			aclRule4.ActionChainMark = markingValue
 			aclRule4.Action = []string{"-j", chainName}
 			aclRule4.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule4)
@@ -1217,7 +1273,7 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 
 			// Embed App id in marking value
 			markingValue := iptables.GetConnmark(uint8(aclArgs.AppNum), uint32(aclRule3.RuleID), foundDrop)
-			createMarkAndAcceptChain(aclArgs, chainName, markingValue)
+			aclRule3.ActionChainMark = markingValue
 			aclRule3.Action = []string{"-j", chainName}
 			aclRule3.ActionChainName = chainName
 			rulesList = append(rulesList, aclRule3)
@@ -1250,6 +1306,18 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 	return rulesList, dependList, nil
 }
 
+// ipVerOfIPorCIDR returns 6 for IPv6 address or subnet and 4 otherwise.
+func ipVerOfIPorCIDR(str string) int {
+	ip := net.ParseIP(str)
+	if ip == nil {
+		ip, _, _ = net.ParseCIDR(str)
+	}
+	if ip != nil && ip.To4() == nil {
+		return 6
+	}
+	return 4
+}
+
 func isIPorCIDR(str string) bool {
 	if net.ParseIP(str) != nil {
 		return true
@@ -1289,7 +1357,9 @@ func rulePrefix(aclArgs types.AppNetworkACLArgs, rule *types.IPTablesRule) error
 		return nil
 	}
 
-	if aclArgs.IPVer == 6 {
+	// Rules with the table and chain set (NAT, marking) are handled below
+	// for both IP versions.
+	if aclArgs.IPVer == 6 && rule.Table == "" && rule.Chain == "" {
 		// The input rules (from domU are applied to raw to intercept
 		// before lisp/pcap can pick them up.
 		// The output rules (to domU) are applied in forwarding path
@@ -1380,69 +1450,30 @@ func containsRule(set types.IPTablesRuleList, member types.IPTablesRule) bool {
 	return false
 }
 
-func diffIpsets(newIpsets, oldIpsets []string) ([]string, []string, bool) {
-
-	staleIpsets := []string{}
-	newIpsetMap := make(map[string]bool)
-	restartDnsmasq := false
-
-	// Add all new ipsets in a map
-	for _, ipset := range newIpsets {
-		newIpsetMap[ipset] = true
-	}
-
-	// Check which of the old ipsets need to be removed
-	for _, ipset := range oldIpsets {
-
-		_, ok := newIpsetMap[ipset]
-		if !ok {
-			staleIpsets = append(staleIpsets, ipset)
-		}
-	}
-
-	// When the ipset did not change, lenghts of old and new ipsets should
-	// be same and then stale ipsets list should be empty.
-
-	// In case if the ipset has changed but the lengh remained same, there
-	// will atleast be one stale entry in the old ipset that needs to be removed.
-	if (len(newIpsets) != len(oldIpsets)) || (len(staleIpsets) != 0) {
-		restartDnsmasq = true
-	}
-	log.Functionf("diffIpsets: restart %v, new %v, stale %v",
-		restartDnsmasq, newIpsets, staleIpsets)
-	return newIpsets, staleIpsets, restartDnsmasq
-}
-
-// it will be difficult the maintain the precedence/order of the iptables
-// rules, across multiple app instance modules
-// apply rules as a block
-// lets just delete the existing ACL iptables rules block
-// and add the new ACL rules, for the appNetwork.
+// updateACLConfiglet recomputes the rules of the app network and returns
+// them together with changed set to true if the ACLs have changed (or force
+// is set) and the rules should be re-applied.
 func updateACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs, oldACLs []types.ACE, ACLs []types.ACE,
-	oldRules types.IPTablesRuleList, oldDepend []types.ACLDepend, force bool) (types.IPTablesRuleList, []types.ACLDepend, error) {
+	oldRules types.IPTablesRuleList, oldDepend []types.ACLDepend, force bool) (
+	rules types.IPTablesRuleList, depend []types.ACLDepend, changed bool, err error) {
 
 	log.Functionf("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s\n",
 		aclArgs.BridgeName, aclArgs.VifName, aclArgs.AppIP)
 
-	aclArgs.IPVer = determineIPVer(aclArgs.IsMgmt, aclArgs.BridgeIP)
 	if !force && compareACLs(oldACLs, ACLs) {
 		log.Functionf("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s: no change\n",
 			aclArgs.BridgeName, aclArgs.VifName, aclArgs.AppIP)
-		return oldRules, oldDepend, nil
-	}
-
-	rules, err := deleteACLConfiglet(aclArgs, oldRules)
-	if err != nil {
-		log.Functionf("updateACLConfiglet: bridgeName %s, vifName %s, appIP %s: delete fail\n",
-			aclArgs.BridgeName, aclArgs.VifName, aclArgs.AppIP)
-		return rules, nil, err
+		return oldRules, oldDepend, false, nil
 	}
+	rules, depend, err = createACLConfiglet(ctx, aclArgs, ACLs)
+	return rules, depend, true, err
+}
 
-	rulesList, dependList, err := createACLConfiglet(ctx, aclArgs, ACLs)
-
-	// Before adding new rules, clear flows if any created matching the old rules
+// clearAppFlows clears flows of the app, which could have been created
+// matching the old rules.
+func clearAppFlows(aclArgs types.AppNetworkACLArgs) {
 	var family netlink.InetFamily = syscall.AF_INET
-	if aclArgs.IPVer == 4 {
+	if determineIPVer(aclArgs.IsMgmt, aclArgs.BridgeIP) == 4 {
 		family = syscall.AF_INET
 	} else {
 		family = syscall.AF_INET6
@@ -1454,42 +1485,25 @@ func updateACLConfiglet(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 		srcIP = net.ParseIP(aclArgs.AppIP)
 	}
 	if srcIP == nil {
-		log.Errorf("updateACLConfiglet: App IP (%s) parse failed", aclArgs.AppIP)
+		log.Errorf("clearAppFlows: App IP (%s) parse failed", aclArgs.AppIP)
+		return
+	}
+	mark := iptables.GetConnmark(uint8(aclArgs.AppNum), 0, false)
+	number, err := netlink.ConntrackDeleteFilter(netlink.ConntrackTable, family,
+		conntrack.SrcIPFilter{
+			Log:      log,
+			SrcIP:    srcIP,
+			Mark:     mark,
+			MarkMask: iptables.AppIDMask})
+	if err != nil {
+		log.Errorf("clearAppFlows: Error clearing flows before update - %s", err)
 	} else {
-		mark := iptables.GetConnmark(uint8(aclArgs.AppNum), 0, false)
-		number, err := netlink.ConntrackDeleteFilter(netlink.ConntrackTable, family,
-			conntrack.SrcIPFilter{
-				Log:      log,
-				SrcIP:    srcIP,
-				Mark:     mark,
-				MarkMask: iptables.AppIDMask})
-		if err != nil {
-			log.Errorf("updateACLConfiglet: Error clearing flows before update - %s", err)
-		} else {
-			log.Functionf("updateACLConfiglet: Cleared %d flows before updating ACLs for app num %d",
-				number, aclArgs.AppNum)
-		}
+		log.Functionf("clearAppFlows: Cleared %d flows before updating ACLs for app num %d",
+			number, aclArgs.AppNum)
 	}
-
-	return rulesList, dependList, err
-}
-
-func deleteACLConfiglet(aclArgs types.AppNetworkACLArgs,
-	rules types.IPTablesRuleList) (types.IPTablesRuleList, error) {
-	var err error
-	var activeRules types.IPTablesRuleList
-	log.Functionf("deleteACLConfiglet: ifname %s vifName %s ACLs %v\n",
-		aclArgs.BridgeName, aclArgs.VifName, rules)
-
-	for _, rule := range rules {
-		log.Tracef("deleteACLConfiglet: rule %v\n", rule)
-		if err != nil {
-			activeRules = append(activeRules, rule)
-		} else {
-			err = executeIPTablesRule("-D", rule)
-		}
+	if aclArgs.BridgeIPv6 != "" {
+		clearAppFlows(ipv6ACLArgs(aclArgs))
 	}
-	return activeRules, err
 }
 
 // utility routines for ACLs
@@ -1522,7 +1536,8 @@ func compareACE(ACE0 types.ACE, ACE1 types.ACE) bool {
 		action1 := ACE1.Actions[idx]
 		if action0.Drop != action1.Drop ||
 			action0.Limit != action1.Limit ||
-			action0.PortMap != action1.PortMap {
+			action0.PortMap != action1.PortMap ||
+			action0.Shape != action1.Shape {
 			return false
 		}
 		if action0.PortMap {
@@ -1537,6 +1552,13 @@ func compareACE(ACE0 types.ACE, ACE1 types.ACE) bool {
 				return false
 			}
 		}
+		if action0.Shape {
+			if action0.ShapeRate != action1.ShapeRate ||
+				action0.ShapeCeil != action1.ShapeCeil ||
+				ACE0.Dir != ACE1.Dir {
+				return false
+			}
+		}
 	}
 	return true
 }
@@ -1651,42 +1673,6 @@ func checkForMatchCondition(ace types.ACE, ace1 types.ACE, matchTypes []string)
 	return true
 }
 
-// utility routines for IpTables Rules
-func executeIPTablesRule(operation string, rule types.IPTablesRule) error {
-	var err error
-	ruleStr := []string{}
-	if rule.Table != "" {
-		ruleStr = append(ruleStr, "-t")
-		ruleStr = append(ruleStr, rule.Table)
-	}
-	ruleStr = append(ruleStr, operation)
-	ruleStr = append(ruleStr, appChain(rule.Chain))
-	ruleStr = append(ruleStr, rule.Prefix...)
-	ruleStr = append(ruleStr, rule.Rule...)
-	if len(rule.Action) > 0 {
-		ruleStr = append(ruleStr, rule.Action...)
-	}
-	if rule.IPVer == 4 {
-		err = iptables.IptableCmd(log, ruleStr...)
-		if operation == "-D" && rule.Table == "mangle" {
-			if rule.ActionChainName != "" {
-				chainFlush := []string{"-t", "mangle", "--flush", rule.ActionChainName}
-				chainDelete := []string{"-t", "mangle", "-X", rule.ActionChainName}
-				err = iptables.IptableCmd(log, chainFlush...)
-				if err == nil {
-					iptables.IptableCmd(log, chainDelete...)
-				}
-			}
-		}
-	} else if rule.IPVer == 6 {
-		err = iptables.Ip6tableCmd(log, ruleStr...)
-	} else {
-		errStr := fmt.Sprintf("ACL: Unknown IP version %d", rule.IPVer)
-		err = errors.New(errStr)
-	}
-	return err
-}
-
 func createFlowMonDummyInterface() {
 	// Check if our dummy interface already exits.
 	link, err := netlink.LinkByName(dummyIntfName)
@@ -1732,89 +1718,6 @@ func createFlowMonDummyInterface() {
 	}
 }
 
-func createMarkAndAcceptChain(aclArgs types.AppNetworkACLArgs,
-	name string, marking uint32) error {
-
-	// not for dom0
-	if aclArgs.IsMgmt {
-		return errors.New("invalid chain creation")
-	}
-
-	chainFlush := []string{"-t", "mangle", "--flush", name}
-
-	newChain := []string{"-t", "mangle", "-N", name}
-	log.Functionf("createMarkAndAcceptChain: Creating new chain (%s)", name)
-	err := iptables.IptableCmd(log, newChain...)
-	if err != nil {
-		// if chain already exists, we can skip this error
-		if !strings.Contains(err.Error(), "Chain already exists") {
-			log.Errorf("createMarkAndAcceptChain: New chain (%s) creation failed: %s",
-				name, err)
-			return err
-		}
-		log.Functionf("createMarkAndAcceptChain: Chain (%s) flushing and recreating of rules: %s",
-			name, err)
-		if err := iptables.IptableCmd(log, chainFlush...); err != nil {
-			log.Errorf("createMarkAndAcceptChain: Flush exists chain (%s) failed: %s",
-				name, err)
-			return err
-		}
-	}
-
-	rule1 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--restore-mark"}
-	rule2 := []string{"-A", name, "-t", "mangle", "-m", "mark", "!", "--mark", "0",
-		"-j", "ACCEPT"}
-
-	rule3 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--set-mark",
-		strconv.FormatUint(uint64(marking), 10)}
-	rule4 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--restore-mark"}
-	rule5 := []string{"-A", name, "-t", "mangle", "-j", "ACCEPT"}
-
-	chainDelete := []string{"-t", "mangle", "-X", name}
-
-	err = iptables.IptableCmd(log, rule1...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule1, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule2...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule2, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule3...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule3, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule4...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule4, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	err = iptables.IptableCmd(log, rule5...)
-	if err != nil {
-		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
-			rule5, err)
-		iptables.IptableCmd(log, chainFlush...)
-		iptables.IptableCmd(log, chainDelete...)
-		return err
-	}
-	return nil
-}
-
 // insert or remove the App Container API endpoint blocking ACL
 func appConfigContainerStatsACL(appIPAddr net.IP, isRemove bool) {
 	var err error
@@ -1861,4 +1764,13 @@ func dropEscapedFlows() {
 	if err != nil {
 		log.Errorf("dropEscapedFlows: iptableCmd err %v", err)
 	}
+	// IPv6 flows of dual-stack network instances are not routed
+	// into the dummy interface, all of them are dropped here.
+	err = iptables.Ip6tableCmd(log, "-t", "mangle", "-I", appChain("POSTROUTING"),
+		"--match", "connmark", "--mark",
+		fmt.Sprintf("%d/%d", iptables.AceDropAction, iptables.AceActionMask),
+		"-j", "DROP")
+	if err != nil {
+		log.Errorf("dropEscapedFlows: ip6tableCmd err %v", err)
+	}
 }
@@ -14,22 +14,14 @@ import (
 	"os"
 	"strconv"
 	"strings"
-	"syscall"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
-	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
 	"github.com/lf-edge/eve/pkg/pillar/types"
-	"github.com/lf-edge/eve/pkg/pillar/utils"
 	"github.com/sirupsen/logrus"
 )
 
-// XXX inotify seems to stop reporting any changes in some cases
-// XXX avoid by start and stop dnsmasq when we add entries
-// XXX KALYAN - We need to set this to have DHCP working wiht Network instances.
-//		Turning this flag on temporarily till we figure out whats happening.
-const dnsmasqStopStart = false // XXX change? remove?
-
 const dnsmasqStatic = `
 # Automatically generated by zedrouter
 except-interface=lo
@@ -110,7 +102,8 @@ func dnsmasqDhcpHostDir(bridgeName string) string {
 	return dhcphostsDir
 }
 
-// createDnsmasqConfiglet
+// createDnsmasqConfiglet returns the content of the dnsmasq config file
+// for the given bridge.
 // When we create a linux bridge we set this up
 // Also called when we need to update the ipsets
 func createDnsmasqConfiglet(
@@ -118,29 +111,13 @@ func createDnsmasqConfiglet(
 	bridgeName string, bridgeIPAddr string,
 	netstatus *types.NetworkInstanceStatus, hostsDir string,
 	ipsetHosts []string, uplink string,
-	dnsServers []net.IP, ntpServers []net.IP) {
+	dnsServers []net.IP, ntpServers []net.IP) string {
 
 	log.Functionf("createDnsmasqConfiglet(%s, %s) netstatus %v, ipsetHosts %v uplink %s dnsServers %v ntpServers %v",
 		bridgeName, bridgeIPAddr, netstatus, ipsetHosts, uplink, dnsServers, ntpServers)
 
-	cfgPathname := dnsmasqConfigPath(bridgeName)
-	// Delete if it exists
-	if _, err := os.Stat(cfgPathname); err == nil {
-		if err := os.Remove(cfgPathname); err != nil {
-			errStr := fmt.Sprintf("createDnsmasqConfiglet %v",
-				err)
-			log.Errorln(errStr)
-		}
-	}
-	file, err := os.Create(cfgPathname)
-	if err != nil {
-		log.Fatal("createDnsmasqConfiglet failed ", err)
-	}
-	defer file.Close()
-
-	// Create a dhcp-hosts directory to be used when hosts are added
+	var file strings.Builder
 	dhcphostsDir := dnsmasqDhcpHostDir(bridgeName)
-	ensureDir(dhcphostsDir)
 
 	file.WriteString(dnsmasqStatic)
 
@@ -173,11 +150,17 @@ func createDnsmasqConfiglet(
 
 	for _, host := range ipsetHosts {
 		ipsetBasename := hostIpsetBasename(host)
+		if ctx.aclBackend == types.ACLBackendNftables {
+			file.WriteString(fmt.Sprintf("nftset=/%s/%s\n", host,
+				nftables.DnsmasqNftset("ipv4."+ipsetBasename,
+					"ipv6."+ipsetBasename)))
+			continue
+		}
 		file.WriteString(fmt.Sprintf("ipset=/%s/ipv4.%s,ipv6.%s\n",
 			host, ipsetBasename, ipsetBasename))
 	}
-	file.WriteString(fmt.Sprintf("pid-file=/run/dnsmasq.%s.pid\n",
-		bridgeName))
+	file.WriteString(fmt.Sprintf("pid-file=%s\n",
+		dnsmasqPidFile(bridgeName)))
 	file.WriteString(fmt.Sprintf("interface=%s\n", bridgeName))
 	isIPv6 := false
 	if bridgeIPAddr != "" {
@@ -296,96 +279,38 @@ func createDnsmasqConfiglet(
 		file.WriteString(fmt.Sprintf("dhcp-range=%s,static,%s,60m\n",
 			dhcpRange, ipv4Netmask))
 	}
-}
-
-func addhostDnsmasq(bridgeName string, appMac string, appIPAddr string,
-	hostname string) {
-
-	log.Functionf("addhostDnsmasq(%s, %s, %s, %s)\n", bridgeName, appMac,
-		appIPAddr, hostname)
-	if dnsmasqStopStart {
-		stopDnsmasq(bridgeName, true, false)
-	}
-	ip := net.ParseIP(appIPAddr)
-	if ip == nil {
-		log.Fatalf("addhostDnsmasq failed to parse IP %s", appIPAddr)
-	}
-	isIPv6 := (ip.To4() == nil)
-	suffix := ".inet"
-	if isIPv6 {
-		suffix += "6"
-	}
-
-	dhcphostsDir := dnsmasqDhcpHostDir(bridgeName)
-	ensureDir(dhcphostsDir)
-	cfgPathname := dhcphostsDir + "/" + appMac + suffix
-
-	file, err := os.Create(cfgPathname)
-	if err != nil {
-		log.Fatal("addhostDnsmasq failed ", err)
-	}
-	defer file.Close()
-	if isIPv6 {
-		file.WriteString(fmt.Sprintf("%s,[%s],%s\n",
-			appMac, appIPAddr, hostname))
-	} else {
-		file.WriteString(fmt.Sprintf("%s,id:*,%s,%s\n",
-			appMac, appIPAddr, hostname))
-	}
-	file.Close()
-	if dnsmasqStopStart {
-		startDnsmasq(bridgeName)
-	}
-}
-
-func removehostDnsmasq(bridgeName string, appMac string, appIPAddr string) {
-
-	log.Functionf("removehostDnsmasq(%s, %s, %s)\n",
-		bridgeName, appMac, appIPAddr)
-	stopDnsmasq(bridgeName, true, false)
-	ip := net.ParseIP(appIPAddr)
-	if ip == nil {
-		log.Fatalf("removehostDnsmasq failed to parse IP %s", appIPAddr)
-	}
-	isIPv6 := (ip.To4() == nil)
-	suffix := ".inet"
-	if isIPv6 {
-		suffix += "6"
-	}
-
-	dhcphostsDir := dnsmasqDhcpHostDir(bridgeName)
-	ensureDir(dhcphostsDir)
-
-	cfgPathname := dhcphostsDir + "/" + appMac + suffix
-	if _, err := os.Stat(cfgPathname); err != nil {
-		log.Functionf("removehostDnsmasq(%s, %s) failed: %s\n",
-			bridgeName, appMac, err)
-	} else {
-		if err := os.Remove(cfgPathname); err != nil {
-			errStr := fmt.Sprintf("removehostDnsmasq %v", err)
-			log.Errorln(errStr)
+	if netstatus.Type == types.NetworkInstanceTypeMesh {
+		// Larger frames would not fit into the VXLAN tunnel to the peers.
+		if mtu := meshVxlanMtu(netstatus); mtu != 0 {
+			file.WriteString(fmt.Sprintf("dhcp-option=option:mtu,%d\n", mtu))
 		}
 	}
-	startDnsmasq(bridgeName)
-}
-
-func deleteDnsmasqConfiglet(bridgeName string) {
-
-	log.Functionf("deleteDnsmasqConfiglet(%s)\n", bridgeName)
-	cfgPathname := dnsmasqConfigPath(bridgeName)
-	if _, err := os.Stat(cfgPathname); err == nil {
-		if err := os.Remove(cfgPathname); err != nil {
-			errStr := fmt.Sprintf("deleteDnsmasqConfiglet %v",
-				err)
-			log.Errorln(errStr)
+	if netstatus.IsDualStack() && netstatus.BridgeIPv6Addr != "" {
+		// DHCPv6 of a dual-stack network instance. The on-link prefix
+		// and the default router are announced by radvd.
+		file.WriteString(fmt.Sprintf("listen-address=%s\n",
+			netstatus.BridgeIPv6Addr))
+		if netstatus.DomainName != "" {
+			file.WriteString(fmt.Sprintf("dhcp-option=option6:domain-search,%s\n",
+				netstatus.DomainName))
 		}
+		var dnsServersV6 []string
+		for _, ns := range netstatus.DnsServers {
+			if ns.To4() == nil {
+				dnsServersV6 = append(dnsServersV6, "["+ns.String()+"]")
+			}
+		}
+		if len(dnsServersV6) != 0 {
+			file.WriteString(fmt.Sprintf("dhcp-option=option6:dns-server,%s\n",
+				strings.Join(dnsServersV6, ",")))
+		} else if !advertizeRouter && !advertizeDns {
+			file.WriteString("dhcp-option=option6:dns-server\n")
+		}
+		prefixLen, _ := netstatus.SubnetIPv6.Mask.Size()
+		file.WriteString(fmt.Sprintf("dhcp-range=%s,static,%d,60m\n",
+			netstatus.DhcpRangeIPv6.Start, prefixLen))
 	}
-	dhcphostsDir := dnsmasqDhcpHostDir(bridgeName)
-	ensureDir(dhcphostsDir)
-	if err := RemoveDirContent(dhcphostsDir); err != nil {
-		errStr := fmt.Sprintf("deleteDnsmasqConfiglet %v", err)
-		log.Errorln(errStr)
-	}
+	return file.String()
 }
 
 func RemoveDirContent(dir string) error {
@@ -404,82 +329,6 @@ func RemoveDirContent(dir string) error {
 	return nil
 }
 
-// Run this:
-//    ${DMDIR}/dnsmasq -b -C /run/zedrouter/dnsmasq.${BRIDGENAME}.conf
-func startDnsmasq(bridgeName string) {
-
-	log.Functionf("startDnsmasq(%s)\n", bridgeName)
-	cfgPathname := dnsmasqConfigPath(bridgeName)
-	name := "nohup"
-	args := []string{
-		"/opt/zededa/bin/dnsmasq",
-		"-C",
-		cfgPathname,
-	}
-	log.Functionf("Calling command %s %v\n", name, args)
-	out, err := base.Exec(log, name, args...).CombinedOutput()
-	if err != nil {
-		log.Errorf("startDnsmasq: Failed starting dnsmasq for bridge %s (%s)",
-			bridgeName, err)
-	} else {
-		log.Functionf("startDnsmasq: Started dnsmasq with output: %s", out)
-	}
-}
-
-func stopDnsmasq(bridgeName string, printOnError bool, delConfiglet bool) {
-
-	log.Functionf("stopDnsmasq(%s)\n", bridgeName)
-	pidfile := fmt.Sprintf("/run/dnsmasq.%s.pid", bridgeName)
-	pidByte, err := ioutil.ReadFile(pidfile)
-	if err != nil {
-		log.Errorf("stopDnsmasq: pid file read error %v\n", err)
-		return
-	}
-	pidStr := string(pidByte)
-	pidStr = strings.TrimSuffix(pidStr, "\n")
-	pidStr = strings.TrimSpace(pidStr)
-	pid, err := strconv.Atoi(pidStr)
-	if err != nil {
-		log.Errorf("stopDnsmasq: pid convert error %v\n", err)
-		return
-	}
-
-	cfgFilename := dnsmasqConfigFile(bridgeName)
-	utils.PkillArgs(log, cfgFilename, printOnError, false)
-
-	startCheckTime := time.Now()
-	// check and wait until the process is gone or maximum of 60 seconds is reached
-	for {
-		p, err := os.FindProcess(pid)
-		if err == nil {
-			err = p.Signal(syscall.Signal(0))
-			if err != nil {
-				log.Functionf("stopDnsmasq: kill process done for %d\n", pid)
-				break
-			} else {
-				log.Functionf("stopDnsmasq: wait for %d to finish\n", pid)
-			}
-			if time.Since(startCheckTime).Seconds() > 60 {
-				log.Errorf("stopDnsmasq: kill dnsmasq on %s pid %d not finish in 60 seconds\n", bridgeName, pid)
-				break
-			}
-			time.Sleep(1 * time.Second)
-		} else {
-			log.Functionf("stopDnsmasq: find dnsmasq process %s error %v\n", pidStr, err)
-			break
-		}
-	}
-
-	err = os.Remove(pidfile)
-	if err != nil && printOnError {
-		log.Errorf("stopDnsmasq: remove pidfile %s error %v", pidfile, err)
-	}
-
-	if delConfiglet {
-		deleteDnsmasqConfiglet(bridgeName)
-	}
-}
-
 // checkAndPublishDhcpLeases needs to be called periodically since it
 // refreshes the LastSeen and does garbage collection based on that timestamp
 func checkAndPublishDhcpLeases(ctx *zedrouterContext) {
@@ -780,19 +629,3 @@ func lookupVifIPTrig(ctx *zedrouterContext, mac string) (net.IP, []net.IP, bool)
 	ipv4Up := !isEmptyIP(vifTrig.IPv4Addr)
 	return vifTrig.IPv4Addr, vifTrig.IPv6Addrs, ipv4Up
 }
-
-// When we restart dnsmasq with smaller changes like chaging DNS server
-// file configuration, we should not delete the hosts configuration for
-// that has the IP address allotment information.
-func deleteOnlyDnsmasqConfiglet(bridgeName string) {
-
-	log.Functionf("deleteOnlyDnsmasqConfiglet(%s)\n", bridgeName)
-	cfgPathname := dnsmasqConfigPath(bridgeName)
-	if _, err := os.Stat(cfgPathname); err == nil {
-		if err := os.Remove(cfgPathname); err != nil {
-			errStr := fmt.Sprintf("deleteOnlyDnsmasqConfiglet %v",
-				err)
-			log.Errorln(errStr)
-		}
-	}
-}
@@ -1,7 +1,7 @@
 // Copyright (c) 2019 Zededa, Inc.
 // SPDX-License-Identifier: Apache-2.0
 
-// Flow Statistics collection from IPtable Conntrack facility
+// Flow Statistics collection from conntrack NEW/DESTROY events
 
 package zedrouter
 
@@ -14,16 +14,17 @@ import (
 	"sync"
 	"time"
 
-	"syscall"
-
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
+	"github.com/lf-edge/eve/pkg/pillar/conntrack"
+	"github.com/lf-edge/eve/pkg/pillar/flowexport"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	pcap "github.com/packetcap/go-pcap"
 	uuid "github.com/satori/go.uuid"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
 )
 
 type flowStats struct {
@@ -43,7 +44,6 @@ type flowStats struct {
 	appNum      uint8
 	drop        bool
 	AppInitiate bool
-	IsTimeOut   bool
 	foundApp    bool
 	dbg1        int
 	dbg2        int
@@ -75,6 +75,7 @@ type networkAttrs struct {
 	intfAddrs []net.Addr              // device interface addresses
 	bnNet     map[string]bridgeAttr   // mainly need to range all the bridge interfaces
 	appNet    map[int]uuid.UUID       // max 256 apps
+	appName   map[int]string          // appNum, display name
 }
 
 type dnsEntry struct {
@@ -88,9 +89,20 @@ type dnsEntry struct {
 
 const (
 	maxBridgeNumber int   = 256
-	timeoutSec      int32 = 150  // less than 150 sec, consider done (make sure to update 01-eve.conf in pkg/dom0-ztools)
 	maxFlowPack     int   = 125  // approximate 320 bytes per flow/dns, got an assert in zedagent when size was 241
 	flowStaleSec    int64 = 1800 // 30 min not touched, the publication will be removed
+	// Publications of one app/bridge are rotated over this many sequence numbers.
+	maxFlowSequence int = 16
+	// Limit on the number of conntrack entries remembered as sampled.
+	// Should be reached only if DESTROY events are lost.
+	maxSampledFlows int = 65536
+	// App/ACL attributes are re-read from publications at most this often
+	// when a flow of an unknown app or ACE is received.
+	flowAttrsRefreshIntv = 10 * time.Second
+	// Flows which exist at least this long are reported by flush() with
+	// the counters so far, i.e. long-lived connections are logged also
+	// while they are open and not only when they are closed.
+	activeFlowMinAge = 2 * time.Minute
 )
 
 type dnsSys struct {
@@ -100,177 +112,444 @@ type dnsSys struct {
 	Snoop       []dnsEntry
 }
 
-var loopcount int // XXX debug
-
 var dnssys [maxBridgeNumber]dnsSys // per bridge DNS records for the collection period
 var nilUUID uuid.UUID
 var broadcastMAC = []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
 
-// FlowStatsCollect : Timer fired to collect iptable flow stats
-func FlowStatsCollect(ctx *zedrouterContext) {
-	var instData networkAttrs
-	var timeOutTuples []flowStats
-	var totalFlow int
+// flowCollector : collects flows of applications from conntrack NEW and DESTROY
+// events (matched with apps and ACEs using the connection mark) and publishes
+// them as IPFlow records. Records are published per app and bridge whenever
+// maxFlowPack flows are collected, remaining flows and DNS requests snooped
+// on bridges are published by flush(), which is called periodically.
+// flush() also adds interim records of the long-lived flows which are still
+// open, taken from the dump of the conntrack table.
+type flowCollector struct {
+	sync.Mutex
+	ctx        *zedrouterContext
+	subscribed bool
+	instData   networkAttrs
+	refreshed  time.Time
+	// Only one of every <sampling> flows is logged.
+	sampling uint32
+	newFlows uint32
+	// IDs and original tuples of conntrack entries selected for logging
+	// (used if sampling > 1).
+	sampled map[uint32]flowKey
+	// At most <maxRate> flows are logged per second (0 = unlimited).
+	maxRate     uint32
+	rateWindow  time.Time
+	rateCount   uint32
+	rateDropped uint64
+	pending     map[flowScopeKey]*pendingFlows
+	// Exporters of flows to IPFIX/NetFlow collectors, key is NI UUID.
+	exporters map[uuid.UUID]*flowexport.Exporter
+}
 
-	instData.ipaclattr = make(map[int]map[int]aclAttr) // App-ID/ACL-Num/aclAttr table
-	instData.appIPinfo = make(map[int][]appInfo)
-	instData.bnNet = make(map[string]bridgeAttr) // borrow the aclAttr for intf attributes
-	instData.appNet = make(map[int]uuid.UUID)
+// flowKey : original direction tuple identifying conntrack entry in the dump
+// of the conntrack table, which does not include entry IDs.
+type flowKey struct {
+	proto   uint8
+	srcIP   string
+	dstIP   string
+	srcPort uint16
+	dstPort uint16
+}
 
-	IntfAddrs, err := net.InterfaceAddrs()
+func newFlowKey(entry *netlink.ConntrackFlow) flowKey {
+	return flowKey{
+		proto:   entry.Forward.Protocol,
+		srcIP:   entry.Forward.SrcIP.String(),
+		dstIP:   entry.Forward.DstIP.String(),
+		srcPort: entry.Forward.SrcPort,
+		dstPort: entry.Forward.DstPort,
+	}
+}
+
+type flowScopeKey struct {
+	appNum int
+	bridge string
+}
+
+// pendingFlows : flow records and DNS requests not yet published.
+type pendingFlows struct {
+	flowdata types.IPFlow
+	sequence int
+	appName  string
+}
+
+func (p *pendingFlows) size() int {
+	return len(p.flowdata.Flows) + len(p.flowdata.DNSReqs)
+}
+
+func newFlowCollector() *flowCollector {
+	return &flowCollector{
+		sampling:  1,
+		sampled:   make(map[uint32]flowKey),
+		pending:   make(map[flowScopeKey]*pendingFlows),
+		exporters: make(map[uuid.UUID]*flowexport.Exporter),
+	}
+}
+
+// start subscribes to conntrack events and starts processing them.
+func (c *flowCollector) start(ctx *zedrouterContext) {
+	c.Lock()
+	defer c.Unlock()
+	c.ctx = ctx
+	c.subscribe()
+}
+
+// subscribe is called with the collector locked.
+func (c *flowCollector) subscribe() {
+	events := make(chan conntrack.Event, 1024)
+	done := make(chan struct{})
+	err := conntrack.SubscribeEvents(log, events, done)
 	if err != nil {
-		log.Errorf("error in getting addresses\n")
+		log.Errorf("flowCollector: %v", err)
 		return
 	}
-	instData.intfAddrs = IntfAddrs
+	c.subscribed = true
+	go c.run(events, done)
+}
 
-	checkAppAndACL(ctx, &instData)
+func (c *flowCollector) run(events <-chan conntrack.Event, done chan struct{}) {
+	for event := range events {
+		c.processEvent(event)
+	}
+	log.Errorf("flowCollector: conntrack event subscription was closed")
+	close(done)
+	c.Lock()
+	c.subscribed = false
+	c.Unlock()
+}
 
-	// Get IPv4/v6 conntrack table flows
-	Protocols := [2]netlink.InetFamily{syscall.AF_INET, syscall.AF_INET6}
-	for _, proto := range Protocols {
-		connT, err := netlink.ConntrackTableList(netlink.ConntrackTable, proto)
-		if err != nil {
-			log.Errorf("FlowStats(%d): ContrackTableList", proto)
+// setFlowExporter (re)creates or removes the exporter of flows of applications
+// connected to the given network instance.
+func (c *flowCollector) setFlowExporter(niUUID uuid.UUID,
+	config types.FlowExporterConfig, domainID uint32) {
+	c.Lock()
+	defer c.Unlock()
+	exporter := c.exporters[niUUID]
+	if exporter != nil {
+		if exporter.Config() == config {
 			return
 		}
+		log.Noticef("flowCollector: removing %s exporter for NI %s (collector %s)",
+			exporter.Config().Protocol, niUUID, exporter.Config().Collector)
+		exporter.Close()
+		delete(c.exporters, niUUID)
+	}
+	if !config.IsEnabled() {
+		return
+	}
+	exporter, err := flowexport.NewExporter(log, config, domainID)
+	if err != nil {
+		log.Errorf("flowCollector: failed to create exporter for NI %s: %v",
+			niUUID, err)
+		return
+	}
+	log.Noticef("flowCollector: exporting flows of NI %s to %s collector %s",
+		niUUID, config.Protocol, config.Collector)
+	c.exporters[niUUID] = exporter
+}
+
+// setConfig updates sampling and rate limit from the global config.
+func (c *flowCollector) setConfig(sampling, maxRate uint32) {
+	c.Lock()
+	defer c.Unlock()
+	if sampling == 0 {
+		sampling = 1
+	}
+	if sampling != c.sampling || maxRate != c.maxRate {
+		log.Noticef("flowCollector: sampling 1/%d, max rate %d flows/s",
+			sampling, maxRate)
+	}
+	c.sampling = sampling
+	c.maxRate = maxRate
+	if sampling == 1 {
+		c.sampled = make(map[uint32]flowKey)
+	}
+}
 
-		log.Tracef("***FlowStats(%d): size of the flows %d", proto, len(connT))
+func (c *flowCollector) processEvent(event conntrack.Event) {
+	appNum, _, _ := iptables.ParseConnmark(event.Flow.Mark)
+	if appNum == 0 {
+		// Not an application flow.
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	switch event.Type {
+	case conntrack.EventNew:
+		if c.sampling <= 1 {
+			return
+		}
+		c.newFlows++
+		if c.newFlows%c.sampling != 0 {
+			return
+		}
+		if len(c.sampled) >= maxSampledFlows {
+			log.Warnf("flowCollector: too many sampled flows, DESTROY events " +
+				"were probably lost, forgetting sampled flows")
+			c.sampled = make(map[uint32]flowKey)
+		}
+		c.sampled[event.ID] = newFlowKey(&event.Flow)
 
-		for _, entry := range connT { // loop through and process current timedout flow collection
-			flowTuple := flowMergeProcess(entry, instData)
-			// flowTuple := FlowMergeTuple(entry, instData, ipToName)
-			if flowTuple.IsTimeOut == false || flowTuple.foundApp == false {
-				continue
+	case conntrack.EventDestroy:
+		if c.sampling > 1 {
+			if _, sampled := c.sampled[event.ID]; !sampled {
+				return
 			}
-
-			timeOutTuples = append(timeOutTuples, flowTuple)
-			totalFlow++
+			delete(c.sampled, event.ID)
+		}
+		if !c.withinRateLimit() {
+			c.rateDropped++
+			return
 		}
+		c.addFlow(&event.Flow)
 	}
+}
 
-	log.Tracef("FlowStats ++ Total timedout flows %d, loopcount debug %d\n", totalFlow, loopcount)
-	loopcount++
+func (c *flowCollector) withinRateLimit() bool {
+	if c.maxRate == 0 {
+		return true
+	}
+	now := time.Now()
+	if now.Sub(c.rateWindow) >= time.Second {
+		c.rateWindow = now
+		c.rateCount = 0
+	}
+	if c.rateCount >= c.maxRate {
+		return false
+	}
+	c.rateCount++
+	return true
+}
 
-	// per app/bridge packing flow stats to be uploaded
-	for bnx := range instData.bnNet {
-		// obtain DNS entries recorded since the last flow collection
-		bnNum, err := bridgeStrToNum(ctx, bnx)
-		if err != nil {
-			log.Error(err)
+// refreshAttrs re-reads app and ACL attributes used to match flows.
+func (c *flowCollector) refreshAttrs() {
+	var instData networkAttrs
+	instData.ipaclattr = make(map[int]map[int]aclAttr) // App-ID/ACL-Num/aclAttr table
+	instData.appIPinfo = make(map[int][]appInfo)
+	instData.bnNet = make(map[string]bridgeAttr) // borrow the aclAttr for intf attributes
+	instData.appNet = make(map[int]uuid.UUID)
+	instData.appName = make(map[int]string)
+	intfAddrs, err := net.InterfaceAddrs()
+	if err != nil {
+		log.Errorf("flowCollector: error in getting addresses: %v", err)
+	}
+	instData.intfAddrs = intfAddrs
+	checkAppAndACL(c.ctx, &instData)
+	c.instData = instData
+	c.refreshed = time.Now()
+}
+
+// addActiveFlows adds interim records of the long-lived flows from the dump
+// of the conntrack table. The records carry the counters so far and the current
+// time as the stop time, the final record is added when the flow is destroyed.
+func (c *flowCollector) addActiveFlows(entries []*netlink.ConntrackFlow) {
+	var sampledKeys map[flowKey]struct{}
+	if c.sampling > 1 {
+		sampledKeys = make(map[flowKey]struct{}, len(c.sampled))
+		for _, key := range c.sampled {
+			sampledKeys[key] = struct{}{}
+		}
+	}
+	minStart := uint64(time.Now().Add(-activeFlowMinAge).UnixNano())
+	for _, entry := range entries {
+		if appNum, _, _ := iptables.ParseConnmark(entry.Mark); appNum == 0 {
+			// Not an application flow.
 			continue
 		}
-		dnssys[bnNum].Lock()
-		dnsEntries := dnssys[bnNum].Snoop
-		dnssys[bnNum].Snoop = nil
-		dnssys[bnNum].Unlock()
-
-		for appIdx := range instData.appNet {
-			var sequence, flowIdx int
-
-			// fill in the partial scope information, later the aclNum and aclAttr will decide
-			// if we have a match in this flow into app/bridge scope
-			scope := types.FlowScope{
-				UUID:      instData.appNet[appIdx],
-				Localintf: instData.bnNet[bnx].bridge,
-				NetUUID:   instData.bnNet[bnx].netUUID,
-			}
-			flowdata := types.IPFlow{
-				Scope: scope,
+		if entry.TimeStart > minStart {
+			// Short-lived flows are logged only when destroyed.
+			continue
+		}
+		if sampledKeys != nil {
+			if _, sampled := sampledKeys[newFlowKey(entry)]; !sampled {
+				continue
 			}
+		}
+		if !c.withinRateLimit() {
+			c.rateDropped++
+			continue
+		}
+		c.addFlow(entry)
+	}
+}
 
-			log.Tracef("FlowStats: bnx=%s, appidx %d\n", bnx, appIdx)
-			// temp print out the flow "tuple" and stats per app/bridge
-			for i, tuple := range timeOutTuples { // search for flowstats by bridge
-				var aclattr aclAttr
-				var aclNum int
-				var aclaction types.ACLActionType
+// dumpConntrack returns the IPv4 and IPv6 conntrack entries.
+func dumpConntrack() (entries []*netlink.ConntrackFlow) {
+	for _, family := range []netlink.InetFamily{unix.AF_INET, unix.AF_INET6} {
+		familyEntries, err := netlink.ConntrackTableList(netlink.ConntrackTable, family)
+		if err != nil {
+			log.Errorf("flowCollector: failed to list conntrack entries (family %d): %v",
+				family, err)
+			continue
+		}
+		entries = append(entries, familyEntries...)
+	}
+	return entries
+}
 
-				appN := tuple.appNum
-				if int(appN) != appIdx { // allow non-App flows to be uploaded
-					//log.Functionf("FlowStats: appN %d, appIdx %d not match", appN, appIdx)
-					continue
-				}
+// addFlow adds record of a flow.
+func (c *flowCollector) addFlow(entry *netlink.ConntrackFlow) {
+	if c.ctx == nil {
+		return
+	}
+	tuple, bridge, flowrec, ok := c.matchFlow(entry)
+	if !ok && time.Since(c.refreshed) > flowAttrsRefreshIntv {
+		// App or ACL could have been added since the last refresh.
+		c.refreshAttrs()
+		tuple, bridge, flowrec, ok = c.matchFlow(entry)
+	}
+	if !ok {
+		return
+	}
+	log.Tracef("flowCollector: on %s %s", bridge, tuple.String())
+	pending := c.getPending(flowScopeKey{appNum: int(tuple.appNum), bridge: bridge})
+	pending.flowdata.Flows = append(pending.flowdata.Flows, flowrec)
+	if pending.size() > maxFlowPack {
+		c.publish(pending)
+	}
+}
 
-				if tuple.aclNum != iptables.DefaultDropAceID {
-					tmpMap := instData.ipaclattr[int(appN)]
-					if tmpMap != nil {
-						if _, ok := tmpMap[int(tuple.aclNum)]; !ok {
-							log.Tracef("FlowStats: == can not get acl map with aclN, should not happen appN %d, aclN %d; %s\n",
-								appN, tuple.aclNum, tuple.String())
-							continue
-						}
-						aclattr = tmpMap[int(tuple.aclNum)]
-					} else {
-						log.Tracef("FlowStats: == can't get acl map with appN, should not happen, appN %d, aclN %d; %s\n",
-							appN, tuple.aclNum, tuple.String())
-						continue
-					}
-					if aclattr.aclNum == 0 {
-						log.Tracef("FlowStats: == aclN zero in attr, appN %d, aclN %d; %s\n", appN, tuple.aclNum, tuple.String())
-						// some debug info
-						continue
-					}
+// matchFlow finds the app, bridge and ACE of the flow.
+func (c *flowCollector) matchFlow(entry *netlink.ConntrackFlow) (
+	tuple flowStats, bridge string, flowrec types.FlowRec, ok bool) {
+	tuple = flowMergeProcess(entry, c.instData)
+	if !tuple.foundApp {
+		return tuple, "", flowrec, false
+	}
+	appN := int(tuple.appNum)
+	if _, known := c.instData.appNet[appN]; !known {
+		log.Tracef("flowCollector: unknown appN %d; %s", appN, tuple.String())
+		return tuple, "", flowrec, false
+	}
+	var aclNum int
+	var aclaction types.ACLActionType
+	if tuple.aclNum != iptables.DefaultDropAceID {
+		aclattr, found := c.instData.ipaclattr[appN][int(tuple.aclNum)]
+		if !found || aclattr.aclNum == 0 {
+			log.Tracef("flowCollector: can not get acl attributes, appN %d, aclN %d; %s",
+				appN, tuple.aclNum, tuple.String())
+			return tuple, "", flowrec, false
+		}
+		bridge = aclattr.bridge
+		if tuple.drop {
+			aclaction = types.ACLActionDrop
+		} else {
+			aclaction = types.ACLActionAccept
+		}
+		aclNum = int(aclattr.aclNum)
+	} else {
+		// default drop ACE
+		appinfo := flowGetAppInfo(tuple, c.instData.appIPinfo[appN])
+		if appinfo.localintf == "" {
+			return tuple, "", flowrec, false
+		}
+		bridge = appinfo.localintf
+		aclaction = types.ACLActionDrop
+		aclNum = 0
+	}
+	flowrec = types.FlowRec{
+		Flow: types.IPTuple{
+			Src:     tuple.SrcIP,
+			Dst:     tuple.DstIP,
+			SrcPort: int32(tuple.SrcPort),
+			DstPort: int32(tuple.DstPort),
+			Proto:   int32(tuple.Proto),
+		},
+		Inbound:   !tuple.AppInitiate,
+		ACLID:     int32(aclNum),
+		Action:    aclaction,
+		StartTime: tuple.TimeStart,
+		StopTime:  tuple.TimeStop,
+		TxBytes:   int64(tuple.SendBytes),
+		TxPkts:    int64(tuple.SendPkts),
+		RxBytes:   int64(tuple.RecvBytes),
+		RxPkts:    int64(tuple.RecvPkts),
+	}
+	return tuple, bridge, flowrec, true
+}
 
-					if aclattr.bridge != bnx {
-						log.Tracef("FlowStats: == bridge name not match %s, %s\n", bnx, aclattr.bridge)
-						continue
-					}
-					scope.Intf = aclattr.intfname // App side DomU internal interface name
-					if tuple.drop {
-						aclaction = types.ACLActionDrop
-					} else {
-						aclaction = types.ACLActionAccept
-					}
-					aclNum = int(aclattr.aclNum)
-				} else {
-					// default drop ACE
-					appinfo := flowGetAppInfo(tuple, instData.appIPinfo[appIdx])
-					if appinfo.localintf != bnx {
-						continue
-					}
-					scope.Intf = appinfo.intf
-					aclaction = types.ACLActionDrop
-					aclNum = 0
-				}
+func (c *flowCollector) getPending(key flowScopeKey) *pendingFlows {
+	if pending, exists := c.pending[key]; exists {
+		return pending
+	}
+	scope := types.FlowScope{
+		UUID:      c.instData.appNet[key.appNum],
+		Localintf: key.bridge,
+		NetUUID:   c.instData.bnNet[key.bridge].netUUID,
+	}
+	for _, info := range c.instData.appIPinfo[key.appNum] {
+		if info.localintf == key.bridge {
+			// App side DomU internal interface name
+			scope.Intf = info.intf
+			break
+		}
+	}
+	pending := &pendingFlows{
+		flowdata: types.IPFlow{Scope: scope},
+		appName:  c.instData.appName[key.appNum],
+	}
+	c.pending[key] = pending
+	return pending
+}
 
-				// temp print out log for the flow
-				log.Tracef("FlowStats [%d]: on bn%d %s\n", i, bnNum, tuple.String()) // just print for now
+func (c *flowCollector) publish(pending *pendingFlows) {
+	if exporter := c.exporters[pending.flowdata.Scope.NetUUID]; exporter != nil {
+		if err := exporter.Export(pending.flowdata, pending.appName); err != nil {
+			log.Warnf("flowCollector: %v", err)
+		}
+	}
+	flowIdx := pending.size()
+	flowPublish(c.ctx, &pending.flowdata, &pending.sequence, &flowIdx)
+	pending.sequence %= maxFlowSequence
+}
 
-				flowtuple := types.IPTuple{
-					Src:     tuple.SrcIP,
-					Dst:     tuple.DstIP,
-					SrcPort: int32(tuple.SrcPort),
-					DstPort: int32(tuple.DstPort),
-					Proto:   int32(tuple.Proto),
-				}
-				flowrec := types.FlowRec{
-					Flow:      flowtuple,
-					Inbound:   !tuple.AppInitiate,
-					ACLID:     int32(aclNum),
-					Action:    aclaction,
-					StartTime: tuple.TimeStart,
-					StopTime:  tuple.TimeStop,
-					TxBytes:   int64(tuple.SendBytes),
-					TxPkts:    int64(tuple.SendPkts),
-					RxBytes:   int64(tuple.RecvBytes),
-					RxPkts:    int64(tuple.RecvPkts),
-				}
+// flush publishes pending flow records together with DNS requests snooped
+// on bridges since the last flush.
+func (c *flowCollector) flush() {
+	// The table is dumped before locking the collector not to delay
+	// processing of conntrack events.
+	activeFlows := dumpConntrack()
+	c.Lock()
+	defer c.Unlock()
+	if c.ctx == nil {
+		return
+	}
+	if !c.subscribed {
+		// Try to recover from a failed subscription.
+		c.subscribe()
+	}
+	if c.rateDropped > 0 {
+		log.Warnf("flowCollector: %d flows were not logged due to the rate limit "+
+			"(%d flows/s)", c.rateDropped, c.maxRate)
+		c.rateDropped = 0
+	}
+	c.refreshAttrs()
+	c.addActiveFlows(activeFlows)
 
-				flowdata.Flows = append(flowdata.Flows, flowrec)
-				flowIdx++
-				if flowIdx > maxFlowPack {
-					flowPublish(ctx, &flowdata, &sequence, &flowIdx)
-				}
-			}
+	for bnx := range c.instData.bnNet {
+		// obtain DNS entries recorded since the last flush
+		bnNum, err := bridgeStrToNum(c.ctx, bnx)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		dnssys[bnNum].Lock()
+		dnsEntries := dnssys[bnNum].Snoop
+		dnssys[bnNum].Snoop = nil
+		dnssys[bnNum].Unlock()
 
+		for appIdx := range c.instData.appNet {
 			var dnsrec [2]map[string]dnsEntry
 			dnsrec[0] = make(map[string]dnsEntry) // store IPv4 addresses from dns
 			dnsrec[1] = make(map[string]dnsEntry) // store IPv6 addresses from dns
 
 			// select dns request/replies corresponding to this app
 			for _, dnsdata := range dnsEntries {
-				if !checkAppIPAddr(instData.appIPinfo[appIdx], dnsdata.AppIP) {
+				if !checkAppIPAddr(c.instData.appIPinfo[appIdx], dnsdata.AppIP) {
 					continue
 				}
 				// unique by domain name, latest reply overwrite previous ones
@@ -280,34 +559,40 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 					dnsrec[1][dnsdata.DomainName] = dnsdata
 				}
 			}
+			if len(dnsrec[0]) == 0 && len(dnsrec[1]) == 0 {
+				continue
+			}
 
 			// append dns records into the flow data
+			pending := c.getPending(flowScopeKey{appNum: appIdx, bridge: bnx})
 			for idx := range dnsrec {
 				for _, dnsRec := range dnsrec[idx] {
-					// temp print out all unique dns replies for the bridge/app
-					log.Tracef("!!FlowStats: DNS time %v, domain %s, appIP %v, count %d, Answers %v",
+					log.Tracef("flowCollector: DNS time %v, domain %s, appIP %v, count %d, Answers %v",
 						dnsRec.TimeStamp, dnsRec.DomainName, dnsRec.AppIP, dnsRec.ANCount, dnsRec.Answers)
-
-					dnsrec := types.DNSReq{
+					pending.flowdata.DNSReqs = append(pending.flowdata.DNSReqs, types.DNSReq{
 						HostName:    dnsRec.DomainName,
 						Addrs:       dnsRec.Answers,
 						RequestTime: dnsRec.TimeStamp.UnixNano(),
-					}
-					flowdata.DNSReqs = append(flowdata.DNSReqs, dnsrec)
-					flowIdx++
-					if flowIdx > maxFlowPack {
-						flowPublish(ctx, &flowdata, &sequence, &flowIdx)
+					})
+					if pending.size() > maxFlowPack {
+						c.publish(pending)
 					}
 				}
 			}
+		}
+	}
 
-			// flow record done for the bridge/app
-			// publish the flow data (per app/bridge) and sequence (for size limit) to zedagent now
-			flowPublish(ctx, &flowdata, &sequence, &flowIdx)
+	// publish the remaining records (per app/bridge) to zedagent now
+	for key, pending := range c.pending {
+		if pending.size() > 0 {
+			c.publish(pending)
+		}
+		if _, appExists := c.instData.appNet[key.appNum]; !appExists {
+			delete(c.pending, key)
 		}
 	}
 	// check and remove stale flowlog publications
-	checkFlowUnpublish(ctx)
+	checkFlowUnpublish(c.ctx)
 }
 
 // conntrack flow of two uni-directional stats into one
@@ -317,11 +602,6 @@ func flowMergeProcess(entry *netlink.ConntrackFlow, instData networkAttrs) flowS
 	var forwSrcApp, forwDstApp, backSrcApp, backDstApp bool
 	var AppNum int
 
-	// for the current phase of implementation, we ignore the flowstats which has not timed out yet
-	ipflowTimeOut := int32(entry.TimeOut)
-	if ipflowTimeOut > timeoutSec {
-		return ipFlow
-	}
 	ipFlow.appNum, ipFlow.aclNum, ipFlow.drop = iptables.ParseConnmark(entry.Mark)
 	AppNum = int(ipFlow.appNum)
 	if AppNum == 0 { // only handle App related flow stats, Mark set needs to zero out the app field if not app related
@@ -329,12 +609,13 @@ func flowMergeProcess(entry *netlink.ConntrackFlow, instData networkAttrs) flowS
 	}
 
 	ipFlow.TimeStart = int64(entry.TimeStart)
-	// the flow timed out timeoutSec - entry.TimeOut seconds before
-	timeStop := time.Now().Add(-(time.Second * time.Duration(timeoutSec-int32(entry.TimeOut))))
-	ipFlow.TimeStop = timeStop.UnixNano()
+	// stop timestamp is set for destroyed conntrack entries
+	ipFlow.TimeStop = int64(entry.TimeStop)
+	if ipFlow.TimeStop == 0 {
+		ipFlow.TimeStop = time.Now().UnixNano()
+	}
 	ipFlow.TimeOut = entry.TimeOut
 	ipFlow.Proto = entry.Forward.Protocol
-	ipFlow.IsTimeOut = true
 
 	// Assume the App has an assigned IP address(es) first
 	// the instData.appIPinfo has the IP addresses of an App, we want to know
@@ -512,6 +793,12 @@ func checkAppAndACL(ctx *zedrouterContext, instData *networkAttrs) {
 				}
 			}
 			instData.appIPinfo[status.AppNum] = append(instData.appIPinfo[status.AppNum], tmpAppInfo)
+			// IPv6 addresses allocated in dual-stack network instances.
+			for _, ipv6Addr := range ulStatus.AllocatedIPv6List {
+				v6AppInfo := tmpAppInfo
+				v6AppInfo.ipaddr = net.ParseIP(ipv6Addr)
+				instData.appIPinfo[status.AppNum] = append(instData.appIPinfo[status.AppNum], v6AppInfo)
+			}
 
 			// Fill in the bnNet indexed by bridge-name, used for loop through bridges, and Scope
 			intfAttr := bridgeAttr{
@@ -523,6 +810,7 @@ func checkAppAndACL(ctx *zedrouterContext, instData *networkAttrs) {
 			// build an App list cache, used for loop through all the Apps
 			if instData.appNet[status.AppNum] == nilUUID {
 				instData.appNet[status.AppNum] = status.UUIDandVersion.UUID
+				instData.appName[status.AppNum] = status.DisplayName
 				log.Tracef("===FlowStats: appNet appNum %d, uuid %v\n", status.AppNum, instData.appNet[status.AppNum])
 			}
 
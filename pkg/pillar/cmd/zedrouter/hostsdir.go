@@ -99,17 +99,6 @@ func containsHostName(nameToIPList []types.DnsNameToIP, hostname string) bool {
 	return false
 }
 
-func containsIP(nameToIPList []types.DnsNameToIP, ip net.IP) bool {
-	for _, ne := range nameToIPList {
-		for _, i := range ne.IPs {
-			if i.Equal(ip) {
-				return true
-			}
-		}
-	}
-	return false
-}
-
 func updateHostsConfiglet(cfgDirname string,
 	oldList []types.DnsNameToIP, newList []types.DnsNameToIP) {
 
@@ -0,0 +1,373 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Mesh network instance, see docs/MESH-NI.md.
+// The bridges of the network instance on all the member devices form one L2
+// segment: every device runs a WireGuard tunnel to the other members and
+// a VXLAN interface on top of it, which is a port of the bridge. Broadcast
+// frames are replicated to all the peers, unicast MAC addresses are learned
+// from the received frames.
+
+package zedrouter
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	meshDefaultListenPort = 51820
+	meshDefaultVNI        = 1
+	meshDefaultMaxMembers = 16
+	meshVxlanPort         = 4789
+	// Ethernet, VXLAN, UDP and IPv4 headers added by the VXLAN interface.
+	meshVxlanOverhead = 50
+)
+
+// meshVxlanIfName returns the name of the VXLAN interface of the network instance
+func meshVxlanIfName(status *types.NetworkInstanceStatus) string {
+	return fmt.Sprintf("vx%d", status.BridgeNum)
+}
+
+// meshConfigParse parses and validates the opaque config
+func meshConfigParse(opaqueConfig string) (types.MeshConfig, error) {
+	var config types.MeshConfig
+	if err := json.Unmarshal([]byte(opaqueConfig), &config); err != nil {
+		return config, fmt.Errorf("invalid Mesh config: %v", err)
+	}
+	if config.ListenPort == 0 {
+		config.ListenPort = meshDefaultListenPort
+	} else if config.ListenPort < 0 || config.ListenPort > 65535 {
+		return config, fmt.Errorf("invalid ListenPort %d", config.ListenPort)
+	}
+	if config.Mtu == 0 {
+		config.Mtu = wireGuardDefaultMtu
+	} else if config.Mtu < 576+meshVxlanOverhead || config.Mtu > 65535 {
+		return config, fmt.Errorf("invalid Mtu %d", config.Mtu)
+	}
+	if config.VNI == 0 {
+		config.VNI = meshDefaultVNI
+	} else if config.VNI >= 1<<24 {
+		return config, fmt.Errorf("invalid VNI %d", config.VNI)
+	}
+	if config.MaxMembers == 0 {
+		config.MaxMembers = meshDefaultMaxMembers
+	} else if config.MaxMembers < 0 || config.MaxMembers > 256 {
+		return config, fmt.Errorf("invalid MaxMembers %d", config.MaxMembers)
+	}
+	localIP, tunnelSubnet, err := net.ParseCIDR(config.Address)
+	if err != nil || localIP.To4() == nil {
+		return config, fmt.Errorf("invalid Address %s, IPv4 CIDR expected",
+			config.Address)
+	}
+	if len(config.Peers) == 0 {
+		return config, errors.New("no peers")
+	}
+	if len(config.Peers)+1 > config.MaxMembers {
+		return config, fmt.Errorf("%d peers do not fit into MaxMembers %d",
+			len(config.Peers), config.MaxMembers)
+	}
+	keys := make(map[string]bool)
+	tunnelIPs := map[string]bool{localIP.String(): true}
+	indexes := map[int]string{
+		meshTunnelIPIndex(localIP, tunnelSubnet, config.MaxMembers): localIP.String(),
+	}
+	for _, peer := range config.Peers {
+		if err := wireGuardKeyValidate(peer.PublicKey); err != nil {
+			return config, fmt.Errorf("peer %s: invalid PublicKey: %v", peer.Name, err)
+		}
+		if keys[peer.PublicKey] {
+			return config, fmt.Errorf("peer %s: duplicate PublicKey", peer.Name)
+		}
+		keys[peer.PublicKey] = true
+		if peer.Endpoint != "" {
+			if _, _, err := net.SplitHostPort(peer.Endpoint); err != nil {
+				return config, fmt.Errorf("peer %s: invalid Endpoint %s: %v",
+					peer.Name, peer.Endpoint, err)
+			}
+		}
+		tunnelIP := net.ParseIP(peer.TunnelIP)
+		if tunnelIP == nil || !tunnelSubnet.Contains(tunnelIP) {
+			return config, fmt.Errorf("peer %s: TunnelIP %s is not inside %s",
+				peer.Name, peer.TunnelIP, tunnelSubnet)
+		}
+		if tunnelIPs[tunnelIP.String()] {
+			return config, fmt.Errorf("peer %s: duplicate TunnelIP %s",
+				peer.Name, peer.TunnelIP)
+		}
+		tunnelIPs[tunnelIP.String()] = true
+		index := meshTunnelIPIndex(tunnelIP, tunnelSubnet, config.MaxMembers)
+		if otherIP, collision := indexes[index]; collision {
+			return config, fmt.Errorf(
+				"peer %s: TunnelIP %s has the same member index %d as %s (MaxMembers %d)",
+				peer.Name, peer.TunnelIP, index, otherIP, config.MaxMembers)
+		}
+		indexes[index] = tunnelIP.String()
+		if peer.PersistentKeepalive < 0 || peer.PersistentKeepalive > 65535 {
+			return config, fmt.Errorf("peer %s: invalid PersistentKeepalive %d",
+				peer.Name, peer.PersistentKeepalive)
+		}
+	}
+	return config, nil
+}
+
+// meshStatusParse returns the config saved by meshNetworkInstanceCreate
+func meshStatusParse(opaqueStatus string) (types.MeshConfig, error) {
+	var config types.MeshConfig
+	if err := json.Unmarshal([]byte(opaqueStatus), &config); err != nil {
+		return config, fmt.Errorf("invalid Mesh status: %v", err)
+	}
+	return config, nil
+}
+
+// meshTunnelIPIndex returns the member index of the device with the given
+// tunnel address: the host part of the address modulo MaxMembers. The index
+// of a member does not depend on the other members, hence it does not change
+// when members join or leave the mesh.
+func meshTunnelIPIndex(tunnelIP net.IP, tunnelSubnet *net.IPNet, maxMembers int) int {
+	ip := tunnelIP.To4()
+	mask := net.IP(tunnelSubnet.Mask).To4()
+	if ip == nil || mask == nil || maxMembers <= 0 {
+		return 0
+	}
+	hostPart := binary.BigEndian.Uint32(ip) &^ binary.BigEndian.Uint32(mask)
+	return int(hostPart % uint32(maxMembers))
+}
+
+// meshMemberIndex returns the member index of this device.
+// meshConfigParse guarantees that it differs from the indexes of the peers.
+func meshMemberIndex(config types.MeshConfig) int {
+	localIP, tunnelSubnet, err := net.ParseCIDR(config.Address)
+	if err != nil {
+		return 0
+	}
+	return meshTunnelIPIndex(localIP, tunnelSubnet, config.MaxMembers)
+}
+
+// meshWireGuardConfig returns the config of the tunnel to the peers.
+// Only the VXLAN traffic between the tunnel addresses goes through it.
+func meshWireGuardConfig(config types.MeshConfig) types.WireGuardConfig {
+	wgConfig := types.WireGuardConfig{
+		ListenPort: config.ListenPort,
+		Address:    config.Address,
+		Mtu:        config.Mtu,
+	}
+	for _, peer := range config.Peers {
+		wgConfig.Peers = append(wgConfig.Peers, types.WireGuardPeerConfig{
+			Name:                peer.Name,
+			PublicKey:           peer.PublicKey,
+			Endpoint:            peer.Endpoint,
+			AllowedIPs:          []string{peer.TunnelIP + "/32"},
+			PersistentKeepalive: peer.PersistentKeepalive,
+		})
+	}
+	return wgConfig
+}
+
+// meshBridgeMac returns the MAC address of the bridge, unique among
+// the members of the mesh.
+func meshBridgeMac(status *types.NetworkInstanceStatus) (string, error) {
+	config, err := meshConfigParse(status.OpaqueConfig)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("02:16:3e:07:%02x:%02x", meshMemberIndex(config),
+		status.BridgeNum), nil
+}
+
+// meshApplyIPAM gives this device its own bridge address and its own block
+// of the DHCP range, so that the bridges and the applications of the members
+// do not collide in the shared subnet. The member with index i uses
+// Gateway+i as the bridge address and block i of MaxMembers equal blocks
+// of the DHCP range.
+func meshApplyIPAM(status *types.NetworkInstanceStatus) error {
+	config, err := meshConfigParse(status.OpaqueConfig)
+	if err != nil {
+		return err
+	}
+	if status.Gateway == nil || status.DhcpRange.Start == nil ||
+		status.DhcpRange.End == nil {
+		return errors.New("Mesh network instance requires gateway and DHCP range")
+	}
+	index := meshMemberIndex(config)
+	maxMembers := uint32(config.MaxMembers)
+	lastGateway := types.AddToIP(status.Gateway, config.MaxMembers-1)
+	if !status.Subnet.Contains(lastGateway) {
+		return fmt.Errorf("bridge addresses %s-%s of the members are not inside %s",
+			status.Gateway, lastGateway, status.Subnet.String())
+	}
+	gatewayRange := types.IpRange{Start: status.Gateway, End: lastGateway}
+	if status.DhcpRange.Contains(status.Gateway) ||
+		gatewayRange.Contains(status.DhcpRange.Start) {
+		return fmt.Errorf("bridge addresses %s-%s of the members overlap DHCP range",
+			status.Gateway, lastGateway)
+	}
+	blockSize := (status.DhcpRange.Size() + 1) / maxMembers
+	if blockSize == 0 {
+		return fmt.Errorf("DHCP range %s-%s is too small for %d members",
+			status.DhcpRange.Start, status.DhcpRange.End, maxMembers)
+	}
+	start := types.AddToIP(status.DhcpRange.Start, index*int(blockSize))
+	status.Gateway = types.AddToIP(status.Gateway, index)
+	status.DhcpRange.Start = start
+	status.DhcpRange.End = types.AddToIP(start, int(blockSize)-1)
+	return nil
+}
+
+// meshVxlanMtu returns the MTU of the VXLAN interface, advertised
+// to the applications by DHCP. Zero if the config is invalid.
+func meshVxlanMtu(status *types.NetworkInstanceStatus) int {
+	config, err := meshConfigParse(status.OpaqueConfig)
+	if err != nil {
+		return 0
+	}
+	return config.Mtu - meshVxlanOverhead
+}
+
+func meshNetworkInstanceCreate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("Mesh network instance create: %s", status.DisplayName)
+	config, err := meshConfigParse(status.OpaqueConfig)
+	if err != nil {
+		return err
+	}
+	privateKey, err := wireGuardPrivateKeyGet(ctx, status)
+	if err != nil {
+		return err
+	}
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	status.OpaqueStatus = string(bytes)
+
+	wgIfName := wireGuardIfName(status)
+	err = wireGuardLinkCreate(wgIfName, privateKey, meshWireGuardConfig(config))
+	if err != nil {
+		return err
+	}
+	wgLink, err := netlink.LinkByName(wgIfName)
+	if err != nil {
+		return fmt.Errorf("failed to find %s: %v", wgIfName, err)
+	}
+	vxIfName := meshVxlanIfName(status)
+	if link, err := netlink.LinkByName(vxIfName); err == nil {
+		netlink.LinkDel(link)
+	}
+	localIP, _, _ := net.ParseCIDR(config.Address)
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = vxIfName
+	attrs.MTU = config.Mtu - meshVxlanOverhead
+	vxlan := &netlink.Vxlan{
+		LinkAttrs:    attrs,
+		VxlanId:      int(config.VNI),
+		VtepDevIndex: wgLink.Attrs().Index,
+		SrcAddr:      localIP,
+		Port:         meshVxlanPort,
+		Learning:     true,
+	}
+	if err := netlink.LinkAdd(vxlan); err != nil {
+		meshLinksDelete(status)
+		return fmt.Errorf("failed to create %s: %v", vxIfName, err)
+	}
+	vxLink, err := netlink.LinkByName(vxIfName)
+	if err != nil {
+		meshLinksDelete(status)
+		return fmt.Errorf("failed to find %s: %v", vxIfName, err)
+	}
+	// Broadcast, unknown unicast and multicast frames are sent to all peers.
+	for _, peer := range config.Peers {
+		err := netlink.NeighAppend(&netlink.Neigh{
+			LinkIndex:    vxLink.Attrs().Index,
+			Family:       unix.AF_BRIDGE,
+			State:        netlink.NUD_PERMANENT,
+			Flags:        netlink.NTF_SELF,
+			IP:           net.ParseIP(peer.TunnelIP),
+			HardwareAddr: make(net.HardwareAddr, 6),
+		})
+		if err != nil {
+			meshLinksDelete(status)
+			return fmt.Errorf("failed to add FDB entry for peer %s: %v",
+				peer.Name, err)
+		}
+	}
+	if err := netlink.LinkSetMasterByIndex(vxLink, status.BridgeIfindex); err != nil {
+		meshLinksDelete(status)
+		return fmt.Errorf("failed to add %s to %s: %v", vxIfName,
+			status.BridgeName, err)
+	}
+	if err := netlink.LinkSetUp(vxLink); err != nil {
+		meshLinksDelete(status)
+		return fmt.Errorf("failed to bring up %s: %v", vxIfName, err)
+	}
+	// nim accepts the traffic of the peers on the port
+	status.WireGuardListenPort = uint16(config.ListenPort)
+	return nil
+}
+
+func meshLinksDelete(status *types.NetworkInstanceStatus) {
+	wireGuardLinkDelete(meshVxlanIfName(status))
+	wireGuardLinkDelete(wireGuardIfName(status))
+}
+
+func meshNetworkInstanceDestroy(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Functionf("Mesh network instance delete: %s", status.DisplayName)
+	meshLinksDelete(status)
+	status.WireGuardListenPort = 0
+}
+
+// meshTunnelConfig returns the routing configuration of the NI reconciler
+// for the mesh. Traffic between the members is bridged, everything routed
+// by the bridge is dropped by the default route of the network instance
+// table. DHCP is not passed between the members, each of them serves only
+// its own applications.
+func meshTunnelConfig(status *types.NetworkInstanceStatus) *nireconciler.TunnelConfig {
+	subnet := status.Subnet
+	return &nireconciler.TunnelConfig{
+		IfName:           wireGuardIfName(status),
+		Subnet:           &subnet,
+		Gateway:          net.ParseIP(status.BridgeIPAddr),
+		NoDHCPBridgePort: meshVxlanIfName(status),
+	}
+}
+
+// meshNetworkInstanceActivate lets the NI reconciler keep the applications
+// inside the mesh.
+func meshNetworkInstanceActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("Mesh network instance activate: %s", status.DisplayName)
+	return updateNIReconciler(ctx, status, true)
+}
+
+func meshNetworkInstanceInactivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Functionf("Mesh network instance inactivate: %s", status.DisplayName)
+	if err := updateNIReconciler(ctx, status, false); err != nil {
+		log.Errorf("Mesh network instance inactivate: %v", err)
+	}
+}
+
+// meshVpnStatusGet reports the reachability of the peers in the VpnStatus
+// of the network instance, the same way as for the WireGuard VPN.
+func meshVpnStatusGet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, nis *types.NetworkInstanceMetrics) bool {
+	config, err := meshStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Functionf("Mesh config absent")
+		return false
+	}
+	return wireGuardTunnelStatusGet(status, meshWireGuardConfig(config), nis)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const testMeshConfig = `{"Address": "10.254.0.2/24", "MaxMembers": 4, "Peers": [` +
+	`{"Name": "dev1", "PublicKey": "` + testWgPeerKey1 + `", ` +
+	`"Endpoint": "192.168.1.10:51820", "TunnelIP": "10.254.0.1"},` +
+	`{"Name": "dev3", "PublicKey": "` + testWgPeerKey2 + `", ` +
+	`"TunnelIP": "10.254.0.3", "PersistentKeepalive": 25}]}`
+
+func TestMeshConfigParse(t *testing.T) {
+	tests := []struct {
+		testname string
+		config   string
+		valid    bool
+	}{
+		{
+			testname: "valid",
+			config:   testMeshConfig,
+			valid:    true,
+		},
+		{
+			testname: "no peers",
+			config:   `{"Address": "10.254.0.2/24"}`,
+		},
+		{
+			testname: "IPv6 address",
+			config: `{"Address": "fd00::2/64", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "TunnelIP": "fd00::1"}]}`,
+		},
+		{
+			testname: "tunnel IP outside subnet",
+			config: `{"Address": "10.254.0.2/24", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "TunnelIP": "10.253.0.1"}]}`,
+		},
+		{
+			testname: "duplicate tunnel IP",
+			config: `{"Address": "10.254.0.2/24", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "TunnelIP": "10.254.0.2"}]}`,
+		},
+		{
+			testname: "too many members",
+			config: `{"Address": "10.254.0.2/24", "MaxMembers": 2, "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "TunnelIP": "10.254.0.1"},` +
+				`{"PublicKey": "` + testWgPeerKey2 + `", "TunnelIP": "10.254.0.3"}]}`,
+		},
+		{
+			testname: "member index collision",
+			config: `{"Address": "10.254.0.2/24", "MaxMembers": 4, "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "TunnelIP": "10.254.0.1"},` +
+				`{"PublicKey": "` + testWgPeerKey2 + `", "TunnelIP": "10.254.0.5"}]}`,
+		},
+		{
+			testname: "invalid VNI",
+			config: `{"Address": "10.254.0.2/24", "VNI": 16777216, "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "TunnelIP": "10.254.0.1"}]}`,
+		},
+	}
+	for _, test := range tests {
+		config, err := meshConfigParse(test.config)
+		if test.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", test.testname, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: expected error", test.testname)
+		}
+		if test.valid && (config.ListenPort != meshDefaultListenPort ||
+			config.Mtu != wireGuardDefaultMtu || config.VNI != meshDefaultVNI) {
+			t.Errorf("%s: defaults not applied: %+v", test.testname, config)
+		}
+	}
+}
+
+func TestMeshWireGuardConfig(t *testing.T) {
+	config, err := meshConfigParse(testMeshConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index := meshMemberIndex(config); index != 2 {
+		t.Errorf("expected member index 2, got %d", index)
+	}
+	// The index does not depend on the other members.
+	config.Peers = config.Peers[1:]
+	if index := meshMemberIndex(config); index != 2 {
+		t.Errorf("expected member index 2 without peer dev1, got %d", index)
+	}
+	config, _ = meshConfigParse(testMeshConfig)
+	wgConfig := meshWireGuardConfig(config)
+	wgJSON, _ := json.Marshal(wgConfig)
+	if _, err := wireGuardConfigParse(string(wgJSON)); err != nil {
+		t.Errorf("invalid WireGuard config: %v", err)
+	}
+	if len(wgConfig.Peers) != 2 || wgConfig.Peers[1].AllowedIPs[0] != "10.254.0.3/32" {
+		t.Errorf("unexpected WireGuard peers: %+v", wgConfig.Peers)
+	}
+}
+
+func TestMeshApplyIPAM(t *testing.T) {
+	_, subnet, _ := net.ParseCIDR("10.20.0.0/24")
+	status := types.NetworkInstanceStatus{
+		NetworkInstanceConfig: types.NetworkInstanceConfig{
+			Type:         types.NetworkInstanceTypeMesh,
+			Subnet:       *subnet,
+			Gateway:      net.ParseIP("10.20.0.1"),
+			OpaqueConfig: testMeshConfig,
+			DhcpRange: types.IpRange{
+				Start: net.ParseIP("10.20.0.10"),
+				End:   net.ParseIP("10.20.0.209"),
+			},
+		},
+	}
+	if err := meshApplyIPAM(&status); err != nil {
+		t.Fatal(err)
+	}
+	// Member 2 of 4, each member gets 50 addresses.
+	if !status.Gateway.Equal(net.ParseIP("10.20.0.3")) {
+		t.Errorf("unexpected bridge address %s", status.Gateway)
+	}
+	if !status.DhcpRange.Start.Equal(net.ParseIP("10.20.0.110")) ||
+		!status.DhcpRange.End.Equal(net.ParseIP("10.20.0.159")) {
+		t.Errorf("unexpected DHCP range %s-%s", status.DhcpRange.Start,
+			status.DhcpRange.End)
+	}
+
+	// Bridge addresses of the members overlap the DHCP range.
+	status.Gateway = net.ParseIP("10.20.0.8")
+	status.DhcpRange = types.IpRange{
+		Start: net.ParseIP("10.20.0.10"),
+		End:   net.ParseIP("10.20.0.209"),
+	}
+	if err := meshApplyIPAM(&status); err == nil {
+		t.Errorf("expected error for overlapping bridge addresses")
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"net"
 	"strings"
 
-	"github.com/lf-edge/eve/pkg/pillar/base"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
@@ -71,70 +70,6 @@ func checkPortAvailable(
 	return nil
 }
 
-func disableIcmpRedirects(bridgeName string) {
-	sysctlSetting := fmt.Sprintf("net.ipv4.conf.%s.send_redirects=0", bridgeName)
-	args := []string{"-w", sysctlSetting}
-	log.Functionf("Calling command %s %v\n", "sysctl", args)
-	out, err := base.Exec(log, "sysctl", args...).CombinedOutput()
-	if err != nil {
-		errStr := fmt.Sprintf("sysctl command %s failed %s output %s",
-			args, err, out)
-		log.Errorln(errStr)
-	}
-}
-
-// doCreateBridge
-//		returns (error, bridgeMac-string)
-func doCreateBridge(bridgeName string, bridgeNum int,
-	status *types.NetworkInstanceStatus) (error, string) {
-
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Fatalf("bridgeCreate(%s) %s not possible",
-			status.DisplayName, status.BridgeName)
-	}
-	// Start clean
-	// delete the bridge
-	attrs := netlink.NewLinkAttrs()
-	attrs.Name = bridgeName
-	link := &netlink.Bridge{LinkAttrs: attrs}
-	netlink.LinkDel(link)
-
-	//    ip link add ${bridgeName} type bridge
-	attrs = netlink.NewLinkAttrs()
-	attrs.Name = bridgeName
-	bridgeMac := fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
-	hw, err := net.ParseMAC(bridgeMac)
-	if err != nil {
-		log.Fatal("ParseMAC failed: ", bridgeMac, err)
-	}
-	attrs.HardwareAddr = hw
-	link = &netlink.Bridge{LinkAttrs: attrs}
-	if err := netlink.LinkAdd(link); err != nil {
-		errStr := fmt.Sprintf("LinkAdd on %s failed: %s",
-			bridgeName, err)
-		return errors.New(errStr), ""
-	}
-	//    ip link set ${bridgeName} up
-	if err := netlink.LinkSetUp(link); err != nil {
-		errStr := fmt.Sprintf("LinkSetUp on %s failed: %s",
-			bridgeName, err)
-		return errors.New(errStr), ""
-	}
-	disableIcmpRedirects(bridgeName)
-
-	// Get Ifindex of bridge and store it in network instance status
-	bridgeLink, err := netlink.LinkByName(bridgeName)
-	if err != nil {
-		errStr := fmt.Sprintf("doCreateBridge: LinkByName(%s) failed: %s",
-			bridgeName, err)
-		log.Errorln(errStr)
-		return errors.New(errStr), ""
-	}
-	index := bridgeLink.Attrs().Index
-	status.BridgeIfindex = index
-	return err, bridgeMac
-}
-
 // doLookupBridge is used for switch network instance where nim
 // has created the bridge. All such NIs have an external port.
 //	returns (bridgeName, bridgeMac-string, error)
@@ -177,13 +112,10 @@ func networkInstanceBridgeDelete(
 	if !strings.HasPrefix(status.BridgeName, "bn") {
 		log.Noticef("networkInstanceBridgeDelete(%s) %s ignored",
 			status.DisplayName, status.BridgeName)
-	} else {
-		attrs := netlink.NewLinkAttrs()
-		attrs.Name = status.BridgeName
-		link := &netlink.Bridge{LinkAttrs: attrs}
-		// Remove link and associated addresses
-		netlink.LinkDel(link)
 	}
+	// Remove the bridge (if created by zedrouter) together with dnsmasq,
+	// radvd and all the iptables rules, routes and ipsets of this NI.
+	delNIReconciler(ctx, status)
 
 	if status.BridgeNum != 0 {
 		status.BridgeName = ""
@@ -210,17 +142,8 @@ func doBridgeAclsDelete(
 			}
 			log.Functionf("NetworkInstance - deleting Acls for UL Interface(%s)",
 				ulStatus.Name)
-			appIP := ulStatus.AllocatedIPv4Addr
-			aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: ulStatus.Bridge,
-				VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIP,
-				UpLinks: status.IfNameList}
-			rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-			ruleList, err := deleteACLConfiglet(aclArgs, rules.ACLRules)
-			if err != nil {
-				log.Errorf("NetworkInstance DeleteACL failed: %s\n",
-					err)
-			}
-			setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+			delAppVIFReconciler(ctx, ulStatus.Vif)
+			setNetworkACLRules(ctx, appID, ulStatus.Name, nil)
 		}
 	}
 	return
@@ -276,6 +199,9 @@ func handleNetworkInstanceModify(
 		}
 		publishNetworkInstanceStatus(ctx, status)
 		doNetworkInstanceModify(ctx, config, status)
+		status.FlowExporter = config.FlowExporter
+		ctx.flowCollector.setFlowExporter(status.UUID, status.FlowExporter,
+			uint32(status.BridgeNum))
 		niUpdateNIprobing(ctx, status)
 		status.ChangeInProgress = types.ChangeInProgressTypeNone
 		publishNetworkInstanceStatus(ctx, status)
@@ -304,6 +230,7 @@ func handleNetworkInstanceCreate(
 			VlanMap:       make(map[uint32]uint32),
 		},
 	}
+	setNIStatusACLBackend(ctx, &status)
 	appNumOnUNetBaseCreate(status.UUID)
 	status.ChangeInProgress = types.ChangeInProgressTypeCreate
 
@@ -335,6 +262,8 @@ func handleNetworkInstanceCreate(
 		return
 	}
 	publishNetworkInstanceStatus(ctx, &status)
+	ctx.flowCollector.setFlowExporter(status.UUID, status.FlowExporter,
+		uint32(status.BridgeNum))
 
 	if config.Activate {
 		log.Functionf("handleNetworkInstanceCreate: Activating network instance")
@@ -394,6 +323,7 @@ func maybeNetworkInstanceDelete(ctx *zedrouterContext, status *types.NetworkInst
 		return false
 	}
 	doNetworkInstanceDelete(ctx, status)
+	ctx.flowCollector.setFlowExporter(status.UUID, types.FlowExporterConfig{}, 0)
 	ctx.networkInstanceStatusMap.Delete(status.UUID)
 	ctx.pubNetworkInstanceStatus.Unpublish(status.Key())
 
@@ -408,6 +338,14 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	log.Functionf("NetworkInstance(%s-%s): NetworkType: %d, IpType: %d\n",
 		status.DisplayName, status.Key(), status.Type, status.IpType)
 
+	if status.Type == types.NetworkInstanceTypeMesh {
+		if err := meshApplyIPAM(status); err != nil {
+			return err
+		}
+		log.Noticef("Mesh network instance %s: bridge %s, DHCP range %s-%s",
+			status.DisplayName, status.Gateway, status.DhcpRange.Start,
+			status.DhcpRange.End)
+	}
 	if err := doNetworkInstanceSanityCheck(ctx, status); err != nil {
 		log.Errorf("NetworkInstance(%s-%s): Sanity Check failed: %s",
 			status.DisplayName, status.Key(), err)
@@ -424,8 +362,12 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	switch status.Type {
 	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeCloud:
 		bridgeName = fmt.Sprintf("bn%d", bridgeNum)
-		status.BridgeName = bridgeName
-		if err, bridgeMac = doCreateBridge(bridgeName, bridgeNum, status); err != nil {
+		bridgeMac = fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
+
+	case types.NetworkInstanceTypeMesh:
+		bridgeName = fmt.Sprintf("bn%d", bridgeNum)
+		// Bridges of all the members are in the same L2 segment.
+		if bridgeMac, err = meshBridgeMac(status); err != nil {
 			return err
 		}
 
@@ -433,19 +375,24 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 		if status.CurrentUplinkIntf == "" {
 			// Create a local-only bridge
 			bridgeName = fmt.Sprintf("bn%d", bridgeNum)
-			status.BridgeName = bridgeName
-			if err, bridgeMac = doCreateBridge(bridgeName, bridgeNum, status); err != nil {
-				return err
-			}
+			bridgeMac = fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
 		} else {
 			// Find bridge created by nim
 			if bridgeName, bridgeMac, err = doLookupBridge(ctx, status); err != nil {
 				// We will retry later
 				return err
 			}
-			status.BridgeName = bridgeName
 		}
 	}
+	status.BridgeName = bridgeName
+	status.BridgeMac = bridgeMac
+
+	// Let the NI reconciler create the bridge (or just wait for the bridge
+	// created by nim).
+	if err = updateNIReconciler(ctx, status, false); err != nil {
+		log.Error(err)
+		return err
+	}
 
 	// Get Ifindex of bridge and store it in network instance status
 	bridgeLink, err := netlink.LinkByName(bridgeName)
@@ -456,8 +403,6 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 		return err
 	}
 	status.BridgeIfindex = bridgeLink.Attrs().Index
-
-	status.BridgeMac = bridgeMac
 	publishNetworkInstanceStatus(ctx, status)
 
 	log.Functionf("bridge created. BridgeMac: %s\n", bridgeMac)
@@ -476,43 +421,34 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 
 	if status.BridgeIPAddr != "" {
 		// XXX arbitrary name "router"!!
-		addToHostsConfiglet(hostsDirpath, "router",
-			[]string{status.BridgeIPAddr})
+		addToHostsConfiglet(hostsDirpath, "router", bridgeIPAddrs(status))
 	}
 
-	// Start clean
-	deleteDnsmasqConfiglet(bridgeName)
-	stopDnsmasq(bridgeName, false, false)
+	// Start clean - DHCP host entries are re-added by the reconciler
+	// for every application VIF.
+	RemoveDirContent(dnsmasqDhcpHostDir(bridgeName))
 
-	if status.BridgeIPAddr != "" {
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			status.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			status.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			status.BridgeIPAddr, status,
-			hostsDirpath, status.BridgeIPSets,
-			status.CurrentUplinkIntf, dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
+	// Start dnsmasq and radvd (if enabled).
+	if err = updateNIReconciler(ctx, status, false); err != nil {
+		log.Error(err)
+		return err
 	}
 
 	// monitor the DNS and DHCP information
 	log.Functionf("Creating %s at %s", "DNSDhcpMonitor", agentlog.GetMyStack())
 	go DNSDhcpMonitor(bridgeName, bridgeNum, ctx, status)
 
-	if status.IsIPv6() {
-		// XXX do we need same logic as for IPv4 dnsmasq to not
-		// advertize as default router? Might we need lower
-		// radvd preference if isolated local network?
-		restartRadvdWithNewConfig(bridgeName)
-	}
-
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
 		err := vpnCreate(ctx, status)
 		if err != nil {
 			return err
 		}
+	case types.NetworkInstanceTypeMesh:
+		err := meshNetworkInstanceCreate(ctx, status)
+		if err != nil {
+			return err
+		}
 	default:
 	}
 	return nil
@@ -539,6 +475,8 @@ func doNetworkInstanceSanityCheck(
 		// Do nothing
 	case types.NetworkInstanceTypeCloud:
 		// Do nothing
+	case types.NetworkInstanceTypeMesh:
+		// Do nothing
 	default:
 		err := fmt.Sprintf("Instance type %d not supported", status.Type)
 		return errors.New(err)
@@ -573,6 +511,12 @@ func doNetworkInstanceSanityCheck(
 		if err != nil {
 			return err
 		}
+		if status.IsDualStack() {
+			err = doNetworkInstanceIPv6SanityCheck(ctx, status)
+			if err != nil {
+				return err
+			}
+		}
 
 	default:
 		err := fmt.Sprintf("IpType %d not supported\n", status.IpType)
@@ -633,6 +577,58 @@ func doNetworkInstanceSubnetSanityCheck(
 	return err
 }
 
+// doNetworkInstanceIPv6SanityCheck checks the IPv6 part of a dual-stack
+// network instance, including overlaps with IPv6 subnets of other
+// network instances.
+func doNetworkInstanceIPv6SanityCheck(
+	ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	subnet := status.SubnetIPv6
+	if subnet.IP.To4() != nil || subnet.IP.IsUnspecified() {
+		return fmt.Errorf("invalid IPv6 Subnet(%s)", subnet.String())
+	}
+	if status.GatewayIPv6 == nil || !subnet.Contains(status.GatewayIPv6) {
+		return fmt.Errorf("IPv6 Gateway(%s) not within Subnet(%s)",
+			status.GatewayIPv6, subnet.String())
+	}
+	if status.DhcpRangeIPv6.Start == nil ||
+		!subnet.Contains(status.DhcpRangeIPv6.Start) {
+		return fmt.Errorf("IPv6 DhcpRange Start(%s) not within Subnet(%s)",
+			status.DhcpRangeIPv6.Start, subnet.String())
+	}
+	if status.DhcpRangeIPv6.End == nil ||
+		!subnet.Contains(status.DhcpRangeIPv6.End) {
+		return fmt.Errorf("IPv6 DhcpRange End(%s) not within Subnet(%s)",
+			status.DhcpRangeIPv6.End, subnet.String())
+	}
+
+	var err error
+	ctx.networkInstanceStatusMap.Range(func(key, value interface{}) bool {
+		iterStatusEntry := value.(*types.NetworkInstanceStatus)
+		if status == iterStatusEntry {
+			return true
+		}
+		var otherSubnet net.IPNet
+		if iterStatusEntry.IsDualStack() {
+			otherSubnet = iterStatusEntry.SubnetIPv6
+		} else if iterStatusEntry.IsIPv6() && iterStatusEntry.Subnet.IP != nil {
+			otherSubnet = iterStatusEntry.Subnet
+		} else {
+			return true
+		}
+		if otherSubnet.Contains(subnet.IP) || subnet.Contains(otherSubnet.IP) {
+			err = fmt.Errorf("IPv6 Subnet(%s) overlaps with another "+
+				"network instance(%s-%s) Subnet(%s)", subnet.String(),
+				iterStatusEntry.DisplayName, iterStatusEntry.UUID,
+				otherSubnet.String())
+			return false
+		}
+		return true
+	})
+	return err
+}
+
 // doNetworkInstanceStatusDhcpRangeSanityCheck
 // 1) Must always be Unspecified
 // 2) It should be a subset of Subnet
@@ -780,38 +776,16 @@ func restartDnsmasq(ctx *zedrouterContext, status *types.NetworkInstanceStatus)
 	log.Functionf("restartDnsmasq(%s) ipsets %v\n",
 		status.BridgeName, status.BridgeIPSets)
 	bridgeName := status.BridgeName
-	stopDnsmasq(bridgeName, false, true)
 
 	hostsDirpath := runDirname + "/hosts." + bridgeName
 	// XXX arbitrary name "router"!!
-	addToHostsConfiglet(hostsDirpath, "router",
-		[]string{status.BridgeIPAddr})
-
-	// Use existing BridgeIPSets
-	dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-		status.CurrentUplinkIntf)
-	ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-		status.CurrentUplinkIntf)
-	createDnsmasqConfiglet(ctx, bridgeName, status.BridgeIPAddr,
-		status, hostsDirpath, status.BridgeIPSets,
-		status.CurrentUplinkIntf, dnsServers, ntpServers)
-	createHostDnsmasqFile(ctx, bridgeName)
-	startDnsmasq(bridgeName)
-}
+	addToHostsConfiglet(hostsDirpath, "router", bridgeIPAddrs(status))
 
-func createHostDnsmasqFile(ctx *zedrouterContext, bridge string) {
-	pub := ctx.pubAppNetworkStatus
-	items := pub.GetAll()
-	for _, st := range items {
-		status := st.(types.AppNetworkStatus)
-		for _, ulStatus := range status.UnderlayNetworkList {
-			if strings.Compare(bridge, ulStatus.Bridge) != 0 {
-				continue
-			}
-			addhostDnsmasq(bridge, ulStatus.Mac,
-				ulStatus.AllocatedIPv4Addr, status.UUIDandVersion.UUID.String())
-			log.Functionf("createHostDnsmasqFile:(%s) mac=%s, IP=%s\n", bridge, ulStatus.Mac, ulStatus.AllocatedIPv4Addr)
-		}
+	// Use existing BridgeIPSets. DHCP host entries of applications
+	// are kept by the NI reconciler, which also restarts dnsmasq
+	// only if its config has actually changed.
+	if err := updateNIReconciler(ctx, status, routingEnabled(status)); err != nil {
+		log.Error(err)
 	}
 }
 
@@ -859,11 +833,38 @@ func lookupOrAllocateIPv4(status *types.NetworkInstanceStatus,
 	return a.String(), nil
 }
 
+// lookupOrAllocateIPv6 returns IPv6 address of the application
+// in a dual-stack network instance.
+func lookupOrAllocateIPv6(status *types.NetworkInstanceStatus,
+	appID uuid.UUID, appNum int, mac net.HardwareAddr) (string, error) {
+	log.Functionf("lookupOrAllocateIPv6(%s-%s): appNum:%d, mac:%s\n",
+		status.DisplayName, status.Key(), appNum, mac.String())
+
+	if addrs, ok := status.IPAssignments[mac.String()]; ok {
+		for _, addr := range addrs.IPv6Addrs {
+			if status.SubnetIPv6.Contains(addr) {
+				return addr.String(), nil
+			}
+		}
+	}
+	a := types.AddToIP(status.DhcpRangeIPv6.Start, appNum)
+	if !status.DhcpRangeIPv6.Contains(a) {
+		errStr := fmt.Sprintf("no free IPv6 addresses in DHCP range(%s, %s)",
+			status.DhcpRangeIPv6.Start.String(),
+			status.DhcpRangeIPv6.End.String())
+		log.Errorf("lookupOrAllocateIPv6(%s, %s): fail: %s",
+			status.UUID.String(), appID.String(), errStr)
+		return "", errors.New(errStr)
+	}
+	log.Functionf("lookupOrAllocateIPv6(%s-%s): allocated %s for %s\n",
+		status.UUID.String(), appID.String(), a.String(), mac.String())
+	return a.String(), nil
+}
+
 // recordIPAssigment updates status and publishes the result
 func recordIPAssignment(ctx *zedrouterContext,
-	status *types.NetworkInstanceStatus, ip net.IP, mac string) {
+	status *types.NetworkInstanceStatus, addrs types.AssignedAddrs, mac string) {
 
-	addrs := types.AssignedAddrs{IPv4Addr: ip}
 	status.IPAssignments[mac] = addrs
 	// Publish the allocation
 	publishNetworkInstanceStatus(ctx, status)
@@ -888,6 +889,16 @@ func releaseIPv4FromNetworkInstance(ctx *zedrouterContext,
 	return nil
 }
 
+// bridgeIPAddrs returns the IPv4 and for dual-stack network instance
+// also the IPv6 address of the bridge.
+func bridgeIPAddrs(status *types.NetworkInstanceStatus) []string {
+	addrs := []string{status.BridgeIPAddr}
+	if status.BridgeIPv6Addr != "" {
+		addrs = append(addrs, status.BridgeIPv6Addr)
+	}
+	return addrs
+}
+
 func getPrefixLenForBridgeIP(
 	status *types.NetworkInstanceStatus) int {
 	var prefixLen int
@@ -901,28 +912,6 @@ func getPrefixLenForBridgeIP(
 	return prefixLen
 }
 
-func doConfigureIpAddrOnInterface(
-	ipAddr string,
-	prefixLen int,
-	link netlink.Link) error {
-
-	ipAddr = fmt.Sprintf("%s/%d", ipAddr, prefixLen)
-
-	//    ip addr add ${ipAddr}/N dev ${bridgeName}
-	addr, err := netlink.ParseAddr(ipAddr)
-	if err != nil {
-		errStr := fmt.Sprintf("ParseAddr %s failed: %s", ipAddr, err)
-		log.Errorln(errStr)
-		return errors.New(errStr)
-	}
-	if err := netlink.AddrAdd(link, addr); err != nil {
-		errStr := fmt.Sprintf("AddrAdd %s failed: %s", ipAddr, err)
-		log.Errorln(errStr)
-		return errors.New(errStr)
-	}
-	return nil
-}
-
 func setBridgeIPAddr(
 	ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) error {
@@ -961,8 +950,6 @@ func setBridgeIPAddr(
 	}
 	log.Functionf("Bridge: %s, Link: %+v\n", status.BridgeName, link)
 
-	var err error
-
 	// Assign the gateway Address as the bridge IP address
 	var bridgeMac net.HardwareAddr
 
@@ -979,8 +966,6 @@ func setBridgeIPAddr(
 	}
 	if status.Gateway != nil {
 		ipAddr = status.Gateway.String()
-		addrs := types.AssignedAddrs{IPv4Addr: status.Gateway}
-		status.IPAssignments[bridgeMac.String()] = addrs
 	}
 	log.Functionf("BridgeMac: %s, ipAddr: %s\n",
 		bridgeMac.String(), ipAddr)
@@ -1000,22 +985,23 @@ func setBridgeIPAddr(
 		return errors.New(errStr)
 	}
 
-	prefixLen := getPrefixLenForBridgeIP(status)
-	if err = doConfigureIpAddrOnInterface(ipAddr, prefixLen, link); err != nil {
-		log.Errorf("Failed to configure IPAddr on Interface\n")
-		return err
+	addrs := types.AssignedAddrs{IPv4Addr: net.ParseIP(ipAddr)}
+	status.BridgeIPv6Addr = ""
+	if status.IsDualStack() {
+		if status.DhcpRangeIPv6.Contains(status.GatewayIPv6) {
+			return fmt.Errorf("IPv6 Gateway(%s) is in Dhcp Range(%s,%s)",
+				status.GatewayIPv6, status.DhcpRangeIPv6.Start,
+				status.DhcpRangeIPv6.End)
+		}
+		status.BridgeIPv6Addr = status.GatewayIPv6.String()
+		addrs.IPv6Addrs = []net.IP{status.GatewayIPv6}
 	}
 
+	// The address itself is assigned to the bridge by the NI reconciler.
 	status.BridgeIPAddr = ipAddr
-	addr := net.ParseIP(ipAddr)
-	recordIPAssignment(ctx, status, addr, bridgeMac.String())
-	log.Functionf("Published NetworkStatus. BridgeIpAddr: %s\n",
-		status.BridgeIPAddr)
-	// Create new radvd configuration and restart radvd if ipv6
-	if status.IsIPv6() {
-		log.Functionf("Restart Radvd\n")
-		restartRadvdWithNewConfig(status.BridgeName)
-	}
+	recordIPAssignment(ctx, status, addrs, bridgeMac.String())
+	log.Functionf("Published NetworkStatus. BridgeIpAddr: %s, BridgeIPv6Addr: %s\n",
+		status.BridgeIPAddr, status.BridgeIPv6Addr)
 	return nil
 }
 
@@ -1258,6 +1244,9 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 	case types.NetworkInstanceTypeCloud:
 		err = vpnActivate(ctx, status)
 
+	case types.NetworkInstanceTypeMesh:
+		err = meshNetworkInstanceActivate(ctx, status)
+
 	default:
 		errStr := fmt.Sprintf("doNetworkInstanceActivate: NetworkInstance %d not yet supported",
 			status.Type)
@@ -1367,9 +1356,11 @@ func doNetworkInstanceInactivate(
 	bridgeInactivateforNetworkInstance(ctx, status)
 	switch status.Type {
 	case types.NetworkInstanceTypeLocal:
-		natInactivate(ctx, status, false)
+		natInactivate(ctx, status)
 	case types.NetworkInstanceTypeCloud:
 		vpnInactivate(ctx, status)
+	case types.NetworkInstanceTypeMesh:
+		meshNetworkInstanceInactivate(ctx, status)
 	case types.NetworkInstanceTypeSwitch:
 		portName := "k" + status.BridgeName
 		link, _ := netlink.LinkByName(portName)
@@ -1402,6 +1393,8 @@ func doNetworkInstanceDelete(
 		natDelete(status)
 	case types.NetworkInstanceTypeCloud:
 		vpnDelete(ctx, status)
+	case types.NetworkInstanceTypeMesh:
+		meshNetworkInstanceDestroy(ctx, status)
 	default:
 		log.Errorf("NetworkInstance(%s-%s): Type %d not yet supported",
 			status.DisplayName, status.UUID, status.Type)
@@ -1413,11 +1406,7 @@ func doNetworkInstanceDelete(
 	}
 	doBridgeAclsDelete(ctx, status)
 	if status.BridgeName != "" {
-		stopDnsmasq(status.BridgeName, false, false)
-
-		if status.IsIPv6() {
-			stopRadvd(status.BridgeName, true)
-		}
+		// Dnsmasq and radvd are stopped by networkInstanceBridgeDelete.
 		DNSStopMonitor(status.BridgeNum)
 	}
 	if status.BridgeMac != "" {
@@ -1485,7 +1474,15 @@ func createNetworkInstanceMetrics(ctx *zedrouterContext,
 	niMetrics.VlanMetrics.VlanCounts = status.VlanMap
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
-		if strongSwanVpnStatusGet(ctx, status, &niMetrics) {
+		vpnStatusGet := strongSwanVpnStatusGet
+		if status.VpnType == types.VpnTypeWireGuard {
+			vpnStatusGet = wireGuardVpnStatusGet
+		}
+		if vpnStatusGet(ctx, status, &niMetrics) {
+			publishNetworkInstanceStatus(ctx, status)
+		}
+	case types.NetworkInstanceTypeMesh:
+		if meshVpnStatusGet(ctx, status, &niMetrics) {
 			publishNetworkInstanceStatus(ctx, status)
 		}
 	default:
@@ -1638,7 +1635,6 @@ func natActivate(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) error {
 
 	log.Functionf("natActivate(%s)\n", status.DisplayName)
-	subnetStr := status.Subnet.String()
 
 	// status.IfNameList should not have more than one interface name.
 	// Put a check anyway.
@@ -1650,52 +1646,19 @@ func natActivate(ctx *zedrouterContext,
 		err := errors.New(errStr)
 		return err
 	}
-	for _, a := range status.IfNameList {
-		log.Functionf("Adding iptables rules for %s \n", a)
-		err := iptables.IptableCmd(log, "-t", "nat", "-A", appChain("POSTROUTING"),
-			"-o", a, "-s", subnetStr, "-j", "MASQUERADE")
-		if err != nil {
-			log.Errorf("IptableCmd failed: %s", err)
-			return err
-		}
-		err = PbrRouteAddAll(status.BridgeName, a)
-		if err != nil {
-			log.Errorf("PbrRouteAddAll for Bridge(%s) and interface %s failed. "+
-				"Err: %s", status.BridgeName, a, err)
-			return err
-		}
-		devicenetwork.AddGatewaySourceRule(log, status.Subnet,
-			net.ParseIP(status.BridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
-		devicenetwork.AddSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatOutPrio)
-		devicenetwork.AddInwardSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatInPrio)
-	}
-	return nil
+	// MASQUERADE rule, PBR rules and routes are configured
+	// by the NI reconciler.
+	return updateNIReconciler(ctx, status, true)
 }
 
+// natInactivate removes NAT and PBR configured for the NI subnet.
+// Dnsmasq and radvd are kept running.
 func natInactivate(ctx *zedrouterContext,
-	status *types.NetworkInstanceStatus, inActivateOld bool) {
+	status *types.NetworkInstanceStatus) {
 
 	log.Functionf("natInactivate(%s)\n", status.DisplayName)
-	subnetStr := status.Subnet.String()
-	var oldUplinkIntf string
-	if inActivateOld {
-		// XXX Should we instead use status.ProgUplinkIntf
-		oldUplinkIntf = status.PrevUplinkIntf
-	} else {
-		oldUplinkIntf = status.CurrentUplinkIntf
-	}
-	err := iptables.IptableCmd(log, "-t", "nat", "-D", appChain("POSTROUTING"),
-		"-o", oldUplinkIntf, "-s", subnetStr, "-j", "MASQUERADE")
-	if err != nil {
-		log.Errorf("natInactivate: iptableCmd failed %s\n", err)
-	}
-	devicenetwork.DelGatewaySourceRule(log, status.Subnet,
-		net.ParseIP(status.BridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
-	devicenetwork.DelSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatOutPrio)
-	devicenetwork.DelInwardSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatInPrio)
-	err = PbrRouteDeleteAll(status.BridgeName, oldUplinkIntf)
-	if err != nil {
-		log.Errorf("natInactivate: PbrRouteDeleteAll failed %s\n", err)
+	if err := updateNIReconciler(ctx, status, false); err != nil {
+		log.Errorf("natInactivate: %v", err)
 	}
 }
 
@@ -1763,6 +1726,9 @@ func vpnCreate(ctx *zedrouterContext,
 	if status.OpaqueConfig == "" {
 		return errors.New("Vpn network instance create, invalid config")
 	}
+	if status.VpnType == types.VpnTypeWireGuard {
+		return wireGuardNetworkInstanceCreate(ctx, status)
+	}
 	return strongswanNetworkInstanceCreate(ctx, status)
 }
 
@@ -1771,18 +1737,29 @@ func vpnActivate(ctx *zedrouterContext,
 	if status.OpaqueConfig == "" {
 		return errors.New("Vpn network instance activate, invalid config")
 	}
+	if status.VpnType == types.VpnTypeWireGuard {
+		return wireGuardNetworkInstanceActivate(ctx, status)
+	}
 	return strongswanNetworkInstanceActivate(ctx, status)
 }
 
 func vpnInactivate(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
 
+	if status.VpnType == types.VpnTypeWireGuard {
+		wireGuardNetworkInstanceInactivate(ctx, status)
+		return
+	}
 	strongswanNetworkInstanceInactivate(ctx, status)
 }
 
 func vpnDelete(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
 
+	if status.VpnType == types.VpnTypeWireGuard {
+		wireGuardNetworkInstanceDestroy(ctx, status)
+		return
+	}
 	strongswanNetworkInstanceDestroy(ctx, status)
 }
 
@@ -1890,35 +1867,6 @@ func vifNameToBridgeName(ctx *zedrouterContext, vifName string) string {
 	return ""
 }
 
-// Get All ifindices for the Network Instances which are using ifname
-func getAllNIindices(ctx *zedrouterContext, ifname string) []int {
-
-	var indicies []int
-	pub := ctx.pubNetworkInstanceStatus
-	if pub == nil {
-		return indicies
-	}
-	instanceItems := pub.GetAll()
-	for _, st := range instanceItems {
-		status := st.(types.NetworkInstanceStatus)
-		if !status.IsUsingIfName(ifname) {
-			continue
-		}
-		if status.BridgeName == "" {
-			continue
-		}
-		link, err := netlink.LinkByName(status.BridgeName)
-		if err != nil {
-			errStr := fmt.Sprintf("LinkByName(%s) failed: %s",
-				status.BridgeName, err)
-			log.Errorln(errStr)
-			continue
-		}
-		indicies = append(indicies, link.Attrs().Index)
-	}
-	return indicies
-}
-
 // checkAndReprogramNetworkInstances handles changes to CurrentUplinkIntf
 // when NeedIntfUpdate is set.
 func checkAndReprogramNetworkInstances(ctx *zedrouterContext) {
@@ -1975,31 +1923,14 @@ func doNetworkInstanceFallback(
 		if !status.Activated {
 			return nil
 		}
-		natInactivate(ctx, status, true)
+		// Moves NAT and PBR to the new uplink and updates dnsmasq to use
+		// the DNS servers received from DHCP for the current uplink.
 		err = natActivate(ctx, status)
 		if err != nil {
 			log.Errorf("doNetworkInstanceFallback: %s", err)
 		}
 		status.ProgUplinkIntf = status.CurrentUplinkIntf
 
-		// Use dns server received from DHCP for the current uplink
-		bridgeName := status.BridgeName
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		deleteOnlyDnsmasqConfiglet(bridgeName)
-		stopDnsmasq(bridgeName, false, false)
-
-		if status.BridgeIPAddr != "" {
-			dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			createDnsmasqConfiglet(ctx, bridgeName,
-				status.BridgeIPAddr, status,
-				hostsDirpath, status.BridgeIPSets,
-				status.CurrentUplinkIntf, dnsServers, ntpServers)
-			startDnsmasq(bridgeName)
-		}
-
 		// Go through the list of all application connected to this network instance
 		// and clear conntrack flows corresponding to them.
 		apps := ctx.pubAppNetworkStatus.GetAll()
@@ -2025,6 +1956,8 @@ func doNetworkInstanceFallback(
 		}
 	case types.NetworkInstanceTypeSwitch:
 		// NA for switch network instance.
+	case types.NetworkInstanceTypeMesh:
+		// The tunnel follows the main routing table of the device.
 	case types.NetworkInstanceTypeCloud:
 		// XXX Add support for Cloud network instance
 		if status.Activated {
@@ -2038,21 +1971,8 @@ func doNetworkInstanceFallback(
 		status.ProgUplinkIntf = status.CurrentUplinkIntf
 
 		// Use dns server received from DHCP for the current uplink
-		bridgeName := status.BridgeName
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		deleteOnlyDnsmasqConfiglet(bridgeName)
-		stopDnsmasq(bridgeName, false, false)
-
-		if status.BridgeIPAddr != "" {
-			dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			createDnsmasqConfiglet(ctx, bridgeName,
-				status.BridgeIPAddr, status,
-				hostsDirpath, status.BridgeIPSets,
-				status.CurrentUplinkIntf, dnsServers, ntpServers)
-			startDnsmasq(bridgeName)
+		if err = updateNIReconciler(ctx, status, routingEnabled(status)); err != nil {
+			log.Errorf("doNetworkInstanceFallback: %s", err)
 		}
 
 		// Go through the list of all application connected to this network instance
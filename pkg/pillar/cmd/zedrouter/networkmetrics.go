@@ -9,6 +9,8 @@ package zedrouter
 
 import (
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
+	"github.com/lf-edge/eve/pkg/pillar/tc"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	psutilnet "github.com/shirou/gopsutil/net"
 	"strings"
@@ -21,8 +23,13 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 		log.Errorln(err)
 		return types.NetworkMetrics{}
 	}
-	// Call iptables once to get counters
-	ac := iptables.FetchIprulesCounters(log)
+	// Call iptables (or nft) once to get counters
+	var ac []iptables.AclCounters
+	if ctx.aclBackend == types.ACLBackendNftables {
+		ac = nftables.FetchACLCounters(log)
+	} else {
+		ac = iptables.FetchIprulesCounters(log)
+	}
 
 	// If we have both ethN and kethN then rename ethN to eethN ('e' for EVE)
 	// and kethN to ethN (the actual port)
@@ -98,18 +105,33 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 		// Packets matching the default DROP rule also match the default LOG rule.
 		// Since we will not have the default DROP rule, we can copy statistics
 		// from default LOG rule as DROP statistics.
-		metric.TxAclDrops = iptables.GetIPRuleACLDrop(log, ac, bridgeName, vifName,
-			ipVer, inout)
-		metric.TxAclDrops += iptables.GetIPRuleACLLog(log, ac, bridgeName, vifName,
-			ipVer, inout)
-		metric.RxAclDrops = iptables.GetIPRuleACLDrop(log, ac, bridgeName, vifName,
-			ipVer, !inout)
-		metric.RxAclDrops += iptables.GetIPRuleACLLog(log, ac, bridgeName, vifName,
-			ipVer, !inout)
-		metric.TxAclRateLimitDrops = iptables.GetIPRuleACLRateLimitDrop(log, ac,
-			bridgeName, vifName, ipVer, inout)
-		metric.RxAclRateLimitDrops = iptables.GetIPRuleACLRateLimitDrop(log, ac,
-			bridgeName, vifName, ipVer, !inout)
+		ipVers := []int{ipVer}
+		niStatus := lookupNetworkInstanceStatusByBridgeName(ctx, bridgeName)
+		if niStatus != nil && niStatus.IsDualStack() {
+			// ACLs are installed for both address families.
+			ipVers = []int{4, 6}
+		}
+		for _, ver := range ipVers {
+			metric.TxAclDrops += iptables.GetIPRuleACLDrop(log, ac, bridgeName,
+				vifName, ver, inout)
+			metric.TxAclDrops += iptables.GetIPRuleACLLog(log, ac, bridgeName,
+				vifName, ver, inout)
+			metric.RxAclDrops += iptables.GetIPRuleACLDrop(log, ac, bridgeName,
+				vifName, ver, !inout)
+			metric.RxAclDrops += iptables.GetIPRuleACLLog(log, ac, bridgeName,
+				vifName, ver, !inout)
+			metric.TxAclRateLimitDrops += iptables.GetIPRuleACLRateLimitDrop(log, ac,
+				bridgeName, vifName, ver, inout)
+			metric.RxAclRateLimitDrops += iptables.GetIPRuleACLRateLimitDrop(log, ac,
+				bridgeName, vifName, ver, !inout)
+		}
+		if vifName != "" {
+			classes, err := tc.FetchClassMetrics(log, vifName)
+			if err != nil {
+				log.Warnf("getNetworkMetrics: %v", err)
+			}
+			metric.TrafficClasses = classes
+		}
 		metrics = append(metrics, metric)
 	}
 	return types.NetworkMetrics{MetricList: metrics, TotalRuleCount: uint64(len(ac))}
@@ -0,0 +1,372 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Translation of network instance and application network status into
+// the configuration applied by the NI reconciler.
+
+package zedrouter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/tc"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+func dnsmasqPidFile(bridgeName string) string {
+	return "/run/dnsmasq." + bridgeName + ".pid"
+}
+
+// routingEnabled returns true if routing of the NI subnet (NAT or tunnel)
+// and PBR should be configured for the network instance.
+func routingEnabled(status *types.NetworkInstanceStatus) bool {
+	if !status.Activated {
+		return false
+	}
+	switch status.Type {
+	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeMesh:
+		return true
+	case types.NetworkInstanceTypeCloud:
+		// strongSwan configures routing of the IPsec VPN on its own.
+		return status.VpnType == types.VpnTypeWireGuard
+	}
+	return false
+}
+
+// getNIReconcilerConfig translates network instance status into the config
+// for the NI reconciler.
+func getNIReconcilerConfig(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, withRouting bool) nireconciler.NIConfig {
+
+	config := nireconciler.NIConfig{
+		UUID:        status.UUID,
+		DisplayName: status.DisplayName,
+		Type:        status.Type,
+		BridgeName:  status.BridgeName,
+		// Bridges without the "bn" prefix are created by nim.
+		ExternalBridge: !strings.HasPrefix(status.BridgeName, "bn"),
+	}
+	if status.BridgeMac != "" {
+		mac, err := net.ParseMAC(status.BridgeMac)
+		if err != nil {
+			log.Errorf("getNIReconcilerConfig(%s): ParseMAC %s failed: %v",
+				status.Key(), status.BridgeMac, err)
+		} else {
+			config.BridgeMAC = mac
+		}
+	}
+	if status.BridgeIPAddr == "" {
+		return config
+	}
+	bridgeIP := net.ParseIP(status.BridgeIPAddr)
+	if bridgeIP == nil {
+		log.Errorf("getNIReconcilerConfig(%s): failed to parse bridge IP %s",
+			status.Key(), status.BridgeIPAddr)
+		return config
+	}
+	if !config.ExternalBridge {
+		bits := 8 * net.IPv6len
+		if bridgeIP.To4() != nil {
+			bits = 8 * net.IPv4len
+		}
+		config.BridgeIPs = []*net.IPNet{{
+			IP:   bridgeIP,
+			Mask: net.CIDRMask(getPrefixLenForBridgeIP(status), bits),
+		}}
+	}
+	var bridgeIPv6 net.IP
+	if status.IsDualStack() && status.BridgeIPv6Addr != "" {
+		bridgeIPv6 = net.ParseIP(status.BridgeIPv6Addr)
+	}
+	if bridgeIPv6 != nil && !config.ExternalBridge {
+		config.BridgeIPs = append(config.BridgeIPs, &net.IPNet{
+			IP:   bridgeIPv6,
+			Mask: status.SubnetIPv6.Mask,
+		})
+	}
+
+	// Sort to make the dnsmasq config independent of the order
+	// in which the ipsets were collected.
+	sortedHosts := append([]string{}, status.BridgeIPSets...)
+	sort.Strings(sortedHosts)
+	var ipsetHosts, ipsets []string
+	for i, host := range sortedHosts {
+		if i > 0 && host == sortedHosts[i-1] {
+			continue
+		}
+		ipsetHosts = append(ipsetHosts, host)
+		ipsetBasename := hostIpsetBasename(host)
+		ipsets = append(ipsets, "ipv4."+ipsetBasename, "ipv6."+ipsetBasename)
+	}
+	hostsDirpath := runDirname + "/hosts." + status.BridgeName
+	dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
+		status.CurrentUplinkIntf)
+	ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
+		status.CurrentUplinkIntf)
+	config.Dnsmasq = &nireconciler.DnsmasqConfig{
+		ConfigPath: dnsmasqConfigPath(status.BridgeName),
+		PidFile:    dnsmasqPidFile(status.BridgeName),
+		Config: createDnsmasqConfiglet(ctx, status.BridgeName,
+			status.BridgeIPAddr, status, hostsDirpath, ipsetHosts,
+			status.CurrentUplinkIntf, dnsServers, ntpServers),
+		HostsDir:     hostsDirpath,
+		DhcpHostsDir: dnsmasqDhcpHostDir(status.BridgeName),
+		IPSets:       ipsets,
+	}
+	if status.IsIPv6() {
+		// XXX do we need same logic as for IPv4 dnsmasq to not
+		// advertize as default router? Might we need lower
+		// radvd preference if isolated local network?
+		config.Radvd = getRadvdConfig(status.BridgeName)
+	} else if bridgeIPv6 != nil {
+		config.Radvd = getDualStackRadvdConfig(status)
+	}
+	if !withRouting {
+		return config
+	}
+	switch status.Type {
+	case types.NetworkInstanceTypeCloud:
+		if status.VpnType == types.VpnTypeWireGuard {
+			config.Tunnel = wireGuardTunnelConfig(status)
+		}
+		return config
+	case types.NetworkInstanceTypeMesh:
+		config.Tunnel = meshTunnelConfig(status)
+		return config
+	}
+	if len(status.IfNameList) > 0 {
+		subnet := status.Subnet
+		config.NAT = &nireconciler.NATConfig{
+			Uplink:  status.IfNameList[0],
+			Subnet:  &subnet,
+			Gateway: bridgeIP,
+		}
+		if bridgeIPv6 != nil {
+			subnetIPv6 := status.SubnetIPv6
+			config.NAT.IPv6Subnet = &subnetIPv6
+			config.NAT.IPv6Gateway = bridgeIPv6
+			config.NAT.NAT66 = status.IPv6UplinkMode == types.IPv6UplinkModeNAT66
+		}
+	}
+	return config
+}
+
+// updateNIReconciler submits the current config of the network instance
+// to the NI reconciler.
+func updateNIReconciler(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, withRouting bool) error {
+
+	config := getNIReconcilerConfig(ctx, status, withRouting)
+	rs := ctx.niReconciler.UpdateNI(context.Background(), config)
+	processNIReconcileStatus(ctx, rs)
+	if rs.Error != nil {
+		return fmt.Errorf("failed to apply config of NI %s: %v",
+			status.DisplayName, rs.Error)
+	}
+	return nil
+}
+
+// updateBridgeIPSets records hosts for which dnsmasq should fill ipsets
+// with resolved addresses and re-applies the config of the network instance.
+// Dnsmasq is restarted only if the set of hosts has changed.
+func updateBridgeIPSets(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, ipsets []string) {
+
+	status.BridgeIPSets = ipsets
+	log.Functionf("set BridgeIPSets to %v for %s", ipsets, status.Key())
+	if status.BridgeName == "" {
+		// Bridge not yet created.
+		return
+	}
+	if err := updateNIReconciler(ctx, status, routingEnabled(status)); err != nil {
+		log.Error(err)
+	}
+}
+
+// setACLBackend switches the packet filtering framework used to implement ACLs
+// and re-applies the config of all network instances, because dnsmasq fills
+// either ipsets or nftables sets with resolved IPs, depending on the backend.
+// The fallback to iptables is reported in the status of every network instance.
+func setACLBackend(ctx *zedrouterContext, backend string) {
+	var backendErr string
+	if backend == types.ACLBackendNftables {
+		if err := nireconciler.CheckNftablesSupport(log); err != nil {
+			backendErr = fmt.Sprintf("cannot use %s ACL backend (%v), using %s",
+				backend, err, types.ACLBackendIptables)
+			log.Errorf("setACLBackend: %s", backendErr)
+			backend = types.ACLBackendIptables
+		}
+	}
+	errChanged := backendErr != ctx.aclBackendError
+	if errChanged {
+		ctx.aclBackendError = backendErr
+		ctx.aclBackendErrorTime = time.Now()
+	}
+	backendChanged := backend != ctx.aclBackend
+	if !backendChanged && !errChanged {
+		return
+	}
+	if backendChanged {
+		log.Noticef("setACLBackend: switching ACL backend from %s to %s",
+			ctx.aclBackend, backend)
+		ctx.aclBackend = backend
+		rs := ctx.niReconciler.SetACLBackend(context.Background(), backend)
+		processNIReconcileStatus(ctx, rs)
+		if rs.Error != nil {
+			log.Errorf("setACLBackend(%s): %v", backend, rs.Error)
+		}
+	}
+	if ctx.pubNetworkInstanceStatus == nil {
+		// Network instances were not yet created.
+		return
+	}
+	for _, item := range ctx.pubNetworkInstanceStatus.GetAll() {
+		status := item.(types.NetworkInstanceStatus)
+		setNIStatusACLBackend(ctx, &status)
+		if backendChanged && status.BridgeName != "" {
+			if err := updateNIReconciler(ctx, &status, routingEnabled(&status)); err != nil {
+				log.Error(err)
+			}
+		}
+		publishNetworkInstanceStatus(ctx, &status)
+	}
+}
+
+// setNIStatusACLBackend reports the ACL backend in use in the NI status.
+func setNIStatusACLBackend(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	status.ACLBackend = ctx.aclBackend
+	status.ACLBackendError = ctx.aclBackendError
+	if ctx.aclBackendError == "" {
+		status.ACLBackendErrorTime = time.Time{}
+	} else {
+		status.ACLBackendErrorTime = ctx.aclBackendErrorTime
+	}
+}
+
+// delNIReconciler removes all the config applied for the network instance.
+func delNIReconciler(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	rs := ctx.niReconciler.DelNI(context.Background(), status.UUID)
+	processNIReconcileStatus(ctx, rs)
+	if rs.Error != nil {
+		log.Errorf("delNIReconciler(%s): %v", status.DisplayName, rs.Error)
+	}
+}
+
+// updateAppVIFReconciler submits the ACLs and the remaining config
+// of the application VIF to the NI reconciler.
+// Rules are expected to have rulePrefix already applied.
+func updateAppVIFReconciler(ctx *zedrouterContext, appID uuid.UUID,
+	ulStatus *types.UnderlayNetworkStatus,
+	netstatus *types.NetworkInstanceStatus,
+	rules types.IPTablesRuleList, shaping *nireconciler.ShapingConfig) error {
+
+	appIPAddr := ulStatus.AllocatedIPv4Addr
+	var appIPv6Addr string
+	if netstatus.IsDualStack() && len(ulStatus.AllocatedIPv6List) > 0 {
+		appIPv6Addr = ulStatus.AllocatedIPv6List[0]
+	}
+	config := nireconciler.AppVIFConfig{
+		VIFName:  ulStatus.Vif,
+		AppID:    appID,
+		NI:       netstatus.UUID,
+		ACLRules: rules,
+		EIDs: getAppVIFEIDs(netstatus.DnsNameToIPList,
+			appIPAddr, appIPv6Addr),
+		Shaping: shaping,
+	}
+	if ulStatus.Mac != "" {
+		mac, err := net.ParseMAC(ulStatus.Mac)
+		if err != nil {
+			log.Errorf("updateAppVIFReconciler(%s): ParseMAC %s failed: %v",
+				ulStatus.Vif, ulStatus.Mac, err)
+		} else {
+			config.GuestMAC = mac
+		}
+	}
+	if appIPAddr != "" && config.GuestMAC != nil {
+		config.DhcpHost = &nireconciler.DhcpHostConfig{
+			MAC:      config.GuestMAC,
+			IP:       net.ParseIP(appIPAddr),
+			Hostname: appID.String(),
+		}
+	}
+	if appIPv6Addr != "" && config.GuestMAC != nil {
+		config.DhcpHostIPv6 = &nireconciler.DhcpHostConfig{
+			MAC:      config.GuestMAC,
+			IP:       net.ParseIP(appIPv6Addr),
+			Hostname: appID.String(),
+		}
+	}
+	rs := ctx.niReconciler.UpdateAppVIF(context.Background(), config)
+	processNIReconcileStatus(ctx, rs)
+	if rs.Error != nil {
+		return fmt.Errorf("failed to apply config of VIF %s: %v",
+			ulStatus.Vif, rs.Error)
+	}
+	return nil
+}
+
+// getVIFShaping returns bandwidth shaping of the application VIF, given by
+// the adapter bandwidth limits and ACEs with the Shape action.
+// Flows of a shaping ACE are recognized by the connection mark set
+// by the ACL rules of the ACE.
+// Returns nil if the VIF traffic should not be shaped.
+func getVIFShaping(aclArgs types.AppNetworkACLArgs,
+	ulConfig *types.UnderlayNetworkConfig) *nireconciler.ShapingConfig {
+	shaping := &nireconciler.ShapingConfig{
+		Ingress: tc.Direction{LimitKbps: ulConfig.Bandwidth.IngressLimit},
+		Egress:  tc.Direction{LimitKbps: ulConfig.Bandwidth.EgressLimit},
+	}
+	for _, ace := range ulConfig.ACLs {
+		if ace.RuleID <= 0 {
+			// Rules of the ACE are not marking flows.
+			continue
+		}
+		for _, action := range ace.Actions {
+			if !action.Shape {
+				continue
+			}
+			class := tc.Class{
+				AceID: ace.RuleID,
+				Mark: iptables.GetConnmark(
+					uint8(aclArgs.AppNum), uint32(ace.RuleID), false),
+				RateKbps: action.ShapeRate,
+				CeilKbps: action.ShapeCeil,
+			}
+			if ace.Dir != types.AceDirEgress {
+				shaping.Ingress.Classes = append(shaping.Ingress.Classes, class)
+			}
+			if ace.Dir != types.AceDirIngress {
+				shaping.Egress.Classes = append(shaping.Egress.Classes, class)
+			}
+		}
+	}
+	if !shaping.Ingress.IsShaped() && !shaping.Egress.IsShaped() {
+		return nil
+	}
+	return shaping
+}
+
+// delAppVIFReconciler removes all the config applied for the application VIF.
+func delAppVIFReconciler(ctx *zedrouterContext, vifName string) {
+	rs := ctx.niReconciler.DelAppVIF(context.Background(), vifName)
+	processNIReconcileStatus(ctx, rs)
+	if rs.Error != nil {
+		log.Errorf("delAppVIFReconciler(%s): %v", vifName, rs.Error)
+	}
+}
+
+// processNIReconcileStatus remembers the latest reconciliation status,
+// which carries the channel used to signal that reconciliation should resume.
+func processNIReconcileStatus(ctx *zedrouterContext,
+	rs nireconciler.ReconcileStatus) {
+	ctx.niReconcileStatus = rs
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Packet captures requested by the local profile server and passed
+// by zedagent as PacketCaptureConfig. Each capture runs in its own goroutine
+// and writes pcapng file into types.PacketCaptureDir. Files of ended
+// captures are kept until the capture config is removed or the space
+// is needed for a new capture.
+
+package zedrouter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/packetcapture"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// Captures running at the same time.
+const maxRunningPacketCaptures = 4
+
+type packetCaptureManager struct {
+	sync.Mutex
+	pub pubsub.Publication
+	// Total size of capture files in bytes.
+	quota   uint64
+	running map[string]*runningCapture
+}
+
+type runningCapture struct {
+	status  types.PacketCaptureStatus
+	maxSize uint64
+	stop    chan struct{}
+	stopped bool
+	// Capture config was removed while the capture was running.
+	deleted bool
+}
+
+func newPacketCaptureManager() *packetCaptureManager {
+	return &packetCaptureManager{
+		running: make(map[string]*runningCapture),
+	}
+}
+
+// init removes capture files left from the previous run of zedrouter,
+// their status is not known anymore.
+func (m *packetCaptureManager) init(pub pubsub.Publication) {
+	m.pub = pub
+	if err := os.RemoveAll(types.PacketCaptureDir); err != nil {
+		log.Errorf("Failed to remove packet capture directory: %v", err)
+	}
+}
+
+func (m *packetCaptureManager) setQuota(quotaMiB uint32) {
+	m.Lock()
+	defer m.Unlock()
+	m.quota = uint64(quotaMiB) << 20
+}
+
+func handlePacketCaptureCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+	ctx := ctxArg.(*zedrouterContext)
+	config := configArg.(types.PacketCaptureConfig)
+	log.Functionf("handlePacketCaptureCreate(%s)", key)
+	ctx.packetCaptures.start(ctx, config)
+	log.Functionf("handlePacketCaptureCreate(%s) done", key)
+}
+
+func handlePacketCaptureModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+	ctx := ctxArg.(*zedrouterContext)
+	config := configArg.(types.PacketCaptureConfig)
+	log.Functionf("handlePacketCaptureModify(%s)", key)
+	// Only the request to stop is accepted for a known capture.
+	if config.Stop {
+		ctx.packetCaptures.stop(key, false)
+	}
+	log.Functionf("handlePacketCaptureModify(%s) done", key)
+}
+
+func handlePacketCaptureDelete(ctxArg interface{}, key string,
+	configArg interface{}) {
+	ctx := ctxArg.(*zedrouterContext)
+	log.Functionf("handlePacketCaptureDelete(%s)", key)
+	ctx.packetCaptures.stop(key, true)
+	log.Functionf("handlePacketCaptureDelete(%s) done", key)
+}
+
+func (m *packetCaptureManager) start(ctx *zedrouterContext,
+	config types.PacketCaptureConfig) {
+	m.Lock()
+	defer m.Unlock()
+	status := types.PacketCaptureStatus{
+		ID:    config.ID,
+		State: types.PacketCaptureStatePending,
+	}
+	fail := func(err error) {
+		log.Errorf("Packet capture %s on %s failed: %v", config.ID,
+			config.Target(), err)
+		status.State = types.PacketCaptureStateFailed
+		status.SetErrorNow(err.Error())
+		m.publish(status)
+	}
+	if config.Stop {
+		fail(fmt.Errorf("capture was stopped before it started"))
+		return
+	}
+	ifName, err := getPacketCaptureInterface(ctx, config)
+	if err != nil {
+		fail(err)
+		return
+	}
+	status.Interface = ifName
+	if len(m.running) >= maxRunningPacketCaptures {
+		fail(fmt.Errorf("too many packet captures are running (%d)",
+			len(m.running)))
+		return
+	}
+	maxSize := config.MaxSize
+	if maxSize == 0 {
+		maxSize = types.DefaultPacketCaptureMaxSize
+	}
+	if maxSize > m.quota {
+		maxSize = m.quota
+	}
+	if err = m.reserveSpace(maxSize); err != nil {
+		fail(err)
+		return
+	}
+	if err = os.MkdirAll(types.PacketCaptureDir, 0700); err != nil {
+		fail(fmt.Errorf("failed to create directory for captures: %w", err))
+		return
+	}
+	captureConfig := packetcapture.Config{
+		Interface: ifName,
+		Filter:    config.Filter,
+		SnapLen:   config.SnapLen,
+		Duration:  config.Duration,
+		MaxSize:   maxSize,
+		Filename:  filepath.Join(types.PacketCaptureDir, config.ID+".pcapng"),
+	}
+	if captureConfig.SnapLen == 0 {
+		captureConfig.SnapLen = types.DefaultPacketCaptureSnapLen
+	}
+	if captureConfig.Duration == 0 {
+		captureConfig.Duration = types.DefaultPacketCaptureDuration
+	}
+	if captureConfig.Duration > types.MaxPacketCaptureDuration {
+		captureConfig.Duration = types.MaxPacketCaptureDuration
+	}
+	// File of a capture with the same ID may still exist if the config
+	// was removed and re-added.
+	os.Remove(captureConfig.Filename)
+	capture, err := packetcapture.Open(log, captureConfig)
+	if err != nil {
+		fail(err)
+		return
+	}
+	log.Noticef("Starting packet capture %s on %s (%s), filter: '%s', "+
+		"duration: %v, max size: %d", config.ID, ifName, config.Target(),
+		config.Filter, captureConfig.Duration, maxSize)
+	status.State = types.PacketCaptureStateRunning
+	status.StartTime = time.Now()
+	status.Filename = captureConfig.Filename
+	rc := &runningCapture{
+		status:  status,
+		maxSize: maxSize,
+		stop:    make(chan struct{}),
+	}
+	m.running[config.ID] = rc
+	m.publish(status)
+	go m.run(capture, rc)
+}
+
+func (m *packetCaptureManager) run(capture *packetcapture.Capture,
+	rc *runningCapture) {
+	progress, err := capture.Run(rc.stop, func(progress packetcapture.Progress) {
+		m.Lock()
+		defer m.Unlock()
+		if rc.deleted {
+			return
+		}
+		rc.status.Packets = progress.Packets
+		rc.status.Size = progress.Size
+		m.publish(rc.status)
+	})
+	m.Lock()
+	defer m.Unlock()
+	status := rc.status
+	delete(m.running, status.ID)
+	if rc.deleted {
+		log.Noticef("Packet capture %s removed while running", status.ID)
+		os.Remove(status.Filename)
+		return
+	}
+	status.EndTime = time.Now()
+	status.Packets = progress.Packets
+	status.Size = progress.Size
+	status.Truncated = progress.Truncated
+	if err != nil {
+		log.Errorf("Packet capture %s failed: %v", status.ID, err)
+		status.State = types.PacketCaptureStateFailed
+		status.SetErrorNow(err.Error())
+	} else {
+		log.Noticef("Packet capture %s completed: %d packets, %d bytes, "+
+			"truncated: %t", status.ID, status.Packets, status.Size, status.Truncated)
+		status.State = types.PacketCaptureStateCompleted
+	}
+	m.publish(status)
+}
+
+// stop ends the capture if it is running. If the config was deleted,
+// the capture file and status are removed as well.
+func (m *packetCaptureManager) stop(id string, deleted bool) {
+	m.Lock()
+	defer m.Unlock()
+	if rc := m.running[id]; rc != nil {
+		if !rc.stopped {
+			log.Noticef("Stopping packet capture %s", id)
+			close(rc.stop)
+			rc.stopped = true
+		}
+		if deleted {
+			rc.deleted = true
+			m.unpublish(id)
+		}
+		return
+	}
+	if !deleted {
+		return
+	}
+	if st, _ := m.pub.Get(id); st != nil {
+		status := st.(types.PacketCaptureStatus)
+		if status.Filename != "" {
+			os.Remove(status.Filename)
+		}
+	}
+	m.unpublish(id)
+}
+
+// reserveSpace removes files of the oldest ended captures until the new
+// capture fits into the quota. Called with the lock held.
+func (m *packetCaptureManager) reserveSpace(size uint64) error {
+	var used uint64
+	for _, rc := range m.running {
+		used += rc.maxSize
+	}
+	var ended []types.PacketCaptureStatus
+	for _, st := range m.pub.GetAll() {
+		status := st.(types.PacketCaptureStatus)
+		if status.Ended() && status.Filename != "" {
+			used += status.Size
+			ended = append(ended, status)
+		}
+	}
+	sort.Slice(ended, func(i, j int) bool {
+		return ended[i].EndTime.Before(ended[j].EndTime)
+	})
+	for used+size > m.quota && len(ended) > 0 {
+		status := ended[0]
+		ended = ended[1:]
+		log.Noticef("Removing file of packet capture %s to free space", status.ID)
+		if err := os.Remove(status.Filename); err != nil && !os.IsNotExist(err) {
+			log.Errorf("Failed to remove %s: %v", status.Filename, err)
+		}
+		used -= status.Size
+		status.Filename = ""
+		status.Size = 0
+		status.State = types.PacketCaptureStateFailed
+		status.SetErrorNow("capture file was removed to make room for newer captures")
+		m.publish(status)
+	}
+	if used+size > m.quota {
+		return fmt.Errorf("packet capture quota (%d MiB) is used by running captures",
+			m.quota>>20)
+	}
+	return nil
+}
+
+func (m *packetCaptureManager) publish(status types.PacketCaptureStatus) {
+	if err := m.pub.Publish(status.Key(), status); err != nil {
+		log.Errorf("Failed to publish packet capture status %s: %v", status.ID, err)
+	}
+}
+
+func (m *packetCaptureManager) unpublish(id string) {
+	if st, _ := m.pub.Get(id); st == nil {
+		return
+	}
+	if err := m.pub.Unpublish(id); err != nil {
+		log.Errorf("Failed to unpublish packet capture status %s: %v", id, err)
+	}
+}
+
+// getPacketCaptureInterface returns the name of the interface to capture on.
+func getPacketCaptureInterface(ctx *zedrouterContext,
+	config types.PacketCaptureConfig) (string, error) {
+	switch config.TargetType {
+	case types.PacketCaptureTargetNetworkInstance:
+		status := lookupNetworkInstanceStatus(ctx, config.NetworkInstance.String())
+		if status == nil {
+			return "", fmt.Errorf("network instance %s not found",
+				config.NetworkInstance)
+		}
+		if status.BridgeName == "" {
+			return "", fmt.Errorf("network instance %s has no bridge",
+				config.NetworkInstance)
+		}
+		return status.BridgeName, nil
+
+	case types.PacketCaptureTargetAppInterface:
+		status := lookupAppNetworkStatus(ctx, config.AppInstance.String())
+		if status == nil {
+			return "", fmt.Errorf("network status of app %s not found",
+				config.AppInstance)
+		}
+		if config.AppIntfIndex < 0 ||
+			config.AppIntfIndex >= len(status.UnderlayNetworkList) {
+			return "", fmt.Errorf("app %s has no network interface with index %d",
+				config.AppInstance, config.AppIntfIndex)
+		}
+		vif := status.UnderlayNetworkList[config.AppIntfIndex].Vif
+		if vif == "" {
+			return "", fmt.Errorf("network interface %d of app %s has no VIF",
+				config.AppIntfIndex, config.AppInstance)
+		}
+		return vif, nil
+
+	case types.PacketCaptureTargetPort:
+		if ctx.deviceNetworkStatus == nil {
+			return "", fmt.Errorf("device network status is not known")
+		}
+		port := ctx.deviceNetworkStatus.GetPortByLogicallabel(config.Port)
+		if port == nil {
+			return "", fmt.Errorf("port %s not found", config.Port)
+		}
+		return port.IfName, nil
+	}
+	return "", fmt.Errorf("capture target is not specified")
+}
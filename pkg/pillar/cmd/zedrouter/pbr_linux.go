@@ -17,36 +17,6 @@ import (
 	"github.com/vishvananda/netlink"
 )
 
-// Return the all routes for one interface
-func getAllIPv4Routes(ifindex int) []netlink.Route {
-	table := syscall.RT_TABLE_MAIN
-	filter := netlink.Route{Table: table, LinkIndex: ifindex}
-	fflags := netlink.RT_FILTER_TABLE
-	fflags |= netlink.RT_FILTER_OIF
-	log.Functionf("getAllIPv4Routes(%d) filter %v\n", ifindex, filter)
-	routes, err := netlink.RouteListFiltered(syscall.AF_INET,
-		&filter, fflags)
-	if err != nil {
-		log.Errorf("getAllIPv4Routes: ifindex %d failed, error %v", ifindex, err)
-		return nil
-	}
-	log.Tracef("getAllIPv4Routes(%d) - got %d matches\n",
-		ifindex, len(routes))
-	return routes
-}
-
-func getDefaultRouteTable() int {
-	return syscall.RT_TABLE_MAIN
-}
-
-func getRouteUpdateTypeDELROUTE() uint16 {
-	return syscall.RTM_DELROUTE
-}
-
-func getRouteUpdateTypeNEWROUTE() uint16 {
-	return syscall.RTM_NEWROUTE
-}
-
 // Handle a link being added or deleted
 // Returns the ifname if there was a change
 func PbrLinkChange(deviceNetworkStatus *types.DeviceNetworkStatus,
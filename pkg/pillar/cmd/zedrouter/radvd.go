@@ -7,11 +7,9 @@ package zedrouter
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
 
-	"github.com/lf-edge/eve/pkg/pillar/agentlog"
-	"github.com/lf-edge/eve/pkg/pillar/utils"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
 // Need to fill in the overlay inteface name
@@ -33,69 +31,55 @@ interface %s {
 };
 `
 
-// Create the radvd config file for the overlay
-// Would be more polite to return an error then to Fatal
-//	olIfname - Overlay Interface Name
-func createRadvdConfiglet(cfgPathname string, olIfname string) {
-
-	log.Tracef("createRadvdConfiglet: %s\n", olIfname)
-	file, err := os.Create(cfgPathname)
-	if err != nil {
-		log.Fatal("createRadvdConfiglet failed ", err)
-	}
-	defer file.Close()
-	file.WriteString(fmt.Sprintf(radvdTemplate, olIfname))
-}
+// Router advertisement of a dual-stack network instance. Only the on-link
+// prefix and the default router are announced, addresses are assigned
+// by dnsmasq using DHCPv6.
+const radvdDualStackTemplate = `
+# Automatically generated by zedrouter
+interface %s {
+	IgnoreIfMissing on;
+	AdvSendAdvert on;
+	MaxRtrAdvInterval 600;
+	AdvManagedFlag on;
+	AdvOtherConfigFlag on;
+	AdvDefaultLifetime %d;
+	prefix %s
+	{
+		AdvOnLink on;
+		AdvAutonomous off;
+	};
+%s};
+`
 
-func deleteRadvdConfiglet(cfgPathname string) {
+const radvdRDNSSTemplate = `	RDNSS %s
+	{
+	};
+`
 
-	log.Tracef("createRadvdConfiglet: %s\n", cfgPathname)
-	if err := os.Remove(cfgPathname); err != nil {
-		log.Errorln(err)
+// getRadvdConfig returns the radvd configuration for the given bridge
+// to be applied by the NI reconciler.
+func getRadvdConfig(bridgeName string) *nireconciler.RadvdConfig {
+	cfgPathname := runDirname + "/radvd." + bridgeName + ".conf"
+	return &nireconciler.RadvdConfig{
+		ConfigPath: cfgPathname,
+		PidFile:    "/run/radvd." + bridgeName + ".pid",
+		Config:     fmt.Sprintf(radvdTemplate, bridgeName),
 	}
 }
 
-// Run this:
-//    radvd -u radvd -C /run/zedrouter/radvd.${OLIFNAME}.conf -p /run/radvd.${OLIFNAME}.pid
-func startRadvd(cfgPathname string, olIfname string) {
-
-	log.Tracef("startRadvd: %s\n", cfgPathname)
-	pidPathname := "/run/radvd." + olIfname + ".pid"
-	cmd := "nohup"
-	args := []string{
-		"radvd",
-		"-u",
-		"radvd",
-		"-C",
-		cfgPathname,
-		"-p",
-		pidPathname,
+// getDualStackRadvdConfig returns the radvd configuration for a dual-stack
+// network instance.
+func getDualStackRadvdConfig(status *types.NetworkInstanceStatus) *nireconciler.RadvdConfig {
+	config := getRadvdConfig(status.BridgeName)
+	var defaultLifetime int
+	var rdnss string
+	if status.Logicallabel != "" {
+		// Without a port the network instance is air-gapped and applications
+		// should not use it as the default router.
+		defaultLifetime = 1800
+		rdnss = fmt.Sprintf(radvdRDNSSTemplate, status.BridgeIPv6Addr)
 	}
-	log.Functionf("Creating %s at %s", "nohup radvd", agentlog.GetMyStack())
-	log.Functionf("Calling command %s %v\n", cmd, args)
-	go exec.Command(cmd, args...).Output()
-}
-
-func getBridgeRadvdCfgFileName(bridgeName string) (string, string) {
-	cfgFilename := "radvd." + bridgeName + ".conf"
-	cfgPathname := runDirname + "/" + cfgFilename
-	return cfgFilename, cfgPathname
-}
-
-func stopRadvd(bridgeName string, printOnError bool) {
-	cfgFilename, cfgPathname := getBridgeRadvdCfgFileName(bridgeName)
-
-	log.Tracef("stopRadvd: cfgFileName:%s, cfgPathName:%s\n",
-		cfgFilename, cfgPathname)
-	utils.PkillArgs(log, cfgFilename, printOnError, false)
-	deleteRadvdConfiglet(cfgPathname)
-}
-
-func restartRadvdWithNewConfig(bridgeName string) {
-	cfgFilename, cfgPathname := getBridgeRadvdCfgFileName(bridgeName)
-
-	// kill existing radvd instance
-	stopRadvd(cfgFilename, false)
-	createRadvdConfiglet(cfgPathname, bridgeName)
-	startRadvd(cfgPathname, bridgeName)
+	config.Config = fmt.Sprintf(radvdDualStackTemplate, status.BridgeName,
+		defaultLifetime, status.SubnetIPv6.String(), rdnss)
+	return config
 }
@@ -0,0 +1,485 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// WireGuard tunnel management routines for the network instances
+// of type Cloud with the WireGuard opaque config, see docs/WIREGUARD.md.
+
+package zedrouter
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	wireGuardDefaultMtu = 1420
+	// a peer without a handshake for longer is reported as connecting,
+	// WireGuard rekeys every two minutes on an active tunnel
+	wireGuardHandshakeTimeout = 3 * time.Minute
+)
+
+// wireGuardIfName returns the name of the tunnel interface of the network instance
+func wireGuardIfName(status *types.NetworkInstanceStatus) string {
+	return fmt.Sprintf("wg%d", status.BridgeNum)
+}
+
+// wireGuardConfigParse parses and validates the opaque config
+func wireGuardConfigParse(opaqueConfig string) (types.WireGuardConfig, error) {
+	var config types.WireGuardConfig
+	if err := json.Unmarshal([]byte(opaqueConfig), &config); err != nil {
+		return config, fmt.Errorf("invalid WireGuard config: %v", err)
+	}
+	if config.ListenPort < 0 || config.ListenPort > 65535 {
+		return config, fmt.Errorf("invalid ListenPort %d", config.ListenPort)
+	}
+	if config.Mtu == 0 {
+		config.Mtu = wireGuardDefaultMtu
+	} else if config.Mtu < 576 || config.Mtu > 65535 {
+		return config, fmt.Errorf("invalid Mtu %d", config.Mtu)
+	}
+	if _, _, err := net.ParseCIDR(config.Address); err != nil {
+		return config, fmt.Errorf("invalid Address %s: %v", config.Address, err)
+	}
+	if len(config.Peers) == 0 {
+		return config, errors.New("no peers")
+	}
+	keys := make(map[string]bool)
+	for _, peer := range config.Peers {
+		if err := wireGuardKeyValidate(peer.PublicKey); err != nil {
+			return config, fmt.Errorf("peer %s: invalid PublicKey: %v", peer.Name, err)
+		}
+		if keys[peer.PublicKey] {
+			return config, fmt.Errorf("peer %s: duplicate PublicKey", peer.Name)
+		}
+		keys[peer.PublicKey] = true
+		if peer.Endpoint != "" {
+			if _, _, err := net.SplitHostPort(peer.Endpoint); err != nil {
+				return config, fmt.Errorf("peer %s: invalid Endpoint %s: %v",
+					peer.Name, peer.Endpoint, err)
+			}
+		}
+		if len(peer.AllowedIPs) == 0 {
+			return config, fmt.Errorf("peer %s: no AllowedIPs", peer.Name)
+		}
+		for _, allowed := range peer.AllowedIPs {
+			if _, _, err := net.ParseCIDR(allowed); err != nil {
+				return config, fmt.Errorf("peer %s: invalid AllowedIPs %s: %v",
+					peer.Name, allowed, err)
+			}
+		}
+		if peer.PersistentKeepalive < 0 || peer.PersistentKeepalive > 65535 {
+			return config, fmt.Errorf("peer %s: invalid PersistentKeepalive %d",
+				peer.Name, peer.PersistentKeepalive)
+		}
+	}
+	return config, nil
+}
+
+// wireGuardKeyValidate checks that key is a base64 encoded curve25519 key
+func wireGuardKeyValidate(key string) error {
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return err
+	}
+	if len(b) != 32 {
+		return fmt.Errorf("key length %d, expected 32", len(b))
+	}
+	return nil
+}
+
+// wireGuardPrivateKeyGet decrypts the private key from the cipher block.
+// There is no cleartext fallback for the key.
+func wireGuardPrivateKeyGet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) (string, error) {
+	if !status.CipherBlockStatus.IsCipher {
+		ctx.cipherMetrics.RecordFailure(log, types.NoCipher)
+		return "", errors.New("WireGuard private key cipherblock not present")
+	}
+	cbStatus, decBlock, err := cipher.GetCipherCredentials(&ctx.decryptCipherContext,
+		status.CipherBlockStatus)
+	ctx.pubCipherBlockStatus.Publish(cbStatus.Key(), cbStatus)
+	if err != nil {
+		ctx.cipherMetrics.RecordFailure(log, types.MissingFallback)
+		return "", fmt.Errorf("WireGuard private key cipherblock decryption unsuccessful: %v",
+			err)
+	}
+	if err := wireGuardKeyValidate(decBlock.WireGuardPrivateKey); err != nil {
+		ctx.cipherMetrics.RecordFailure(log, types.NoData)
+		return "", fmt.Errorf("invalid WireGuard private key: %v", err)
+	}
+	log.Functionf("%s, WireGuard private key decryption successful", status.Key())
+	return decBlock.WireGuardPrivateKey, nil
+}
+
+// wireGuardStatusParse returns the config saved by wireGuardNetworkInstanceCreate
+func wireGuardStatusParse(opaqueStatus string) (types.WireGuardConfig, error) {
+	var config types.WireGuardConfig
+	if err := json.Unmarshal([]byte(opaqueStatus), &config); err != nil {
+		return config, fmt.Errorf("invalid WireGuard status: %v", err)
+	}
+	return config, nil
+}
+
+func wireGuardNetworkInstanceCreate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("WireGuard network instance create: %s", status.DisplayName)
+	config, err := wireGuardConfigParse(status.OpaqueConfig)
+	if err != nil {
+		return err
+	}
+	privateKey, err := wireGuardPrivateKeyGet(ctx, status)
+	if err != nil {
+		return err
+	}
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	status.OpaqueStatus = string(bytes)
+	ifName := wireGuardIfName(status)
+	if err := wireGuardLinkCreate(ifName, privateKey, config); err != nil {
+		return err
+	}
+	status.WireGuardListenPort, err = wireGuardListenPortGet(ifName)
+	return err
+}
+
+// wireGuardLinkCreate creates the tunnel interface with the address
+// and the peers of the config and brings it up.
+func wireGuardLinkCreate(ifName string, privateKey string,
+	config types.WireGuardConfig) error {
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = ifName
+	attrs.MTU = config.Mtu
+	// remove any leftover from a previous run
+	if link, err := netlink.LinkByName(ifName); err == nil {
+		netlink.LinkDel(link)
+	}
+	if err := netlink.LinkAdd(&netlink.Wireguard{LinkAttrs: attrs}); err != nil {
+		return fmt.Errorf("failed to create %s: %v", ifName, err)
+	}
+	if err := wireGuardSetPeers(ifName, privateKey, config); err != nil {
+		wireGuardLinkDelete(ifName)
+		return err
+	}
+	link, err := netlink.LinkByName(ifName)
+	if err != nil {
+		return fmt.Errorf("failed to find %s: %v", ifName, err)
+	}
+	addr, err := netlink.ParseAddr(config.Address)
+	if err != nil {
+		wireGuardLinkDelete(ifName)
+		return fmt.Errorf("invalid Address %s: %v", config.Address, err)
+	}
+	if err := netlink.AddrAdd(link, addr); err != nil {
+		wireGuardLinkDelete(ifName)
+		return fmt.Errorf("failed to add %s to %s: %v", config.Address, ifName, err)
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		wireGuardLinkDelete(ifName)
+		return fmt.Errorf("failed to bring up %s: %v", ifName, err)
+	}
+	return nil
+}
+
+// wireGuardSetPeers configures the key and the peers of the interface.
+// wg only reads the private key from a file.
+func wireGuardSetPeers(ifName string, privateKey string,
+	config types.WireGuardConfig) error {
+	keyFile, err := ioutil.TempFile(runDirname, ifName+".key")
+	if err != nil {
+		return fmt.Errorf("failed to create the key file: %v", err)
+	}
+	defer os.Remove(keyFile.Name())
+	_, err = keyFile.WriteString(privateKey)
+	keyFile.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write the key file: %v", err)
+	}
+	args := []string{"set", ifName, "private-key", keyFile.Name()}
+	if config.ListenPort != 0 {
+		args = append(args, "listen-port", strconv.Itoa(config.ListenPort))
+	}
+	for _, peer := range config.Peers {
+		args = append(args, "peer", peer.PublicKey,
+			"allowed-ips", strings.Join(peer.AllowedIPs, ","))
+		if peer.Endpoint != "" {
+			args = append(args, "endpoint", peer.Endpoint)
+		}
+		if peer.PersistentKeepalive != 0 {
+			args = append(args, "persistent-keepalive",
+				strconv.Itoa(peer.PersistentKeepalive))
+		}
+	}
+	if out, err := base.Exec(log, "wg", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("wg set %s failed: %v: %s", ifName, err, string(out))
+	}
+	return nil
+}
+
+// wireGuardListenPortGet returns the UDP port of the interface,
+// which is random if the config does not set it
+func wireGuardListenPortGet(ifName string) (uint16, error) {
+	out, err := base.Exec(log, "wg", "show", ifName, "listen-port").Output()
+	if err != nil {
+		return 0, fmt.Errorf("wg show %s listen-port failed: %v", ifName, err)
+	}
+	port, err := strconv.ParseUint(strings.TrimSpace(string(out)), 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid listen-port of %s: %v", ifName, err)
+	}
+	return uint16(port), nil
+}
+
+func wireGuardLinkDelete(ifName string) {
+	link, err := netlink.LinkByName(ifName)
+	if err != nil {
+		return
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		log.Errorf("failed to delete %s: %v", ifName, err)
+	}
+}
+
+func wireGuardNetworkInstanceDestroy(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Functionf("WireGuard network instance delete: %s", status.DisplayName)
+	wireGuardLinkDelete(wireGuardIfName(status))
+	status.WireGuardListenPort = 0
+}
+
+// wireGuardTunnelConfig returns the routing configuration of the NI
+// reconciler for the WireGuard VPN: the traffic of the apps to the AllowedIPs
+// of the peers goes through the tunnel, everything else is dropped
+// by the default route of the network instance table.
+func wireGuardTunnelConfig(status *types.NetworkInstanceStatus) *nireconciler.TunnelConfig {
+	config, err := wireGuardStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Errorf("wireGuardTunnelConfig(%s): %v", status.DisplayName, err)
+		return nil
+	}
+	subnet := status.Subnet
+	tunnel := &nireconciler.TunnelConfig{
+		IfName:     wireGuardIfName(status),
+		Subnet:     &subnet,
+		Gateway:    net.ParseIP(status.BridgeIPAddr),
+		Masquerade: config.Masquerade,
+	}
+	for _, peer := range config.Peers {
+		for _, allowed := range peer.AllowedIPs {
+			_, dst, err := net.ParseCIDR(allowed)
+			if err != nil || dst.IP.To4() == nil {
+				continue
+			}
+			tunnel.Routes = append(tunnel.Routes, dst)
+		}
+	}
+	return tunnel
+}
+
+// wireGuardNetworkInstanceActivate lets the NI reconciler route the traffic
+// of the apps through the tunnel.
+func wireGuardNetworkInstanceActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("WireGuard network instance activate: %s", status.DisplayName)
+	if _, err := wireGuardStatusParse(status.OpaqueStatus); err != nil {
+		return err
+	}
+	return updateNIReconciler(ctx, status, true)
+}
+
+func wireGuardNetworkInstanceInactivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Functionf("WireGuard network instance inactivate: %s", status.DisplayName)
+	if err := updateNIReconciler(ctx, status, false); err != nil {
+		log.Errorf("WireGuard network instance inactivate: %v", err)
+	}
+}
+
+// wireGuardPeerStatus is a peer line of "wg show <interface> dump"
+type wireGuardPeerStatus struct {
+	PublicKey     string
+	Endpoint      string
+	AllowedIPs    string
+	LastHandshake uint64 // unix time, zero if there was none
+	RxBytes       uint64
+	TxBytes       uint64
+}
+
+// wireGuardDumpParse parses the output of "wg show <interface> dump".
+// The first line describes the interface, the others the peers with the
+// tab separated fields public-key, preshared-key, endpoint, allowed-ips,
+// latest-handshake, transfer-rx, transfer-tx and persistent-keepalive.
+func wireGuardDumpParse(dump string) ([]wireGuardPeerStatus, error) {
+	var peers []wireGuardPeerStatus
+	scanner := bufio.NewScanner(strings.NewReader(dump))
+	first := true
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		if first {
+			first = false
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 8 {
+			return nil, fmt.Errorf("unexpected peer line %q", line)
+		}
+		peer := wireGuardPeerStatus{
+			PublicKey:  fields[0],
+			Endpoint:   fields[2],
+			AllowedIPs: fields[3],
+		}
+		if peer.Endpoint == "(none)" {
+			peer.Endpoint = ""
+		}
+		if peer.AllowedIPs == "(none)" {
+			peer.AllowedIPs = ""
+		}
+		var err error
+		if peer.LastHandshake, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid latest-handshake in %q: %v", line, err)
+		}
+		if peer.RxBytes, err = strconv.ParseUint(fields[5], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid transfer-rx in %q: %v", line, err)
+		}
+		if peer.TxBytes, err = strconv.ParseUint(fields[6], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid transfer-tx in %q: %v", line, err)
+		}
+		peers = append(peers, peer)
+	}
+	return peers, scanner.Err()
+}
+
+// wireGuardVpnStatus fills the VpnStatus and VpnMetrics from the peers.
+// The connection is identified by the public key of the peer.
+func wireGuardVpnStatus(status *types.NetworkInstanceStatus,
+	config types.WireGuardConfig, peers []wireGuardPeerStatus,
+	now time.Time) (*types.VpnStatus, *types.VpnMetrics) {
+
+	vpnStatus := &types.VpnStatus{Version: "wireguard"}
+	vpnMetrics := &types.VpnMetrics{}
+	localAddr := config.Address
+	if ip, _, err := net.ParseCIDR(config.Address); err == nil {
+		localAddr = ip.String()
+	}
+	names := make(map[string]string)
+	for _, peer := range config.Peers {
+		names[peer.PublicKey] = peer.Name
+	}
+	for _, peer := range peers {
+		connStatus := &types.VpnConnStatus{
+			Id:      peer.PublicKey,
+			Name:    names[peer.PublicKey],
+			State:   types.VPN_CONNECTING,
+			Version: "wireguard",
+			EstTime: peer.LastHandshake,
+			LInfo:   types.VpnEndPoint{IpAddr: localAddr},
+			RInfo:   types.VpnEndPoint{Id: peer.PublicKey},
+		}
+		connMetrics := &types.VpnConnMetrics{
+			Id:      peer.PublicKey,
+			Name:    connStatus.Name,
+			EstTime: peer.LastHandshake,
+			Type:    types.NST_WIREGUARD,
+			NIType:  status.Type,
+		}
+		if host, port, err := net.SplitHostPort(peer.Endpoint); err == nil {
+			connStatus.RInfo.IpAddr = host
+			if p, err := strconv.ParseUint(port, 10, 16); err == nil {
+				connStatus.RInfo.Port = uint32(p)
+			}
+		}
+		if peer.LastHandshake != 0 {
+			age := now.Sub(time.Unix(int64(peer.LastHandshake), 0))
+			if age < 0 {
+				age = 0
+			}
+			connMetrics.HandshakeAge = uint64(age / time.Second)
+			if age < wireGuardHandshakeTimeout {
+				connStatus.State = types.VPN_ESTABLISHED
+			}
+		}
+		if connStatus.State == types.VPN_ESTABLISHED {
+			vpnStatus.ActiveTunCount++
+		} else {
+			vpnStatus.ConnectingTunCount++
+		}
+		connMetrics.LEndPoint.IpAddr = localAddr
+		connMetrics.LEndPoint.LinkInfo.SubNet = status.Subnet.String()
+		connMetrics.LEndPoint.PktStats.Bytes = peer.RxBytes
+		connMetrics.REndPoint.IpAddr = connStatus.RInfo.IpAddr
+		connMetrics.REndPoint.LinkInfo.SubNet = peer.AllowedIPs
+		connMetrics.REndPoint.PktStats.Bytes = peer.TxBytes
+		vpnStatus.ActiveVpnConns = append(vpnStatus.ActiveVpnConns, connStatus)
+		vpnMetrics.VpnConns = append(vpnMetrics.VpnConns, connMetrics)
+	}
+	return vpnStatus, vpnMetrics
+}
+
+// wireGuardVpnStatusGet updates the VpnStatus of the network instance
+// and fills its VpnMetrics. Returns true if the status changed.
+func wireGuardVpnStatusGet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, nis *types.NetworkInstanceMetrics) bool {
+	config, err := wireGuardStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Functionf("WireGuard config absent")
+		return false
+	}
+	return wireGuardTunnelStatusGet(status, config, nis)
+}
+
+// wireGuardTunnelStatusGet reads the state of the peers of the tunnel
+// interface of the network instance into its VpnStatus and VpnMetrics.
+func wireGuardTunnelStatusGet(status *types.NetworkInstanceStatus,
+	config types.WireGuardConfig, nis *types.NetworkInstanceMetrics) bool {
+	ifName := wireGuardIfName(status)
+	out, err := base.Exec(log, "wg", "show", ifName, "dump").Output()
+	if err != nil {
+		log.Errorf("wg show %s dump failed: %v", ifName, err)
+		return false
+	}
+	peers, err := wireGuardDumpParse(string(out))
+	if err != nil {
+		log.Errorf("wg show %s dump: %v", ifName, err)
+		return false
+	}
+	vpnStatus, vpnMetrics := wireGuardVpnStatus(status, config, peers, time.Now())
+	if link, err := netlink.LinkByName(ifName); err == nil {
+		if stats := link.Attrs().Statistics; stats != nil {
+			vpnMetrics.DataStat.InPkts.Pkts = stats.RxPackets
+			vpnMetrics.DataStat.InPkts.Bytes = stats.RxBytes
+			vpnMetrics.DataStat.OutPkts.Pkts = stats.TxPackets
+			vpnMetrics.DataStat.OutPkts.Bytes = stats.TxBytes
+		}
+	}
+	nis.VpnMetrics = vpnMetrics
+
+	change := isVpnStatusChanged(status.VpnStatus, vpnStatus)
+	if change {
+		log.Tracef("vpn state change:%v", vpnStatus)
+		status.VpnStatus = vpnStatus
+	}
+	return change
+}
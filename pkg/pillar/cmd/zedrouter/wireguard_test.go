@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	testWgPeerKey1 = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+	testWgPeerKey2 = "TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0="
+)
+
+func TestWireGuardConfigParse(t *testing.T) {
+	tests := []struct {
+		testname string
+		config   string
+		valid    bool
+	}{
+		{
+			testname: "valid",
+			config: `{"ListenPort": 51820, "Address": "10.8.0.2/24", "Peers": [` +
+				`{"Name": "hub", "PublicKey": "` + testWgPeerKey1 + `", ` +
+				`"Endpoint": "vpn.example.com:51820", "AllowedIPs": ["10.8.0.0/24", "192.168.10.0/24"], ` +
+				`"PersistentKeepalive": 25}]}`,
+			valid: true,
+		},
+		{
+			testname: "no peers",
+			config:   `{"Address": "10.8.0.2/24"}`,
+		},
+		{
+			testname: "invalid address",
+			config: `{"Address": "10.8.0.2", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "AllowedIPs": ["10.8.0.0/24"]}]}`,
+		},
+		{
+			testname: "short public key",
+			config: `{"Address": "10.8.0.2/24", "Peers": [` +
+				`{"PublicKey": "AAAA", "AllowedIPs": ["10.8.0.0/24"]}]}`,
+		},
+		{
+			testname: "duplicate public key",
+			config: `{"Address": "10.8.0.2/24", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "AllowedIPs": ["10.8.0.0/24"]},` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "AllowedIPs": ["10.9.0.0/24"]}]}`,
+		},
+		{
+			testname: "endpoint without port",
+			config: `{"Address": "10.8.0.2/24", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "Endpoint": "vpn.example.com", ` +
+				`"AllowedIPs": ["10.8.0.0/24"]}]}`,
+		},
+		{
+			testname: "invalid allowed ips",
+			config: `{"Address": "10.8.0.2/24", "Peers": [` +
+				`{"PublicKey": "` + testWgPeerKey1 + `", "AllowedIPs": ["10.8.0.300/24"]}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.testname, func(t *testing.T) {
+			config, err := wireGuardConfigParse(test.config)
+			if test.valid && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Fatal("invalid config accepted")
+			}
+			if test.valid && config.Mtu != wireGuardDefaultMtu {
+				t.Errorf("expected default Mtu, got %d", config.Mtu)
+			}
+		})
+	}
+}
+
+func TestWireGuardVpnStatus(t *testing.T) {
+	now := time.Unix(1650000000, 0)
+	dump := "cGFzc3dvcmQ=\tpubkey=\t51820\toff\n" +
+		testWgPeerKey1 + "\t(none)\t198.51.100.7:51820\t10.8.0.0/24,192.168.10.0/24\t1649999990\t1024\t2048\t25\n" +
+		testWgPeerKey2 + "\t(none)\t(none)\t10.9.0.0/24\t0\t0\t0\toff\n"
+	peers, err := wireGuardDumpParse(dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 2 {
+		t.Fatalf("expected 2 peers, got %d", len(peers))
+	}
+	if peers[1].Endpoint != "" {
+		t.Errorf("expected no endpoint, got %s", peers[1].Endpoint)
+	}
+
+	status := &types.NetworkInstanceStatus{}
+	status.Type = types.NetworkInstanceTypeCloud
+	_, subnet, _ := net.ParseCIDR("10.1.0.0/24")
+	status.Subnet = *subnet
+	config := types.WireGuardConfig{
+		Address: "10.8.0.2/24",
+		Peers:   []types.WireGuardPeerConfig{{Name: "hub", PublicKey: testWgPeerKey1}},
+	}
+	vpnStatus, vpnMetrics := wireGuardVpnStatus(status, config, peers, now)
+	if vpnStatus.ActiveTunCount != 1 || vpnStatus.ConnectingTunCount != 1 {
+		t.Errorf("unexpected tunnel counts %d/%d",
+			vpnStatus.ActiveTunCount, vpnStatus.ConnectingTunCount)
+	}
+	hub := vpnStatus.ActiveVpnConns[0]
+	if hub.Name != "hub" || hub.State != types.VPN_ESTABLISHED ||
+		hub.RInfo.IpAddr != "198.51.100.7" || hub.RInfo.Port != 51820 {
+		t.Errorf("unexpected connection status %+v", hub)
+	}
+	if vpnStatus.ActiveVpnConns[1].State != types.VPN_CONNECTING {
+		t.Errorf("peer without handshake is %d", vpnStatus.ActiveVpnConns[1].State)
+	}
+	metrics := vpnMetrics.VpnConns[0]
+	if metrics.HandshakeAge != 10 || metrics.Type != types.NST_WIREGUARD ||
+		metrics.LEndPoint.PktStats.Bytes != 1024 ||
+		metrics.REndPoint.PktStats.Bytes != 2048 ||
+		metrics.LEndPoint.IpAddr != "10.8.0.2" {
+		t.Errorf("unexpected connection metrics %+v", metrics)
+	}
+
+	if _, err := wireGuardDumpParse("iface\nbad line\n"); err == nil {
+		t.Error("invalid dump accepted")
+	}
+}
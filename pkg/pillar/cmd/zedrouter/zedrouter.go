@@ -5,11 +5,13 @@
 // from zedmanager and zedagent. Publish the status as AppNetworkStatus.
 // Produce the updated configlets (for radvd, dnsmasq, ip*tables,
 // ipset, ip link/addr/route configuration) based on that and apply those
-// configlets.
+// configlets. Network instances and application VIFs are programmed using
+// the NI reconciler (see pkg/pillar/nireconciler).
 
 package zedrouter
 
 import (
+	"context"
 	"crypto/sha256"
 	"errors"
 	"flag"
@@ -26,6 +28,8 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
 	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nireconciler"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -92,6 +96,9 @@ type zedrouterContext struct {
 	aclog                     *logrus.Logger // App Container logger
 	disableDHCPAllOnesNetMask bool
 	flowPublishMap            map[string]time.Time
+	flowCollector             *flowCollector
+	subPacketCaptureConfig    pubsub.Subscription
+	packetCaptures            *packetCaptureManager
 	metricInterval            uint32 // In seconds
 
 	zedcloudMetrics *zedcloud.AgentMetrics
@@ -101,6 +108,15 @@ type zedrouterContext struct {
 	pubCipherBlockStatus pubsub.Publication
 	decryptCipherContext cipher.DecryptCipherContext
 	pubAppInstMetaData   pubsub.Publication
+
+	// NI reconciler applies the config of network instances and app VIFs
+	niReconciler      nireconciler.NIReconciler
+	niReconcileStatus nireconciler.ReconcileStatus
+	// Packet filtering framework used to implement ACLs
+	aclBackend string
+	// Why the configured ACL backend is not used, reported in NI status
+	aclBackendError     string
+	aclBackendErrorTime time.Time
 }
 
 var debug = false
@@ -171,9 +187,21 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		aclog:              agentlog.CustomLogInit(logrus.InfoLevel),
 		NLaclMap:           make(map[uuid.UUID]map[string]types.ULNetworkACLs),
 		flowPublishMap:     make(map[string]time.Time),
+		flowCollector:      newFlowCollector(),
+		packetCaptures:     newPacketCaptureManager(),
 		zedcloudMetrics:    zedcloud.NewAgentMetrics(),
 		cipherMetrics:      cipher.NewAgentMetrics(agentName),
+		aclBackend:         types.ACLBackendIptables,
+	}
+	zedrouterCtx.niReconciler = &nireconciler.LinuxNIReconciler{
+		Log:                 log,
+		ExportCurrentState:  true,
+		ExportIntendedState: true,
+		NetworkMonitor:      &netmonitor.LinuxNetworkMonitor{Log: log},
 	}
+	// Apply config independent of network instances (e.g. default ipsets)
+	zedrouterCtx.niReconcileStatus = zedrouterCtx.niReconciler.ResumeReconcile(
+		context.Background())
 
 	subDeviceNetworkStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "nim",
@@ -289,6 +317,15 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	zedrouterCtx.pubAppFlowMonitor = pubAppFlowMonitor
 
+	pubPacketCaptureStatus, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.PacketCaptureStatus{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedrouterCtx.packetCaptures.init(pubPacketCaptureStatus)
+
 	pubAppVifIPTrig, err := ps.NewPublication(pubsub.PublicationOptions{
 		AgentName: agentName,
 		TopicType: types.VifIPTrig{},
@@ -510,6 +547,25 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedrouterCtx.subAppInstanceConfig = subAppInstanceConfig
 	subAppInstanceConfig.Activate()
 
+	// Subscribe to packet captures requested by the local profile server
+	subPacketCaptureConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.PacketCaptureConfig{},
+		Activate:      false,
+		Ctx:           &zedrouterCtx,
+		CreateHandler: handlePacketCaptureCreate,
+		ModifyHandler: handlePacketCaptureModify,
+		DeleteHandler: handlePacketCaptureDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedrouterCtx.subPacketCaptureConfig = subPacketCaptureConfig
+	subPacketCaptureConfig.Activate()
+
 	cloudProbeMetricPub, err := ps.NewPublication(
 		pubsub.PublicationOptions{
 			AgentName: agentName,
@@ -519,8 +575,6 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		log.Fatal(err)
 	}
 
-	PbrInit(&zedrouterCtx)
-	routeChanges := devicenetwork.RouteChangeInit(log)
 	linkChanges := devicenetwork.LinkChangeInit(log)
 
 	// Publish 20X more often than zedagent publishes to controller
@@ -531,11 +585,14 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	publishTimer := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
 
-	flowStatIntv := time.Duration(120 * time.Second) // 120 sec, flow timeout if less than150 sec
+	// Flows are collected from conntrack events as they end, the timer only
+	// publishes partially filled flow records and snooped DNS requests.
+	flowStatIntv := time.Duration(120 * time.Second)
 	fmax := float64(flowStatIntv)
 	fmin := fmax * 0.9
 	flowStatTimer := flextimer.NewRangeTicker(time.Duration(fmin),
 		time.Duration(fmax))
+	zedrouterCtx.flowCollector.start(&zedrouterCtx)
 
 	setProbeTimer(&zedrouterCtx, nhProbeInterval)
 	zedrouterCtx.checkNIUplinks = make(chan bool, 1) // allow one signal without blocking
@@ -569,6 +626,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			log.Functionf("AppNetworkConfig - waiting to Restart - "+
 				"InstanceConfig change at %+v", time.Now())
 			subNetworkInstanceConfig.ProcessChange(change)
+
+		case <-zedrouterCtx.niReconcileStatus.ResumeReconcile:
+			rs := zedrouterCtx.niReconciler.ResumeReconcile(context.Background())
+			processNIReconcileStatus(&zedrouterCtx, rs)
 		}
 		// Are we likely to have seen all of the initial config?
 		if zedrouterCtx.triggerNumGC &&
@@ -613,6 +674,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subAppInstanceConfig.MsgChan():
 			subAppInstanceConfig.ProcessChange(change)
 
+		case change := <-subPacketCaptureConfig.MsgChan():
+			subPacketCaptureConfig.ProcessChange(change)
+
 		case change := <-subDeviceNetworkStatus.MsgChan():
 			subDeviceNetworkStatus.ProcessChange(change)
 
@@ -637,16 +701,11 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			ps.CheckMaxTimeTopic(agentName, "linkChanges", start,
 				warningTime, errorTime)
 
-		case change, ok := <-routeChanges:
+		case <-zedrouterCtx.niReconcileStatus.ResumeReconcile:
 			start := time.Now()
-			if !ok {
-				log.Errorf("routeChanges closed\n")
-				routeChanges = devicenetwork.RouteChangeInit(log)
-				break
-			}
-			PbrRouteChange(&zedrouterCtx,
-				zedrouterCtx.deviceNetworkStatus, change)
-			ps.CheckMaxTimeTopic(agentName, "routeChanges", start,
+			rs := zedrouterCtx.niReconciler.ResumeReconcile(context.Background())
+			processNIReconcileStatus(&zedrouterCtx, rs)
+			ps.CheckMaxTimeTopic(agentName, "niReconciler", start,
 				warningTime, errorTime)
 
 		case <-publishTimer.C:
@@ -684,11 +743,8 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case <-flowStatTimer.C:
 			start := time.Now()
 			log.Tracef("FlowStatTimer at %v", time.Now())
-			// XXX why start a new go routine for each change?
-			log.Functionf("Creating %s at %s", "FlowStatsCollect",
-				agentlog.GetMyStack())
-			go FlowStatsCollect(&zedrouterCtx)
-			ps.CheckMaxTimeTopic(agentName, "FlowStatsCollect", start,
+			go zedrouterCtx.flowCollector.flush()
+			ps.CheckMaxTimeTopic(agentName, "FlowStatsFlush", start,
 				warningTime, errorTime)
 
 		case <-zedrouterCtx.hostProbeTimer.C:
@@ -785,9 +841,6 @@ func handleInit() {
 
 	// Setup initial iptables rules
 	dropEscapedFlows()
-
-	// ipsets which are independent of config
-	createDefaultIpset()
 }
 
 func publishAppNetworkStatus(ctx *zedrouterContext,
@@ -1142,7 +1195,7 @@ func appNetworkDoActivateUnderlayNetwork(
 	}
 
 	appID := status.UUIDandVersion.UUID
-	appIPAddr, err := getUlAddrs(ctx, netInstStatus, ulStatus, appID)
+	appIPAddr, appIPv6Addr, err := getUlAddrs(ctx, netInstStatus, ulStatus, appID)
 	if err != nil {
 		err := fmt.Errorf("App IP address allocation failed: %v", err)
 		log.Error(err.Error())
@@ -1150,25 +1203,31 @@ func appNetworkDoActivateUnderlayNetwork(
 		return err
 	}
 	bridgeIPAddr := netInstStatus.BridgeIPAddr
-	log.Functionf("bridgeIPAddr %s appIPAddr %s\n", bridgeIPAddr, appIPAddr)
+	log.Functionf("bridgeIPAddr %s appIPAddr %s appIPv6Addr %s\n",
+		bridgeIPAddr, appIPAddr, appIPv6Addr)
 	ulStatus.BridgeIPAddr = bridgeIPAddr
 	// appIPAddr is "" for switch NI. DHCP snoop will set AllocatedIPv4Addr later
 	ulStatus.AllocatedIPv4Addr = appIPAddr
+	if appIPv6Addr != "" {
+		ulStatus.AllocatedIPv6List = []string{appIPv6Addr}
+	}
 	hostsDirpath := runDirname + "/hosts." + bridgeName
 	if appIPAddr != "" {
-		addToHostsConfiglet(hostsDirpath, config.DisplayName,
-			[]string{appIPAddr})
+		appAddrs := []string{appIPAddr}
+		if appIPv6Addr != "" {
+			appAddrs = append(appAddrs, appIPv6Addr)
+		}
+		addToHostsConfiglet(hostsDirpath, config.DisplayName, appAddrs)
 	}
 
-	// Default ipset
-	deleteDefaultIpsetConfiglet(vifName, false)
-	createDefaultIpsetConfiglet(vifName, netInstStatus.DnsNameToIPList,
-		appIPAddr)
-
 	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: bridgeName,
 		VifName: vifName, BridgeIP: bridgeIPAddr, AppIP: appIPAddr,
 		UpLinks: netInstStatus.IfNameList, NIType: netInstStatus.Type,
 		AppNum: int32(status.AppNum)}
+	if appIPv6Addr != "" {
+		aclArgs.BridgeIPv6 = netInstStatus.BridgeIPv6Addr
+		aclArgs.AppIPv6 = appIPv6Addr
+	}
 
 	// Set up ACLs
 	ruleList, dependList, err := createACLConfiglet(ctx, aclArgs, ulConfig.ACLs)
@@ -1179,52 +1238,34 @@ func appNetworkDoActivateUnderlayNetwork(
 	}
 	setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
 
-	if appIPAddr != "" {
-		// XXX clobber any IPv6 EID entry since same name
-		// but that's probably OK since we're doing IPv4 EIDs
-		addhostDnsmasq(bridgeName, appMac, appIPAddr,
-			config.UUIDandVersion.UUID.String())
+	// Apply ACLs together with the default ipset and the DHCP host entry
+	err = updateAppVIFReconciler(ctx, appID, ulStatus, netInstStatus, ruleList,
+		getVIFShaping(aclArgs, ulConfig))
+	if err != nil {
+		addError(ctx, status, "createACL", err)
+		return err
 	}
+	clearUDPFlows(aclArgs, ulConfig.ACLs)
 
-	// Look for added or deleted ipsets
-	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
-		netInstStatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netInstStatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netInstStatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netInstStatus, hostsDirpath,
-			newIpsets, netInstStatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
 	netInstStatus.AddVif(log, vifName, appMac,
 		config.UUIDandVersion.UUID)
-	netInstStatus.BridgeIPSets = newIpsets
-	log.Functionf("set BridgeIPSets to %v for %s", newIpsets,
-		netInstStatus.Key())
+	updateBridgeIPSets(ctx, netInstStatus, ipsets)
 
 	// Check App Container Stats ACL need to be reinstalled
 	appStatsMayNeedReinstallACL(ctx, config)
 
 	publishNetworkInstanceStatus(ctx, netInstStatus)
-
-	maybeRemoveStaleIpsets(staleIpsets)
 	return nil
 }
 
 // generateAppMac picks a fixed address for Local and Cloud and uses a fixed
-// hash for Switch which still produces a stable MAC address
+// hash for Switch and Mesh which still produces a stable MAC address
 // for a given app instance
 func generateAppMac(appUUID uuid.UUID, ulNum int, appNum int, netInstStatus *types.NetworkInstanceStatus) string {
 	var appMac string
 
 	switch netInstStatus.Type {
-	case types.NetworkInstanceTypeSwitch:
+	case types.NetworkInstanceTypeSwitch, types.NetworkInstanceTypeMesh:
 		h := sha256.New()
 		h.Write(appUUID[:])
 		h.Write(netInstStatus.UUIDandVersion.UUID[:])
@@ -1363,11 +1404,12 @@ func findBridge(bridgeName string) (*netlink.Bridge, error) {
 	return bridgeLink, nil
 }
 
-// XXX Need additional logic for IPv6 underlays.
+// getUlAddrs returns the IPv4 address of the application and for dual-stack
+// network instance also the IPv6 address.
 func getUlAddrs(ctx *zedrouterContext,
 	netInstStatus *types.NetworkInstanceStatus,
-	ulStatus *types.UnderlayNetworkStatus, appID uuid.UUID) (string, error) {
-	var err error
+	ulStatus *types.UnderlayNetworkStatus,
+	appID uuid.UUID) (ipAddr string, ipv6Addr string, err error) {
 	var mac net.HardwareAddr
 	networkID := netInstStatus.UUID
 
@@ -1375,12 +1417,12 @@ func getUlAddrs(ctx *zedrouterContext,
 		netInstStatus.DhcpRange.Start == nil {
 		log.Functionf("getUlAddrs(%s): app(%s), no subnet\n",
 			networkID.String(), appID.String())
-		return "", nil
+		return "", "", nil
 	}
 	if ulStatus.Mac == "" {
 		log.Functionf("getUlAddrs(%s): app(%s) fail: no mac",
 			networkID.String(), appID.String())
-		return "", nil
+		return "", "", nil
 	}
 	log.Functionf("getUlAddrs(%s): app(%s)",
 		networkID.String(), appID.String())
@@ -1391,10 +1433,9 @@ func getUlAddrs(ctx *zedrouterContext,
 		errStr := fmt.Sprintf("parse Mac fail: %v\n", err)
 		log.Errorf("getUlAddrs(%s): app(%s) fail: %s\n",
 			networkID.String(), appID.String(), err)
-		return "", errors.New(errStr)
+		return "", "", errors.New(errStr)
 	}
 
-	ipAddr := ""
 	// for static IP Address
 	if ulStatus.AppIPAddr != nil {
 		ipAddr = ulStatus.AppIPAddr.String()
@@ -1405,7 +1446,7 @@ func getUlAddrs(ctx *zedrouterContext,
 				netInstStatus.DhcpRange.End.String())
 			log.Errorf("getUlAddrs(%s): app(%s) fail: %s",
 				networkID.String(), appID.String(), errStr)
-			return "", errors.New(errStr)
+			return "", "", errors.New(errStr)
 		}
 		// IP Address must be inside the subnet range
 		if !netInstStatus.Subnet.Contains(ulStatus.AppIPAddr) {
@@ -1413,7 +1454,7 @@ func getUlAddrs(ctx *zedrouterContext,
 				ipAddr)
 			log.Errorf("getUlAddrs(%s): app(%s) fail: %s",
 				networkID.String(), appID.String(), errStr)
-			return "", errors.New(errStr)
+			return "", "", errors.New(errStr)
 		}
 	} else {
 		// get the app number for the underlay network entry
@@ -1422,22 +1463,41 @@ func getUlAddrs(ctx *zedrouterContext,
 			errStr := fmt.Sprintf("App Number get failed: %v", err)
 			log.Errorf("getUlAddrs(%s): app(%s) fail: %s",
 				networkID.String(), appID.String(), errStr)
-			return "", errors.New(errStr)
+			return "", "", errors.New(errStr)
 		}
 		ipAddr, err = lookupOrAllocateIPv4(netInstStatus, appID, appNum, mac)
 		if err != nil {
 			errStr := fmt.Sprintf("IP Addr get fail: %v", err)
 			log.Errorf("getUlAddrs(%s): app(%s) fail: %s",
 				networkID.String(), appID.String(), errStr)
-			return "", errors.New(errStr)
+			return "", "", errors.New(errStr)
 		}
 	}
-	addr := net.ParseIP(ipAddr)
+	addrs := types.AssignedAddrs{IPv4Addr: net.ParseIP(ipAddr)}
+	if netInstStatus.IsDualStack() {
+		// IPv6 address is always allocated from the DHCP range,
+		// static addresses are IPv4 only.
+		appNum, err := appNumOnUNetGet(ctx, networkID, appID, ulStatus.IfIdx)
+		if err != nil {
+			errStr := fmt.Sprintf("App Number get failed: %v", err)
+			log.Errorf("getUlAddrs(%s): app(%s) fail: %s",
+				networkID.String(), appID.String(), errStr)
+			return "", "", errors.New(errStr)
+		}
+		ipv6Addr, err = lookupOrAllocateIPv6(netInstStatus, appID, appNum, mac)
+		if err != nil {
+			errStr := fmt.Sprintf("IPv6 Addr get fail: %v", err)
+			log.Errorf("getUlAddrs(%s): app(%s) fail: %s",
+				networkID.String(), appID.String(), errStr)
+			return "", "", errors.New(errStr)
+		}
+		addrs.IPv6Addrs = []net.IP{net.ParseIP(ipv6Addr)}
+	}
 	ulStatus.BridgeIPAddr = netInstStatus.BridgeIPAddr
-	recordIPAssignment(ctx, netInstStatus, addr, ulStatus.Mac)
-	log.Functionf("getUlAddrs(%s): App %s done, ipAddr: %s",
-		networkID.String(), appID.String(), ipAddr)
-	return ipAddr, nil
+	recordIPAssignment(ctx, netInstStatus, addrs, ulStatus.Mac)
+	log.Functionf("getUlAddrs(%s): App %s done, ipAddr: %s, ipv6Addr: %s",
+		networkID.String(), appID.String(), ipAddr, ipv6Addr)
+	return ipAddr, ipv6Addr, nil
 }
 
 // Caller should clear the appropriate status.Pending* if the the caller will
@@ -1649,12 +1709,16 @@ func doAppNetworkModifyUNetAcls(
 		VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIPAddr,
 		UpLinks: netstatus.IfNameList, NIType: netstatus.Type,
 		AppNum: int32(status.AppNum)}
+	if netstatus.IsDualStack() && len(ulStatus.AllocatedIPv6List) > 0 {
+		aclArgs.BridgeIPv6 = netstatus.BridgeIPv6Addr
+		aclArgs.AppIPv6 = ulStatus.AllocatedIPv6List[0]
+	}
 
 	// We ignore any errors in netstatus
 
 	appID := status.UUIDandVersion.UUID
 	rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-	ruleList, dependList, err := updateACLConfiglet(ctx, aclArgs,
+	ruleList, dependList, changed, err := updateACLConfiglet(ctx, aclArgs,
 		oldulConfig.ACLs, ulConfig.ACLs, rules.ACLRules,
 		ulStatus.ACLDependList, force)
 	if err != nil {
@@ -1662,28 +1726,21 @@ func doAppNetworkModifyUNetAcls(
 	}
 	ulStatus.ACLDependList = dependList
 	setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+	bandwidthChanged := ulConfig.Bandwidth != oldulConfig.Bandwidth
+	if err == nil && (changed || bandwidthChanged) {
+		err = updateAppVIFReconciler(ctx, appID, ulStatus, netstatus, ruleList,
+			getVIFShaping(aclArgs, ulConfig))
+		if err != nil {
+			addError(ctx, status, "updateACL", err)
+		}
+		if changed {
+			clearUDPFlows(aclArgs, ulConfig.ACLs)
+			clearAppFlows(aclArgs)
+		}
+	}
 
-	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
-		netstatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netstatus, hostsDirpath,
-			newIpsets, netstatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
-	netstatus.BridgeIPSets = newIpsets
-	log.Functionf("set BridgeIPSets to %v for %s", newIpsets, netstatus.Key())
+	updateBridgeIPSets(ctx, netstatus, ipsets)
 	publishNetworkInstanceStatus(ctx, netstatus)
-
-	maybeRemoveStaleIpsets(staleIpsets)
 }
 
 // Check if any references to network instances changed and update the appnums
@@ -1746,24 +1803,6 @@ func doAppNetworkModifyUNetAppNum(
 	return nil
 }
 
-func maybeRemoveStaleIpsets(staleIpsetHosts []string) {
-	// Remove stale ipsets previously created for ACLs with the "host" match.
-	// In case if there are any references to these ipsets from other
-	// domUs, then the kernel would not remove them.
-	// The ipset destroy command would just fail.
-	for _, host := range staleIpsetHosts {
-		ipsetBasename := hostIpsetBasename(host)
-		err := ipsetDestroy(fmt.Sprintf("ipv4.%s", ipsetBasename))
-		if err != nil {
-			log.Errorln("ipset destroy ipv4", ipsetBasename, err)
-		}
-		err = ipsetDestroy(fmt.Sprintf("ipv6.%s", ipsetBasename))
-		if err != nil {
-			log.Errorln("ipset destroy ipv6", ipsetBasename, err)
-		}
-	}
-}
-
 func handleDelete(ctx *zedrouterContext, key string,
 	status *types.AppNetworkStatus) {
 
@@ -1871,25 +1910,13 @@ func appNetworkDoInactivateUnderlayNetwork(
 		}
 	}
 
-	appIPAddr := ulStatus.AllocatedIPv4Addr
-	if appIPAddr != "" {
-		removehostDnsmasq(bridgeName, ulStatus.Mac,
-			appIPAddr)
-	}
-
 	appID := status.UUIDandVersion.UUID
-	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: bridgeName,
-		VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIPAddr,
-		UpLinks: netstatus.IfNameList}
 
 	// XXX Could ulStatus.Vif not be set? Means we didn't add
 	if ulStatus.Vif != "" {
-		rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-		ruleList, err := deleteACLConfiglet(aclArgs, rules.ACLRules)
-		if err != nil {
-			addError(ctx, status, "deleteACL", err)
-		}
-		setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+		// Removes ACLs, the default ipset and the DHCP host entry
+		delAppVIFReconciler(ctx, ulStatus.Vif)
+		setNetworkACLRules(ctx, appID, ulStatus.Name, nil)
 	} else {
 		log.Warnf("doInactivate(%s): no vifName for bridge %s for %s\n",
 			status.UUIDandVersion, bridgeName,
@@ -1901,21 +1928,8 @@ func appNetworkDoInactivateUnderlayNetwork(
 	removeFromHostsConfiglet(hostsDirpath,
 		status.DisplayName)
 	// Look for added or deleted ipsets
-	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
-		netstatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netstatus, hostsDirpath,
-			newIpsets, netstatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
+	updateBridgeIPSets(ctx, netstatus, ipsets)
+
 	if netstatus.Type == types.NetworkInstanceTypeSwitch {
 		if ulStatus.AccessVlanID <= 1 {
 			netstatus.NumTrunkPorts--
@@ -1929,10 +1943,6 @@ func appNetworkDoInactivateUnderlayNetwork(
 			}
 		}
 	}
-	netstatus.BridgeIPSets = newIpsets
-	log.Functionf("set BridgeIPSets to %v for %s", newIpsets, netstatus.Key())
-	maybeRemoveStaleIpsets(staleIpsets)
-
 	netstatus.RemoveVif(log, ulStatus.Vif)
 	publishNetworkInstanceStatus(ctx, netstatus)
 	if maybeNetworkInstanceDelete(ctx, netstatus) {
@@ -1972,6 +1982,10 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 		if gcp.GlobalValueInt(types.MetricInterval) != 0 {
 			ctx.metricInterval = gcp.GlobalValueInt(types.MetricInterval)
 		}
+		setACLBackend(ctx, gcp.GlobalValueString(types.NetworkACLBackend))
+		ctx.flowCollector.setConfig(gcp.GlobalValueInt(types.FlowlogSampling),
+			gcp.GlobalValueInt(types.FlowlogMaxRate))
+		ctx.packetCaptures.setQuota(gcp.GlobalValueInt(types.PacketCaptureQuota))
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s\n", key)
 }
@@ -1989,6 +2003,10 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 		debugOverride, logger)
 	gcp := *types.DefaultConfigItemValueMap()
 	ctx.appStatsInterval = gcp.GlobalValueInt(types.AppContainerStatsInterval)
+	setACLBackend(ctx, gcp.GlobalValueString(types.NetworkACLBackend))
+	ctx.flowCollector.setConfig(gcp.GlobalValueInt(types.FlowlogSampling),
+		gcp.GlobalValueInt(types.FlowlogMaxRate))
+	ctx.packetCaptures.setQuota(gcp.GlobalValueInt(types.PacketCaptureQuota))
 	log.Functionf("handleGlobalConfigDelete done for %s\n", key)
 }
 
@@ -2372,14 +2390,10 @@ func releaseAppNetworkResources(ctx *zedrouterContext, key string,
 	log.Functionf("relaseAppNetworkResources(%s)\n", key)
 	appID := status.UUIDandVersion.UUID
 	for _, ulStatus := range status.UnderlayNetworkList {
-		aclArgs := types.AppNetworkACLArgs{BridgeName: ulStatus.Bridge,
-			VifName: ulStatus.Vif}
-		rules := getNetworkACLRules(ctx, appID, ulStatus.Name)
-		ruleList, err := deleteACLConfiglet(aclArgs, rules.ACLRules)
-		if err != nil {
-			addError(ctx, status, "deleteACL", err)
+		if ulStatus.Vif != "" {
+			delAppVIFReconciler(ctx, ulStatus.Vif)
 		}
-		setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
+		setNetworkACLRules(ctx, appID, ulStatus.Name, nil)
 	}
 	publishAppNetworkStatus(ctx, status)
 }
@@ -16,6 +16,16 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/zfs"
 )
 
+// disk name resolution and zpool commands, replaced in unit tests
+var (
+	getDiskNameByPartName = disks.GetDiskNameByPartName
+	zpoolAddVDev          = zfs.AddVDev
+	zpoolAddVDevGroup     = zfs.AddVDevGroup
+	zpoolAttachVDev       = zfs.AttachVDev
+	zpoolDetachVDev       = zfs.DetachVDev
+	zpoolRemoveVDev       = zfs.RemoveVDev
+)
+
 func getVdevNameAndStatus(devTree libzfs.VDevTree, name string) (string, libzfs.VDevStat) {
 	if devTree.Type != libzfs.VDevTypeDisk {
 		for _, d := range devTree.Devices {
@@ -26,7 +36,7 @@ func getVdevNameAndStatus(devTree libzfs.VDevTree, name string) (string, libzfs.
 		}
 	} else {
 		// we can receive partition number
-		devName, err := disks.GetDiskNameByPartName(devTree.Name)
+		devName, err := getDiskNameByPartName(devTree.Name)
 		if err != nil {
 			log.Errorf("failed to get disk name by part name %s: %s", devTree.Name, err)
 		}
@@ -97,34 +107,92 @@ func processDisks(ctx *zfsContext) error {
 		return fmt.Errorf("cannot open pool: %s", err)
 	}
 	defer persistPool.Close()
-	disksStateProcessing(disksConfig, persistPool)
-	disksLayoutProcessing(disksConfig, persistPool)
+	errs := new(disksProcessingErrors)
+	disksStateProcessing(disksConfig, persistPool, errs)
+	disksLayoutProcessing(disksConfig, persistPool, errs)
+	ctx.setDisksProcessingErrors(errs.String())
 	return nil
 }
 
+//disksProcessingErrors collects errors of processing of disks config to report them in storage status
+type disksProcessingErrors []string
+
+func (errs *disksProcessingErrors) add(format string, args ...interface{}) {
+	errStr := fmt.Sprintf(format, args...)
+	log.Error(errStr)
+	*errs = append(*errs, errStr)
+}
+
+func (errs disksProcessingErrors) String() string {
+	return strings.Join(errs, "; ")
+}
+
+//getVdevParent returns vdev which contains disk with provided name as direct child
+func getVdevParent(devTree libzfs.VDevTree, name string) *libzfs.VDevTree {
+	for _, d := range devTree.Devices {
+		if d.Type == libzfs.VDevTypeDisk {
+			if devName, _ := getVdevNameAndStatus(d, name); devName != "" {
+				return &devTree
+			}
+			continue
+		}
+		if parent := getVdevParent(d, name); parent != nil {
+			return parent
+		}
+	}
+	return nil
+}
+
+//hasDataVdevs returns true if the pool has top-level vdevs storing data
+func hasDataVdevs(vdevTree libzfs.VDevTree) bool {
+	return len(vdevTree.Devices) > 0
+}
+
+//isDiskExpectedInPool returns true if disk config expects the disk to be part of zfs pool
+func isDiskExpectedInPool(diskCfg types.EdgeNodeDiskConfig) bool {
+	switch diskCfg.Config {
+	case types.EdgeNodeDiskConfigTypeUnused,
+		types.EdgeNodeDiskConfigTypeAppDirect,
+		types.EdgeNodeDiskConfigTypePersist:
+		return false
+	}
+	return diskCfg.Disk.Name != ""
+}
+
+//isDiskReplacing returns true if disk config expects the disk to replace another one which is still in pool
+//we should not attach or add such disk, replacement will be done in disksStateProcessing
+func isDiskReplacing(vdevTree libzfs.VDevTree, diskCfg types.EdgeNodeDiskConfig) bool {
+	if diskCfg.OldDisk == nil || diskCfg.OldDisk.Name == "" {
+		return false
+	}
+	oldDevName, _ := getVdevNameAndStatus(vdevTree, filepath.Base(diskCfg.OldDisk.Name))
+	return oldDevName != ""
+}
+
 //disksStateProcessing iterate over disks and adjust its state accordingly to the config
 //we expect that zfs will handle conflicts between order of calls
-func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
+func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool, errs *disksProcessingErrors) {
 	vdevTree, err := pool.VDevTree()
 	if err != nil {
-		log.Errorf("cannot get vdev tree: %s", err)
+		errs.add("cannot get vdev tree: %s", err)
 		return
 	}
 	for _, diskCfg := range disks.Disks {
 		diskName := filepath.Base(diskCfg.Disk.Name)
-		if diskCfg.OldDisk != nil {
+		devName, devStat := getVdevNameAndStatus(vdevTree, diskName)
+		if diskCfg.OldDisk != nil && devName == "" {
 			oldDiskName := filepath.Base(diskCfg.OldDisk.Name)
 			oldDevName, oldDevStat := getVdevNameAndStatus(vdevTree, oldDiskName)
 			if oldDevName != "" && diskCfg.Disk.Name != "" {
-				// if we found device oldDevName, replace it
+				// if we found device oldDevName and new one is not in pool yet, replace it
+				// zfs will detach the old one after resilver of the new one
 				log.Functionf("replacing %s with %s, old stat %s", oldDevName, diskCfg.Disk.Name, oldDevStat.State.String())
 				if stdout, err := zfs.ReplaceVDev(log, vault.DefaultZpool, oldDevName, diskCfg.Disk.Name); err != nil {
-					log.Errorf("cannot replace %s with %s: %s %s", oldDevName, diskCfg.Disk.Name, stdout, err)
-					continue
+					errs.add("cannot replace %s with %s: %s %s", oldDevName, diskCfg.Disk.Name, stdout, err)
 				}
+				continue
 			}
 		}
-		devName, devStat := getVdevNameAndStatus(vdevTree, diskName)
 		// found in pool
 		if devName != "" {
 			log.Functionf("zpool config disk %s, op %d, stat %s", devName, diskCfg.Config, devStat.State.String())
@@ -133,7 +201,7 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 				switch zfs.GetZfsDeviceStatusFromStr(devStat.State.String()) {
 				case types.StorageStatusOffline:
 					if err := pool.Online(true, devName); err != nil {
-						log.Errorf("cannot bring %s online: %s", devName, err)
+						errs.add("cannot bring %s online: %s", devName, err)
 					}
 				case types.StorageStatusOnline:
 					continue
@@ -145,7 +213,7 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 				switch zfs.GetZfsDeviceStatusFromStr(devStat.State.String()) {
 				case types.StorageStatusOnline:
 					if err := pool.Offline(true, devName); err != nil {
-						log.Errorf("cannot bring %s offline: %s", devName, err)
+						errs.add("cannot bring %s offline: %s", devName, err)
 					}
 				case types.StorageStatusOffline:
 					continue
@@ -154,74 +222,190 @@ func disksStateProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
 					continue
 				}
 			case types.EdgeNodeDiskConfigTypeUnused:
-				if stdout, err := zfs.RemoveVDev(log, vault.DefaultZpool, devName); err != nil {
-					log.Errorf("cannot remove %s: %s %s", devName, stdout, err)
-				}
+				disksRemoveProcess(vdevTree, devName, errs)
 			}
 		}
 	}
 	for _, el := range disks.Children {
 		// process children states
 		// we process only states of devices as part of vdevs, so we assume that we can handle them in any order
-		disksStateProcessing(el, pool)
+		disksStateProcessing(el, pool, errs)
+	}
+}
+
+//disksRemoveProcess removes disk from the pool depending on the vdev it is part of
+func disksRemoveProcess(vdevTree libzfs.VDevTree, devName string, errs *disksProcessingErrors) {
+	parent := getVdevParent(vdevTree, devName)
+	if parent == nil {
+		return
+	}
+	switch parent.Type {
+	case libzfs.VDevTypeMirror, libzfs.VDevTypeReplacing, libzfs.VDevTypeSpare:
+		// do not detach the last healthy disk of the mirror
+		healthy := 0
+		for _, d := range parent.Devices {
+			if zfs.GetZfsDeviceStatusFromStr(d.Stat.State.String()) == types.StorageStatusOnline {
+				if currentName, _ := getVdevNameAndStatus(d, devName); currentName == "" {
+					healthy++
+				}
+			}
+		}
+		if healthy == 0 {
+			errs.add("cannot detach %s from %s: no other online disks", devName, parent.Name)
+			return
+		}
+		if stdout, err := zpoolDetachVDev(log, vault.DefaultZpool, devName); err != nil {
+			errs.add("cannot detach %s: %s %s", devName, stdout, err)
+		}
+	case libzfs.VDevTypeRaidz:
+		errs.add("cannot remove %s from %s: use replacement instead", devName, parent.Name)
+	default:
+		if stdout, err := zpoolRemoveVDev(log, vault.DefaultZpool, devName); err != nil {
+			errs.add("cannot remove %s: %s %s", devName, stdout, err)
+		}
 	}
 }
 
 //disksLayoutProcessing iterate over disks and adjust pool layout accordingly to the config
-func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool) {
+func disksLayoutProcessing(disks types.EdgeNodeDisks, pool libzfs.Pool, errs *disksProcessingErrors) {
 	vdevTree, err := pool.VDevTree()
 	if err != nil {
-		log.Errorf("cannot get vdev tree: %s", err)
+		errs.add("cannot get vdev tree: %s", err)
 		return
 	}
 	switch disks.ArrayType {
 	case types.EdgeNodeDiskArrayTypeRAID0:
-		disksLayoutRaid0Process(vdevTree, disks.Children)
+		disksLayoutRaid0Process(vdevTree, disks.Children, errs)
+	case types.EdgeNodeDiskArrayTypeRAID1:
+		// pool with one top-level mirror
+		disksLayoutMirrorProcess(vdevTree, disks, true, errs)
+	case types.EdgeNodeDiskArrayTypeRAID5, types.EdgeNodeDiskArrayTypeRAID6:
+		// pool with one top-level raidz
+		disksLayoutRaidzProcess(vdevTree, disks, true, errs)
+	case types.EdgeNodeDiskArrayTypeUnspecified:
+		// nothing to do with layout
 	default:
-		// TBD: other array types
 		log.Warnf("Not implemented layout processing for array type: %d", disks.ArrayType)
 	}
 }
 
 //disksLayoutRaid0Process ensure layout for batch of top-level vdevs in pool
-//we support raid0 of unspecified (single disk) and raid1 (mirror of disks) layout here
-func disksLayoutRaid0Process(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDisks) {
+//we support raid0 of unspecified (single disk), raid1 (mirror of disks) and raid5/raid6 (raidz) layout here
+func disksLayoutRaid0Process(vdevTree libzfs.VDevTree, disks []types.EdgeNodeDisks, errs *disksProcessingErrors) {
 	for _, el := range disks {
 		switch el.ArrayType {
 		case types.EdgeNodeDiskArrayTypeUnspecified, types.EdgeNodeDiskArrayTypeRAID1:
-			diskName := ""
-			// check if we have one of defined devices as part of vdev
-			for _, dsk := range el.Disks {
-				if currentDiskName, _ := getVdevNameAndStatus(vdevTree, dsk.Disk.Name); currentDiskName != "" {
-					diskName = dsk.Disk.Name
-					break
-				}
+			disksLayoutMirrorProcess(vdevTree, el, false, errs)
+		case types.EdgeNodeDiskArrayTypeRAID5, types.EdgeNodeDiskArrayTypeRAID6:
+			disksLayoutRaidzProcess(vdevTree, el, false, errs)
+		default:
+			log.Warnf("No supported child processing for array type: %d", el.ArrayType)
+		}
+	}
+}
+
+//disksLayoutMirrorProcess ensure that all expected disks are part of the same vdev
+//single disk vdev converts into the mirror with attach of the second disk
+//onlyVdev is set if the vdev must be the only top-level vdev of the pool
+func disksLayoutMirrorProcess(vdevTree libzfs.VDevTree, el types.EdgeNodeDisks, onlyVdev bool, errs *disksProcessingErrors) {
+	var expectedDisks []string
+	for _, dsk := range el.Disks {
+		if isDiskExpectedInPool(dsk) && !isDiskReplacing(vdevTree, dsk) {
+			expectedDisks = append(expectedDisks, dsk.Disk.Name)
+		}
+	}
+	diskName := ""
+	// check if we have one of defined devices as part of vdev
+	for _, dsk := range expectedDisks {
+		if currentDiskName, _ := getVdevNameAndStatus(vdevTree, filepath.Base(dsk)); currentDiskName != "" {
+			diskName = dsk
+			break
+		}
+	}
+	// if no disk found add first as a new vdev
+	if diskName == "" {
+		if len(expectedDisks) > 0 && onlyVdev && hasDataVdevs(vdevTree) {
+			errs.add("cannot add mirror of %s: pool has other vdevs and data would not be mirrored, migration is required",
+				strings.Join(expectedDisks, ","))
+			return
+		}
+		if len(expectedDisks) > 0 {
+			// we add first device here as a new vdev to attach needed disks to it later
+			if stdout, err := zpoolAddVDev(log, vault.DefaultZpool, expectedDisks[0]); err != nil {
+				errs.add("cannot add %s: %s %s", expectedDisks[0], stdout, err)
+			} else {
+				diskName = expectedDisks[0]
 			}
-			// if no disk found add first as a new vdev
-			if diskName == "" {
-				if len(el.Disks) > 0 {
-					// we add first device here as a new vdev to attach needed disks to it later
-					if stdout, err := zfs.AddVDev(log, vault.DefaultZpool, el.Disks[0].Disk.Name); err != nil {
-						log.Errorf("cannot add %s: %s %s", diskName, stdout, err)
-					} else {
-						diskName = el.Disks[0].Disk.Name
-					}
-				}
+		}
+	}
+	// if disk is here attach another disks
+	if diskName != "" {
+		parent := getVdevParent(vdevTree, filepath.Base(diskName))
+		if parent != nil && parent.Type == libzfs.VDevTypeRaidz {
+			errs.add("cannot attach disks to %s: %s is part of %s", diskName, diskName, parent.Name)
+			return
+		}
+		for _, dsk := range expectedDisks {
+			// check if already in pool or added as part of current iteration (we do not refresh the tree)
+			if currentDiskName, _ := getVdevNameAndStatus(vdevTree, filepath.Base(dsk)); currentDiskName != "" || diskName == dsk {
+				continue
 			}
-			// if disk is here attach another disks
-			if diskName != "" {
-				for _, dsk := range el.Disks {
-					// check if already in pool or added as part of current iteration (we do not refresh the tree)
-					if currentDiskName, _ := getVdevNameAndStatus(vdevTree, dsk.Disk.Name); currentDiskName != "" || diskName == dsk.Disk.Name {
-						continue
-					}
-					if stdout, err := zfs.AttachVDev(log, vault.DefaultZpool, diskName, dsk.Disk.Name); err != nil {
-						log.Errorf("cannot attach %s to %s: %s %s", dsk.Disk.Name, diskName, stdout, err)
-					}
-				}
+			if stdout, err := zpoolAttachVDev(log, vault.DefaultZpool, diskName, dsk); err != nil {
+				errs.add("cannot attach %s to %s: %s %s", dsk, diskName, stdout, err)
 			}
-		default:
-			log.Warnf("No supported child processing for array type: %d", el.ArrayType)
 		}
 	}
 }
+
+//disksLayoutRaidzProcess ensure that raidz vdev exists with all expected disks
+//raidz cannot be extended with new disks, so we can only create it or replace disks inside
+//onlyVdev is set if the vdev must be the only top-level vdev of the pool
+func disksLayoutRaidzProcess(vdevTree libzfs.VDevTree, el types.EdgeNodeDisks, onlyVdev bool, errs *disksProcessingErrors) {
+	vdevType := "raidz1"
+	parity := uint(1)
+	if el.ArrayType == types.EdgeNodeDiskArrayTypeRAID6 {
+		vdevType = "raidz2"
+		parity = 2
+	}
+	var expectedDisks, missingDisks []string
+	var parent *libzfs.VDevTree
+	for _, dsk := range el.Disks {
+		if !isDiskExpectedInPool(dsk) || isDiskReplacing(vdevTree, dsk) {
+			continue
+		}
+		expectedDisks = append(expectedDisks, dsk.Disk.Name)
+		if currentDiskName, _ := getVdevNameAndStatus(vdevTree, filepath.Base(dsk.Disk.Name)); currentDiskName == "" {
+			missingDisks = append(missingDisks, dsk.Disk.Name)
+		} else if parent == nil {
+			parent = getVdevParent(vdevTree, filepath.Base(dsk.Disk.Name))
+		}
+	}
+	if len(missingDisks) == 0 {
+		return
+	}
+	if len(missingDisks) != len(expectedDisks) {
+		// some disks are in pool already
+		if parent == nil || parent.Type != libzfs.VDevTypeRaidz || parent.Parity != parity {
+			errs.add("cannot create %s from %s: some disks are in pool with another layout",
+				vdevType, strings.Join(expectedDisks, ","))
+			return
+		}
+		errs.add("cannot add %s to %s: raidz cannot be extended",
+			strings.Join(missingDisks, ","), parent.Name)
+		return
+	}
+	if onlyVdev && hasDataVdevs(vdevTree) {
+		// zfs would stripe data across the new raidz and the existing vdevs
+		errs.add("cannot add %s of %s: pool has other vdevs and data would not be redundant, migration is required",
+			vdevType, strings.Join(expectedDisks, ","))
+		return
+	}
+	if uint(len(expectedDisks)) <= parity {
+		errs.add("cannot create %s from %s: not enough disks",
+			vdevType, strings.Join(expectedDisks, ","))
+		return
+	}
+	if stdout, err := zpoolAddVDevGroup(log, vault.DefaultZpool, vdevType, expectedDisks...); err != nil {
+		errs.add("cannot add %s of %s: %s %s", vdevType, strings.Join(expectedDisks, ","), stdout, err)
+	}
+}
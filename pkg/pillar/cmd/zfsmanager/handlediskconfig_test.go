@@ -0,0 +1,288 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// zpoolCalls records the zpool commands instead of running them
+type zpoolCalls []string
+
+func initDiskConfigTest(t *testing.T) *zpoolCalls {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), agentName, 0)
+	calls := new(zpoolCalls)
+	record := func(args ...string) (string, error) {
+		*calls = append(*calls, strings.Join(args, " "))
+		return "", nil
+	}
+	origGetDiskName, origAdd, origAddGroup := getDiskNameByPartName, zpoolAddVDev, zpoolAddVDevGroup
+	origAttach, origDetach, origRemove := zpoolAttachVDev, zpoolDetachVDev, zpoolRemoveVDev
+	getDiskNameByPartName = func(name string) (string, error) {
+		return name, nil
+	}
+	zpoolAddVDev = func(_ *base.LogObject, pool, vdev string) (string, error) {
+		return record("add", pool, vdev)
+	}
+	zpoolAddVDevGroup = func(_ *base.LogObject, pool, vdevType string, vdevs ...string) (string, error) {
+		return record(append([]string{"add", pool, vdevType}, vdevs...)...)
+	}
+	zpoolAttachVDev = func(_ *base.LogObject, pool, vdev, newVdev string) (string, error) {
+		return record("attach", pool, vdev, newVdev)
+	}
+	zpoolDetachVDev = func(_ *base.LogObject, pool, vdev string) (string, error) {
+		return record("detach", pool, vdev)
+	}
+	zpoolRemoveVDev = func(_ *base.LogObject, pool, vdev string) (string, error) {
+		return record("remove", pool, vdev)
+	}
+	t.Cleanup(func() {
+		getDiskNameByPartName, zpoolAddVDev, zpoolAddVDevGroup = origGetDiskName, origAdd, origAddGroup
+		zpoolAttachVDev, zpoolDetachVDev, zpoolRemoveVDev = origAttach, origDetach, origRemove
+	})
+	return calls
+}
+
+func disk(name string, state libzfs.VDevState) libzfs.VDevTree {
+	return libzfs.VDevTree{
+		Type: libzfs.VDevTypeDisk,
+		Name: name,
+		Stat: libzfs.VDevStat{State: state},
+	}
+}
+
+func group(vdevType libzfs.VDevType, name string, parity uint,
+	devices ...libzfs.VDevTree) libzfs.VDevTree {
+	return libzfs.VDevTree{
+		Type:    vdevType,
+		Name:    name,
+		Parity:  parity,
+		Devices: devices,
+	}
+}
+
+func pool(devices ...libzfs.VDevTree) libzfs.VDevTree {
+	return group(libzfs.VDevTypeRoot, "persist", 0, devices...)
+}
+
+func diskConfigs(names ...string) []types.EdgeNodeDiskConfig {
+	var configs []types.EdgeNodeDiskConfig
+	for _, name := range names {
+		configs = append(configs, types.EdgeNodeDiskConfig{
+			Disk:   types.EdgeNodeDiskDescription{Name: name},
+			Config: types.EdgeNodeDiskConfigTypeZfsOnline,
+		})
+	}
+	return configs
+}
+
+func TestGetVdevParent(t *testing.T) {
+	initDiskConfigTest(t)
+	mirror := group(libzfs.VDevTypeMirror, "mirror-1", 0,
+		disk("/dev/sdb", libzfs.VDevStateHealthy),
+		disk("/dev/sdc", libzfs.VDevStateHealthy))
+	tree := pool(disk("/dev/sda", libzfs.VDevStateHealthy), mirror)
+
+	testMatrix := map[string]struct {
+		name         string
+		expectParent string
+	}{
+		"top-level disk": {
+			name:         "sda",
+			expectParent: "persist",
+		},
+		"disk in mirror": {
+			name:         "sdc",
+			expectParent: "mirror-1",
+		},
+		"unknown disk": {
+			name:         "sdd",
+			expectParent: "",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		parent := getVdevParent(tree, test.name)
+		if test.expectParent == "" {
+			assert.Nil(t, parent)
+			continue
+		}
+		if assert.NotNil(t, parent) {
+			assert.Equal(t, test.expectParent, parent.Name)
+		}
+	}
+}
+
+func TestDisksRemoveProcess(t *testing.T) {
+	testMatrix := map[string]struct {
+		tree        libzfs.VDevTree
+		devName     string
+		expectCalls []string
+		expectError string
+	}{
+		"top-level disk": {
+			tree: pool(disk("/dev/sda", libzfs.VDevStateHealthy),
+				disk("/dev/sdb", libzfs.VDevStateHealthy)),
+			devName:     "/dev/sdb",
+			expectCalls: []string{"remove persist /dev/sdb"},
+		},
+		"disk in mirror": {
+			tree: pool(group(libzfs.VDevTypeMirror, "mirror-0", 0,
+				disk("/dev/sda", libzfs.VDevStateHealthy),
+				disk("/dev/sdb", libzfs.VDevStateHealthy))),
+			devName:     "/dev/sdb",
+			expectCalls: []string{"detach persist /dev/sdb"},
+		},
+		"last healthy disk in mirror": {
+			tree: pool(group(libzfs.VDevTypeMirror, "mirror-0", 0,
+				disk("/dev/sda", libzfs.VDevStateFaulted),
+				disk("/dev/sdb", libzfs.VDevStateHealthy))),
+			devName:     "/dev/sdb",
+			expectError: "no other online disks",
+		},
+		"disk in raidz": {
+			tree: pool(group(libzfs.VDevTypeRaidz, "raidz1-0", 1,
+				disk("/dev/sda", libzfs.VDevStateHealthy),
+				disk("/dev/sdb", libzfs.VDevStateHealthy),
+				disk("/dev/sdc", libzfs.VDevStateHealthy))),
+			devName:     "/dev/sdb",
+			expectError: "use replacement instead",
+		},
+		"unknown disk": {
+			tree:    pool(disk("/dev/sda", libzfs.VDevStateHealthy)),
+			devName: "/dev/sdb",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		calls := initDiskConfigTest(t)
+		errs := new(disksProcessingErrors)
+		disksRemoveProcess(test.tree, test.devName, errs)
+		assert.Equal(t, test.expectCalls, []string(*calls))
+		if test.expectError == "" {
+			assert.Empty(t, *errs)
+		} else {
+			assert.Contains(t, errs.String(), test.expectError)
+		}
+	}
+}
+
+func TestDisksLayoutRaidzProcess(t *testing.T) {
+	raidz := group(libzfs.VDevTypeRaidz, "raidz1-0", 1,
+		disk("/dev/sda", libzfs.VDevStateHealthy),
+		disk("/dev/sdb", libzfs.VDevStateHealthy),
+		disk("/dev/sdc", libzfs.VDevStateHealthy))
+	testMatrix := map[string]struct {
+		tree        libzfs.VDevTree
+		disks       types.EdgeNodeDisks
+		onlyVdev    bool
+		expectCalls []string
+		expectError string
+	}{
+		"create raidz1": {
+			tree: pool(),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID5,
+				Disks:     diskConfigs("/dev/sda", "/dev/sdb", "/dev/sdc"),
+			},
+			onlyVdev:    true,
+			expectCalls: []string{"add persist raidz1 /dev/sda /dev/sdb /dev/sdc"},
+		},
+		"create raidz2 next to raidz1": {
+			tree: pool(raidz),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID6,
+				Disks:     diskConfigs("/dev/sdd", "/dev/sde", "/dev/sdf"),
+			},
+			expectCalls: []string{"add persist raidz2 /dev/sdd /dev/sde /dev/sdf"},
+		},
+		"raidz next to single disk": {
+			tree: pool(disk("/dev/sda", libzfs.VDevStateHealthy)),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID5,
+				Disks:     diskConfigs("/dev/sdb", "/dev/sdc", "/dev/sdd"),
+			},
+			onlyVdev:    true,
+			expectError: "migration is required",
+		},
+		"raidz with disk from single disk vdev": {
+			tree: pool(disk("/dev/sda", libzfs.VDevStateHealthy)),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID5,
+				Disks:     diskConfigs("/dev/sda", "/dev/sdb", "/dev/sdc"),
+			},
+			onlyVdev:    true,
+			expectError: "some disks are in pool with another layout",
+		},
+		"not enough disks": {
+			tree: pool(),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID6,
+				Disks:     diskConfigs("/dev/sda", "/dev/sdb"),
+			},
+			onlyVdev:    true,
+			expectError: "not enough disks",
+		},
+		"extend raidz": {
+			tree: pool(raidz),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID5,
+				Disks:     diskConfigs("/dev/sda", "/dev/sdb", "/dev/sdc", "/dev/sdd"),
+			},
+			onlyVdev:    true,
+			expectError: "raidz cannot be extended",
+		},
+		"raidz in sync": {
+			tree: pool(raidz),
+			disks: types.EdgeNodeDisks{
+				ArrayType: types.EdgeNodeDiskArrayTypeRAID5,
+				Disks:     diskConfigs("/dev/sda", "/dev/sdb", "/dev/sdc"),
+			},
+			onlyVdev: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		calls := initDiskConfigTest(t)
+		errs := new(disksProcessingErrors)
+		disksLayoutRaidzProcess(test.tree, test.disks, test.onlyVdev, errs)
+		assert.Equal(t, test.expectCalls, []string(*calls),
+			fmt.Sprintf("test case %s", testname))
+		if test.expectError == "" {
+			assert.Empty(t, *errs, "test case %s", testname)
+		} else {
+			assert.Contains(t, errs.String(), test.expectError,
+				"test case %s", testname)
+		}
+	}
+}
+
+func TestDisksLayoutMirrorOnlyVdev(t *testing.T) {
+	calls := initDiskConfigTest(t)
+	errs := new(disksProcessingErrors)
+	disks := types.EdgeNodeDisks{
+		ArrayType: types.EdgeNodeDiskArrayTypeRAID1,
+		Disks:     diskConfigs("/dev/sdb", "/dev/sdc"),
+	}
+	disksLayoutMirrorProcess(pool(disk("/dev/sda", libzfs.VDevStateHealthy)),
+		disks, true, errs)
+	assert.Empty(t, *calls)
+	assert.Contains(t, errs.String(), "migration is required")
+
+	// single disk vdev converts into the mirror
+	errs = new(disksProcessingErrors)
+	disks.Disks = diskConfigs("/dev/sda", "/dev/sdb")
+	disksLayoutMirrorProcess(pool(disk("/dev/sda", libzfs.VDevStateHealthy)),
+		disks, true, errs)
+	assert.Equal(t, []string{"attach persist /dev/sda /dev/sdb"}, []string(*calls))
+	assert.Empty(t, *errs)
+}
@@ -7,9 +7,11 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
@@ -44,10 +46,31 @@ type zVolDeviceEvent struct {
 
 type zfsContext struct {
 	ps                     *pubsub.PubSub
+	subGlobalConfig        pubsub.Subscription
 	zVolStatusPub          pubsub.Publication
 	storageStatusPub       pubsub.Publication
 	subDisksConfig         pubsub.Subscription
 	disksProcessingTrigger chan interface{}
+
+	// errors of the last processing of disks config, reported in storage status
+	disksProcessingErrors     string
+	disksProcessingErrorsLock sync.Mutex
+
+	scrubInterval time.Duration // zero disables scrub scheduling
+	nextScrubTime time.Time
+	scrubLock     sync.Mutex
+}
+
+func (ctx *zfsContext) setDisksProcessingErrors(errs string) {
+	ctx.disksProcessingErrorsLock.Lock()
+	defer ctx.disksProcessingErrorsLock.Unlock()
+	ctx.disksProcessingErrors = errs
+}
+
+func (ctx *zfsContext) getDisksProcessingErrors() string {
+	ctx.disksProcessingErrorsLock.Lock()
+	defer ctx.disksProcessingErrorsLock.Unlock()
+	return ctx.disksProcessingErrors
 }
 
 // Run - an zfs run
@@ -73,12 +96,34 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ps.StillRunning(agentName, warningTime, errorTime)
 
 	ctxPtr := zfsContext{ps: ps, disksProcessingTrigger: make(chan interface{}, 1)}
+	ctxPtr.scrubInterval = time.Duration(types.DefaultConfigItemValueMap().
+		GlobalValueInt(types.ZfsScrubInterval)) * time.Second
 
 	if err := utils.WaitForVault(ps, log, agentName, warningTime, errorTime); err != nil {
 		log.Fatal(err)
 	}
 	log.Functionf("processed Vault Status")
 
+	// Look for global config such as log levels
+	subGlobalConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ConfigItemValueMap{},
+		Persistent:    true,
+		Activate:      false,
+		Ctx:           &ctxPtr,
+		CreateHandler: handleGlobalConfigCreate,
+		ModifyHandler: handleGlobalConfigModify,
+		DeleteHandler: handleGlobalConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.subGlobalConfig = subGlobalConfig
+	subGlobalConfig.Activate()
+
 	// Publish cloud metrics
 	zVolStatusPub, err := ps.NewPublication(
 		pubsub.PublicationOptions{
@@ -133,10 +178,14 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 
 	go storageStatusPublisher(&ctxPtr)
 
+	go scrubTask(&ctxPtr)
+
 	for {
 		select {
 		case event := <-deviceNotifyChannel:
 			processEvent(&ctxPtr, event)
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
 		case change := <-subDisksConfig.MsgChan():
 			subDisksConfig.ProcessChange(change)
 		case <-stillRunning.C:
@@ -232,3 +281,45 @@ func deviceWatcher(notifyChannel chan *zVolDeviceEvent) {
 		}
 	}
 }
+
+func handleGlobalConfigCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigImpl: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigImpl for %s", key)
+	var gcp *types.ConfigItemValueMap
+	debug, gcp = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		debugOverride, logger)
+	if gcp != nil {
+		ctx.setScrubInterval(time.Duration(gcp.GlobalValueInt(types.ZfsScrubInterval)) * time.Second)
+	}
+	log.Functionf("handleGlobalConfigImpl done for %s", key)
+}
+
+func handleGlobalConfigDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigDelete: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigDelete for %s", key)
+	debug, _ = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		debugOverride, logger)
+	log.Functionf("handleGlobalConfigDelete done for %s", key)
+}
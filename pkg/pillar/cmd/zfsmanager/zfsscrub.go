@@ -0,0 +1,145 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	libzfs "github.com/bicomsystems/go-libzfs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+const (
+	scrubCheckInterval = 10 * time.Minute // interval between checks if we should start scrub
+	// we start scrub only if the pool transferred less than this since the previous check
+	scrubIdleBytesPerSecond = 1024 * 1024
+	// zfs keeps only the last scan, so we remember the end of the last scrub
+	// to not lose it after resilver
+	lastScrubFile = types.PersistStatusDir + "/" + agentName + "/last-scrub"
+)
+
+func (ctx *zfsContext) setScrubInterval(interval time.Duration) {
+	ctx.scrubLock.Lock()
+	defer ctx.scrubLock.Unlock()
+	ctx.scrubInterval = interval
+}
+
+func (ctx *zfsContext) getScrubInterval() time.Duration {
+	ctx.scrubLock.Lock()
+	defer ctx.scrubLock.Unlock()
+	return ctx.scrubInterval
+}
+
+func (ctx *zfsContext) setNextScrubTime(next time.Time) {
+	ctx.scrubLock.Lock()
+	defer ctx.scrubLock.Unlock()
+	ctx.nextScrubTime = next
+}
+
+func (ctx *zfsContext) getNextScrubTime() time.Time {
+	ctx.scrubLock.Lock()
+	defer ctx.scrubLock.Unlock()
+	return ctx.nextScrubTime
+}
+
+func readLastScrubTime() time.Time {
+	content, err := ioutil.ReadFile(lastScrubFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("readLastScrubTime: %s", err)
+		}
+		return time.Time{}
+	}
+	lastScrub, err := time.Parse(time.RFC3339, strings.TrimSpace(string(content)))
+	if err != nil {
+		log.Errorf("readLastScrubTime: %s", err)
+		return time.Time{}
+	}
+	return lastScrub
+}
+
+func writeLastScrubTime(lastScrub time.Time) {
+	if err := os.MkdirAll(filepath.Dir(lastScrubFile), 0755); err != nil {
+		log.Errorf("writeLastScrubTime: %s", err)
+		return
+	}
+	if err := fileutils.WriteRename(lastScrubFile, []byte(lastScrub.Format(time.RFC3339))); err != nil {
+		log.Errorf("writeLastScrubTime: %s", err)
+	}
+}
+
+// scrubTask periodically checks if the scrub of the persist pool is due
+// and starts it when the pool is not busy
+func scrubTask(ctx *zfsContext) {
+	if vault.ReadPersistType() != types.PersistZFS {
+		return
+	}
+	lastScrub := readLastScrubTime()
+	if lastScrub.IsZero() {
+		// do not start scrub just after install or upgrade,
+		// wait for the full interval
+		lastScrub = time.Now()
+		writeLastScrubTime(lastScrub)
+	}
+	var lastBytes uint64
+	var lastCheck time.Time
+
+	t := time.NewTicker(scrubCheckInterval)
+	for range t.C {
+		pool, err := libzfs.PoolOpen(vault.DefaultZpool)
+		if err != nil {
+			log.Errorf("scrubTask: cannot open pool: %s", err)
+			continue
+		}
+		vdevTree, err := pool.VDevTree()
+		pool.Close()
+		if err != nil {
+			log.Errorf("scrubTask: cannot get vdev tree: %s", err)
+			continue
+		}
+		scan := zfs.GetZfsScanStatus(vdevTree)
+		if scan.Func == types.StorageScanFuncScrub &&
+			scan.State == types.StorageScanStateFinished &&
+			scan.EndTime.After(lastScrub) {
+			lastScrub = scan.EndTime
+			writeLastScrubTime(lastScrub)
+		}
+
+		now := time.Now()
+		bytes := vdevTree.Stat.Bytes[libzfs.ZIOTypeRead] + vdevTree.Stat.Bytes[libzfs.ZIOTypeWrite]
+		idle := false
+		if !lastCheck.IsZero() && bytes >= lastBytes {
+			rate := float64(bytes-lastBytes) / now.Sub(lastCheck).Seconds()
+			idle = rate < scrubIdleBytesPerSecond
+		}
+		lastBytes = bytes
+		lastCheck = now
+
+		interval := ctx.getScrubInterval()
+		if interval == 0 {
+			ctx.setNextScrubTime(time.Time{})
+			continue
+		}
+		next := lastScrub.Add(interval)
+		ctx.setNextScrubTime(next)
+		if scan.State == types.StorageScanStateScanning || now.Before(next) {
+			continue
+		}
+		if !idle {
+			log.Functionf("scrubTask: postpone scrub of %s, pool is busy", vault.DefaultZpool)
+			continue
+		}
+		log.Noticef("scrubTask: starting scrub of %s, last scrub at %v", vault.DefaultZpool, lastScrub)
+		if stdout, err := zfs.StartScrub(log, vault.DefaultZpool); err != nil {
+			log.Errorf("scrubTask: cannot start scrub of %s: %s %s", vault.DefaultZpool, stdout, err)
+		}
+	}
+}
@@ -32,6 +32,25 @@ func storageStatusPublisher(ctxPtr *zfsContext) {
 	}
 }
 
+// getVDevDisksStatus returns status of disks inside mirror or raidz vdev
+// including disks which are in process of replacement
+func getVDevDisksStatus(vdev libzfs.VDevTree) []*types.StorageDiskState {
+	var disks []*types.StorageDiskState
+	for _, disk := range vdev.Devices {
+		if disk.Type == libzfs.VDevTypeReplacing || disk.Type == libzfs.VDevTypeSpare {
+			disks = append(disks, getVDevDisksStatus(disk)...)
+			continue
+		}
+		rDiskStatus, err := zfs.GetZfsDiskAndStatus(disk)
+		// vdev.Devices might includes snapshots or caches,
+		// and those will result in errors which need to ignore.
+		if err == nil {
+			disks = append(disks, rDiskStatus)
+		}
+	}
+	return disks
+}
+
 func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 	log.Functionf("collectAndPublishStorageStatus start")
 	zfsVersion, err := zfs.GetZfsVersion()
@@ -86,6 +105,8 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 			if err != nil {
 				log.Errorf("error with get about RAID info from devTree: %v", err)
 			} else {
+				status.Scan = zfs.GetZfsScanStatus(vdevs)
+				status.Vdevs = zfs.GetZfsVdevStates(vdevs)
 				// it returns top-level raid type
 				currentRaid = zfs.GetZpoolRaidType(vdevs)
 				// handle the case when we have only one top-level vdev
@@ -93,14 +114,7 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 					for _, vdev := range vdevs.Devices {
 						// If this is a RAID or mirror, look at the disks it consists of
 						if vdev.Type == libzfs.VDevTypeMirror || vdev.Type == libzfs.VDevTypeRaidz {
-							for _, disk := range vdev.Devices {
-								rDiskStatus, err := zfs.GetZfsDiskAndStatus(disk)
-								// vdev.Devices might includes snapshots or caches,
-								// and those will result in errors which need to ignore.
-								if err == nil {
-									status.Disks = append(status.Disks, rDiskStatus)
-								}
-							}
+							status.Disks = append(status.Disks, getVDevDisksStatus(vdev)...)
 							break // in that case we have only one RAID
 						}
 						// If there is no RAID or mirror, add a disk if it is a disk
@@ -136,14 +150,7 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 						if vdev.Type == libzfs.VDevTypeMirror || vdev.Type == libzfs.VDevTypeRaidz {
 							child.CurrentRaid = zfs.GetRaidTypeFromStr(vdev.Name)
 							updateCurrentRaid(child.CurrentRaid)
-							for _, disk := range vdev.Devices {
-								rDiskStatus, err := zfs.GetZfsDiskAndStatus(disk)
-								// vdev.Devices might includes snapshots or caches,
-								// and those will result in errors which need to ignore.
-								if err == nil {
-									child.Disks = append(child.Disks, rDiskStatus)
-								}
-							}
+							child.Disks = append(child.Disks, getVDevDisksStatus(vdev)...)
 							status.Children = append(status.Children, child)
 							continue
 						}
@@ -175,6 +182,16 @@ func collectAndPublishStorageStatus(ctxPtr *zfsContext) {
 			if storageState != types.StorageStatusOnline {
 				status.CollectorErrors = zfs.GetZfsStatusStr(log, zpoolName)
 			}
+			if zpoolName == vault.DefaultZpool {
+				status.NextScrubTime = ctxPtr.getNextScrubTime()
+				// report problems with applying of disks config
+				if errs := ctxPtr.getDisksProcessingErrors(); errs != "" {
+					if status.CollectorErrors != "" {
+						status.CollectorErrors += "; "
+					}
+					status.CollectorErrors += errs
+				}
+			}
 			if err := ctxPtr.storageStatusPub.Publish(status.Key(), *status); err != nil {
 				log.Errorf("error in publishing of storageStatus: %s", err)
 			}
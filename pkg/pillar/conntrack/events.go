@@ -0,0 +1,298 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conntrack
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// EventType : type of conntrack event.
+type EventType uint8
+
+const (
+	// EventNew : conntrack entry was created (connection was confirmed).
+	EventNew EventType = iota
+	// EventDestroy : conntrack entry was destroyed (connection has ended
+	// or timed out).
+	EventDestroy
+)
+
+// String returns human-readable name of the event type.
+func (t EventType) String() string {
+	switch t {
+	case EventNew:
+		return "NEW"
+	case EventDestroy:
+		return "DESTROY"
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(t))
+}
+
+// Event : conntrack event received over ctnetlink.
+type Event struct {
+	Type EventType
+	// ID of the conntrack entry, unique among the entries that currently exist.
+	ID uint32
+	// Flow is filled with counters and timestamps only if enabled
+	// by nf_conntrack_acct and nf_conntrack_timestamp sysctls, respectively.
+	Flow netlink.ConntrackFlow
+}
+
+// Size of the socket receive buffer. Events are generated from the packet path
+// and when the buffer is full the kernel drops them.
+const eventsRcvBufSize = 8 << 20
+
+// SubscribeEvents subscribes to ctnetlink NEW and DESTROY events and delivers
+// them into the given channel until done is closed.
+// Events lost due to the socket buffer overflow are counted and reported
+// with a warning.
+func SubscribeEvents(log *base.LogObject, ch chan<- Event, done <-chan struct{}) error {
+	sock, err := nl.Subscribe(unix.NETLINK_NETFILTER,
+		unix.NFNLGRP_CONNTRACK_NEW, unix.NFNLGRP_CONNTRACK_DESTROY)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe for conntrack events: %w", err)
+	}
+	err = unix.SetsockoptInt(sock.GetFd(), unix.SOL_SOCKET, unix.SO_RCVBUFFORCE,
+		eventsRcvBufSize)
+	if err != nil {
+		log.Warnf("SubscribeEvents: failed to set socket receive buffer size: %v", err)
+	}
+	go func() {
+		<-done
+		sock.Close()
+	}()
+	go func() {
+		defer close(ch)
+		var lost uint64
+		for {
+			msgs, _, err := sock.Receive()
+			if err != nil {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if errors.Is(err, unix.ENOBUFS) {
+					lost++
+					log.Warnf("SubscribeEvents: conntrack events lost "+
+						"(socket buffer overflow, %d times so far)", lost)
+					continue
+				}
+				log.Errorf("SubscribeEvents: receive failed: %v", err)
+				return
+			}
+			for _, msg := range msgs {
+				event, ok, err := parseEvent(msg)
+				if err != nil {
+					log.Warnf("SubscribeEvents: failed to parse event: %v", err)
+					continue
+				}
+				if !ok {
+					continue
+				}
+				select {
+				case ch <- event:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// parseEvent parses ctnetlink message. Returns false if the message is not
+// a conntrack NEW or DESTROY event.
+func parseEvent(msg syscall.NetlinkMessage) (event Event, ok bool, err error) {
+	if msg.Header.Type>>8 != unix.NFNL_SUBSYS_CTNETLINK {
+		return event, false, nil
+	}
+	switch msg.Header.Type & 0xff {
+	case ipctnlMsgCtNew:
+		event.Type = EventNew
+	case nl.IPCTNL_MSG_CT_DELETE:
+		event.Type = EventDestroy
+	default:
+		return event, false, nil
+	}
+	if len(msg.Data) < nl.SizeofNfgenmsg {
+		return event, false, errors.New("message too short")
+	}
+	event.Flow.FamilyType = msg.Data[0]
+	attrs, err := nl.ParseRouteAttr(msg.Data[nl.SizeofNfgenmsg:])
+	if err != nil {
+		return event, false, err
+	}
+	var orig, reply flowTuple
+	for _, attr := range attrs {
+		switch attrType(attr) {
+		case nl.CTA_TUPLE_ORIG:
+			err = parseTuple(attr.Value, &orig)
+		case nl.CTA_TUPLE_REPLY:
+			err = parseTuple(attr.Value, &reply)
+		case nl.CTA_COUNTERS_ORIG:
+			err = parseCounters(attr.Value, &orig)
+		case nl.CTA_COUNTERS_REPLY:
+			err = parseCounters(attr.Value, &reply)
+		case nl.CTA_TIMESTAMP:
+			err = parseTimestamp(attr.Value, &event.Flow)
+		case nl.CTA_MARK:
+			event.Flow.Mark, err = beUint32(attr.Value)
+		case nl.CTA_TIMEOUT:
+			event.Flow.TimeOut, err = beUint32(attr.Value)
+		case nl.CTA_ID:
+			event.ID, err = beUint32(attr.Value)
+		}
+		if err != nil {
+			return event, false, err
+		}
+	}
+	// Type of Forward/Reverse is not exported by the netlink package.
+	event.Flow.Forward.SrcIP = orig.srcIP
+	event.Flow.Forward.DstIP = orig.dstIP
+	event.Flow.Forward.SrcPort = orig.srcPort
+	event.Flow.Forward.DstPort = orig.dstPort
+	event.Flow.Forward.Protocol = orig.protocol
+	event.Flow.Forward.Packets = orig.packets
+	event.Flow.Forward.Bytes = orig.bytes
+	event.Flow.Reverse.SrcIP = reply.srcIP
+	event.Flow.Reverse.DstIP = reply.dstIP
+	event.Flow.Reverse.SrcPort = reply.srcPort
+	event.Flow.Reverse.DstPort = reply.dstPort
+	event.Flow.Reverse.Protocol = reply.protocol
+	event.Flow.Reverse.Packets = reply.packets
+	event.Flow.Reverse.Bytes = reply.bytes
+	return event, true, nil
+}
+
+type flowTuple struct {
+	srcIP    net.IP
+	dstIP    net.IP
+	srcPort  uint16
+	dstPort  uint16
+	protocol uint8
+	packets  uint64
+	bytes    uint64
+}
+
+// Not defined by the netlink package.
+const ipctnlMsgCtNew = 0
+
+func attrType(attr syscall.NetlinkRouteAttr) uint16 {
+	return attr.Attr.Type & nl.NLA_TYPE_MASK
+}
+
+func parseTuple(data []byte, tuple *flowTuple) error {
+	attrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		switch attrType(attr) {
+		case nl.CTA_TUPLE_IP:
+			ipAttrs, err := nl.ParseRouteAttr(attr.Value)
+			if err != nil {
+				return err
+			}
+			for _, ipAttr := range ipAttrs {
+				switch attrType(ipAttr) {
+				case nl.CTA_IP_V4_SRC, nl.CTA_IP_V6_SRC:
+					tuple.srcIP = net.IP(ipAttr.Value)
+				case nl.CTA_IP_V4_DST, nl.CTA_IP_V6_DST:
+					tuple.dstIP = net.IP(ipAttr.Value)
+				}
+			}
+		case nl.CTA_TUPLE_PROTO:
+			protoAttrs, err := nl.ParseRouteAttr(attr.Value)
+			if err != nil {
+				return err
+			}
+			for _, protoAttr := range protoAttrs {
+				switch attrType(protoAttr) {
+				case nl.CTA_PROTO_NUM:
+					if len(protoAttr.Value) < 1 {
+						return errors.New("invalid protocol attribute")
+					}
+					tuple.protocol = protoAttr.Value[0]
+				case nl.CTA_PROTO_SRC_PORT:
+					tuple.srcPort, err = beUint16(protoAttr.Value)
+				case nl.CTA_PROTO_DST_PORT:
+					tuple.dstPort, err = beUint16(protoAttr.Value)
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func parseCounters(data []byte, tuple *flowTuple) error {
+	attrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		switch attrType(attr) {
+		case nl.CTA_COUNTERS_PACKETS:
+			tuple.packets, err = beUint64(attr.Value)
+		case nl.CTA_COUNTERS_BYTES:
+			tuple.bytes, err = beUint64(attr.Value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseTimestamp(data []byte, flow *netlink.ConntrackFlow) error {
+	attrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		switch attrType(attr) {
+		case nl.CTA_TIMESTAMP_START:
+			flow.TimeStart, err = beUint64(attr.Value)
+		case nl.CTA_TIMESTAMP_STOP:
+			flow.TimeStop, err = beUint64(attr.Value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func beUint16(b []byte) (uint16, error) {
+	if len(b) < 2 {
+		return 0, errors.New("attribute too short for uint16")
+	}
+	return binary.BigEndian.Uint16(b), nil
+}
+
+func beUint32(b []byte) (uint32, error) {
+	if len(b) < 4 {
+		return 0, errors.New("attribute too short for uint32")
+	}
+	return binary.BigEndian.Uint32(b), nil
+}
+
+func beUint64(b []byte) (uint64, error) {
+	if len(b) < 8 {
+		return 0, errors.New("attribute too short for uint64")
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
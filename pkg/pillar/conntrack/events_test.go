@@ -0,0 +1,127 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conntrack
+
+import (
+	"encoding/binary"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+func beBytes16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+func beBytes32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func beBytes64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func tupleAttr(attrType int, src, dst net.IP, proto uint8,
+	sport, dport uint16) *nl.RtAttr {
+	tuple := nl.NewRtAttr(attrType|int(nl.NLA_F_NESTED), nil)
+	ip := tuple.AddRtAttr(nl.CTA_TUPLE_IP|int(nl.NLA_F_NESTED), nil)
+	ip.AddRtAttr(nl.CTA_IP_V4_SRC, src.To4())
+	ip.AddRtAttr(nl.CTA_IP_V4_DST, dst.To4())
+	protoAttr := tuple.AddRtAttr(nl.CTA_TUPLE_PROTO|int(nl.NLA_F_NESTED), nil)
+	protoAttr.AddRtAttr(nl.CTA_PROTO_NUM, []byte{proto})
+	protoAttr.AddRtAttr(nl.CTA_PROTO_SRC_PORT, beBytes16(sport))
+	protoAttr.AddRtAttr(nl.CTA_PROTO_DST_PORT, beBytes16(dport))
+	return tuple
+}
+
+func countersAttr(attrType int, packets, bytes uint64) *nl.RtAttr {
+	counters := nl.NewRtAttr(attrType|int(nl.NLA_F_NESTED), nil)
+	counters.AddRtAttr(nl.CTA_COUNTERS_PACKETS, beBytes64(packets))
+	counters.AddRtAttr(nl.CTA_COUNTERS_BYTES, beBytes64(bytes))
+	return counters
+}
+
+func TestParseDestroyEvent(t *testing.T) {
+	appIP := net.ParseIP("10.1.0.2")
+	remoteIP := net.ParseIP("192.168.1.10")
+	natIP := net.ParseIP("192.168.1.5")
+	// nfgenmsg header
+	data := []byte{unix.AF_INET, nl.NFNETLINK_V0, 0, 0}
+	timestamp := nl.NewRtAttr(nl.CTA_TIMESTAMP|int(nl.NLA_F_NESTED), nil)
+	timestamp.AddRtAttr(nl.CTA_TIMESTAMP_START, beBytes64(1000))
+	timestamp.AddRtAttr(nl.CTA_TIMESTAMP_STOP, beBytes64(2000))
+	attrs := []*nl.RtAttr{
+		tupleAttr(nl.CTA_TUPLE_ORIG, appIP, remoteIP, 6, 40000, 443),
+		tupleAttr(nl.CTA_TUPLE_REPLY, remoteIP, natIP, 6, 443, 40000),
+		nl.NewRtAttr(nl.CTA_ID, beBytes32(1234)),
+		nl.NewRtAttr(nl.CTA_MARK, beBytes32(0x1000005)),
+		countersAttr(nl.CTA_COUNTERS_ORIG, 10, 1000),
+		countersAttr(nl.CTA_COUNTERS_REPLY, 20, 20000),
+		timestamp,
+	}
+	for _, attr := range attrs {
+		data = append(data, attr.Serialize()...)
+	}
+	msg := syscall.NetlinkMessage{
+		Header: syscall.NlMsghdr{
+			Type: unix.NFNL_SUBSYS_CTNETLINK<<8 | nl.IPCTNL_MSG_CT_DELETE,
+		},
+		Data: data,
+	}
+	event, ok, err := parseEvent(msg)
+	if err != nil {
+		t.Fatalf("parseEvent failed: %v", err)
+	}
+	if !ok {
+		t.Fatalf("parseEvent did not recognize conntrack event")
+	}
+	if event.Type != EventDestroy {
+		t.Errorf("expected DESTROY event, got %v", event.Type)
+	}
+	if event.ID != 1234 {
+		t.Errorf("expected ID 1234, got %d", event.ID)
+	}
+	flow := event.Flow
+	if flow.FamilyType != unix.AF_INET || flow.Mark != 0x1000005 {
+		t.Errorf("unexpected family (%d) or mark (0x%x)", flow.FamilyType, flow.Mark)
+	}
+	if !flow.Forward.SrcIP.Equal(appIP) || !flow.Forward.DstIP.Equal(remoteIP) ||
+		flow.Forward.SrcPort != 40000 || flow.Forward.DstPort != 443 ||
+		flow.Forward.Protocol != 6 {
+		t.Errorf("unexpected original tuple: %+v", flow.Forward)
+	}
+	if !flow.Reverse.SrcIP.Equal(remoteIP) || !flow.Reverse.DstIP.Equal(natIP) ||
+		flow.Reverse.SrcPort != 443 || flow.Reverse.DstPort != 40000 {
+		t.Errorf("unexpected reply tuple: %+v", flow.Reverse)
+	}
+	if flow.Forward.Packets != 10 || flow.Forward.Bytes != 1000 ||
+		flow.Reverse.Packets != 20 || flow.Reverse.Bytes != 20000 {
+		t.Errorf("unexpected counters: %+v, %+v", flow.Forward, flow.Reverse)
+	}
+	if flow.TimeStart != 1000 || flow.TimeStop != 2000 {
+		t.Errorf("unexpected timestamps: %d, %d", flow.TimeStart, flow.TimeStop)
+	}
+}
+
+func TestParseNonConntrackMessage(t *testing.T) {
+	msg := syscall.NetlinkMessage{
+		Header: syscall.NlMsghdr{
+			Type: unix.NFNL_SUBSYS_CTNETLINK_EXP<<8 | ipctnlMsgCtNew,
+		},
+		Data: []byte{unix.AF_INET, nl.NFNETLINK_V0, 0, 0},
+	}
+	_, ok, err := parseEvent(msg)
+	if err != nil || ok {
+		t.Errorf("expected message to be skipped, got ok=%t err=%v", ok, err)
+	}
+}
@@ -550,16 +550,37 @@ func (client *Client) CtrStopContainer(ctx context.Context, containerID string,
 		return err
 	}
 
+	if !force {
+		// give the task the grace period declared by its image
+		// to exit after the stop signal before killing it
+		labels, err := ctr.Labels(ctx)
+		if err != nil {
+			return err
+		}
+		timeout := stopTimeoutFromLabels(labels)
+		exitC, err := task.Wait(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Kill(ctx, signal, containerd.WithKillAll); err != nil {
+			logrus.Warnf("CtrStopContainer: sending %v to %s failed: %v", signal, containerID, err)
+		}
+		select {
+		case <-exitC:
+		case <-time.After(timeout):
+			logrus.Warnf("CtrStopContainer: %s did not exit within %v after %v, killing it",
+				containerID, timeout, signal)
+		}
+		_, err = task.Delete(ctx, containerd.WithProcessKill)
+		return err
+	}
+
 	// it is unclear whether we have to wait after this or proceed
 	// straight away. It is also unclear whether paying any attention
 	// to the err returned is worth anything at this point
 	_ = task.Kill(ctx, signal, containerd.WithKillAll)
 
-	if force {
-		_, err = task.Delete(ctx, containerd.WithProcessKill)
-	} else {
-		_, err = task.Delete(ctx)
-	}
+	_, err = task.Delete(ctx, containerd.WithProcessKill)
 
 	return err
 }
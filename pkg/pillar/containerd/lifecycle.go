@@ -0,0 +1,132 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// eveStopTimeoutLabel is a container label with the grace period (in seconds)
+	// between the stop signal and killing the task
+	eveStopTimeoutLabel = "org.lfedge.eve.stop_timeout"
+	// same defaults as docker uses
+	defaultStopTimeout         = 10 * time.Second
+	defaultHealthcheckInterval = 30 * time.Second
+	defaultHealthcheckTimeout  = 30 * time.Second
+	defaultHealthcheckRetries  = 3
+	// default of the timer.app.stop.timeout.max global setting
+	defaultMaxStopTimeout = 120 * time.Second
+)
+
+// maxStopTimeout caps the grace period declared by images, accessed atomically
+var maxStopTimeout = int64(defaultMaxStopTimeout)
+
+// SetMaxStopTimeout caps the grace period between the stop signal and killing
+// the task, whatever the image declares. Stopping of the task blocks its caller.
+func SetMaxStopTimeout(timeout time.Duration) {
+	atomic.StoreInt64(&maxStopTimeout, int64(timeout))
+}
+
+// dockerImageConfig has the fields of the image config produced by docker
+// which are missing in the OCI image spec (v1.ImageConfig).
+type dockerImageConfig struct {
+	Config struct {
+		Healthcheck *struct {
+			Test        []string
+			Interval    time.Duration
+			Timeout     time.Duration
+			StartPeriod time.Duration
+			Retries     int
+		}
+		StopSignal  string
+		StopTimeout *int
+	} `json:"config"`
+}
+
+// GetImageLifecycle returns container lifecycle metadata from the image
+// config saved in the volume. Defaults are returned for a volume without
+// an image config (e.g. with a full OCI runtime spec instead) and also
+// together with an error.
+func GetImageLifecycle(volume string) (types.ImageLifecycle, error) {
+	data, err := ioutil.ReadFile(filepath.Join(volume, imageConfigFilename))
+	if err != nil {
+		lifecycle := types.ImageLifecycle{StopTimeout: defaultStopTimeout}
+		if os.IsNotExist(err) {
+			return lifecycle, nil
+		}
+		return lifecycle, err
+	}
+	return parseImageLifecycle(data)
+}
+
+func parseImageLifecycle(data []byte) (types.ImageLifecycle, error) {
+	lifecycle := types.ImageLifecycle{StopTimeout: defaultStopTimeout}
+	var image dockerImageConfig
+	if err := json.Unmarshal(data, &image); err != nil {
+		return lifecycle, err
+	}
+	lifecycle.StopSignal = image.Config.StopSignal
+	if image.Config.StopTimeout != nil && *image.Config.StopTimeout >= 0 {
+		lifecycle.StopTimeout = time.Duration(*image.Config.StopTimeout) * time.Second
+	}
+	healthcheck := image.Config.Healthcheck
+	if healthcheck == nil || len(healthcheck.Test) == 0 {
+		return lifecycle, nil
+	}
+	switch healthcheck.Test[0] {
+	case "NONE":
+		return lifecycle, nil
+	case "CMD":
+		lifecycle.Healthcheck.Command = healthcheck.Test[1:]
+	case "CMD-SHELL":
+		if len(healthcheck.Test) != 2 {
+			return lifecycle, fmt.Errorf("invalid healthcheck %q", healthcheck.Test)
+		}
+		lifecycle.Healthcheck.Command = []string{"/bin/sh", "-c", healthcheck.Test[1]}
+	default:
+		return lifecycle, fmt.Errorf("unsupported healthcheck %q", healthcheck.Test)
+	}
+	if len(lifecycle.Healthcheck.Command) == 0 {
+		return lifecycle, fmt.Errorf("healthcheck without a command")
+	}
+	lifecycle.Healthcheck.Interval = healthcheck.Interval
+	if lifecycle.Healthcheck.Interval == 0 {
+		lifecycle.Healthcheck.Interval = defaultHealthcheckInterval
+	}
+	lifecycle.Healthcheck.Timeout = healthcheck.Timeout
+	if lifecycle.Healthcheck.Timeout == 0 {
+		lifecycle.Healthcheck.Timeout = defaultHealthcheckTimeout
+	}
+	lifecycle.Healthcheck.StartPeriod = healthcheck.StartPeriod
+	lifecycle.Healthcheck.Retries = healthcheck.Retries
+	if lifecycle.Healthcheck.Retries == 0 {
+		lifecycle.Healthcheck.Retries = defaultHealthcheckRetries
+	}
+	return lifecycle, nil
+}
+
+// stopTimeoutFromLabels returns the grace period recorded in container labels
+// capped by SetMaxStopTimeout.
+func stopTimeoutFromLabels(labels map[string]string) time.Duration {
+	timeout := defaultStopTimeout
+	if value, ok := labels[eveStopTimeoutLabel]; ok {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			timeout = time.Duration(seconds) * time.Second
+		}
+	}
+	if max := time.Duration(atomic.LoadInt64(&maxStopTimeout)); timeout > max {
+		return max
+	}
+	return timeout
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package containerd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImageLifecycle(t *testing.T) {
+	tests := map[string]struct {
+		imageConfig string
+		expected    types.ImageLifecycle
+		expectErr   bool
+	}{
+		"no lifecycle metadata": {
+			imageConfig: `{"config": {"Cmd": ["/bin/sh"]}}`,
+			expected:    types.ImageLifecycle{StopTimeout: defaultStopTimeout},
+		},
+		"healthcheck with CMD-SHELL and defaults": {
+			imageConfig: `{"config": {"Healthcheck": {"Test": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]},
+				"StopSignal": "SIGQUIT", "StopTimeout": 30}}`,
+			expected: types.ImageLifecycle{
+				Healthcheck: types.ImageHealthcheck{
+					Command:  []string{"/bin/sh", "-c", "curl -f http://localhost/ || exit 1"},
+					Interval: defaultHealthcheckInterval,
+					Timeout:  defaultHealthcheckTimeout,
+					Retries:  defaultHealthcheckRetries,
+				},
+				StopSignal:  "SIGQUIT",
+				StopTimeout: 30 * time.Second,
+			},
+		},
+		"healthcheck with CMD": {
+			imageConfig: `{"config": {"Healthcheck": {"Test": ["CMD", "/healthz", "--quiet"],
+				"Interval": 5000000000, "Timeout": 2000000000, "StartPeriod": 60000000000, "Retries": 5}}}`,
+			expected: types.ImageLifecycle{
+				Healthcheck: types.ImageHealthcheck{
+					Command:     []string{"/healthz", "--quiet"},
+					Interval:    5 * time.Second,
+					Timeout:     2 * time.Second,
+					StartPeriod: time.Minute,
+					Retries:     5,
+				},
+				StopTimeout: defaultStopTimeout,
+			},
+		},
+		"healthcheck disabled": {
+			imageConfig: `{"config": {"Healthcheck": {"Test": ["NONE"]}, "StopTimeout": 0}}`,
+			expected:    types.ImageLifecycle{},
+		},
+		"unsupported healthcheck": {
+			imageConfig: `{"config": {"Healthcheck": {"Test": ["FOO", "bar"]}}}`,
+			expected:    types.ImageLifecycle{StopTimeout: defaultStopTimeout},
+			expectErr:   true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			lifecycle, err := parseImageLifecycle([]byte(test.imageConfig))
+			if test.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, lifecycle)
+		})
+	}
+}
+
+func TestGetImageLifecycle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lifecycle")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// Volume without image config
+	lifecycle, err := GetImageLifecycle(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, types.ImageLifecycle{StopTimeout: defaultStopTimeout}, lifecycle)
+
+	err = ioutil.WriteFile(filepath.Join(dir, imageConfigFilename),
+		[]byte(`{"config": {"StopSignal": "SIGINT"}}`), 0644)
+	assert.NoError(t, err)
+	lifecycle, err = GetImageLifecycle(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "SIGINT", lifecycle.StopSignal)
+}
+
+func TestStopTimeoutFromLabels(t *testing.T) {
+	assert.Equal(t, defaultStopTimeout, stopTimeoutFromLabels(nil))
+	assert.Equal(t, 45*time.Second,
+		stopTimeoutFromLabels(map[string]string{eveStopTimeoutLabel: "45"}))
+	assert.Equal(t, time.Duration(0),
+		stopTimeoutFromLabels(map[string]string{eveStopTimeoutLabel: "0"}))
+	assert.Equal(t, defaultStopTimeout,
+		stopTimeoutFromLabels(map[string]string{eveStopTimeoutLabel: "soon"}))
+	assert.Equal(t, defaultMaxStopTimeout,
+		stopTimeoutFromLabels(map[string]string{eveStopTimeoutLabel: "86400"}))
+
+	SetMaxStopTimeout(5 * time.Second)
+	defer SetMaxStopTimeout(defaultMaxStopTimeout)
+	assert.Equal(t, 5*time.Second, stopTimeoutFromLabels(nil))
+	assert.Equal(t, 5*time.Second,
+		stopTimeoutFromLabels(map[string]string{eveStopTimeoutLabel: "45"}))
+}
@@ -17,7 +17,9 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
@@ -26,6 +28,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
 )
 
 const eveScript = "/bin/eve"
@@ -46,6 +49,8 @@ type ociSpec struct {
 	volumes      map[string]struct{}
 	labels       map[string]string
 	stopSignal   string
+	// labels of the container, e.g. how to stop its task
+	ctrLabels map[string]string
 }
 
 // OCISpec provides methods to manipulate OCI runtime specifications and create containers based on them
@@ -233,11 +238,13 @@ func (s *ociSpec) Load(file *os.File) error {
 func (s *ociSpec) CreateContainer(removeExisting bool) error {
 	ctrdCtx, done := s.client.CtrNewUserServicesCtx()
 	defer done()
-	_, err := s.client.ctrdClient.NewContainer(ctrdCtx, s.name, containerd.WithSpec(&s.Spec))
+	opts := []containerd.NewContainerOpts{containerd.WithSpec(&s.Spec),
+		containerd.WithContainerLabels(s.ctrLabels)}
+	_, err := s.client.ctrdClient.NewContainer(ctrdCtx, s.name, opts...)
 	// if container exists, is stopped and we are asked to remove existing - try that
 	if err != nil && removeExisting {
 		_ = s.client.CtrDeleteContainer(ctrdCtx, s.name)
-		_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, containerd.WithSpec(&s.Spec))
+		_, err = s.client.ctrdClient.NewContainer(ctrdCtx, s.name, opts...)
 	}
 	return err
 }
@@ -326,7 +333,21 @@ func (s *ociSpec) UpdateFromVolume(volume string) error {
 		return err
 	}
 	s.Root.Path = volume + "/rootfs" // we need to set Root.Path before doing things with users/groups in spec
-	return s.updateFromImageConfig(imgInfo.Config)
+	if err := s.updateFromImageConfig(imgInfo.Config); err != nil {
+		return err
+	}
+	// Invalid healthcheck does not prevent the container from stopping properly
+	lifecycle, err := GetImageLifecycle(volume)
+	if err != nil {
+		logrus.Warnf("UpdateFromVolume(%s): %v", volume, err)
+	}
+	s.ctrLabels = map[string]string{
+		eveStopTimeoutLabel: strconv.Itoa(int(lifecycle.StopTimeout / time.Second)),
+	}
+	if s.stopSignal != "" {
+		s.ctrLabels[containerd.StopSignalLabel] = s.stopSignal
+	}
+	return nil
 }
 
 // UpdateFromImageConfig updates values in the OCI spec based
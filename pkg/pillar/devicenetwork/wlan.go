@@ -10,4 +10,7 @@ const (
 	RunWlanDir = "/run/wlan"
 	// WpaTempname : name used for a temporary wpa_supplicant file.
 	WpaTempname = "wpa_supplicant.temp"
+	// WpaCtrlDir : directory where wpa_supplicant creates control interface
+	// sockets, one per wireless interface.
+	WpaCtrlDir = "/run/wlan/ctrl"
 )
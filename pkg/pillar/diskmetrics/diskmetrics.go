@@ -84,3 +84,32 @@ func ConvertImg(ctx context.Context, log *base.LogObject, diskfile, outputFile,
 	}
 	return nil
 }
+
+//CreateSnapshotImg creates internal snapshot with provided name inside qcow2 diskfile
+func CreateSnapshotImg(ctx context.Context, log *base.LogObject, diskfile, snapshot string) error {
+	return snapshotImg(ctx, log, diskfile, "-c", snapshot)
+}
+
+//ApplySnapshotImg reverts qcow2 diskfile to the internal snapshot with provided name
+func ApplySnapshotImg(ctx context.Context, log *base.LogObject, diskfile, snapshot string) error {
+	return snapshotImg(ctx, log, diskfile, "-a", snapshot)
+}
+
+//DeleteSnapshotImg removes internal snapshot with provided name from qcow2 diskfile
+func DeleteSnapshotImg(ctx context.Context, log *base.LogObject, diskfile, snapshot string) error {
+	return snapshotImg(ctx, log, diskfile, "-d", snapshot)
+}
+
+func snapshotImg(ctx context.Context, log *base.LogObject, diskfile, op, snapshot string) error {
+	if _, err := os.Stat(diskfile); err != nil {
+		return err
+	}
+	args := []string{"snapshot", op, snapshot, diskfile}
+	output, err := base.Exec(log, "/usr/bin/qemu-img", args...).WithContext(ctx).CombinedOutput()
+	if err != nil {
+		errStr := fmt.Sprintf("qemu-img failed: %s, %s\n",
+			err, output)
+		return errors.New(errStr)
+	}
+	return nil
+}
@@ -153,6 +153,16 @@ func (m *DpcManager) updateDNS() {
 				}
 			}
 		}
+		// Likewise for WiFi, status is obtained from wpa_supplicant.
+		// Only one WiFi adapter is currently supported.
+		if port.WirelessCfg.WType == types.WirelessTypeWifi {
+			if m.wlanStatus.Interface == "" || m.wlanStatus.Interface == port.IfName {
+				m.deviceNetStatus.Ports[ix].WirelessStatus = types.WirelessStatus{
+					WType: types.WirelessTypeWifi,
+					Wifi:  m.wlanStatus,
+				}
+			}
+		}
 	}
 
 	// Preserve geo info for existing interface and IP address
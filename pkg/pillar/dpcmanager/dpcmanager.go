@@ -62,6 +62,7 @@ type DpcManager struct {
 	// Keep nil values to let DpcManager to use default implementations.
 	// It is useful to override for unit testing purposes.
 	WwanWatcher WwanWatcher
+	WlanWatcher WlanWatcher
 	GeoService  GeolocationService
 
 	// Minimum time that should pass after a DPC verification failure
@@ -81,6 +82,7 @@ type DpcManager struct {
 	PubDevicePortConfigList  pubsub.Publication
 	PubDeviceNetworkStatus   pubsub.Publication
 	PubWwanMetrics           pubsub.Publication
+	PubWlanMetrics           pubsub.Publication
 
 	// Metrics
 	ZedcloudMetrics *zedcloud.AgentMetrics
@@ -90,6 +92,7 @@ type DpcManager struct {
 	adapters     types.AssignableAdapters
 	globalCfg    types.ConfigItemValueMap
 	radioSilence types.RadioSilence
+	wgPorts      []uint16
 
 	// DPC verification
 	dpcVerify dpcVerify
@@ -99,11 +102,14 @@ type DpcManager struct {
 	deviceNetStatus types.DeviceNetworkStatus
 	wwanStatus      types.WwanStatus
 	wwanMetrics     types.WwanMetrics
+	wlanStatus      types.WifiStatus
+	wlanMetrics     types.WlanMetrics
 
 	// Channels
 	inputCommands chan inputCommand
 	networkEvents <-chan netmonitor.Event
 	wwanEvents    <-chan WwanEvent
+	wlanEvents    <-chan WlanEvent
 
 	// Timers
 	dpcTestTimer          *time.Timer
@@ -153,6 +159,29 @@ type WwanWatcher interface {
 	LoadMetrics() (types.WwanMetrics, error)
 }
 
+// WlanEvent is sent by WlanWatcher whenever there is a new status
+// or new metrics obtained for the WiFi adapter.
+type WlanEvent uint8
+
+const (
+	// WlanEventUndefined : undefined event, will be ignored.
+	WlanEventUndefined WlanEvent = iota
+	// WlanEventNewStatus : new WiFi status is available,
+	// reload with WlanWatcher.LoadStatus()
+	WlanEventNewStatus
+	// WlanEventNewMetrics : new WiFi metrics are available,
+	// reload with WlanWatcher.LoadMetrics()
+	WlanEventNewMetrics
+)
+
+// WlanWatcher allows to watch for the state of the WiFi adapter
+// as reported by wpa_supplicant running in the wlan microservice.
+type WlanWatcher interface {
+	Watch(ctx context.Context) (<-chan WlanEvent, error)
+	LoadStatus() types.WifiStatus
+	LoadMetrics() types.WlanMetrics
+}
+
 // GeolocationService allows to obtain geolocation information based
 // on assigned IP address.
 type GeolocationService interface {
@@ -170,6 +199,7 @@ const (
 	commandUpdateGCP
 	commandUpdateAA
 	commandUpdateRS
+	commandUpdateWgPorts
 )
 
 type inputCommand struct {
@@ -178,6 +208,7 @@ type inputCommand struct {
 	gcp types.ConfigItemValueMap // for inputCmdUpdateGCP
 	aa  types.AssignableAdapters // for inputCmdUpdateAA
 	rs  types.RadioSilence       // for inputCmdUpdateRS
+	wg  []uint16                 // for inputCmdUpdateWgPorts
 }
 
 type dpcVerify struct {
@@ -194,6 +225,9 @@ func (m *DpcManager) Run(ctx context.Context) (err error) {
 	if m.WwanWatcher == nil {
 		m.WwanWatcher = &wwanWatcher{Log: m.Log}
 	}
+	if m.WlanWatcher == nil {
+		m.WlanWatcher = &wlanWatcher{Log: m.Log}
+	}
 	if m.GeoService == nil {
 		m.GeoService = &geoService{}
 	}
@@ -215,6 +249,10 @@ func (m *DpcManager) Run(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	m.wlanEvents, err = m.WlanWatcher.Watch(ctx)
+	if err != nil {
+		return err
+	}
 
 	go m.run(ctx)
 	return nil
@@ -248,6 +286,8 @@ func (m *DpcManager) run(ctx context.Context) {
 				m.updateAA(ctx, inputCmd.aa)
 			case commandUpdateRS:
 				m.updateRadioSilence(ctx, inputCmd.rs)
+			case commandUpdateWgPorts:
+				m.updateWireGuardPorts(ctx, inputCmd.wg)
 			}
 			m.resumeVerifyIfAsyncDone(ctx)
 
@@ -381,6 +421,20 @@ func (m *DpcManager) run(ctx context.Context) {
 				m.reloadWwanMetrics()
 			}
 
+		case event, ok := <-m.wlanEvents:
+			if !ok {
+				m.Log.Warnf("Wlan watcher stopped")
+				continue
+			}
+			switch event {
+			case WlanEventUndefined:
+				m.Log.Warnf("Undefined event received from WlanWatcher")
+			case WlanEventNewStatus:
+				m.reloadWlanStatus()
+			case WlanEventNewMetrics:
+				m.reloadWlanMetrics()
+			}
+
 		case <-ctx.Done():
 			return
 
@@ -393,9 +447,10 @@ func (m *DpcManager) run(ctx context.Context) {
 
 func (m *DpcManager) reconcilerArgs() dpcreconciler.Args {
 	args := dpcreconciler.Args{
-		GCP: m.globalCfg,
-		AA:  m.adapters,
-		RS:  m.radioSilence,
+		GCP:            m.globalCfg,
+		AA:             m.adapters,
+		RS:             m.radioSilence,
+		WireGuardPorts: m.wgPorts,
 	}
 	if m.currentDPC() != nil {
 		args.DPC = *m.currentDPC()
@@ -451,6 +506,16 @@ func (m *DpcManager) UpdateRadioSilence(rs types.RadioSilence) {
 	}
 }
 
+// UpdateWireGuardPorts : apply an updated list of UDP ports on which
+// WireGuard tunnels of network instances receive from their peers.
+// The list should be sorted.
+func (m *DpcManager) UpdateWireGuardPorts(ports []uint16) {
+	m.inputCommands <- inputCommand{
+		cmd: commandUpdateWgPorts,
+		wg:  ports,
+	}
+}
+
 // GetDNS returns device network state information.
 func (m *DpcManager) GetDNS() types.DeviceNetworkStatus {
 	return m.deviceNetStatus
@@ -526,6 +591,11 @@ func (m *DpcManager) updateAA(ctx context.Context, adapters types.AssignableAdap
 	m.reconcileStatus = m.DpcReconciler.Reconcile(ctx, m.reconcilerArgs())
 }
 
+func (m *DpcManager) updateWireGuardPorts(ctx context.Context, ports []uint16) {
+	m.wgPorts = ports
+	m.reconcileStatus = m.DpcReconciler.Reconcile(ctx, m.reconcilerArgs())
+}
+
 func (m *DpcManager) resumeVerifyIfAsyncDone(ctx context.Context) {
 	if dpc := m.currentDPC(); dpc != nil {
 		asyncInProgress := m.reconcileStatus.AsyncInProgress
@@ -36,6 +36,7 @@ import (
 var (
 	networkMonitor  *netmonitor.MockNetworkMonitor
 	wwanWatcher     *MockWwanWatcher
+	wlanWatcher     *MockWlanWatcher
 	geoService      *MockGeoService
 	dpcReconciler   *dpcrec.LinuxDpcReconciler
 	dpcManager      *dpcmngr.DpcManager
@@ -44,6 +45,7 @@ var (
 	pubDPCList      pubsub.Publication
 	pubDNS          pubsub.Publication
 	pubWwwanMetrics pubsub.Publication
+	pubWlanMetrics  pubsub.Publication
 )
 
 func initTest(test *testing.T) *GomegaWithT {
@@ -90,6 +92,14 @@ func initTest(test *testing.T) *GomegaWithT {
 	if err != nil {
 		log.Fatal(err)
 	}
+	pubWlanMetrics, err = ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: "test",
+			TopicType: types.WlanMetrics{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
 	networkMonitor = &netmonitor.MockNetworkMonitor{
 		Log:    logObj,
 		MainRT: syscall.RT_TABLE_MAIN,
@@ -100,6 +110,7 @@ func initTest(test *testing.T) *GomegaWithT {
 		NetworkMonitor: networkMonitor,
 	}
 	wwanWatcher = &MockWwanWatcher{}
+	wlanWatcher = &MockWlanWatcher{}
 	geoService = &MockGeoService{}
 	connTester = &conntester.MockConnectivityTester{
 		TestDuration: 2 * time.Second,
@@ -109,6 +120,7 @@ func initTest(test *testing.T) *GomegaWithT {
 		Watchdog:                 &MockWatchdog{},
 		AgentName:                "test",
 		WwanWatcher:              wwanWatcher,
+		WlanWatcher:              wlanWatcher,
 		GeoService:               geoService,
 		DpcMinTimeSinceFailure:   3 * time.Second,
 		NetworkMonitor:           networkMonitor,
@@ -118,6 +130,7 @@ func initTest(test *testing.T) *GomegaWithT {
 		PubDevicePortConfigList:  pubDPCList,
 		PubDeviceNetworkStatus:   pubDNS,
 		PubWwanMetrics:           pubWwwanMetrics,
+		PubWlanMetrics:           pubWlanMetrics,
 		ZedcloudMetrics:          zedcloud.NewAgentMetrics(),
 	}
 	ctx := reconciler.MockRun(context.Background())
@@ -1115,6 +1128,52 @@ func TestWireless(test *testing.T) {
 	t.Expect(metrics.Networks[0].SignalInfo.RSRP).To(BeEquivalentTo(-97))
 	t.Expect(metrics.Networks[0].SignalInfo.SNR).To(BeEquivalentTo(92))
 
+	// Simulate WiFi status obtained from wpa_supplicant.
+	wlanWatcher.UpdateStatus(types.WifiStatus{
+		Interface:    "wlan0",
+		State:        types.WifiStateConnected,
+		SSID:         "mock-ssid",
+		BSSID:        "00:11:22:33:44:55",
+		FrequencyMHz: 2437,
+		KeyMgmt:      "WPA2-PSK",
+		ScanResults: []types.WifiScanResult{
+			{
+				SSID:         "mock-ssid",
+				BSSID:        "00:11:22:33:44:55",
+				FrequencyMHz: 2437,
+				SignalLevel:  -48,
+				Flags:        "[WPA2-PSK-CCMP][ESS]",
+			},
+		},
+	})
+	wlanWatcher.UpdateMetrics(types.WlanMetrics{
+		Interface:     "wlan0",
+		RSSI:          -52,
+		Noise:         -95,
+		TxBitrateKbps: 65000,
+		FrequencyMHz:  2437,
+	})
+	t.Eventually(func() bool {
+		wlanDNS := wirelessStatusFromDNS(types.WirelessTypeWifi)
+		return wlanDNS.Wifi.State == types.WifiStateConnected
+	}).Should(BeTrue())
+	wlanDNS := wirelessStatusFromDNS(types.WirelessTypeWifi)
+	t.Expect(wlanDNS.Wifi.SSID).To(Equal("mock-ssid"))
+	t.Expect(wlanDNS.Wifi.BSSID).To(Equal("00:11:22:33:44:55"))
+	t.Expect(wlanDNS.Wifi.FrequencyMHz).To(BeEquivalentTo(2437))
+	t.Expect(wlanDNS.Wifi.ScanResults).To(HaveLen(1))
+	t.Expect(wlanDNS.Wifi.ScanResults[0].SignalLevel).To(BeEquivalentTo(-48))
+	t.Eventually(func() bool {
+		obj, err := pubWlanMetrics.Get("global")
+		return err == nil && obj != nil
+	}).Should(BeTrue())
+	obj, err = pubWlanMetrics.Get("global")
+	t.Expect(err).To(BeNil())
+	wlanMetrics := obj.(types.WlanMetrics)
+	t.Expect(wlanMetrics.Interface).To(Equal("wlan0"))
+	t.Expect(wlanMetrics.RSSI).To(BeEquivalentTo(-52))
+	t.Expect(wlanMetrics.TxBitrateKbps).To(BeEquivalentTo(65000))
+
 	// Impose radio silence.
 	// But actually there is a config error coming from upper layers,
 	// so there should be no change in the wwan config.
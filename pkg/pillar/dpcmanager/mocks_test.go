@@ -83,6 +83,61 @@ func (m *MockWwanWatcher) LoadMetrics() (types.WwanMetrics, error) {
 	return m.wwanMetrics, nil
 }
 
+// MockWlanWatcher allows to simulate WiFi status and metrics obtained
+// from wpa_supplicant. Can be injected to DpcManager.WlanWatcher in UTs.
+type MockWlanWatcher struct {
+	sync.Mutex
+	wlanStatus  types.WifiStatus
+	wlanMetrics types.WlanMetrics
+	wlanEvents  chan dpcmngr.WlanEvent
+}
+
+// UpdateStatus : simulate update of WiFi status.
+func (m *MockWlanWatcher) UpdateStatus(status types.WifiStatus) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.wlanStatus = status
+	if m.wlanEvents != nil {
+		m.wlanEvents <- dpcmngr.WlanEventNewStatus
+	}
+}
+
+// UpdateMetrics : simulate update of WiFi metrics.
+func (m *MockWlanWatcher) UpdateMetrics(metrics types.WlanMetrics) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.wlanMetrics = metrics
+	if m.wlanEvents != nil {
+		m.wlanEvents <- dpcmngr.WlanEventNewMetrics
+	}
+}
+
+// Watch for simulated wlan events.
+func (m *MockWlanWatcher) Watch(context.Context) (<-chan dpcmngr.WlanEvent, error) {
+	m.Lock()
+	defer m.Unlock()
+	if m.wlanEvents == nil {
+		m.wlanEvents = make(chan dpcmngr.WlanEvent, 10)
+	}
+	return m.wlanEvents, nil
+}
+
+// LoadStatus returns last status submitted via UpdateStatus.
+func (m *MockWlanWatcher) LoadStatus() types.WifiStatus {
+	m.Lock()
+	defer m.Unlock()
+	return m.wlanStatus
+}
+
+// LoadMetrics returns last metrics submitted via UpdateMetrics.
+func (m *MockWlanWatcher) LoadMetrics() types.WlanMetrics {
+	m.Lock()
+	defer m.Unlock()
+	return m.wlanMetrics
+}
+
 // MockGeoService allows to simulate geolocation service.
 // Can be injected to DpcManager.GeoService in UTs.
 type MockGeoService struct {
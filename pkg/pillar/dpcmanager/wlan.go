@@ -0,0 +1,454 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dpcmanager
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/wpactrl"
+)
+
+const (
+	// How often to try to connect to wpa_supplicant when its control
+	// interface is not available (e.g. WiFi is not used).
+	wlanReconnectPeriod = 10 * time.Second
+	wlanMetricsPeriod   = 30 * time.Second
+	// Scan results are published with DeviceNetworkStatus, do not scan
+	// too often to avoid frequent updates.
+	wlanScanPeriod = 10 * time.Minute
+	// Only networks with the strongest signal are reported.
+	maxWifiScanResults = 32
+	// Wait for the next event at most this long before checking if
+	// the event connection was closed.
+	wlanEventTimeout = time.Minute
+)
+
+// wlanWatcher obtains status and metrics of the WiFi adapter
+// from wpa_supplicant (running in the wlan microservice) over its control
+// interface. Note that currently only one WiFi adapter per device is supported.
+type wlanWatcher struct {
+	Log *base.LogObject
+
+	sync.Mutex
+	status  types.WifiStatus
+	metrics types.WlanMetrics
+
+	// Used only by the run goroutine.
+	reqConn   *wpactrl.Conn
+	eventConn *wpactrl.Conn
+	events    chan wpactrl.Event
+	eventErrs chan error
+	stopRead  chan struct{}
+	lastErr   string
+}
+
+func (w *wlanWatcher) Watch(ctx context.Context) (<-chan WlanEvent, error) {
+	// Buffered to not block the watcher, events are coalesced since
+	// LoadStatus and LoadMetrics always return the latest state.
+	sub := make(chan WlanEvent, 10)
+	go w.run(ctx, sub)
+	return sub, nil
+}
+
+// LoadStatus returns the latest WiFi status.
+func (w *wlanWatcher) LoadStatus() types.WifiStatus {
+	w.Lock()
+	defer w.Unlock()
+	status := w.status
+	status.ScanResults = append([]types.WifiScanResult{}, w.status.ScanResults...)
+	return status
+}
+
+// LoadMetrics returns the latest WiFi metrics.
+func (w *wlanWatcher) LoadMetrics() types.WlanMetrics {
+	w.Lock()
+	defer w.Unlock()
+	return w.metrics
+}
+
+func (w *wlanWatcher) run(ctx context.Context, sub chan WlanEvent) {
+	reconnectTicker := time.NewTicker(wlanReconnectPeriod)
+	metricsTicker := time.NewTicker(wlanMetricsPeriod)
+	scanTicker := time.NewTicker(wlanScanPeriod)
+	defer reconnectTicker.Stop()
+	defer metricsTicker.Stop()
+	defer scanTicker.Stop()
+	defer w.disconnect()
+
+	w.connect(sub)
+	for {
+		select {
+		case <-reconnectTicker.C:
+			if w.reqConn == nil {
+				w.connect(sub)
+			}
+		case <-metricsTicker.C:
+			w.updateMetrics(sub)
+		case <-scanTicker.C:
+			w.requestScan(sub)
+		case event := <-w.events:
+			w.handleEvent(event, sub)
+		case err := <-w.eventErrs:
+			w.failed(fmt.Errorf("lost connection to wpa_supplicant: %w", err), sub)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// connect opens the control interface of wpa_supplicant
+// and loads the current state.
+func (w *wlanWatcher) connect(sub chan WlanEvent) {
+	ifName, err := w.findWpaInterface()
+	if err != nil {
+		w.logOnce(err)
+		return
+	}
+	if ifName == "" {
+		// wpa_supplicant is not running.
+		return
+	}
+	w.reqConn, err = wpactrl.Open(devicenetwork.WpaCtrlDir, ifName,
+		devicenetwork.RunWlanDir)
+	if err != nil {
+		w.failed(err, sub)
+		return
+	}
+	w.eventConn, err = wpactrl.Open(devicenetwork.WpaCtrlDir, ifName,
+		devicenetwork.RunWlanDir)
+	if err == nil {
+		err = w.eventConn.Attach()
+	}
+	if err != nil {
+		w.failed(err, sub)
+		return
+	}
+	w.Log.Noticef("Connected to wpa_supplicant for interface %s", ifName)
+	w.lastErr = ""
+	w.events = make(chan wpactrl.Event, 10)
+	w.eventErrs = make(chan error, 1)
+	w.stopRead = make(chan struct{})
+	go w.readEvents(w.eventConn, w.events, w.eventErrs, w.stopRead)
+
+	w.Lock()
+	w.status = types.WifiStatus{Interface: ifName}
+	w.Unlock()
+	w.updateStatus(sub)
+	w.updateScanResults(sub)
+	w.updateMetrics(sub)
+}
+
+// findWpaInterface returns the name of the interface with the control
+// socket created by wpa_supplicant.
+func (w *wlanWatcher) findWpaInterface() (string, error) {
+	entries, err := ioutil.ReadDir(devicenetwork.WpaCtrlDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	for _, entry := range entries {
+		if entry.Mode()&os.ModeSocket != 0 {
+			return entry.Name(), nil
+		}
+	}
+	return "", nil
+}
+
+func (w *wlanWatcher) readEvents(conn *wpactrl.Conn, events chan<- wpactrl.Event,
+	errs chan<- error, stop <-chan struct{}) {
+	for {
+		event, err := conn.ReadEvent(wlanEventTimeout)
+		if err == wpactrl.ErrTimeout {
+			select {
+			case <-stop:
+				return
+			default:
+				continue
+			}
+		}
+		if err != nil {
+			select {
+			case errs <- err:
+			case <-stop:
+			}
+			return
+		}
+		select {
+		case events <- event:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (w *wlanWatcher) disconnect() {
+	if w.stopRead != nil {
+		close(w.stopRead)
+		w.stopRead = nil
+	}
+	if w.eventConn != nil {
+		w.eventConn.Close()
+		w.eventConn = nil
+	}
+	if w.reqConn != nil {
+		w.reqConn.Close()
+		w.reqConn = nil
+	}
+	// Reading from nil channels blocks forever.
+	w.events = nil
+	w.eventErrs = nil
+}
+
+// failed closes the connection and publishes the error with the status.
+// Connection is retried periodically.
+func (w *wlanWatcher) failed(err error, sub chan WlanEvent) {
+	w.logOnce(err)
+	w.disconnect()
+	w.Lock()
+	interfaceName := w.status.Interface
+	w.status = types.WifiStatus{
+		Interface: interfaceName,
+		Error:     err.Error(),
+	}
+	w.metrics = types.WlanMetrics{}
+	w.Unlock()
+	w.notify(sub, WlanEventNewStatus)
+	w.notify(sub, WlanEventNewMetrics)
+}
+
+// logOnce avoids logging the same error on every reconnect attempt.
+func (w *wlanWatcher) logOnce(err error) {
+	if err.Error() != w.lastErr {
+		w.Log.Warnf("wlanWatcher: %v", err)
+		w.lastErr = err.Error()
+	}
+}
+
+func (w *wlanWatcher) notify(sub chan WlanEvent, event WlanEvent) {
+	select {
+	case sub <- event:
+	default:
+		// Receiver is busy, it will load the latest state anyway.
+	}
+}
+
+func (w *wlanWatcher) handleEvent(event wpactrl.Event, sub chan WlanEvent) {
+	switch event.Name {
+	case wpactrl.EventConnected:
+		w.Log.Noticef("wlanWatcher: %s", event.Text)
+		w.Lock()
+		w.status.AuthFailures = 0
+		w.Unlock()
+		w.updateStatus(sub)
+		w.updateMetrics(sub)
+	case wpactrl.EventDisconnected, wpactrl.EventStateChange:
+		w.updateStatus(sub)
+	case wpactrl.EventSSIDTempDisabled:
+		w.Log.Warnf("wlanWatcher: %s", event.Text)
+		failures, _ := strconv.ParseUint(event.Params["auth_failures"], 10, 32)
+		w.Lock()
+		w.status.AuthFailures = uint32(failures)
+		w.status.LastAuthFailure = event.Params["reason"]
+		w.status.LastAuthFailureTime = time.Now()
+		w.Unlock()
+		w.notify(sub, WlanEventNewStatus)
+	case wpactrl.EventScanResults:
+		w.updateScanResults(sub)
+	case wpactrl.EventTerminating:
+		w.Log.Noticef("wlanWatcher: wpa_supplicant is terminating")
+		w.disconnect()
+		w.Lock()
+		w.status = types.WifiStatus{}
+		w.metrics = types.WlanMetrics{}
+		w.Unlock()
+		w.notify(sub, WlanEventNewStatus)
+		w.notify(sub, WlanEventNewMetrics)
+	}
+}
+
+func (w *wlanWatcher) updateStatus(sub chan WlanEvent) {
+	if w.reqConn == nil {
+		// Not connected or the connection has just failed.
+		return
+	}
+	reply, err := w.reqConn.Request("STATUS")
+	if err != nil {
+		w.failed(err, sub)
+		return
+	}
+	wpaStatus, err := wpactrl.ParseStatus(reply)
+	if err != nil {
+		w.failed(err, sub)
+		return
+	}
+	w.Lock()
+	defer w.Unlock()
+	oldStatus := w.status
+	w.status.State = wifiStateFromWpa(wpaStatus.WpaState)
+	w.status.SSID = wpaStatus.SSID
+	w.status.BSSID = wpaStatus.BSSID
+	w.status.FrequencyMHz = wpaStatus.Frequency
+	w.status.KeyMgmt = wpaStatus.KeyMgmt
+	w.status.Error = ""
+	if w.status.State == types.WifiStateConnected {
+		w.status.AuthFailures = 0
+	}
+	if !reflect.DeepEqual(oldStatus, w.status) {
+		if oldStatus.State != w.status.State {
+			w.Log.Noticef("wlanWatcher: WiFi state changed from %q to %q (SSID: %q)",
+				oldStatus.State, w.status.State, w.status.SSID)
+		}
+		w.notify(sub, WlanEventNewStatus)
+	}
+}
+
+func (w *wlanWatcher) updateScanResults(sub chan WlanEvent) {
+	if w.reqConn == nil {
+		return
+	}
+	reply, err := w.reqConn.Request("SCAN_RESULTS")
+	if err != nil {
+		w.failed(err, sub)
+		return
+	}
+	wpaResults, err := wpactrl.ParseScanResults(reply)
+	if err != nil {
+		w.Log.Warnf("wlanWatcher: %v", err)
+		return
+	}
+	sort.SliceStable(wpaResults, func(i, j int) bool {
+		return wpaResults[i].SignalLevel > wpaResults[j].SignalLevel
+	})
+	if len(wpaResults) > maxWifiScanResults {
+		wpaResults = wpaResults[:maxWifiScanResults]
+	}
+	var results []types.WifiScanResult
+	for _, result := range wpaResults {
+		results = append(results, types.WifiScanResult{
+			SSID:         result.SSID,
+			BSSID:        result.BSSID,
+			FrequencyMHz: result.Frequency,
+			SignalLevel:  result.SignalLevel,
+			Flags:        result.Flags,
+		})
+	}
+	w.Lock()
+	w.status.ScanResults = results
+	w.status.LastScanTime = time.Now()
+	w.Unlock()
+	w.notify(sub, WlanEventNewStatus)
+}
+
+func (w *wlanWatcher) requestScan(sub chan WlanEvent) {
+	if w.reqConn == nil {
+		return
+	}
+	reply, err := w.reqConn.Request("SCAN")
+	if err != nil {
+		w.failed(err, sub)
+		return
+	}
+	// FAIL-BUSY is returned if a scan is already in progress.
+	w.Log.Functionf("wlanWatcher: SCAN: %s", reply)
+}
+
+func (w *wlanWatcher) updateMetrics(sub chan WlanEvent) {
+	if w.reqConn == nil {
+		return
+	}
+	w.Lock()
+	connected := w.status.State == types.WifiStateConnected
+	ifName := w.status.Interface
+	w.Unlock()
+	var metrics types.WlanMetrics
+	if connected {
+		reply, err := w.reqConn.Request("SIGNAL_POLL")
+		if err != nil {
+			w.failed(err, sub)
+			return
+		}
+		signal, err := wpactrl.ParseSignalPoll(reply)
+		if err != nil {
+			// Not supported by all drivers.
+			w.Log.Functionf("wlanWatcher: %v", err)
+		} else {
+			metrics = types.WlanMetrics{
+				Interface:     ifName,
+				RSSI:          signal.RSSI,
+				Noise:         signal.Noise,
+				TxBitrateKbps: signal.LinkSpeed * 1000,
+				FrequencyMHz:  signal.Frequency,
+			}
+			if signal.Noise == wpactrl.NoiseUnknown {
+				metrics.Noise = math.MaxInt32
+			}
+		}
+	}
+	w.Lock()
+	changed := !reflect.DeepEqual(w.metrics, metrics)
+	w.metrics = metrics
+	w.Unlock()
+	if changed {
+		w.notify(sub, WlanEventNewMetrics)
+	}
+}
+
+// wifiStateFromWpa maps state of the wpa_supplicant state machine
+// to WifiState.
+func wifiStateFromWpa(wpaState string) types.WifiState {
+	switch wpaState {
+	case "COMPLETED":
+		return types.WifiStateConnected
+	case "AUTHENTICATING", "ASSOCIATING", "ASSOCIATED",
+		"4WAY_HANDSHAKE", "GROUP_HANDSHAKE":
+		return types.WifiStateConnecting
+	case "SCANNING":
+		return types.WifiStateScanning
+	case "DISCONNECTED", "INACTIVE":
+		return types.WifiStateDisconnected
+	case "INTERFACE_DISABLED":
+		return types.WifiStateDisabled
+	}
+	return types.WifiStateUnspecified
+}
+
+// reloadWlanStatus loads the latest WiFi status obtained from wpa_supplicant.
+func (m *DpcManager) reloadWlanStatus() {
+	status := m.WlanWatcher.LoadStatus()
+	if reflect.DeepEqual(m.wlanStatus, status) {
+		return
+	}
+	m.Log.Functionf("Have new wlan status: %+v", status)
+	m.wlanStatus = status
+	m.updateDNS()
+}
+
+// reloadWlanMetrics loads the latest WiFi metrics obtained from wpa_supplicant.
+func (m *DpcManager) reloadWlanMetrics() {
+	metrics := m.WlanWatcher.LoadMetrics()
+	if reflect.DeepEqual(metrics, m.wlanMetrics) {
+		return
+	}
+	m.wlanMetrics = metrics
+	if m.PubWlanMetrics != nil {
+		m.Log.Functionf("PubWlanMetrics: %+v", metrics)
+		if err := m.PubWlanMetrics.Publish("global", metrics); err != nil {
+			m.Log.Errorf("Failed to publish wlan metrics: %v", err)
+		}
+	}
+}
@@ -30,6 +30,8 @@ type Args struct {
 	AA  types.AssignableAdapters
 	RS  types.RadioSilence
 	GCP types.ConfigItemValueMap
+	// UDP ports of the WireGuard tunnels of network instances.
+	WireGuardPorts []uint16
 }
 
 // ReconcileStatus : state data related to config reconciliation.
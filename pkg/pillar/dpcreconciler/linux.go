@@ -342,6 +342,9 @@ func (r *LinuxDpcReconciler) Reconcile(ctx context.Context, args Args) Reconcile
 		if r.rsChanged(args.RS) {
 			r.addPendingReconcile(WirelessSG, "RS change", false)
 		}
+		if r.wireGuardPortsChanged(args.WireGuardPorts) {
+			r.addPendingReconcile(ACLsSG, "WireGuard ports change", false)
+		}
 	}
 	if r.pendingReconcile.isPending {
 		reconcileSG = r.pendingReconcile.forSubGraph
@@ -381,7 +384,7 @@ func (r *LinuxDpcReconciler) Reconcile(ctx context.Context, args Args) Reconcile
 		case WirelessSG:
 			intSG = r.getIntendedWirelessCfg(args.DPC, args.AA, args.RS)
 		case ACLsSG:
-			intSG = r.getIntendedACLs(args.DPC, args.GCP)
+			intSG = r.getIntendedACLs(args.DPC, args.GCP, args.WireGuardPorts)
 		default:
 			// Only these top-level subgraphs are used for selective-reconcile for now.
 			r.Log.Fatalf("Unexpected SG select for reconcile: %s", reconcileSG)
@@ -553,6 +556,18 @@ func (r *LinuxDpcReconciler) rsChanged(newRS types.RadioSilence) bool {
 	return r.prevArgs.RS.Imposed != newRS.Imposed
 }
 
+func (r *LinuxDpcReconciler) wireGuardPortsChanged(newPorts []uint16) bool {
+	if len(newPorts) != len(r.prevArgs.WireGuardPorts) {
+		return true
+	}
+	for i := range newPorts {
+		if newPorts[i] != r.prevArgs.WireGuardPorts[i] {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *LinuxDpcReconciler) gcpChanged(newGCP types.ConfigItemValueMap) bool {
 	prevAuthKeys := r.prevArgs.GCP.GlobalValueString(types.SSHAuthorizedKeys)
 	newAuthKeys := newGCP.GlobalValueString(types.SSHAuthorizedKeys)
@@ -564,6 +579,21 @@ func (r *LinuxDpcReconciler) gcpChanged(newGCP types.ConfigItemValueMap) bool {
 	if prevAllowVNC != newAllowVNC {
 		return true
 	}
+	prevPeerCachePort := r.prevArgs.GCP.GlobalValueInt(types.PeerCachePort)
+	newPeerCachePort := newGCP.GlobalValueInt(types.PeerCachePort)
+	if prevPeerCachePort != newPeerCachePort {
+		return true
+	}
+	prevExporterPort := r.prevArgs.GCP.GlobalValueInt(types.MetricsExporterPort)
+	newExporterPort := newGCP.GlobalValueInt(types.MetricsExporterPort)
+	if prevExporterPort != newExporterPort {
+		return true
+	}
+	prevExporterIntf := r.prevArgs.GCP.GlobalValueString(types.MetricsExporterInterface)
+	newExporterIntf := newGCP.GlobalValueString(types.MetricsExporterInterface)
+	if prevExporterIntf != newExporterIntf {
+		return true
+	}
 	return false
 }
 
@@ -681,7 +711,7 @@ func (r *LinuxDpcReconciler) updateIntendedState(args Args) {
 	r.intendedState.PutSubGraph(r.getIntendedLogicalIO(args.DPC))
 	r.intendedState.PutSubGraph(r.getIntendedL3Cfg(args.DPC))
 	r.intendedState.PutSubGraph(r.getIntendedWirelessCfg(args.DPC, args.AA, args.RS))
-	r.intendedState.PutSubGraph(r.getIntendedACLs(args.DPC, args.GCP))
+	r.intendedState.PutSubGraph(r.getIntendedACLs(args.DPC, args.GCP, args.WireGuardPorts))
 }
 
 func (r *LinuxDpcReconciler) getIntendedGlobalCfg(dpc types.DevicePortConfig) dg.Graph {
@@ -1185,8 +1215,8 @@ func (r *LinuxDpcReconciler) getIntendedWwanConfig(dpc types.DevicePortConfig,
 	return generic.Wwan{Config: config}
 }
 
-func (r *LinuxDpcReconciler) getIntendedACLs(
-	dpc types.DevicePortConfig, gcp types.ConfigItemValueMap) dg.Graph {
+func (r *LinuxDpcReconciler) getIntendedACLs(dpc types.DevicePortConfig,
+	gcp types.ConfigItemValueMap, wireGuardPorts []uint16) dg.Graph {
 	graphArgs := dg.InitArgs{
 		Name:        ACLsSG,
 		Description: "Device-wide ACLs",
@@ -1302,6 +1332,51 @@ func (r *LinuxDpcReconciler) getIntendedACLs(
 		markSSHAndGuacamole, markVnc, markIcmpV6,
 	}
 
+	// Allow other EVE devices to discover and download blobs from the peer cache.
+	if peerCachePort := gcp.GlobalValueInt(types.PeerCachePort); peerCachePort != 0 {
+		markPeerCache := linux.IptablesRule{
+			Args: []string{"-p", "tcp", "--dport", strconv.FormatUint(uint64(peerCachePort), 10),
+				"-j", "CONNMARK", "--set-mark", iptables.ControlProtocolMarkingIDMap["in_peer_cache"]},
+			Description: "Mark peer cache traffic",
+		}
+		markMdns := linux.IptablesRule{
+			Args: []string{"-p", "udp", "--dport", "5353",
+				"-j", "CONNMARK", "--set-mark", iptables.ControlProtocolMarkingIDMap["in_peer_cache"]},
+			Description: "Mark mDNS traffic",
+		}
+		mangleV4Rules = append(mangleV4Rules, markPeerCache, markMdns)
+		mangleV6Rules = append(mangleV6Rules, markPeerCache, markMdns)
+	}
+
+	// Allow scrapes of the local metrics endpoint if it listens on a device port.
+	exporterPort := gcp.GlobalValueInt(types.MetricsExporterPort)
+	exporterIntf := gcp.GlobalValueString(types.MetricsExporterInterface)
+	for _, port := range dpc.Ports {
+		if exporterPort == 0 || exporterIntf == "" ||
+			(port.Logicallabel != exporterIntf && port.IfName != exporterIntf) {
+			continue
+		}
+		markMetricsExporter := linux.IptablesRule{
+			Args: []string{"-i", port.IfName, "-p", "tcp",
+				"--dport", strconv.FormatUint(uint64(exporterPort), 10),
+				"-j", "CONNMARK", "--set-mark", iptables.ControlProtocolMarkingIDMap["in_metrics_exporter"]},
+			Description: "Mark metrics exporter traffic",
+		}
+		mangleV4Rules = append(mangleV4Rules, markMetricsExporter)
+		mangleV6Rules = append(mangleV6Rules, markMetricsExporter)
+	}
+
+	// Allow peers to connect to WireGuard tunnels of network instances.
+	for _, wgPort := range wireGuardPorts {
+		markWireGuard := linux.IptablesRule{
+			Args: []string{"-p", "udp", "--dport", strconv.FormatUint(uint64(wgPort), 10),
+				"-j", "CONNMARK", "--set-mark", iptables.ControlProtocolMarkingIDMap["in_vpn_control"]},
+			Description: "Mark WireGuard traffic",
+		}
+		mangleV4Rules = append(mangleV4Rules, markWireGuard)
+		mangleV6Rules = append(mangleV6Rules, markWireGuard)
+	}
+
 	// Mark incoming traffic not matched by the rules above with the DROP action.
 	const dropIncomingChain = "drop-incoming"
 	incomingDefDrop := iptables.GetConnmark(0, iptables.DefaultDropAceID, true)
@@ -23,6 +23,7 @@ import (
 	dpcrec "github.com/lf-edge/eve/pkg/pillar/dpcreconciler"
 	generic "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/genericitems"
 	linux "github.com/lf-edge/eve/pkg/pillar/dpcreconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
@@ -330,6 +331,93 @@ func TestSingleEthInterface(test *testing.T) {
 	t.Expect(itemCountWithType(generic.DhcpcdTypename)).To(Equal(0))
 }
 
+func TestMetricsExporterACL(test *testing.T) {
+	t := initTest(test)
+	eth0 := netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       1,
+			IfName:        "eth0",
+			IfType:        "device",
+			WithBroadcast: true,
+			AdminUp:       true,
+			LowerUp:       true,
+		},
+		HwAddr: macAddress("02:00:00:00:00:01"),
+	}
+	networkMonitor.AddOrUpdateInterface(eth0)
+	gcp := types.DefaultConfigItemValueMap()
+	dpc := types.DevicePortConfig{
+		Version:      types.DPCIsMgmt,
+		Key:          "zedagent",
+		TimePriority: time.Now(),
+		Ports: []types.NetworkPortConfig{
+			{
+				IfName:       "eth0",
+				Phylabel:     "eth0",
+				Logicallabel: "mock-eth0",
+				IsMgmt:       true,
+				IsL3Port:     true,
+				DhcpConfig: types.DhcpConfig{
+					Dhcp: types.DT_CLIENT,
+					Type: types.NT_IPV4,
+				},
+			},
+		},
+	}
+	aa := types.AssignableAdapters{
+		Initialized: true,
+		IoBundleList: []types.IoBundle{
+			{
+				Type:         types.IoNetEth,
+				Phylabel:     "eth0",
+				Logicallabel: "mock-eth0",
+				Usage:        evecommon.PhyIoMemberUsage_PhyIoUsageMgmtAndApps,
+				Ifname:       "eth0",
+				MacAddr:      "02:00:00:00:00:01",
+				IsPort:       true,
+			},
+		},
+	}
+	markChain := dg.Reference(linux.IptablesChain{Table: "mangle",
+		ChainName: "PREROUTING-device"})
+
+	ctx := reconciler.MockRun(context.Background())
+	status := dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc, AA: aa})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(markChain)).ToNot(ContainSubstring("Mark metrics exporter traffic"))
+
+	// Enable the metrics exporter on the management port
+	// (new GCP map since the reconciler keeps the previous one to detect changes)
+	gcp = types.DefaultConfigItemValueMap()
+	gcp.SetGlobalValueInt(types.MetricsExporterPort, 9100)
+	gcp.SetGlobalValueString(types.MetricsExporterInterface, "mock-eth0")
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc, AA: aa})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(markChain)).To(ContainSubstring(
+		"-i eth0 -p tcp --dport 9100 -j CONNMARK --set-mark " +
+			iptables.ControlProtocolMarkingIDMap["in_metrics_exporter"]))
+
+	// Change the port
+	gcp = types.DefaultConfigItemValueMap()
+	gcp.SetGlobalValueInt(types.MetricsExporterPort, 9101)
+	gcp.SetGlobalValueString(types.MetricsExporterInterface, "mock-eth0")
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc, AA: aa})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(markChain)).To(ContainSubstring("--dport 9101"))
+	t.Expect(itemDescription(markChain)).ToNot(ContainSubstring("--dport 9100"))
+
+	// Listening on a network instance does not open device ports
+	gcp = types.DefaultConfigItemValueMap()
+	gcp.SetGlobalValueInt(types.MetricsExporterPort, 9101)
+	gcp.SetGlobalValueString(types.MetricsExporterInterface, "local-ni")
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc, AA: aa})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(markChain)).ToNot(ContainSubstring("Mark metrics exporter traffic"))
+}
+
 func TestMultipleEthsSameSubnet(test *testing.T) {
 	t := initTest(test)
 	eth0Mac := "02:00:00:00:00:01"
@@ -655,3 +743,63 @@ func TestWireless(test *testing.T) {
 }
 
 // TODO: test for VLANs and Bonds
+
+func TestWireGuardACL(test *testing.T) {
+	t := initTest(test)
+	eth0 := netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       1,
+			IfName:        "eth0",
+			IfType:        "device",
+			WithBroadcast: true,
+			AdminUp:       true,
+			LowerUp:       true,
+		},
+		HwAddr: macAddress("02:00:00:00:00:01"),
+	}
+	networkMonitor.AddOrUpdateInterface(eth0)
+	gcp := types.DefaultConfigItemValueMap()
+	dpc := types.DevicePortConfig{
+		Version:      types.DPCIsMgmt,
+		Key:          "zedagent",
+		TimePriority: time.Now(),
+		Ports: []types.NetworkPortConfig{
+			{
+				IfName:       "eth0",
+				Phylabel:     "eth0",
+				Logicallabel: "mock-eth0",
+				IsMgmt:       true,
+				IsL3Port:     true,
+				DhcpConfig: types.DhcpConfig{
+					Dhcp: types.DT_CLIENT,
+					Type: types.NT_IPV4,
+				},
+			},
+		},
+	}
+	markChain := dg.Reference(linux.IptablesChain{Table: "mangle",
+		ChainName: "PREROUTING-device"})
+
+	ctx := reconciler.MockRun(context.Background())
+	status := dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(markChain)).ToNot(ContainSubstring("Mark WireGuard traffic"))
+
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc,
+		WireGuardPorts: []uint16{51820, 51821}})
+	t.Expect(status.Error).To(BeNil())
+	for _, port := range []string{"51820", "51821"} {
+		t.Expect(itemDescription(markChain)).To(ContainSubstring(
+			"-p udp --dport " + port + " -j CONNMARK --set-mark " +
+				iptables.ControlProtocolMarkingIDMap["in_vpn_control"]))
+	}
+
+	// Network instance with the tunnel was deleted
+	ctx = reconciler.MockRun(context.Background())
+	status = dpcReconciler.Reconcile(ctx, dpcrec.Args{GCP: *gcp, DPC: dpc,
+		WireGuardPorts: []uint16{51821}})
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemDescription(markChain)).To(ContainSubstring("--dport 51821"))
+	t.Expect(itemDescription(markChain)).ToNot(ContainSubstring("--dport 51820"))
+}
@@ -136,6 +136,8 @@ func (c *WlanConfigurator) installWifiConfig(config []WifiConfig) error {
 		c.Log.Error(err)
 		return err
 	}
+	// Control interface is used by nim to obtain WiFi status and metrics.
+	tmpfile.WriteString(fmt.Sprintf("ctrl_interface=%s\n", devicenetwork.WpaCtrlDir))
 	if len(config) == 0 {
 		// generate dummy wpa_supplicant.conf
 		tmpfile.WriteString("# Fill in the networks and their passwords\nnetwork={\n")
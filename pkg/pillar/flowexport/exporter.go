@@ -0,0 +1,300 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package flowexport exports application flow records and DNS requests
+// collected by zedrouter to an IPFIX (RFC 7011) or NetFlow v9 (RFC 3954)
+// collector over UDP.
+//
+// Source of every flow record is the application endpoint. Application UUID,
+// application name and ACE ID are exported as enterprise-specific fields
+// (under the configured PEN for IPFIX and as field types above 0x8000
+// for NetFlow v9). Traffic received by the application is reported using
+// reverse information elements (RFC 5103) with IPFIX and OUT_BYTES/OUT_PKTS
+// with NetFlow v9.
+package flowexport
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	ipfixVersion     = 10
+	netflowV9Version = 9
+	// Set IDs of template sets.
+	ipfixTemplateSetID     = 2
+	netflowV9TemplateSetID = 0
+	ipfixHeaderLen         = 16
+	netflowV9HeaderLen     = 20
+	setHeaderLen           = 4
+	// Keep messages below the usual MTU to avoid IP fragmentation.
+	maxMessageLen = 1400
+	// Templates are resent periodically because UDP is unreliable
+	// and collectors may restart.
+	templateRefreshIntv = time.Minute
+	// PEN reserved for documentation (RFC 5612), used if not configured.
+	documentationPEN = 32473
+)
+
+// Exporter : exports flow records to one collector.
+// Exporter is not thread-safe.
+type Exporter struct {
+	log       *base.LogObject
+	config    types.FlowExporterConfig
+	domainID  uint32
+	conn      net.Conn
+	templates []template
+	started   time.Time
+	// Time when templates were last sent.
+	templatesSent time.Time
+	// IPFIX: number of data records sent, NetFlow v9: number of messages sent.
+	sequence uint32
+}
+
+// NewExporter creates exporter sending records to the configured collector.
+// domainID is used as the IPFIX observation domain ID or the NetFlow v9
+// source ID.
+func NewExporter(log *base.LogObject, config types.FlowExporterConfig,
+	domainID uint32) (*Exporter, error) {
+	if config.Collector == "" {
+		return nil, errors.New("collector address is not configured")
+	}
+	conn, err := net.Dial("udp", config.Collector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open UDP socket for collector %s: %w",
+			config.Collector, err)
+	}
+	netflowV9 := config.Protocol == types.FlowExportProtocolNetflowV9
+	pen := config.EnterpriseNumber
+	if pen == 0 {
+		pen = documentationPEN
+	}
+	return &Exporter{
+		log:       log,
+		config:    config,
+		domainID:  domainID,
+		conn:      conn,
+		templates: buildTemplates(netflowV9, pen),
+		started:   time.Now(),
+	}, nil
+}
+
+// Config returns the exporter configuration.
+func (e *Exporter) Config() types.FlowExporterConfig {
+	return e.config
+}
+
+// Close closes the exporter socket.
+func (e *Exporter) Close() error {
+	return e.conn.Close()
+}
+
+// Export sends flow records and DNS requests of one application.
+func (e *Exporter) Export(flows types.IPFlow, appName string) error {
+	records := e.buildRecords(flows, appName)
+	if len(records) == 0 {
+		return nil
+	}
+	messages := e.encodeMessages(records, time.Now())
+	for _, msg := range messages {
+		if _, err := e.conn.Write(msg); err != nil {
+			return fmt.Errorf("failed to send flow records to %s: %w",
+				e.config.Collector, err)
+		}
+	}
+	return nil
+}
+
+type templatedRecord struct {
+	templateID uint16
+	data       []byte
+}
+
+func (e *Exporter) netflowV9() bool {
+	return e.config.Protocol == types.FlowExportProtocolNetflowV9
+}
+
+func (e *Exporter) uptimeMs(t time.Time) uint32 {
+	if t.Before(e.started) {
+		return 0
+	}
+	return uint32(t.Sub(e.started).Milliseconds())
+}
+
+func (e *Exporter) getTemplate(id uint16) template {
+	for _, t := range e.templates {
+		if t.id == id {
+			return t
+		}
+	}
+	panic(fmt.Sprintf("unknown template %d", id))
+}
+
+func (e *Exporter) buildRecords(flows types.IPFlow,
+	appName string) (records []templatedRecord) {
+	appUUID := flows.Scope.UUID
+	for _, flow := range flows.Flows {
+		start := time.Unix(0, flow.StartTime)
+		end := time.Unix(0, flow.StopTime)
+		r := &record{
+			src:         flow.Flow.Src,
+			dst:         flow.Flow.Dst,
+			sport:       uint16(flow.Flow.SrcPort),
+			dport:       uint16(flow.Flow.DstPort),
+			proto:       uint8(flow.Flow.Proto),
+			inbound:     flow.Inbound,
+			denied:      flow.Action == types.ACLActionDrop,
+			aclID:       uint32(flow.ACLID),
+			startMs:     uint64(start.UnixMilli()),
+			endMs:       uint64(end.UnixMilli()),
+			startUptime: e.uptimeMs(start),
+			endUptime:   e.uptimeMs(end),
+			txBytes:     uint64(flow.TxBytes),
+			txPkts:      uint64(flow.TxPkts),
+			rxBytes:     uint64(flow.RxBytes),
+			rxPkts:      uint64(flow.RxPkts),
+			appUUID:     appUUID,
+			appName:     appName,
+		}
+		templateID := flowIPv4TemplateID
+		if r.src.To4() == nil {
+			templateID = flowIPv6TemplateID
+		}
+		records = append(records, templatedRecord{
+			templateID: templateID,
+			data:       e.getTemplate(templateID).encodeRecord(r),
+		})
+	}
+	dnsTemplate := e.getTemplate(dnsTemplateID)
+	for _, dns := range flows.DNSReqs {
+		requested := time.Unix(0, dns.RequestTime)
+		r := &record{
+			appUUID:     appUUID,
+			appName:     appName,
+			dnsHost:     dns.HostName,
+			startMs:     uint64(requested.UnixMilli()),
+			startUptime: e.uptimeMs(requested),
+		}
+		answers := dns.Addrs
+		if len(answers) == 0 {
+			// Report the request even if it was not answered.
+			answers = []net.IP{nil}
+		}
+		for _, answer := range answers {
+			r.dnsAnswer = answer
+			records = append(records, templatedRecord{
+				templateID: dnsTemplateID,
+				data:       dnsTemplate.encodeRecord(r),
+			})
+		}
+	}
+	return records
+}
+
+// message : IPFIX or NetFlow v9 message being built.
+type message struct {
+	sets    [][]byte
+	length  int
+	records int // data records
+	count   int // template and data records (NetFlow v9 header)
+}
+
+func (m *message) lastSetID() (uint16, bool) {
+	if len(m.sets) == 0 {
+		return 0, false
+	}
+	set := m.sets[len(m.sets)-1]
+	return uint16(set[0])<<8 | uint16(set[1]), true
+}
+
+func (e *Exporter) headerLen() int {
+	if e.netflowV9() {
+		return netflowV9HeaderLen
+	}
+	return ipfixHeaderLen
+}
+
+// encodeMessages packs records (and templates if due) into messages.
+func (e *Exporter) encodeMessages(records []templatedRecord, now time.Time) [][]byte {
+	var msgs []*message
+	msg := &message{length: e.headerLen()}
+	if now.Sub(e.templatesSent) >= templateRefreshIntv {
+		setID := uint16(ipfixTemplateSetID)
+		if e.netflowV9() {
+			setID = netflowV9TemplateSetID
+		}
+		set := append(uint16Value(setID), 0, 0)
+		for _, t := range e.templates {
+			set = append(set, t.encodeTemplate()...)
+			msg.count++
+		}
+		msg.sets = append(msg.sets, set)
+		msg.length += len(set)
+		e.templatesSent = now
+	}
+	for _, record := range records {
+		addLen := len(record.data)
+		lastSetID, hasSet := msg.lastSetID()
+		newSet := !hasSet || lastSetID != record.templateID
+		if newSet {
+			addLen += setHeaderLen
+		}
+		if msg.length+addLen > maxMessageLen && msg.records > 0 {
+			msgs = append(msgs, msg)
+			msg = &message{length: e.headerLen()}
+			newSet = true
+			addLen = len(record.data) + setHeaderLen
+		}
+		if newSet {
+			msg.sets = append(msg.sets, append(uint16Value(record.templateID), 0, 0))
+		}
+		lastSet := &msg.sets[len(msg.sets)-1]
+		*lastSet = append(*lastSet, record.data...)
+		msg.length += addLen
+		msg.records++
+		msg.count++
+	}
+	msgs = append(msgs, msg)
+	var encoded [][]byte
+	for _, m := range msgs {
+		encoded = append(encoded, e.encodeMessage(m, now))
+	}
+	return encoded
+}
+
+func (e *Exporter) encodeMessage(m *message, now time.Time) []byte {
+	var body []byte
+	for _, set := range m.sets {
+		if e.netflowV9() {
+			// FlowSets are padded to 32-bit boundary.
+			for len(set)%4 != 0 {
+				set = append(set, 0)
+			}
+		}
+		copy(set[2:4], uint16Value(uint16(len(set))))
+		body = append(body, set...)
+	}
+	var header []byte
+	if e.netflowV9() {
+		header = append(header, uint16Value(netflowV9Version)...)
+		header = append(header, uint16Value(uint16(m.count))...)
+		header = append(header, uint32Value(e.uptimeMs(now))...)
+		header = append(header, uint32Value(uint32(now.Unix()))...)
+		header = append(header, uint32Value(e.sequence)...)
+		header = append(header, uint32Value(e.domainID)...)
+		e.sequence++
+	} else {
+		header = append(header, uint16Value(ipfixVersion)...)
+		header = append(header, uint16Value(uint16(ipfixHeaderLen+len(body)))...)
+		header = append(header, uint32Value(uint32(now.Unix()))...)
+		header = append(header, uint32Value(e.sequence)...)
+		header = append(header, uint32Value(e.domainID)...)
+		e.sequence += uint32(m.records)
+	}
+	return append(header, body...)
+}
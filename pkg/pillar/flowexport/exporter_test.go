@@ -0,0 +1,177 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package flowexport
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+func testFlows(numFlows int) types.IPFlow {
+	flows := types.IPFlow{
+		Scope: types.FlowScope{
+			UUID: uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		},
+	}
+	now := time.Now()
+	for i := 0; i < numFlows; i++ {
+		flows.Flows = append(flows.Flows, types.FlowRec{
+			Flow: types.IPTuple{
+				Src:     net.ParseIP("10.1.0.2"),
+				Dst:     net.ParseIP("192.168.1.10"),
+				SrcPort: int32(40000 + i),
+				DstPort: 443,
+				Proto:   6,
+			},
+			ACLID:     1,
+			Action:    types.ACLActionAccept,
+			StartTime: now.Add(-time.Minute).UnixNano(),
+			StopTime:  now.UnixNano(),
+			TxBytes:   1000,
+			TxPkts:    10,
+			RxBytes:   20000,
+			RxPkts:    20,
+		})
+	}
+	flows.DNSReqs = append(flows.DNSReqs, types.DNSReq{
+		HostName:    "example.com",
+		Addrs:       []net.IP{net.ParseIP("93.184.216.34"), net.ParseIP("2606:2800:220:1::")},
+		RequestTime: now.UnixNano(),
+	})
+	return flows
+}
+
+// parseSets returns IDs of sets contained in the message body.
+func parseSets(t *testing.T, body []byte) (setIDs []uint16) {
+	for len(body) > 0 {
+		if len(body) < setHeaderLen {
+			t.Fatalf("truncated set header")
+		}
+		setID := binary.BigEndian.Uint16(body[0:2])
+		setLen := int(binary.BigEndian.Uint16(body[2:4]))
+		if setLen < setHeaderLen || setLen > len(body) {
+			t.Fatalf("invalid length %d of set %d", setLen, setID)
+		}
+		setIDs = append(setIDs, setID)
+		body = body[setLen:]
+	}
+	return setIDs
+}
+
+func newTestExporter(t *testing.T, protocol types.FlowExportProtocol) (
+	*Exporter, net.PacketConn) {
+	collector, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to open collector socket: %v", err)
+	}
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	exporter, err := NewExporter(log, types.FlowExporterConfig{
+		Collector:        collector.LocalAddr().String(),
+		Protocol:         protocol,
+		EnterpriseNumber: 32473,
+	}, 7)
+	if err != nil {
+		t.Fatalf("NewExporter failed: %v", err)
+	}
+	return exporter, collector
+}
+
+func receive(t *testing.T, collector net.PacketConn) []byte {
+	buf := make([]byte, 65535)
+	collector.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := collector.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("failed to receive message: %v", err)
+	}
+	return buf[:n]
+}
+
+func TestExportIPFIX(t *testing.T) {
+	exporter, collector := newTestExporter(t, types.FlowExportProtocolIPFIX)
+	defer exporter.Close()
+	defer collector.Close()
+
+	if err := exporter.Export(testFlows(1), "app1"); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	msg := receive(t, collector)
+	if version := binary.BigEndian.Uint16(msg[0:2]); version != ipfixVersion {
+		t.Errorf("expected IPFIX version, got %d", version)
+	}
+	if length := int(binary.BigEndian.Uint16(msg[2:4])); length != len(msg) {
+		t.Errorf("header length %d does not match message length %d", length, len(msg))
+	}
+	if seq := binary.BigEndian.Uint32(msg[8:12]); seq != 0 {
+		t.Errorf("expected sequence number 0, got %d", seq)
+	}
+	if domain := binary.BigEndian.Uint32(msg[12:16]); domain != 7 {
+		t.Errorf("expected observation domain 7, got %d", domain)
+	}
+	setIDs := parseSets(t, msg[ipfixHeaderLen:])
+	expSets := []uint16{ipfixTemplateSetID, flowIPv4TemplateID, dnsTemplateID}
+	if len(setIDs) != len(expSets) {
+		t.Fatalf("expected sets %v, got %v", expSets, setIDs)
+	}
+	for i := range setIDs {
+		if setIDs[i] != expSets[i] {
+			t.Errorf("expected sets %v, got %v", expSets, setIDs)
+		}
+	}
+
+	// Templates are not repeated, sequence number counts data records
+	// (one flow and two DNS answers).
+	if err := exporter.Export(testFlows(1), "app1"); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	msg = receive(t, collector)
+	if seq := binary.BigEndian.Uint32(msg[8:12]); seq != 3 {
+		t.Errorf("expected sequence number 3, got %d", seq)
+	}
+	setIDs = parseSets(t, msg[ipfixHeaderLen:])
+	if len(setIDs) != 2 || setIDs[0] != flowIPv4TemplateID {
+		t.Errorf("expected only data sets, got %v", setIDs)
+	}
+}
+
+func TestExportNetflowV9Split(t *testing.T) {
+	exporter, collector := newTestExporter(t, types.FlowExportProtocolNetflowV9)
+	defer exporter.Close()
+	defer collector.Close()
+
+	const numFlows = 100
+	if err := exporter.Export(testFlows(numFlows), "app1"); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	var records int
+	for seq := uint32(0); records < numFlows+2+len(exporter.templates); seq++ {
+		msg := receive(t, collector)
+		if len(msg) > maxMessageLen+4 {
+			t.Errorf("message too long: %d bytes", len(msg))
+		}
+		if version := binary.BigEndian.Uint16(msg[0:2]); version != netflowV9Version {
+			t.Fatalf("expected NetFlow v9 version, got %d", version)
+		}
+		if msgSeq := binary.BigEndian.Uint32(msg[12:16]); msgSeq != seq {
+			t.Errorf("expected sequence number %d, got %d", seq, msgSeq)
+		}
+		for _, setID := range parseSets(t, msg[netflowV9HeaderLen:]) {
+			if setID != netflowV9TemplateSetID && setID < flowIPv4TemplateID {
+				t.Errorf("unexpected FlowSet ID %d", setID)
+			}
+		}
+		records += int(binary.BigEndian.Uint16(msg[2:4]))
+	}
+	if records != numFlows+2+len(exporter.templates) {
+		t.Errorf("expected %d records, got %d",
+			numFlows+2+len(exporter.templates), records)
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package flowexport
+
+import (
+	"encoding/binary"
+	"net"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Template IDs (the same for IPFIX and NetFlow v9).
+const (
+	flowIPv4TemplateID uint16 = 256
+	flowIPv6TemplateID uint16 = 257
+	dnsTemplateID      uint16 = 258
+)
+
+// Length of a variable-length IPFIX field (RFC 7011, section 7).
+const varLength uint16 = 0xffff
+
+// PEN of reverse information elements (RFC 5103).
+const reversePEN uint32 = 29305
+
+// Enterprise-specific fields with application information. IPFIX uses
+// the configured PEN, NetFlow v9 uses these numbers above 0x8000.
+const (
+	appUUIDField    uint16 = 1
+	appNameField    uint16 = 2
+	aclIDField      uint16 = 3
+	dnsHostField    uint16 = 4
+	dnsAnswerField  uint16 = 5
+	netflowV9Vendor uint16 = 0x8000
+)
+
+// Lengths of NetFlow v9 fields which are variable-length in IPFIX.
+const (
+	netflowV9AppNameLen = 64
+	netflowV9DNSHostLen = 128
+)
+
+// IANA information elements.
+const (
+	ieOctetDeltaCount        uint16 = 1
+	iePacketDeltaCount       uint16 = 2
+	ieProtocolIdentifier     uint16 = 4
+	ieSourceTransportPort    uint16 = 7
+	ieSourceIPv4Address      uint16 = 8
+	ieDestTransportPort      uint16 = 11
+	ieDestIPv4Address        uint16 = 12
+	ieLastSwitched           uint16 = 21 // NetFlow v9
+	ieFirstSwitched          uint16 = 22 // NetFlow v9
+	ieOutBytes               uint16 = 23 // NetFlow v9
+	ieOutPkts                uint16 = 24 // NetFlow v9
+	ieSourceIPv6Address      uint16 = 27
+	ieDestIPv6Address        uint16 = 28
+	ieFlowDirection          uint16 = 61
+	ieFlowStartMilliseconds  uint16 = 152
+	ieFlowEndMilliseconds    uint16 = 153
+	ieFirewallEvent          uint16 = 233
+	firewallEventFlowDeleted uint8  = 2
+	firewallEventFlowDenied  uint8  = 3
+)
+
+// record : flow or DNS record with all the values any template may need.
+type record struct {
+	src, dst     net.IP
+	sport, dport uint16
+	proto        uint8
+	inbound      bool
+	denied       bool
+	aclID        uint32
+	// epoch milliseconds
+	startMs, endMs uint64
+	// milliseconds since the exporter start (NetFlow v9)
+	startUptime, endUptime uint32
+	txBytes, txPkts        uint64
+	rxBytes, rxPkts        uint64
+	appUUID                uuid.UUID
+	appName                string
+	dnsHost                string
+	dnsAnswer              net.IP
+}
+
+// field : field of a template.
+type field struct {
+	id         uint16
+	enterprise uint32 // 0 for IANA elements
+	length     uint16
+	value      func(r *record) []byte
+}
+
+type template struct {
+	id     uint16
+	fields []field
+}
+
+func ipv4Value(ip net.IP) []byte {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return make([]byte, net.IPv4len)
+}
+
+func ipv6Value(ip net.IP) []byte {
+	if ip16 := ip.To16(); ip16 != nil {
+		return ip16
+	}
+	return make([]byte, net.IPv6len)
+}
+
+func uint8Value(v uint8) []byte {
+	return []byte{v}
+}
+
+func uint16Value(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+func uint32Value(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func uint64Value(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+// Fields shared by IPv4 and IPv6 flow templates, following addresses.
+func flowFields(netflowV9 bool, pen uint32) []field {
+	fields := []field{
+		{id: ieSourceTransportPort, length: 2,
+			value: func(r *record) []byte { return uint16Value(r.sport) }},
+		{id: ieDestTransportPort, length: 2,
+			value: func(r *record) []byte { return uint16Value(r.dport) }},
+		{id: ieProtocolIdentifier, length: 1,
+			value: func(r *record) []byte { return uint8Value(r.proto) }},
+		// 0 - ingress (towards the app), 1 - egress (initiated by the app)
+		{id: ieFlowDirection, length: 1,
+			value: func(r *record) []byte {
+				if r.inbound {
+					return uint8Value(0)
+				}
+				return uint8Value(1)
+			}},
+		{id: ieFirewallEvent, length: 1,
+			value: func(r *record) []byte {
+				if r.denied {
+					return uint8Value(firewallEventFlowDenied)
+				}
+				return uint8Value(firewallEventFlowDeleted)
+			}},
+	}
+	// Source is always the application endpoint, the forward counters are
+	// those of the traffic sent by the application.
+	if netflowV9 {
+		fields = append(fields,
+			field{id: ieFirstSwitched, length: 4,
+				value: func(r *record) []byte { return uint32Value(r.startUptime) }},
+			field{id: ieLastSwitched, length: 4,
+				value: func(r *record) []byte { return uint32Value(r.endUptime) }},
+			field{id: ieOctetDeltaCount, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.txBytes) }},
+			field{id: iePacketDeltaCount, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.txPkts) }},
+			field{id: ieOutBytes, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.rxBytes) }},
+			field{id: ieOutPkts, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.rxPkts) }},
+		)
+	} else {
+		fields = append(fields,
+			field{id: ieFlowStartMilliseconds, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.startMs) }},
+			field{id: ieFlowEndMilliseconds, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.endMs) }},
+			field{id: ieOctetDeltaCount, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.txBytes) }},
+			field{id: iePacketDeltaCount, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.txPkts) }},
+			field{id: ieOctetDeltaCount, enterprise: reversePEN, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.rxBytes) }},
+			field{id: iePacketDeltaCount, enterprise: reversePEN, length: 8,
+				value: func(r *record) []byte { return uint64Value(r.rxPkts) }},
+		)
+	}
+	fields = append(fields, appFields(netflowV9, pen)...)
+	return append(fields, field{
+		id: enterpriseFieldID(aclIDField, netflowV9), enterprise: enterprisePEN(pen, netflowV9),
+		length: 4, value: func(r *record) []byte { return uint32Value(r.aclID) }})
+}
+
+func appFields(netflowV9 bool, pen uint32) []field {
+	nameLen := varLength
+	if netflowV9 {
+		nameLen = netflowV9AppNameLen
+	}
+	return []field{
+		{id: enterpriseFieldID(appUUIDField, netflowV9),
+			enterprise: enterprisePEN(pen, netflowV9), length: uuid.Size,
+			value: func(r *record) []byte { return r.appUUID.Bytes() }},
+		{id: enterpriseFieldID(appNameField, netflowV9),
+			enterprise: enterprisePEN(pen, netflowV9), length: nameLen,
+			value: func(r *record) []byte { return []byte(r.appName) }},
+	}
+}
+
+func enterpriseFieldID(id uint16, netflowV9 bool) uint16 {
+	if netflowV9 {
+		return netflowV9Vendor | id
+	}
+	return id
+}
+
+func enterprisePEN(pen uint32, netflowV9 bool) uint32 {
+	if netflowV9 {
+		return 0
+	}
+	return pen
+}
+
+func buildTemplates(netflowV9 bool, pen uint32) []template {
+	flowIPv4 := template{id: flowIPv4TemplateID, fields: []field{
+		{id: ieSourceIPv4Address, length: net.IPv4len,
+			value: func(r *record) []byte { return ipv4Value(r.src) }},
+		{id: ieDestIPv4Address, length: net.IPv4len,
+			value: func(r *record) []byte { return ipv4Value(r.dst) }},
+	}}
+	flowIPv4.fields = append(flowIPv4.fields, flowFields(netflowV9, pen)...)
+	flowIPv6 := template{id: flowIPv6TemplateID, fields: []field{
+		{id: ieSourceIPv6Address, length: net.IPv6len,
+			value: func(r *record) []byte { return ipv6Value(r.src) }},
+		{id: ieDestIPv6Address, length: net.IPv6len,
+			value: func(r *record) []byte { return ipv6Value(r.dst) }},
+	}}
+	flowIPv6.fields = append(flowIPv6.fields, flowFields(netflowV9, pen)...)
+	hostLen := varLength
+	if netflowV9 {
+		hostLen = netflowV9DNSHostLen
+	}
+	dns := template{id: dnsTemplateID, fields: appFields(netflowV9, pen)}
+	dns.fields = append(dns.fields,
+		field{id: enterpriseFieldID(dnsHostField, netflowV9),
+			enterprise: enterprisePEN(pen, netflowV9), length: hostLen,
+			value: func(r *record) []byte { return []byte(r.dnsHost) }},
+		// IPv4 answers are IPv4-mapped IPv6 addresses.
+		field{id: enterpriseFieldID(dnsAnswerField, netflowV9),
+			enterprise: enterprisePEN(pen, netflowV9), length: net.IPv6len,
+			value: func(r *record) []byte { return ipv6Value(r.dnsAnswer) }},
+	)
+	if netflowV9 {
+		dns.fields = append(dns.fields, field{id: ieFirstSwitched, length: 4,
+			value: func(r *record) []byte { return uint32Value(r.startUptime) }})
+	} else {
+		dns.fields = append(dns.fields, field{id: ieFlowStartMilliseconds, length: 8,
+			value: func(r *record) []byte { return uint64Value(r.startMs) }})
+	}
+	return []template{flowIPv4, flowIPv6, dns}
+}
+
+// encodeTemplate returns template record (without set header).
+func (t template) encodeTemplate() []byte {
+	b := append(uint16Value(t.id), uint16Value(uint16(len(t.fields)))...)
+	for _, f := range t.fields {
+		id := f.id
+		if f.enterprise != 0 {
+			id |= 0x8000
+		}
+		b = append(b, uint16Value(id)...)
+		b = append(b, uint16Value(f.length)...)
+		if f.enterprise != 0 {
+			b = append(b, uint32Value(f.enterprise)...)
+		}
+	}
+	return b
+}
+
+// encodeRecord returns data record of the template.
+func (t template) encodeRecord(r *record) []byte {
+	var b []byte
+	for _, f := range t.fields {
+		value := f.value(r)
+		if f.length == varLength {
+			if len(value) < 255 {
+				b = append(b, uint8(len(value)))
+			} else {
+				if len(value) > 0xffff {
+					value = value[:0xffff]
+				}
+				b = append(b, 255)
+				b = append(b, uint16Value(uint16(len(value)))...)
+			}
+			b = append(b, value...)
+			continue
+		}
+		fixed := make([]byte, f.length)
+		copy(fixed, value)
+		b = append(b, fixed...)
+	}
+	return b
+}
@@ -4,6 +4,7 @@
 package hypervisor
 
 import (
+	"context"
 	"fmt"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -164,6 +165,17 @@ func (ctx ctrdContext) Delete(domainName string) error {
 	return nil
 }
 
+// Exec runs a command inside the running task, it fails if the command
+// exits with a non-zero status or does not finish within the timeout.
+func (ctx ctrdContext) Exec(domainName string, args []string, timeout time.Duration) error {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	ctrdCtx, cancel := context.WithTimeout(ctrdCtx, timeout)
+	defer cancel()
+	_, _, err := ctx.ctrdClient.CtrExec(ctrdCtx, domainName, args)
+	return err
+}
+
 //Cleanup is noop for containerd hypervisor
 func (ctx ctrdContext) Cleanup(_ string) error {
 	return nil
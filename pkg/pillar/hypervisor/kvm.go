@@ -32,7 +32,7 @@ const minUringKernelTag = uint64((5 << 16) | (4 << 8) | (72 << 0))
 // Thus the only PCI devices plugged directly into the root (pci.0) bus are:
 //    00:01.0 cirrus-vga
 //    00:02.0 pcie-root-port for QEMU XHCI Host Controller
-//    00:03.0 virtio-serial for hvc consoles and serial communications with the domain
+//    00:03.0 virtio-serial for hvc consoles, the guest agent and serial communications with the domain
 //    00:0x.0 pcie-root-port for block or network device #x (where x > 2)
 //    00:0y.0 virtio-9p-pci
 //
@@ -149,6 +149,36 @@ const qemuConfTemplate = `# This file is automatically generated by domainmgr
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "` + kvmStateDir + `{{.DisplayName}}/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
+{{- if .VTPM}}
+
+[chardev "chartpm"]
+  backend = "socket"
+  path = "` + kvmStateDir + `{{.DisplayName}}/swtpm.sock"
+
+[tpmdev "tpm0"]
+  type = "emulator"
+  chardev = "chartpm"
+
+[device "tpm0"]
+{{- if eq .Machine "virt"}}
+  driver = "tpm-tis-device"
+{{- else}}
+  driver = "{{.VTPM}}"
+{{- end}}
+  tpmdev = "tpm0"
+{{- end}}
+
 {{if .EnableVnc}}
 [vnc "default"]
   vnc = "0.0.0.0:{{if .VncDisplay}}{{.VncDisplay}}{{else}}0{{end}}"
@@ -352,6 +382,8 @@ const vfioDriverPath = "/sys/bus/pci/drivers/vfio-pci"
 // /run/hypervisor/kvm/DOMAIN_NAME:
 //    pid - contains PID of the anchor process
 //    qmp - UNIX domain socket that allows us to talk to anchor process
+//    qga - UNIX domain socket that allows us to talk to the guest agent (if the guest runs one)
+//  swtpm.sock, swtpm.pid, swtpm.log - TPM emulator of the domain (if it has a virtual TPM)
 //   cons - symlink to /dev/pts/X that allows us to talk to the serial console of the domain
 // In addition to that, we also maintain DOMAIN_NAME -> PID mapping in kvmContext, so we don't
 // have to look things up in the filesystem all the time (this also allows us to filter domains
@@ -466,6 +498,12 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 
 	os.MkdirAll(kvmStateDir+domainName, 0777)
 
+	if config.VTPM != types.VTPMNone {
+		if err := startVTPM(domainName, domainUUID); err != nil {
+			return logError("failed to start virtual TPM for domain %s: %v", domainName, err)
+		}
+	}
+
 	args := []string{ctx.dmExec}
 	args = append(args, dmArgs...)
 	args = append(args, "-name", domainName,
@@ -747,7 +785,18 @@ func (ctx kvmContext) Start(domainName string) error {
 	return nil
 }
 
+// Stop asks the guest agent to shut the guest OS down unless force is set
+// and falls back to ACPI power button which some guests ignore.
 func (ctx kvmContext) Stop(domainName string, force bool) error {
+	if !force {
+		err := execGuestShutdown(domainName)
+		if err == nil {
+			logrus.Infof("Stop: guest agent of %s is shutting down the guest", domainName)
+			return nil
+		}
+		logrus.Infof("Stop: guest agent of %s is not available (%v), using ACPI shutdown",
+			domainName, err)
+	}
 	if err := execShutdown(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("Stop: failed to execute shutdown command %v", err)
 	}
@@ -760,6 +809,9 @@ func (ctx kvmContext) Delete(domainName string) (result error) {
 	if err := execQuit(getQmpExecutorSocket(domainName)); err != nil {
 		return logError("failed to execute quit command %v", err)
 	}
+	if err := stopVTPM(domainName); err != nil {
+		return logError("failed to stop virtual TPM %v", err)
+	}
 	// we may want to wait a little bit here and actually kill qemu process if it gets wedged
 	if err := os.RemoveAll(kvmStateDir + domainName); err != nil {
 		return logError("failed to clean up domain state directory %s (%v)", domainName, err)
@@ -811,10 +863,39 @@ func (ctx kvmContext) Cleanup(domainName string) error {
 	if err := waitForQmp(domainName, false); err != nil {
 		return fmt.Errorf("error waiting for Qmp absent for domain %s: %v", domainName, err)
 	}
+	if err := stopVTPM(domainName); err != nil {
+		return fmt.Errorf("couldn't stop virtual TPM of domain %s: %v", domainName, err)
+	}
 
 	return nil
 }
 
+// Exec is not supported for VMs, it overrides the method of ctrdContext
+// which would run the command in the container of the device model.
+func (ctx kvmContext) Exec(domainName string, _ []string, _ time.Duration) error {
+	return fmt.Errorf("exec is not supported for VM %s", domainName)
+}
+
+// GuestInfo returns information reported by the guest agent
+func (ctx kvmContext) GuestInfo(domainName string) (types.GuestInfo, error) {
+	return getGuestInfo(domainName)
+}
+
+// GuestSetTime sets the clock of the guest via the guest agent
+func (ctx kvmContext) GuestSetTime(domainName string, t time.Time) error {
+	return execGuestSetTime(domainName, t)
+}
+
+// GuestFsFreeze freezes the file systems of the guest via the guest agent
+func (ctx kvmContext) GuestFsFreeze(domainName string) (int, error) {
+	return execGuestFsFreeze(domainName)
+}
+
+// GuestFsThaw thaws the file systems of the guest via the guest agent
+func (ctx kvmContext) GuestFsThaw(domainName string) (int, error) {
+	return execGuestFsThaw(domainName)
+}
+
 func (ctx kvmContext) PCIReserve(long string) error {
 	logrus.Infof("PCIReserve long addr is %s", long)
 
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
@@ -193,6 +194,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -455,6 +467,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -693,6 +716,17 @@ func TestCreateDomConfigOnlyCom1(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1035,6 +1069,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1330,6 +1375,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1644,6 +1700,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -1929,6 +1996,17 @@ func TestCreateDomConfig(t *testing.T) {
   chardev = "charserial0"
   name = "org.lfedge.eve.console.0"
 
+[chardev "charqga"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/qga"
+  server = "on"
+  wait = "off"
+
+[device]
+  driver = "virtserialport"
+  chardev = "charqga"
+  name = "org.qemu.guest_agent.0"
+
 
 #[device "video0"]
 #  driver = "qxl-vga"
@@ -2127,6 +2205,72 @@ func TestCreateDomConfig(t *testing.T) {
 	})
 }
 
+func TestCreateDomConfigVTPM(t *testing.T) {
+	initTest(t)
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Errorf("NewV4 failed: %v", err)
+	}
+	config := types.DomainConfig{
+		UUIDandVersion: types.UUIDandVersion{UUID: id, Version: "1.0"},
+		VmConfig: types.VmConfig{
+			Memory: 1024 * 1024 * 10,
+			VCpus:  2,
+			VTPM:   types.VTPMCRB,
+		},
+	}
+	conf, err := ioutil.TempFile("/tmp", "config")
+	if err != nil {
+		t.Errorf("Can't create config file for a domain %v", err)
+	} else {
+		defer os.Remove(conf.Name())
+	}
+	tpmConfig := func(driver string) string {
+		return `
+[chardev "chartpm"]
+  backend = "socket"
+  path = "/run/hypervisor/kvm/test/swtpm.sock"
+
+[tpmdev "tpm0"]
+  type = "emulator"
+  chardev = "chartpm"
+
+[device "tpm0"]
+  driver = "` + driver + `"
+  tpmdev = "tpm0"
+`
+	}
+
+	for _, test := range []struct {
+		name   string
+		kvm    kvmContext
+		vtpm   types.VTPMType
+		driver string
+	}{
+		{"amd64-crb", kvmIntel, types.VTPMCRB, "tpm-crb"},
+		{"amd64-tis", kvmIntel, types.VTPMTIS, "tpm-tis"},
+		// tpm-crb is not available on arm64
+		{"arm64", kvmArm, types.VTPMCRB, "tpm-tis-device"},
+	} {
+		config.VTPM = test.vtpm
+		t.Run(test.name, func(t *testing.T) {
+			conf.Seek(0, 0)
+			if err := test.kvm.CreateDomConfig("test", config, nil, &types.AssignableAdapters{}, conf); err != nil {
+				t.Errorf("CreateDomConfig failed %v", err)
+			}
+			defer os.Truncate(conf.Name(), 0)
+
+			result, err := ioutil.ReadFile(conf.Name())
+			if err != nil {
+				t.Errorf("reading conf file failed %v", err)
+			}
+			if !strings.Contains(string(result), tpmConfig(test.driver)) {
+				t.Errorf("virtual TPM is missing in the resulting config %s", string(result))
+			}
+		})
+	}
+}
+
 func TestCreateDom(t *testing.T) {
 	initTest(t)
 	if exec.Command("qemu-system-x86_64", "--version").Run() != nil {
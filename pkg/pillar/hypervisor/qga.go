@@ -0,0 +1,250 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+// this file implements a client of the QEMU guest agent (qemu-ga)
+//     https://qemu.readthedocs.io/en/latest/interop/qemu-ga-ref.html
+// The agent listens on a virtio-serial port of the VM which qemu
+// exposes as the qga UNIX domain socket in the state directory of the domain.
+
+const (
+	qgaTimeout = 5 * time.Second
+	// Freezing file systems flushes dirty pages in the guest
+	qgaFreezeTimeout = time.Minute
+	// qemu-ga prefixes the response of guest-sync-delimited with this byte
+	qgaSyncDelimiter = 0xff
+)
+
+var qgaSyncID = uint32(time.Now().Unix())
+
+type qgaError struct {
+	Class string `json:"class"`
+	Desc  string `json:"desc"`
+}
+
+type qgaResponse struct {
+	Return json.RawMessage `json:"return"`
+	Error  *qgaError       `json:"error"`
+}
+
+// qgaSession is a connection to the agent synchronized by guest-sync-delimited
+// so that responses left over from an earlier connection are skipped.
+type qgaSession struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func newQgaSession(socket string, timeout time.Duration) (*qgaSession, error) {
+	conn, err := net.DialTimeout("unix", socket, timeout)
+	if err != nil {
+		return nil, err
+	}
+	session, err := startQgaSession(conn, timeout)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return session, nil
+}
+
+func startQgaSession(conn net.Conn, timeout time.Duration) (*qgaSession, error) {
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+	session := &qgaSession{conn: conn, reader: bufio.NewReader(conn)}
+	id := atomic.AddUint32(&qgaSyncID, 1)
+	// The agent may be in the middle of parsing garbage; the delimiter
+	// byte resets its parser.
+	if _, err := conn.Write([]byte{qgaSyncDelimiter}); err != nil {
+		return nil, err
+	}
+	if err := session.send("guest-sync-delimited",
+		map[string]uint32{"id": id}); err != nil {
+		return nil, err
+	}
+	for {
+		if _, err := session.reader.ReadBytes(qgaSyncDelimiter); err != nil {
+			return nil, fmt.Errorf("guest agent is not responding: %v", err)
+		}
+		var syncID uint32
+		if err := session.receive(&syncID); err != nil {
+			return nil, err
+		}
+		if syncID == id {
+			return session, nil
+		}
+		logrus.Debugf("qga: skipping sync response %d, expected %d", syncID, id)
+	}
+}
+
+func (s *qgaSession) close() {
+	s.conn.Close()
+}
+
+func (s *qgaSession) send(cmd string, args interface{}) error {
+	request := struct {
+		Execute   string      `json:"execute"`
+		Arguments interface{} `json:"arguments,omitempty"`
+	}{Execute: cmd, Arguments: args}
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	logrus.Debugf("executing guest agent command: %s", data)
+	_, err = s.conn.Write(append(data, '\n'))
+	return err
+}
+
+func (s *qgaSession) receive(result interface{}) error {
+	line, err := s.reader.ReadBytes('\n')
+	if err != nil {
+		return err
+	}
+	var resp qgaResponse
+	if err := json.Unmarshal(line, &resp); err != nil {
+		return fmt.Errorf("invalid guest agent response %q: %v", line, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %s", resp.Error.Class, resp.Error.Desc)
+	}
+	if result == nil || len(resp.Return) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Return, result)
+}
+
+// exec runs the command and stores its return value in result
+func (s *qgaSession) exec(cmd string, args interface{}, result interface{}) error {
+	if err := s.send(cmd, args); err != nil {
+		return err
+	}
+	if err := s.receive(result); err != nil {
+		return fmt.Errorf("%s failed: %v", cmd, err)
+	}
+	return nil
+}
+
+// execGuestAgent runs the command with the timeout, the agent has to respond
+// to the synchronization within qgaTimeout in any case.
+func execGuestAgent(domainName string, timeout time.Duration,
+	cmd string, args interface{}, result interface{}) error {
+
+	session, err := newQgaSession(getQgaSocket(domainName), qgaTimeout)
+	if err != nil {
+		return err
+	}
+	defer session.close()
+	if err := session.conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	return session.exec(cmd, args, result)
+}
+
+// execGuestShutdown asks the guest OS to power down. The agent does not
+// respond on success.
+func execGuestShutdown(domainName string) error {
+	session, err := newQgaSession(getQgaSocket(domainName), qgaTimeout)
+	if err != nil {
+		return err
+	}
+	defer session.close()
+	return session.send("guest-shutdown", map[string]string{"mode": "powerdown"})
+}
+
+func execGuestSetTime(domainName string, t time.Time) error {
+	return execGuestAgent(domainName, qgaTimeout, "guest-set-time",
+		map[string]int64{"time": t.UnixNano()}, nil)
+}
+
+// execGuestFsFreeze freezes the file systems of a KVM VM with the guest agent
+// and returns the number of frozen file systems. It has to be followed by
+// execGuestFsThaw, the agent thaws them only if it is restarted.
+func execGuestFsFreeze(domainName string) (int, error) {
+	var count int
+	err := execGuestAgent(domainName, qgaFreezeTimeout,
+		"guest-fsfreeze-freeze", nil, &count)
+	return count, err
+}
+
+// execGuestFsThaw thaws file systems frozen by execGuestFsFreeze
+func execGuestFsThaw(domainName string) (int, error) {
+	var count int
+	err := execGuestAgent(domainName, qgaFreezeTimeout,
+		"guest-fsfreeze-thaw", nil, &count)
+	return count, err
+}
+
+func getGuestInfo(domainName string) (types.GuestInfo, error) {
+	session, err := newQgaSession(getQgaSocket(domainName), qgaTimeout)
+	if err != nil {
+		return types.GuestInfo{}, err
+	}
+	defer session.close()
+	return queryGuestInfo(session), nil
+}
+
+// queryGuestInfo collects what the agent supports; older agents do not
+// implement all the commands.
+func queryGuestInfo(session *qgaSession) types.GuestInfo {
+	info := types.GuestInfo{AgentConnected: true}
+	var osInfo struct {
+		Name          string `json:"name"`
+		PrettyName    string `json:"pretty-name"`
+		Version       string `json:"version"`
+		KernelRelease string `json:"kernel-release"`
+	}
+	if err := session.exec("guest-get-osinfo", nil, &osInfo); err != nil {
+		logrus.Debugf("queryGuestInfo: %v", err)
+	} else {
+		info.OSName = osInfo.PrettyName
+		if info.OSName == "" {
+			info.OSName = osInfo.Name
+		}
+		info.OSVersion = osInfo.Version
+		info.KernelRelease = osInfo.KernelRelease
+	}
+	var hostName struct {
+		HostName string `json:"host-name"`
+	}
+	if err := session.exec("guest-get-host-name", nil, &hostName); err != nil {
+		logrus.Debugf("queryGuestInfo: %v", err)
+	} else {
+		info.Hostname = hostName.HostName
+	}
+	var interfaces []struct {
+		Name        string `json:"name"`
+		IPAddresses []struct {
+			IPAddress string `json:"ip-address"`
+		} `json:"ip-addresses"`
+	}
+	if err := session.exec("guest-network-get-interfaces", nil, &interfaces); err != nil {
+		logrus.Debugf("queryGuestInfo: %v", err)
+	}
+	for _, intf := range interfaces {
+		for _, addr := range intf.IPAddresses {
+			ip := net.ParseIP(addr.IPAddress)
+			if ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+				continue
+			}
+			info.IPAddrs = append(info.IPAddrs, ip)
+		}
+	}
+	return info
+}
+
+func getQgaSocket(domainName string) string {
+	return kvmStateDir + domainName + "/qga"
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGuestAgent answers commands with canned responses, for
+// guest-sync-delimited it first sends a stale response.
+func fakeGuestAgent(conn net.Conn, responses map[string]string) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+		var request struct {
+			Execute   string
+			Arguments struct{ ID uint32 }
+		}
+		if err := json.Unmarshal(bytes.TrimLeft(line, "\xff"), &request); err != nil {
+			return
+		}
+		var resp string
+		if request.Execute == "guest-sync-delimited" {
+			resp = fmt.Sprintf("\xff{\"return\": %d}\n\xff{\"return\": %d}\n",
+				request.Arguments.ID-1, request.Arguments.ID)
+		} else if r, ok := responses[request.Execute]; ok {
+			// qemu-ga sends every response on a single line
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, []byte(r)); err != nil {
+				return
+			}
+			resp = compact.String() + "\n"
+		} else {
+			resp = `{"error": {"class": "CommandNotFound", "desc": "not supported"}}` + "\n"
+		}
+		if _, err := conn.Write([]byte(resp)); err != nil {
+			return
+		}
+	}
+}
+
+func TestQgaSession(t *testing.T) {
+	client, server := net.Pipe()
+	go fakeGuestAgent(server, map[string]string{
+		"guest-fsfreeze-freeze": `{"return": 2}`,
+	})
+	session, err := startQgaSession(client, time.Second)
+	assert.NoError(t, err)
+	defer session.close()
+
+	var count int
+	assert.NoError(t, session.exec("guest-fsfreeze-freeze", nil, &count))
+	assert.Equal(t, 2, count)
+	err = session.exec("guest-fsfreeze-thaw", nil, &count)
+	assert.EqualError(t, err, "guest-fsfreeze-thaw failed: CommandNotFound: not supported")
+}
+
+func TestQgaSessionTimeout(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+	// Nobody reads on the other side, e.g. the guest does not run the agent
+	_, err := startQgaSession(client, 100*time.Millisecond)
+	assert.Error(t, err)
+}
+
+func TestQueryGuestInfo(t *testing.T) {
+	client, server := net.Pipe()
+	go fakeGuestAgent(server, map[string]string{
+		"guest-get-osinfo": `{"return": {"name": "Ubuntu", "pretty-name": "Ubuntu 20.04.4 LTS",
+			"version": "20.04.4 LTS (Focal Fossa)", "kernel-release": "5.4.0-100-generic"}}`,
+		// guest-get-host-name is not supported by old agents
+		"guest-network-get-interfaces": `{"return": [
+			{"name": "lo", "ip-addresses": [{"ip-address-type": "ipv4", "ip-address": "127.0.0.1", "prefix": 8}]},
+			{"name": "eth0", "ip-addresses": [
+				{"ip-address-type": "ipv4", "ip-address": "10.1.0.2", "prefix": 24},
+				{"ip-address-type": "ipv6", "ip-address": "fe80::1", "prefix": 64},
+				{"ip-address-type": "ipv6", "ip-address": "fd00::2", "prefix": 64}]}]}`,
+	})
+	session, err := startQgaSession(client, time.Second)
+	assert.NoError(t, err)
+	defer session.close()
+
+	info := queryGuestInfo(session)
+	assert.True(t, info.AgentConnected)
+	assert.Equal(t, "Ubuntu 20.04.4 LTS", info.OSName)
+	assert.Equal(t, "20.04.4 LTS (Focal Fossa)", info.OSVersion)
+	assert.Equal(t, "5.4.0-100-generic", info.KernelRelease)
+	assert.Empty(t, info.Hostname)
+	assert.Equal(t, []net.IP{net.ParseIP("10.1.0.2"), net.ParseIP("fd00::2")}, info.IPAddrs)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package hypervisor
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// this file manages software TPM emulators (swtpm) backing the virtual
+// TPM devices of KVM VMs. Every VM with a virtual TPM gets its own swtpm
+// process which qemu talks to over the swtpm.sock UNIX domain socket in the
+// state directory of the domain. The TPM state itself (keys, NV indices,
+// PCR banks) is kept in the encrypted vault so that it survives reboots.
+
+const (
+	swtpmExec = "swtpm"
+	// swtpm writes the pid file only after it is ready to accept connections
+	swtpmStartTimeout = 10 * time.Second
+	swtpmStopTimeout  = 5 * time.Second
+)
+
+// VTPMStateDir returns the directory with the persistent state of the
+// virtual TPM of the application instance
+func VTPMStateDir(appUUID uuid.UUID) string {
+	return filepath.Join(types.VTPMStateDirName, appUUID.String())
+}
+
+func getSwtpmSocket(domainName string) string {
+	return kvmStateDir + domainName + "/swtpm.sock"
+}
+
+func getSwtpmPidFile(domainName string) string {
+	return kvmStateDir + domainName + "/swtpm.pid"
+}
+
+func getSwtpmLogFile(domainName string) string {
+	return kvmStateDir + domainName + "/swtpm.log"
+}
+
+func swtpmArgs(domainName string, stateDir string) []string {
+	return []string{"socket", "--tpm2",
+		"--tpmstate", "dir=" + stateDir + ",mode=0600",
+		"--ctrl", "type=unixio,path=" + getSwtpmSocket(domainName),
+		"--pid", "file=" + getSwtpmPidFile(domainName),
+		"--log", "file=" + getSwtpmLogFile(domainName) + ",level=1",
+		// exit when qemu closes the control channel
+		"--terminate", "--daemon"}
+}
+
+// startVTPM starts the TPM emulator for the domain, qemu has to be started
+// after it since it connects to the emulator on startup.
+func startVTPM(domainName string, appUUID uuid.UUID) error {
+	// in case Setup is retried
+	if err := stopVTPM(domainName); err != nil {
+		return err
+	}
+	stateDir := VTPMStateDir(appUUID)
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return fmt.Errorf("failed to create vTPM state directory: %v", err)
+	}
+	args := swtpmArgs(domainName, stateDir)
+	logrus.Infof("startVTPM: %s %s", swtpmExec, strings.Join(args, " "))
+	// with --daemon swtpm forks once it set up its sockets, errors
+	// go to the log file
+	if err := exec.Command(swtpmExec, args...).Run(); err != nil {
+		return fmt.Errorf("failed to start swtpm for %s: %v (see %s)",
+			domainName, err, getSwtpmLogFile(domainName))
+	}
+	deadline := time.Now().Add(swtpmStartTimeout)
+	for {
+		if _, err := readSwtpmPid(domainName); err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("swtpm for %s did not start in %v",
+				domainName, swtpmStartTimeout)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func readSwtpmPid(domainName string) (int, error) {
+	data, err := ioutil.ReadFile(getSwtpmPidFile(domainName))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+// stopVTPM terminates the TPM emulator of the domain if it is running.
+// It normally exits on its own once qemu is gone.
+func stopVTPM(domainName string) error {
+	pid, err := readSwtpmPid(domainName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to read swtpm pid of %s: %v", domainName, err)
+	}
+	if !stopProcess(pid, syscall.SIGTERM) {
+		logrus.Warnf("stopVTPM: swtpm of %s did not exit, killing it", domainName)
+		if !stopProcess(pid, syscall.SIGKILL) {
+			return fmt.Errorf("swtpm of %s (pid %d) does not exit", domainName, pid)
+		}
+	}
+	if err := os.Remove(getSwtpmPidFile(domainName)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove swtpm pid file of %s: %v", domainName, err)
+	}
+	return nil
+}
+
+// stopProcess sends the signal and returns true if the process is gone
+// within swtpmStopTimeout
+func stopProcess(pid int, signal syscall.Signal) bool {
+	deadline := time.Now().Add(swtpmStopTimeout)
+	for {
+		if err := syscall.Kill(pid, signal); errors.Is(err, syscall.ESRCH) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		// only check whether it is still running from now on
+		signal = 0
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -129,6 +130,9 @@ func (ctx xenContext) Task(status *types.DomainStatus) types.Task {
 }
 
 func (ctx xenContext) Setup(status types.DomainStatus, config types.DomainConfig, aa *types.AssignableAdapters, file *os.File) error {
+	if config.VTPM != types.VTPMNone {
+		return logError("virtual TPM is not supported by %s hypervisor", ctx.Name())
+	}
 	// first lets build the domain config
 	if err := ctx.CreateDomConfig(status.DomainName, config, status.DiskStatusList, aa, file); err != nil {
 		return logError("failed to build domain config: %v", err)
@@ -519,6 +523,11 @@ func (ctx xenContext) Cleanup(domainName string) error {
 	return nil
 }
 
+// Exec is not supported for VMs, it overrides the method of ctrdContext.
+func (ctx xenContext) Exec(domainName string, _ []string, _ time.Duration) error {
+	return fmt.Errorf("exec is not supported for VM %s", domainName)
+}
+
 func (ctx xenContext) Info(domainName string) (int, types.SwState, error) {
 	// first we ask for the task status
 	effectiveDomainID, effectiveDomainState, err := ctx.ctrdContext.Info(domainName)
@@ -155,22 +155,26 @@ func Ip6tableCmd(log *base.LogObject, args ...string) error {
 // XXX : Everything below is zedrouter-specific, consider moving to pillar/cmd/zedrouter
 
 func FetchIprulesCounters(log *base.LogObject) []AclCounters {
-	// Get for IPv4 and IPv6 from filter and raw tables.
+	// Get for IPv4 from filter and raw tables.
+	// ACLs of every application VIF are installed into a separate chain
+	// <chain>-apps-<vif>, jumped to from <chain>-apps. Therefore we list all
+	// rules of the table and let parseline select those of the app chains.
 	chainsWithCounters := map[string][]string{ // table -> chains
 		"filter": {"FORWARD", "OUTPUT"},
 		"raw":    {"PREROUTING"},
 	}
 	var counters []AclCounters
 	for table, chains := range chainsWithCounters {
-		for _, chain := range chains {
-			out, err := IptableCmdOut(
-				nil, "-t", table, "-S", chain+AppChainSuffix, "-v")
-			if err != nil {
-				log.Errorf("FetchIprulesCounters: iptables -S failed %s\n", err)
-			} else {
-				c := parseCounters(log, out, "filter", 4)
-				if c != nil {
-					counters = append(counters, c...)
+		out, err := IptableCmdOut(nil, "-t", table, "-S", "-v")
+		if err != nil {
+			log.Errorf("FetchIprulesCounters: iptables -S failed %s\n", err)
+			continue
+		}
+		for _, c := range parseCounters(log, out, table, 4) {
+			for _, chain := range chains {
+				if c.Chain == chain {
+					counters = append(counters, c)
+					break
 				}
 			}
 		}
@@ -278,6 +282,15 @@ func GetIPRuleACLRateLimitDrop(log *base.LogObject, counters []AclCounters, brid
 	return c.Pkts
 }
 
+// RuleCounters classifies an app ACL rule, given by its iptables arguments,
+// the same way as rules listed by FetchIprulesCounters are classified.
+// Returns nil if the rule is not of interest for ACL counters.
+func RuleCounters(log *base.LogObject, table, chain string, ipVer int,
+	args []string) *AclCounters {
+	items := append([]string{"-A", chain + AppChainSuffix}, args...)
+	return parseline(log, strings.Join(items, " "), table, ipVer)
+}
+
 // Parse the output of iptables -S -v
 func parseCounters(log *base.LogObject, out string, table string, ipVer int) []AclCounters {
 	var counters []AclCounters
@@ -319,7 +332,13 @@ func parseline(log *base.LogObject, line string, table string, ipVer int) *AclCo
 	if items[0] != "-A" {
 		return nil
 	}
-	chain := strings.TrimSuffix(items[1], AppChainSuffix)
+	// Only rules of the app chains (<chain>-apps and <chain>-apps-<vif>)
+	// are of interest.
+	suffixIdx := strings.Index(items[1], AppChainSuffix)
+	if suffixIdx <= 0 {
+		return nil
+	}
+	chain := items[1][:suffixIdx]
 	forward := chain == "FORWARD"
 	ac := AclCounters{Table: table, Chain: chain, IpVer: ipVer}
 	i := 2
@@ -28,4 +28,8 @@ var ControlProtocolMarkingIDMap = map[string]string{
 	// DHCP packets originating from outside
 	// (e.g. DHCP multicast requests from other devices on the same network)
 	"in_dhcp": "10",
+	// Blobs served to other EVE devices and mDNS queries for them
+	"in_peer_cache": "11",
+	// Scrapes of the local metrics endpoint
+	"in_metrics_exporter": "12",
 }
@@ -0,0 +1,124 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+)
+
+// Every ACL rule with a counter of interest is commented with a tag
+// describing the iptables rule it was translated from. This allows to
+// reconstruct iptables.AclCounters from nftables counters.
+const counterTagPrefix = "acl:"
+
+// CounterTag returns a tag used as the comment of the nftables rule translated
+// from the given iptables rule. Returns empty string if counters of the rule
+// are not of interest.
+func CounterTag(log *base.LogObject, table, chain string, ipVer int,
+	args []string) string {
+	ac := iptables.RuleCounters(log, table, chain, ipVer, args)
+	if ac == nil {
+		return ""
+	}
+	var flags string
+	for _, flag := range []struct {
+		set bool
+		tag string
+	}{
+		{ac.Log, "L"}, {ac.Drop, "D"}, {ac.Accept, "A"},
+		{ac.Limit, "R"}, {ac.More, "M"},
+	} {
+		if flag.set {
+			flags += flag.tag
+		}
+	}
+	return counterTagPrefix + strings.Join([]string{ac.Table, ac.Chain,
+		strconv.Itoa(ac.IpVer), ac.IIf, ac.Piif, ac.OIf, ac.Poif, flags}, ":")
+}
+
+func parseCounterTag(tag string) (ac iptables.AclCounters, ok bool) {
+	if !strings.HasPrefix(tag, counterTagPrefix) {
+		return ac, false
+	}
+	fields := strings.Split(strings.TrimPrefix(tag, counterTagPrefix), ":")
+	if len(fields) != 8 {
+		return ac, false
+	}
+	ipVer, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return ac, false
+	}
+	flags := fields[7]
+	return iptables.AclCounters{
+		Table:  fields[0],
+		Chain:  fields[1],
+		IpVer:  ipVer,
+		IIf:    fields[3],
+		Piif:   fields[4],
+		OIf:    fields[5],
+		Poif:   fields[6],
+		Log:    strings.Contains(flags, "L"),
+		Drop:   strings.Contains(flags, "D"),
+		Accept: strings.Contains(flags, "A"),
+		Limit:  strings.Contains(flags, "R"),
+		More:   strings.Contains(flags, "M"),
+	}, true
+}
+
+// FetchACLCounters returns counters of the nftables rules implementing
+// application ACLs, in the same form as returned by
+// iptables.FetchIprulesCounters for the iptables backend (i.e. only IPv4
+// rules of the filter FORWARD and OUTPUT, and raw PREROUTING chains).
+func FetchACLCounters(log *base.LogObject) []iptables.AclCounters {
+	chainsWithCounters := map[string][]string{ // table -> chains
+		"filter": {"FORWARD", "OUTPUT"},
+		"raw":    {"PREROUTING"},
+	}
+	var counters []iptables.AclCounters
+	for _, family := range []string{FamilyIP, FamilyBridge} {
+		rules, err := listRules(nil, family, "")
+		if err != nil {
+			log.Errorf("FetchACLCounters: %v", err)
+			continue
+		}
+		for _, rule := range rules {
+			ac, ok := parseCounterTag(rule.Comment)
+			if !ok || ac.IpVer != 4 {
+				continue
+			}
+			var withCounters bool
+			for _, chain := range chainsWithCounters[ac.Table] {
+				if ac.Chain == chain {
+					withCounters = true
+					break
+				}
+			}
+			if !withCounters {
+				continue
+			}
+			for _, expr := range rule.Expr {
+				value, isCounter := expr["counter"]
+				if !isCounter {
+					continue
+				}
+				var counter nftJSONCounter
+				if err := json.Unmarshal(value, &counter); err != nil {
+					log.Errorf("FetchACLCounters: bad counter %s: %v",
+						string(value), err)
+					continue
+				}
+				ac.Pkts = counter.Packets
+				ac.Bytes = counter.Bytes
+				counters = append(counters, ac)
+				break
+			}
+		}
+	}
+	return counters
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package nftables implements the nftables backend of application ACLs.
+// ACLs are generated by zedrouter as iptables rules and translated here into
+// nftables rules, which are then applied with one atomic transaction
+// per chain, instead of executing iptables once for every rule.
+
+package nftables
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// Families of nftables tables used for application ACLs.
+const (
+	// FamilyIP : IPv4 family.
+	FamilyIP = "ip"
+	// FamilyIP6 : IPv6 family.
+	FamilyIP6 = "ip6"
+	// FamilyBridge : bridge family, sees frames forwarded by Linux bridges
+	// before they enter the IP stack (if they do at all).
+	FamilyBridge = "bridge"
+)
+
+// AppTable : name of the table (created for every family) with the chains
+// and sets implementing application ACLs.
+const AppTable = "eve_apps"
+
+// NftCmdOut executes nft with the given arguments and returns the output.
+func NftCmdOut(log *base.LogObject, args ...string) (string, error) {
+	out, err := base.Exec(log, "nft", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("nft command %s failed %s output %s",
+			args, err, out)
+	}
+	return string(out), nil
+}
+
+// ApplyScript runs the given nft script (one command per line) as a single
+// atomic transaction. Either all commands are applied or none of them.
+func ApplyScript(log *base.LogObject, script string) error {
+	return runScript(log, script, false)
+}
+
+// CheckScript submits the given nft script to the kernel in the dry-run mode
+// (nft -c), i.e. the script is validated including kernel support for all used
+// families, expressions and set types, but nothing is applied.
+func CheckScript(log *base.LogObject, script string) error {
+	return runScript(log, script, true)
+}
+
+func runScript(log *base.LogObject, script string, check bool) error {
+	file, err := ioutil.TempFile("", "nft-script-")
+	if err != nil {
+		return fmt.Errorf("failed to create file for nft script: %w", err)
+	}
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(script)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write nft script: %w", err)
+	}
+	args := []string{"-f", file.Name()}
+	if check {
+		args = append([]string{"-c"}, args...)
+	}
+	if log != nil {
+		log.Functionf("Running nft %v with script:\n%s", args, script)
+	}
+	out, err := base.Exec(log, "nft", args...).CombinedOutput()
+	if err != nil && check {
+		return fmt.Errorf("nft script check failed %s output %s", err, out)
+	}
+	if err != nil {
+		return fmt.Errorf("nft script failed %s output %s script:\n%s",
+			err, out, script)
+	}
+	return nil
+}
+
+// Available returns true if the nft utility can be executed.
+func Available(log *base.LogObject) bool {
+	_, err := NftCmdOut(log, "--version")
+	return err == nil
+}
+
+// SetName converts the name of an ipset into the name of the corresponding
+// nftables set. Dots and dashes are valid in set names, but the separator
+// used by zedrouter to shorten long host names is not.
+func SetName(ipsetName string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r == '.', r == '-', r == '_':
+			return r
+		}
+		return '.'
+	}, ipsetName)
+}
+
+// DnsmasqNftset returns the value of the dnsmasq "nftset" option (without
+// the domain part) which makes dnsmasq add resolved IPv4 and IPv6 addresses
+// into the given sets. Every set exists in the IP and in the bridge table.
+func DnsmasqNftset(ipv4SetName, ipv6SetName string) string {
+	ipv4Set, ipv6Set := SetName(ipv4SetName), SetName(ipv6SetName)
+	return strings.Join([]string{
+		fmt.Sprintf("4#%s#%s#%s", FamilyIP, AppTable, ipv4Set),
+		fmt.Sprintf("4#%s#%s#%s", FamilyBridge, AppTable, ipv4Set),
+		fmt.Sprintf("6#%s#%s#%s", FamilyIP6, AppTable, ipv6Set),
+		fmt.Sprintf("6#%s#%s#%s", FamilyBridge, AppTable, ipv6Set),
+	}, ",")
+}
+
+// Subset of the JSON output of "nft -j list".
+type nftJSONOutput struct {
+	Nftables []struct {
+		Rule *nftJSONRule `json:"rule"`
+	} `json:"nftables"`
+}
+
+type nftJSONRule struct {
+	Family  string                       `json:"family"`
+	Table   string                       `json:"table"`
+	Chain   string                       `json:"chain"`
+	Handle  uint64                       `json:"handle"`
+	Comment string                       `json:"comment"`
+	Expr    []map[string]json.RawMessage `json:"expr"`
+}
+
+type nftJSONCounter struct {
+	Packets uint64 `json:"packets"`
+	Bytes   uint64 `json:"bytes"`
+}
+
+type nftJSONJump struct {
+	Target string `json:"target"`
+}
+
+// listRules returns all rules of the given table (or only of the given chain
+// if chain is not empty).
+func listRules(log *base.LogObject, family, chain string) ([]nftJSONRule, error) {
+	args := []string{"-j", "list", "table", family, AppTable}
+	if chain != "" {
+		args = []string{"-j", "list", "chain", family, AppTable, chain}
+	}
+	out, err := NftCmdOut(log, args...)
+	if err != nil {
+		return nil, err
+	}
+	var output nftJSONOutput
+	if err = json.Unmarshal([]byte(out), &output); err != nil {
+		return nil, fmt.Errorf("failed to parse nft output: %w", err)
+	}
+	var rules []nftJSONRule
+	for _, item := range output.Nftables {
+		if item.Rule != nil {
+			rules = append(rules, *item.Rule)
+		}
+	}
+	return rules, nil
+}
+
+// JumpRuleHandles returns handles of the rules of the given chain which jump
+// to the target chain.
+func JumpRuleHandles(log *base.LogObject, family, chain, target string) (
+	handles []uint64, err error) {
+	rules, err := listRules(log, family, chain)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		for _, expr := range rule.Expr {
+			value, isJump := expr["jump"]
+			if !isJump {
+				continue
+			}
+			var jump nftJSONJump
+			if err := json.Unmarshal(value, &jump); err != nil {
+				return nil, fmt.Errorf("failed to parse jump %s: %w",
+					string(value), err)
+			}
+			if jump.Target == target {
+				handles = append(handles, rule.Handle)
+			}
+		}
+	}
+	return handles, nil
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// RuleContext : context in which an iptables rule is translated.
+type RuleContext struct {
+	// Bridge : the rule is evaluated by a chain of the bridge family.
+	// Interface matches then refer to bridges and bridge ports.
+	Bridge bool
+	// ForIPv6 : the rule is an ip6tables rule.
+	ForIPv6 bool
+	// VIFMAC : MAC address of the application VIF. Outside of the bridge family
+	// the physdev-in match is translated into a match of the source MAC address.
+	VIFMAC net.HardwareAddr
+}
+
+// Port names used by zedrouter in ACL rules.
+// Translated to numbers to avoid depending on /etc/services.
+var portNames = map[string]string{
+	"domain":        "53",
+	"bootps":        "67",
+	"bootpc":        "68",
+	"http":          "80",
+	"dhcpv6-client": "546",
+	"dhcpv6-server": "547",
+}
+
+// Log levels of the iptables LOG target.
+var logLevels = []string{
+	"emerg", "alert", "crit", "err", "warn", "notice", "info", "debug",
+}
+
+// TranslateRule translates an iptables rule, given by its arguments (without
+// -t and -A), into the nftables rule syntax (the part following
+// "add rule <family> <table> <chain>").
+// Every translated rule has a counter. Returns empty string (and no error)
+// if the rule can never match in the given context, e.g. physdev-out match
+// outside of the bridge family.
+func TranslateRule(args []string, rc RuleContext) (rule string, err error) {
+	l3 := "ip"
+	if rc.ForIPv6 {
+		l3 = "ip6"
+	}
+	var (
+		matches    []string
+		statements []string
+		verdict    string
+		proto      string
+		limitRate  string
+		limitBurst string
+		hasLimit   bool
+		negate     bool
+		target     string
+	)
+	if rc.Bridge {
+		// Only IP packets are seen by iptables.
+		matches = append(matches, "meta protocol "+l3)
+	}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "!" {
+			negate = true
+			continue
+		}
+		op := ""
+		if negate {
+			op = "!= "
+		}
+		negate = false
+		var value string
+		switch arg {
+		case "-m", "--match":
+			// Modules are implied by the options which follow,
+			// except for limit, which can be used without any.
+			i++
+			if i < len(args) && args[i] == "limit" {
+				hasLimit = true
+			}
+			continue
+		case "--restore-mark":
+			statements = append(statements, "meta mark set ct mark")
+			continue
+		case "--physdev-is-bridged":
+			if op == "" {
+				return "", fmt.Errorf("unsupported physdev-is-bridged match")
+			}
+			if rc.Bridge {
+				// Everything in the bridge family is bridged.
+				return "", nil
+			}
+			// Traffic bridged by EVE is never NATed, while routed traffic
+			// reaches application ports only through a port map (DNAT).
+			matches = append(matches, "ct status dnat")
+			continue
+		}
+		if i+1 >= len(args) {
+			return "", fmt.Errorf("missing value for %s", arg)
+		}
+		i++
+		value = args[i]
+		switch arg {
+		case "-i":
+			if rc.Bridge {
+				matches = append(matches, "meta ibrname "+op+quote(value))
+			} else {
+				matches = append(matches, "iifname "+op+quote(ifName(value)))
+			}
+		case "-o":
+			if rc.Bridge {
+				matches = append(matches, "meta obrname "+op+quote(value))
+			} else {
+				matches = append(matches, "oifname "+op+quote(ifName(value)))
+			}
+		case "--physdev-in":
+			if rc.Bridge {
+				matches = append(matches, "iifname "+op+quote(ifName(value)))
+			} else if rc.VIFMAC != nil {
+				matches = append(matches, "ether saddr "+op+rc.VIFMAC.String())
+			} else {
+				return "", fmt.Errorf("physdev-in match requires VIF MAC address")
+			}
+		case "--physdev-out":
+			if !rc.Bridge {
+				// Output bridge port is not known to the IP stack.
+				return "", nil
+			}
+			matches = append(matches, "oifname "+op+quote(ifName(value)))
+		case "-s":
+			matches = append(matches, l3+" saddr "+op+value)
+		case "-d":
+			matches = append(matches, l3+" daddr "+op+value)
+		case "-p":
+			proto = strings.ToLower(value)
+			switch proto {
+			case "all", "0":
+				proto = ""
+				continue
+			case "ipv6-icmp":
+				proto = "icmpv6"
+			}
+			matches = append(matches, "meta l4proto "+op+proto)
+		case "--dport", "--sport":
+			if proto == "" {
+				return "", fmt.Errorf("port match requires protocol")
+			}
+			header := "th"
+			switch proto {
+			case "tcp", "udp", "sctp", "dccp":
+				header = proto
+			}
+			field := "dport"
+			if arg == "--sport" {
+				field = "sport"
+			}
+			matches = append(matches, header+" "+field+" "+op+portRange(value))
+		case "--match-set":
+			if i+1 >= len(args) {
+				return "", fmt.Errorf("missing direction for set %s", value)
+			}
+			i++
+			field := "daddr"
+			if args[i] == "src" {
+				field = "saddr"
+			}
+			matches = append(matches, l3+" "+field+" "+op+"@"+SetName(value))
+		case "--mark":
+			matches = append(matches, "meta mark "+op+value)
+		case "--limit":
+			hasLimit = true
+			limitRate = value
+		case "--limit-burst":
+			hasLimit = true
+			limitBurst = value
+		case "-j":
+			target = value
+			switch value {
+			case "ACCEPT", "DROP", "RETURN":
+				verdict = strings.ToLower(value)
+			case "LOG", "DNAT", "SNAT", "CONNMARK":
+				// Statement is defined by the options which follow.
+			case "MASQUERADE":
+				statements = append(statements, "masquerade")
+			default:
+				verdict = "jump " + value
+			}
+		case "--log-prefix":
+			statements = append(statements, "log prefix "+quote(value))
+		case "--log-level":
+			level, err := logLevel(value)
+			if err != nil {
+				return "", err
+			}
+			if n := len(statements); n > 0 &&
+				strings.HasPrefix(statements[n-1], "log ") {
+				statements[n-1] += " level " + level
+			} else {
+				statements = append(statements, "log level "+level)
+			}
+		case "--to-destination":
+			statements = append(statements, "dnat to "+value)
+		case "--to-source":
+			statements = append(statements, "snat to "+value)
+		case "--set-mark":
+			statements = append(statements, "ct mark set "+value)
+		default:
+			return "", fmt.Errorf("unsupported iptables option %s", arg)
+		}
+	}
+	if target == "LOG" && len(statements) == 0 {
+		statements = append(statements, "log")
+	}
+	if hasLimit {
+		limit, err := limitStatement(limitRate, limitBurst)
+		if err != nil {
+			return "", err
+		}
+		matches = append(matches, limit)
+	}
+	// Counter is put after the limit (which is a match in iptables)
+	// but before any action.
+	parts := append(matches, "counter")
+	parts = append(parts, statements...)
+	if verdict != "" {
+		parts = append(parts, verdict)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// ifName translates iptables interface wildcard into nftables wildcard.
+func ifName(name string) string {
+	if strings.HasSuffix(name, "+") {
+		return strings.TrimSuffix(name, "+") + "*"
+	}
+	return name
+}
+
+func quote(value string) string {
+	return "\"" + value + "\""
+}
+
+// portRange translates port or port range (first:last) with possibly
+// named ports into the nftables syntax.
+func portRange(value string) string {
+	ports := strings.Split(value, ":")
+	for i, port := range ports {
+		if number, known := portNames[port]; known {
+			ports[i] = number
+		}
+	}
+	return strings.Join(ports, "-")
+}
+
+func logLevel(value string) (string, error) {
+	for i, level := range logLevels {
+		if value == level || value == fmt.Sprintf("%d", i) {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported log level %s", value)
+}
+
+// limitStatement translates iptables limit match into nftables limit
+// statement. Defaults are the same as those of the iptables limit module.
+func limitStatement(rate, burst string) (string, error) {
+	if rate == "" {
+		rate = "3/hour"
+	}
+	if burst == "" {
+		burst = "5"
+	}
+	split := strings.SplitN(rate, "/", 2)
+	unit := "second"
+	if len(split) == 2 && split[1] != "" {
+		switch split[1][0] {
+		case 's':
+			unit = "second"
+		case 'm':
+			unit = "minute"
+		case 'h':
+			unit = "hour"
+		case 'd':
+			unit = "day"
+		default:
+			return "", fmt.Errorf("unsupported limit unit %s", split[1])
+		}
+	}
+	return fmt.Sprintf("limit rate %s/%s burst %s packets",
+		split[0], unit, burst), nil
+}
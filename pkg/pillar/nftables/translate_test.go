@@ -0,0 +1,183 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nftables
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestTranslateRule(t *testing.T) {
+	vifMAC, _ := net.ParseMAC("02:16:3e:00:00:01")
+	testMatrix := map[string]struct {
+		args        []string
+		rc          RuleContext
+		expRule     string
+		expNoMatch  bool
+		expectError bool
+	}{
+		"host-based ACE in bridge family": {
+			args: []string{"-m", "physdev", "--physdev-in", "nbu1x1+",
+				"-i", "bn1", "-m", "set", "--match-set", "ipv4.zededa.com", "dst",
+				"-p", "tcp", "--dport", "443", "-j", "ACCEPT"},
+			rc: RuleContext{Bridge: true},
+			expRule: `meta protocol ip iifname "nbu1x1*" meta ibrname "bn1" ` +
+				`ip daddr @ipv4.zededa.com meta l4proto tcp tcp dport 443 counter accept`,
+		},
+		"named port range": {
+			args: []string{"-i", "bn1", "-p", "udp", "--sport", "bootps:bootpc",
+				"-j", "ACCEPT"},
+			rc:      RuleContext{ForIPv6: true},
+			expRule: `iifname "bn1" meta l4proto udp udp sport 67-68 counter accept`,
+		},
+		"drop with log": {
+			args: []string{"-d", "10.0.1.2", "-o", "bn1", "-j", "LOG",
+				"--log-prefix", "FORWARD:TO:", "--log-level", "3"},
+			rc: RuleContext{},
+			expRule: `ip daddr 10.0.1.2 oifname "bn1" counter ` +
+				`log prefix "FORWARD:TO:" level err`,
+		},
+		"physdev-out outside of bridge family": {
+			args: []string{"-o", "bn1", "-m", "physdev", "--physdev-out",
+				"nbu1x1", "-j", "DROP"},
+			rc:         RuleContext{},
+			expNoMatch: true,
+		},
+		"physdev-out in bridge family": {
+			args: []string{"-o", "bn1", "-m", "physdev", "--physdev-out",
+				"nbu1x1", "-j", "DROP"},
+			rc: RuleContext{Bridge: true},
+			expRule: `meta protocol ip meta obrname "bn1" oifname "nbu1x1" ` +
+				`counter drop`,
+		},
+		"physdev-in with VIF MAC": {
+			args: []string{"-m", "physdev", "--physdev-in", "nbu1x1+",
+				"-i", "bn1", "-j", "mark-bn1-nbu1x1-2"},
+			rc: RuleContext{VIFMAC: vifMAC},
+			expRule: `ether saddr 02:16:3e:00:00:01 iifname "bn1" counter ` +
+				`jump mark-bn1-nbu1x1-2`,
+		},
+		"physdev-in without VIF MAC": {
+			args:        []string{"-m", "physdev", "--physdev-in", "nbu1x1+"},
+			rc:          RuleContext{},
+			expectError: true,
+		},
+		"rate limit": {
+			args: []string{"-i", "bn1", "-p", "icmp", "-m", "limit",
+				"--limit", "10/m", "--limit-burst", "20", "-j", "ACCEPT"},
+			rc: RuleContext{Bridge: true},
+			expRule: `meta protocol ip meta ibrname "bn1" meta l4proto icmp ` +
+				`limit rate 10/minute burst 20 packets counter accept`,
+		},
+		"rate limit defaults": {
+			args:    []string{"-m", "limit", "-j", "ACCEPT"},
+			rc:      RuleContext{},
+			expRule: `limit rate 3/hour burst 5 packets counter accept`,
+		},
+		"port map": {
+			args: []string{"-i", "eth0", "-p", "tcp", "-d", "192.168.1.10",
+				"--dport", "8080", "-j", "DNAT", "--to-destination", "10.0.1.2:80"},
+			rc: RuleContext{},
+			expRule: `iifname "eth0" meta l4proto tcp ip daddr 192.168.1.10 ` +
+				`tcp dport 8080 counter dnat to 10.0.1.2:80`,
+		},
+		"port map SNAT": {
+			args: []string{"-o", "bn1", "-p", "tcp", "--dport", "80",
+				"-m", "physdev", "!", "--physdev-is-bridged",
+				"-j", "SNAT", "--to-source", "10.0.1.1"},
+			rc: RuleContext{},
+			expRule: `oifname "bn1" meta l4proto tcp tcp dport 80 ct status dnat ` +
+				`counter snat to 10.0.1.1`,
+		},
+		"mark chain": {
+			args:    []string{"-m", "mark", "!", "--mark", "0", "-j", "ACCEPT"},
+			rc:      RuleContext{},
+			expRule: `meta mark != 0 counter accept`,
+		},
+		"connmark": {
+			args:    []string{"-j", "CONNMARK", "--set-mark", "16777218"},
+			rc:      RuleContext{},
+			expRule: `counter ct mark set 16777218`,
+		},
+		"connmark restore": {
+			args:    []string{"-j", "CONNMARK", "--restore-mark"},
+			rc:      RuleContext{},
+			expRule: `counter meta mark set ct mark`,
+		},
+		"negated address": {
+			args:    []string{"!", "-s", "fe80::/10", "-j", "DROP"},
+			rc:      RuleContext{ForIPv6: true},
+			expRule: `ip6 saddr != fe80::/10 counter drop`,
+		},
+		"unsupported option": {
+			args:        []string{"-m", "state", "--state", "NEW", "-j", "ACCEPT"},
+			rc:          RuleContext{},
+			expectError: true,
+		},
+		"port without protocol": {
+			args:        []string{"--dport", "80", "-j", "ACCEPT"},
+			rc:          RuleContext{},
+			expectError: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		rule, err := TranslateRule(test.args, test.rc)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("TestTranslateRule(%s): expected error, got rule: %s",
+					testname, rule)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("TestTranslateRule(%s): unexpected error: %v", testname, err)
+			continue
+		}
+		if test.expNoMatch {
+			if rule != "" {
+				t.Errorf("TestTranslateRule(%s): expected no rule, got: %s",
+					testname, rule)
+			}
+			continue
+		}
+		if rule != test.expRule {
+			t.Errorf("TestTranslateRule(%s): expected:\n%s\ngot:\n%s",
+				testname, test.expRule, rule)
+		}
+	}
+}
+
+func TestSetName(t *testing.T) {
+	if name := SetName("ipv4.eids.nbu1x1"); name != "ipv4.eids.nbu1x1" {
+		t.Errorf("unexpected set name %s", name)
+	}
+	if name := SetName("ipv6.Xy-z_12#example.com"); name != "ipv6.Xy-z_12.example.com" {
+		t.Errorf("unexpected set name %s", name)
+	}
+	nftset := DnsmasqNftset("ipv4.a#b", "ipv6.a#b")
+	if !strings.Contains(nftset, "4#ip#eve_apps#ipv4.a.b") ||
+		!strings.Contains(nftset, "6#bridge#eve_apps#ipv6.a.b") {
+		t.Errorf("unexpected dnsmasq nftset %s", nftset)
+	}
+}
+
+func TestCounterTag(t *testing.T) {
+	args := []string{"-m", "physdev", "--physdev-in", "nbu1x1+", "-i", "bn1",
+		"-j", "LOG", "--log-prefix", "FORWARD:FROM:", "--log-level", "3"}
+	tag := CounterTag(nil, "raw", "PREROUTING", 4, args)
+	ac, ok := parseCounterTag(tag)
+	if !ok {
+		t.Fatalf("failed to parse counter tag %s", tag)
+	}
+	if ac.Table != "raw" || ac.Chain != "PREROUTING" || ac.IpVer != 4 ||
+		ac.IIf != "bn1" || ac.Piif != "nbu1x1+" || !ac.Log || ac.Drop ||
+		ac.More {
+		t.Errorf("unexpected counters parsed from tag %s: %+v", tag, ac)
+	}
+	if len(tag) > 128 {
+		t.Errorf("counter tag %s is too long for nftables comment", tag)
+	}
+}
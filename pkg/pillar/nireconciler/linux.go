@@ -0,0 +1,1297 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+	uuid "github.com/satori/go.uuid"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Configuration graph of network instances and application VIFs:
+//
+//	+---------------------------------------------------------------------+
+//	|                           Network-Instances                         |
+//	|                                                                     |
+//	| +-----------------------------------+                               |
+//	| |             Global                |                               |
+//	| |                                   |                               |
+//	| | IPSets (local, host-name based)   |                               |
+//	| | or Nftables tables, base chains   |                               |
+//	| | and sets (local, host-name based) |                               |
+//	| +-----------------------------------+                               |
+//	|                                                                     |
+//	| +-----------------------------------+  +--------------------------+ |
+//	| |           NI-<UUID>               |  |      VIF-<vif-name>      | |
+//	| |                                   |  |                          | |
+//	| | Bridge, Dnsmasq, Radvd,           |  | IPSets (eids),           | |
+//	| | Routes (NI table), IP rules,      |  | Iptables chains (ACLs),  | |
+//	| | Iptables chains (NAT, tunnel)     |  | or Nftables sets (eids)  | |
+//	| |                                   |  | and chains (ACLs),       | |
+//	| |                                   |  | DHCP host,               | |
+//	| |                                   |  | Bandwidth shaper,        | |
+//	| |                                   |  | VIF (external)           | |
+//	| +-----------------------------------+  +--------------------------+ |
+//	|                  ...                               ...              |
+//	+---------------------------------------------------------------------+
+const (
+	// GraphName : name of the graph with the managed state as a whole.
+	GraphName = "Network-Instances"
+	// GlobalSG : name of the sub-graph with the global configuration
+	// shared by all network instances.
+	GlobalSG = "Global"
+	// NISGPrefix : prefix used for names of sub-graphs with the configuration
+	// of network instances.
+	NISGPrefix = "NI-"
+	// AppVIFSGPrefix : prefix used for names of sub-graphs with the configuration
+	// of application VIFs.
+	AppVIFSGPrefix = "VIF-"
+)
+
+const (
+	// File where the current state graph is exported (as DOT) after each reconcile.
+	// Can be used for troubleshooting purposes.
+	currentStateFile = "/run/zedrouter-current-state.dot"
+	// File where the intended state graph is exported (as DOT) after each reconcile.
+	// Can be used for troubleshooting purposes.
+	intendedStateFile = "/run/zedrouter-intended-state.dot"
+)
+
+// Names of ipsets with local (link-local, broadcast, multicast) addresses.
+const (
+	localIPv4SetName = "ipv4.local"
+	localIPv6SetName = "ipv6.local"
+)
+
+// Content of ipsets with local addresses.
+var (
+	localIPv4Entries = []string{"0.0.0.0/32", "255.255.255.255/32", "224.0.0.0/4"}
+	localIPv6Entries = []string{"fe80::/10", "ff02::/16"}
+)
+
+// NISGName returns the name of the sub-graph with the configuration
+// of the given network instance.
+func NISGName(niID uuid.UUID) string {
+	return NISGPrefix + niID.String()
+}
+
+// AppVIFSGName returns the name of the sub-graph with the configuration
+// of the given application VIF.
+func AppVIFSGName(vifName string) string {
+	return AppVIFSGPrefix + vifName
+}
+
+// LinuxNIReconciler is a network instance reconciler for Linux network stack,
+// i.e. it configures and uses Linux networking to provide connectivity
+// for applications.
+type LinuxNIReconciler struct {
+	sync.Mutex
+
+	// Enable to have the current state exported to /run/zedrouter-current-state.dot
+	// on every change.
+	ExportCurrentState bool
+	// Enable to have the intended state exported to /run/zedrouter-intended-state.dot
+	// on every change.
+	ExportIntendedState bool
+
+	Log            *base.LogObject           // mandatory
+	NetworkMonitor netmonitor.NetworkMonitor // mandatory
+
+	currentState  dg.Graph
+	intendedState dg.Graph
+
+	initialized      bool
+	registry         reconciler.ConfiguratorRegistry
+	watcherControl   chan watcherCtrl
+	pendingReconcile pendingReconcile
+	resumeReconcile  chan struct{}
+	resumeAsync      <-chan string // nil if no async ops
+
+	nis  map[uuid.UUID]NIConfig
+	vifs map[string]AppVIFConfig
+
+	// types.ACLBackendIptables (default if empty) or types.ACLBackendNftables.
+	aclBackend string
+
+	prevStatus ReconcileStatus
+}
+
+type pendingReconcile struct {
+	isPending bool
+	reasons   []string
+}
+
+type watcherCtrl uint8
+
+const (
+	watcherCtrlUndefined watcherCtrl = iota
+	watcherCtrlStart
+	watcherCtrlPause
+	watcherCtrlCont
+)
+
+// GetCurrentState : get the current state (read-only).
+// Exported only for unit-testing purposes.
+func (r *LinuxNIReconciler) GetCurrentState() dg.GraphR {
+	return r.currentState
+}
+
+// GetIntendedState : get the intended state (read-only).
+// Exported only for unit-testing purposes.
+func (r *LinuxNIReconciler) GetIntendedState() dg.GraphR {
+	return r.intendedState
+}
+
+func (r *LinuxNIReconciler) init() (startWatcher func()) {
+	r.Lock()
+	if r.initialized {
+		r.Log.Fatal("Already initialized")
+	}
+	registry := &reconciler.DefaultRegistry{}
+	err := linux.RegisterItems(r.Log, registry, r.NetworkMonitor)
+	if err != nil {
+		r.Log.Fatal(err)
+	}
+	r.registry = registry
+	r.nis = make(map[uuid.UUID]NIConfig)
+	r.vifs = make(map[string]AppVIFConfig)
+	r.watcherControl = make(chan watcherCtrl, 10)
+	netEvents := r.NetworkMonitor.WatchEvents(
+		context.Background(), "linux-ni-reconciler")
+	go r.watcher(netEvents)
+	// Apply the global configuration (e.g. default ipsets) with the first
+	// reconciliation even if there are no NIs yet.
+	r.addPendingReconcile("initial reconciliation", false)
+	r.initialized = true
+	return func() {
+		r.watcherControl <- watcherCtrlStart
+		r.Unlock()
+	}
+}
+
+func (r *LinuxNIReconciler) pauseWatcher() (cont func()) {
+	r.watcherControl <- watcherCtrlPause
+	r.Lock()
+	return func() {
+		r.watcherControl <- watcherCtrlCont
+		r.Unlock()
+	}
+}
+
+// lock either initializes the reconciler (on the first call) or pauses
+// the watcher. Returned function should be called when done.
+func (r *LinuxNIReconciler) lock() (unlock func()) {
+	if !r.initialized {
+		return r.init()
+	}
+	return r.pauseWatcher()
+}
+
+func (r *LinuxNIReconciler) watcher(netEvents <-chan netmonitor.Event) {
+	var ctrl watcherCtrl
+	for ctrl != watcherCtrlStart {
+		ctrl = <-r.watcherControl
+	}
+	r.Lock()
+	defer r.Unlock()
+	for {
+		select {
+		case <-r.resumeAsync:
+			r.addPendingReconcile("async op finalized", true)
+
+		case event := <-netEvents:
+			switch ev := event.(type) {
+			case netmonitor.RouteChange:
+				if ev.Table == syscall.RT_TABLE_MAIN &&
+					r.isWatchedIfIndex(ev.IfIndex) {
+					r.addPendingReconcile("route change", true)
+				}
+			case netmonitor.IfChange:
+				if (ev.Added || ev.Deleted) &&
+					r.isWatchedIfName(ev.Attrs.IfName) {
+					r.addPendingReconcile("interface added/deleted", true)
+				}
+			}
+
+		case ctrl = <-r.watcherControl:
+			if ctrl == watcherCtrlPause {
+				r.Unlock()
+				for ctrl != watcherCtrlCont {
+					ctrl = <-r.watcherControl
+				}
+				r.Lock()
+			}
+		}
+	}
+}
+
+// isWatchedIfName returns true if the interface is a bridge or an uplink
+// of some network instance, or a VIF with shaped bandwidth.
+func (r *LinuxNIReconciler) isWatchedIfName(ifName string) bool {
+	if vif, exists := r.vifs[ifName]; exists && vif.Shaping != nil {
+		return true
+	}
+	for _, ni := range r.nis {
+		if ni.BridgeName == ifName {
+			return true
+		}
+		if ni.NAT != nil && ni.NAT.Uplink == ifName {
+			return true
+		}
+		if ni.Tunnel != nil && ni.Tunnel.IfName == ifName {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *LinuxNIReconciler) isWatchedIfIndex(ifIndex int) bool {
+	for _, ni := range r.nis {
+		ifNames := []string{ni.BridgeName}
+		if ni.NAT != nil {
+			ifNames = append(ifNames, ni.NAT.Uplink)
+		}
+		if ni.Tunnel != nil {
+			ifNames = append(ifNames, ni.Tunnel.IfName)
+		}
+		for _, ifName := range ifNames {
+			idx, found, err := r.NetworkMonitor.GetInterfaceIndex(ifName)
+			if err == nil && found && idx == ifIndex {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *LinuxNIReconciler) addPendingReconcile(reason string, sendSignal bool) {
+	var duplicateReason bool
+	for _, prevReason := range r.pendingReconcile.reasons {
+		if prevReason == reason {
+			duplicateReason = true
+			break
+		}
+	}
+	if !duplicateReason {
+		r.pendingReconcile.reasons = append(r.pendingReconcile.reasons, reason)
+	}
+	if r.pendingReconcile.isPending {
+		return
+	}
+	r.pendingReconcile.isPending = true
+	if !sendSignal {
+		return
+	}
+	select {
+	case r.resumeReconcile <- struct{}{}:
+	default:
+		r.Log.Warn("Failed to send signal to resume reconciliation")
+	}
+}
+
+// UpdateNI : create a new or update an existing network instance.
+func (r *LinuxNIReconciler) UpdateNI(ctx context.Context, ni NIConfig) ReconcileStatus {
+	unlock := r.lock()
+	defer unlock()
+	reason := fmt.Sprintf("NI %s (%s) update", ni.UUID, ni.DisplayName)
+	r.nis[ni.UUID] = ni
+	r.addPendingReconcile(reason, false)
+	return r.reconcile(ctx, NISGName(ni.UUID))
+}
+
+// DelNI : remove network instance with all its configuration.
+func (r *LinuxNIReconciler) DelNI(ctx context.Context, niID uuid.UUID) ReconcileStatus {
+	unlock := r.lock()
+	defer unlock()
+	if _, exists := r.nis[niID]; !exists {
+		return r.reconcile(ctx, "")
+	}
+	reason := fmt.Sprintf("NI %s delete", niID)
+	delete(r.nis, niID)
+	r.addPendingReconcile(reason, false)
+	return r.reconcile(ctx, NISGName(niID))
+}
+
+// UpdateAppVIF : create a new or update an existing application VIF.
+func (r *LinuxNIReconciler) UpdateAppVIF(ctx context.Context, vif AppVIFConfig) ReconcileStatus {
+	unlock := r.lock()
+	defer unlock()
+	reason := fmt.Sprintf("VIF %s update", vif.VIFName)
+	r.vifs[vif.VIFName] = vif
+	r.addPendingReconcile(reason, false)
+	return r.reconcile(ctx, AppVIFSGName(vif.VIFName))
+}
+
+// DelAppVIF : remove application VIF with all its configuration.
+func (r *LinuxNIReconciler) DelAppVIF(ctx context.Context, vifName string) ReconcileStatus {
+	unlock := r.lock()
+	defer unlock()
+	if _, exists := r.vifs[vifName]; !exists {
+		return r.reconcile(ctx, "")
+	}
+	reason := fmt.Sprintf("VIF %s delete", vifName)
+	delete(r.vifs, vifName)
+	r.addPendingReconcile(reason, false)
+	return r.reconcile(ctx, AppVIFSGName(vifName))
+}
+
+// SetACLBackend : select the packet filtering framework used to implement ACLs.
+func (r *LinuxNIReconciler) SetACLBackend(ctx context.Context, backend string) ReconcileStatus {
+	unlock := r.lock()
+	defer unlock()
+	if backend == r.aclBackend {
+		return r.reconcile(ctx, "")
+	}
+	reason := fmt.Sprintf("ACL backend changed to %s", backend)
+	r.aclBackend = backend
+	r.addPendingReconcile(reason, false)
+	return r.reconcile(ctx, "")
+}
+
+// ResumeReconcile : resume reconciliation after a signal received
+// from ReconcileStatus.ResumeReconcile.
+func (r *LinuxNIReconciler) ResumeReconcile(ctx context.Context) ReconcileStatus {
+	unlock := r.lock()
+	defer unlock()
+	return r.reconcile(ctx, "")
+}
+
+// reconcile runs the full state reconciliation if there is any pending.
+// Error of the returned status covers only items of the given sub-graph
+// (all items if forSG is empty).
+func (r *LinuxNIReconciler) reconcile(ctx context.Context, forSG string) ReconcileStatus {
+	if !r.pendingReconcile.isPending {
+		// Nothing to reconcile.
+		newStatus := r.prevStatus
+		newStatus.Error = nil
+		newStatus.FailingItems = nil
+		return newStatus
+	}
+
+	// Reconcile with clear network monitor cache to avoid working with stale data.
+	r.NetworkMonitor.ClearCache()
+	r.updateCurrentState()
+	r.updateIntendedState()
+	r.Log.Noticef("Running a full state reconciliation, reasons: %s",
+		strings.Join(r.pendingReconcile.reasons, ", "))
+	rs := reconciler.New(r.registry).Reconcile(ctx, r.currentState, r.intendedState)
+	r.currentState = rs.NewCurrentState
+	opLog := rs.OperationLog
+	if r.createdBridge(opLog) {
+		// Routing tables of network instances are identified by bridge
+		// ifindex, which is known only after the bridge is created.
+		r.NetworkMonitor.ClearCache()
+		r.updateIntendedState()
+		rs = reconciler.New(r.registry).Reconcile(ctx, r.currentState, r.intendedState)
+		r.currentState = rs.NewCurrentState
+		opLog = append(opLog, rs.OperationLog...)
+	}
+
+	// Log every executed operation.
+	for _, log := range opLog {
+		var withErr string
+		if log.Err != nil {
+			withErr = fmt.Sprintf(" with error: %v", log.Err)
+		}
+		r.Log.Noticef("NI Reconciler executed %v for %v%s, content: %s",
+			log.Operation, dg.Reference(log.Item), withErr, log.Item.String())
+	}
+
+	// Log transitions from no-error to error and vice-versa.
+	var failed, fixed []string
+	var failingItems reconciler.OperationLog
+	for _, log := range opLog {
+		if log.PrevErr == nil && log.Err != nil {
+			failed = append(failed,
+				fmt.Sprintf("%v (err: %v)", dg.Reference(log.Item), log.Err))
+		}
+		if log.PrevErr != nil && log.Err == nil {
+			fixed = append(fixed, dg.Reference(log.Item).String())
+		}
+		if log.Err != nil {
+			failingItems = append(failingItems, log)
+		}
+	}
+	if len(failed) > 0 {
+		r.Log.Errorf("Newly failed config items: %s",
+			strings.Join(failed, ", "))
+	}
+	if len(fixed) > 0 {
+		r.Log.Noticef("Fixed config items: %s",
+			strings.Join(fixed, ", "))
+	}
+
+	r.resumeReconcile = make(chan struct{}, 10)
+	newStatus := ReconcileStatus{
+		Error:           r.getSubGraphError(forSG),
+		AsyncInProgress: rs.AsyncOpsInProgress,
+		ResumeReconcile: r.resumeReconcile,
+		CancelAsyncOps:  rs.CancelAsyncOps,
+		WaitForAsyncOps: rs.WaitForAsyncOps,
+		FailingItems:    failingItems,
+	}
+
+	// Update the internal state.
+	r.prevStatus = newStatus
+	r.resumeAsync = rs.ReadyToResume
+	r.pendingReconcile.isPending = false
+	r.pendingReconcile.reasons = []string{}
+
+	// Output the current state into a file for troubleshooting purposes.
+	if r.ExportCurrentState {
+		dotExporter := &dg.DotExporter{CheckDeps: true}
+		dot, err := dotExporter.Export(r.currentState)
+		if err != nil {
+			r.Log.Warnf("Failed to export the current state to DOT: %v", err)
+		} else {
+			err := fileutils.WriteRename(currentStateFile, []byte(dot))
+			if err != nil {
+				r.Log.Warnf("WriteRename failed for %s: %v",
+					currentStateFile, err)
+			}
+		}
+	}
+	// Output the intended state into a file for troubleshooting purposes.
+	if r.ExportIntendedState {
+		dotExporter := &dg.DotExporter{CheckDeps: true}
+		dot, err := dotExporter.Export(r.intendedState)
+		if err != nil {
+			r.Log.Warnf("Failed to export the intended state to DOT: %v", err)
+		} else {
+			err := fileutils.WriteRename(intendedStateFile, []byte(dot))
+			if err != nil {
+				r.Log.Warnf("WriteRename failed for %s: %v",
+					intendedStateFile, err)
+			}
+		}
+	}
+	return newStatus
+}
+
+func (r *LinuxNIReconciler) createdBridge(opLog reconciler.OperationLog) bool {
+	for _, log := range opLog {
+		if log.Item.Type() == linux.BridgeTypename &&
+			log.Operation == reconciler.OperationCreate && log.Err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// getSubGraphError aggregates errors of all failing items from the given
+// sub-graph of the current state.
+func (r *LinuxNIReconciler) getSubGraphError(sgName string) error {
+	var graph dg.GraphR = r.currentState
+	if sgName != "" {
+		graph = r.currentState.SubGraph(sgName)
+		if graph == nil {
+			return nil
+		}
+	}
+	var errMsgs []string
+	iter := graph.Items(true)
+	for iter.Next() {
+		item, state := iter.Item()
+		if state.WithError() != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%v: %v",
+				dg.Reference(item), state.WithError()))
+		}
+	}
+	if len(errMsgs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(errMsgs, "; "))
+}
+
+// updateCurrentState updates external items of the current state,
+// i.e. bridges created by NIM.
+func (r *LinuxNIReconciler) updateCurrentState() {
+	if r.currentState == nil {
+		r.currentState = dg.New(dg.InitArgs{Name: GraphName})
+	}
+	// Forget bridges of removed network instances.
+	var removedNIs []dg.GraphR
+	sgIter := r.currentState.SubGraphs()
+	for sgIter.Next() {
+		sg := sgIter.SubGraph()
+		if !strings.HasPrefix(sg.Name(), NISGPrefix) {
+			continue
+		}
+		niID, err := uuid.FromString(strings.TrimPrefix(sg.Name(), NISGPrefix))
+		if err != nil {
+			continue
+		}
+		if _, exists := r.nis[niID]; !exists {
+			removedNIs = append(removedNIs, sg)
+		}
+	}
+	for _, sg := range removedNIs {
+		var externalItems []dg.ItemRef
+		iter := sg.Items(true)
+		for iter.Next() {
+			item, _ := iter.Item()
+			if item.External() {
+				externalItems = append(externalItems, dg.Reference(item))
+			}
+		}
+		for _, itemRef := range externalItems {
+			r.currentState.EditSubGraph(sg).DelItem(itemRef)
+		}
+	}
+	// Forget VIFs which were removed or are no longer shaped.
+	var unshapedVIFs []dg.GraphR
+	sgIter = r.currentState.SubGraphs()
+	for sgIter.Next() {
+		sg := sgIter.SubGraph()
+		if !strings.HasPrefix(sg.Name(), AppVIFSGPrefix) {
+			continue
+		}
+		vifName := strings.TrimPrefix(sg.Name(), AppVIFSGPrefix)
+		if vif, exists := r.vifs[vifName]; !exists || vif.Shaping == nil {
+			unshapedVIFs = append(unshapedVIFs, sg)
+		}
+	}
+	for _, sg := range unshapedVIFs {
+		vifName := strings.TrimPrefix(sg.Name(), AppVIFSGPrefix)
+		r.currentState.EditSubGraph(sg).DelItem(
+			dg.Reference(linux.VIF{IfName: vifName}))
+	}
+	for _, vif := range r.vifs {
+		if vif.Shaping == nil {
+			continue
+		}
+		sgName := AppVIFSGName(vif.VIFName)
+		sgR := r.currentState.SubGraph(sgName)
+		if sgR == nil {
+			r.currentState.PutSubGraph(dg.New(dg.InitArgs{Name: sgName}))
+			sgR = r.currentState.SubGraph(sgName)
+		}
+		sg := r.currentState.EditSubGraph(sgR)
+		vifItem := linux.VIF{IfName: vif.VIFName}
+		_, found, err := r.NetworkMonitor.GetInterfaceIndex(vif.VIFName)
+		if err != nil {
+			r.Log.Errorf("updateCurrentState: failed to get ifIndex for %s: %v",
+				vif.VIFName, err)
+		}
+		if found {
+			sg.PutItem(vifItem, &reconciler.ItemStateData{
+				State:         reconciler.ItemStateCreated,
+				LastOperation: reconciler.OperationCreate,
+			})
+		} else {
+			sg.DelItem(dg.Reference(vifItem))
+		}
+	}
+	for _, ni := range r.nis {
+		if !ni.ExternalBridge {
+			continue
+		}
+		sgName := NISGName(ni.UUID)
+		sgR := r.currentState.SubGraph(sgName)
+		if sgR == nil {
+			r.currentState.PutSubGraph(dg.New(dg.InitArgs{Name: sgName}))
+			sgR = r.currentState.SubGraph(sgName)
+		}
+		sg := r.currentState.EditSubGraph(sgR)
+		bridge := linux.Bridge{IfName: ni.BridgeName, CreatedByNIM: true}
+		_, found, err := r.NetworkMonitor.GetInterfaceIndex(ni.BridgeName)
+		if err != nil {
+			r.Log.Errorf("updateCurrentState: failed to get ifIndex for %s: %v",
+				ni.BridgeName, err)
+		}
+		if found {
+			sg.PutItem(bridge, &reconciler.ItemStateData{
+				State:         reconciler.ItemStateCreated,
+				LastOperation: reconciler.OperationCreate,
+			})
+		} else {
+			sg.DelItem(dg.Reference(bridge))
+		}
+	}
+}
+
+func (r *LinuxNIReconciler) updateIntendedState() {
+	graphArgs := dg.InitArgs{
+		Name:        GraphName,
+		Description: "Network instances and application VIFs",
+	}
+	r.intendedState = dg.New(graphArgs)
+	r.intendedState.PutSubGraph(r.getIntendedGlobalCfg())
+	for _, ni := range r.nis {
+		r.intendedState.PutSubGraph(r.getIntendedNICfg(ni))
+	}
+	for _, vif := range r.vifs {
+		r.intendedState.PutSubGraph(r.getIntendedAppVIFCfg(vif))
+	}
+}
+
+func (r *LinuxNIReconciler) getIntendedGlobalCfg() dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        GlobalSG,
+		Description: "Global configuration shared by all network instances",
+	}
+	intendedCfg := dg.New(graphArgs)
+	// Sets filled by dnsmasq with IPs of resolved host names.
+	hostIPSets := make(map[string]struct{})
+	for _, ni := range r.nis {
+		if ni.Dnsmasq == nil {
+			continue
+		}
+		for _, ipset := range ni.Dnsmasq.IPSets {
+			hostIPSets[ipset] = struct{}{}
+		}
+	}
+	for _, vif := range r.vifs {
+		for _, rule := range vif.ACLRules {
+			for _, ipset := range referencedIPSets(rule) {
+				hostIPSets[ipset] = struct{}{}
+			}
+		}
+	}
+	for ipset := range hostIPSets {
+		if ipset == localIPv4SetName || ipset == localIPv6SetName ||
+			isEIDsIPSet(ipset) {
+			delete(hostIPSets, ipset)
+		}
+	}
+	if r.useNftables() {
+		r.putIntendedGlobalNftCfg(intendedCfg, hostIPSets)
+		return intendedCfg
+	}
+	intendedCfg.PutItem(linux.IPSet{
+		SetName:  localIPv4SetName,
+		TypeName: "hash:net",
+		Entries:  localIPv4Entries,
+	}, nil)
+	intendedCfg.PutItem(linux.IPSet{
+		SetName:  localIPv6SetName,
+		TypeName: "hash:net",
+		ForIPv6:  true,
+		Entries:  localIPv6Entries,
+	}, nil)
+	for ipset := range hostIPSets {
+		intendedCfg.PutItem(linux.IPSet{
+			SetName:  ipset,
+			TypeName: "hash:ip",
+			ForIPv6:  strings.HasPrefix(ipset, "ipv6."),
+		}, nil)
+	}
+	return intendedCfg
+}
+
+// putIntendedGlobalNftCfg puts nftables tables, base chains and sets
+// shared by all VIFs into the global sub-graph.
+func (r *LinuxNIReconciler) putIntendedGlobalNftCfg(intendedCfg dg.Graph,
+	hostIPSets map[string]struct{}) {
+	for _, family := range []string{nftables.FamilyIP, nftables.FamilyIP6,
+		nftables.FamilyBridge} {
+		intendedCfg.PutItem(linux.NftTable{Family: family}, nil)
+	}
+	for _, baseChain := range nftBaseChains {
+		for _, family := range baseChain.families() {
+			intendedCfg.PutItem(linux.NftChain{
+				Family:    family,
+				ChainName: baseChain.name(),
+				Hook:      baseChain.hook,
+				Priority:  baseChain.priority,
+				NAT:       baseChain.nat,
+			}, nil)
+		}
+	}
+	for _, family := range nftSetFamilies(false) {
+		intendedCfg.PutItem(linux.NftSet{
+			Family:   family,
+			SetName:  nftables.SetName(localIPv4SetName),
+			Interval: true,
+			Entries:  localIPv4Entries,
+		}, nil)
+	}
+	for _, family := range nftSetFamilies(true) {
+		intendedCfg.PutItem(linux.NftSet{
+			Family:   family,
+			SetName:  nftables.SetName(localIPv6SetName),
+			ForIPv6:  true,
+			Interval: true,
+			Entries:  localIPv6Entries,
+		}, nil)
+	}
+	for ipset := range hostIPSets {
+		forIPv6 := strings.HasPrefix(ipset, "ipv6.")
+		for _, family := range nftSetFamilies(forIPv6) {
+			intendedCfg.PutItem(linux.NftSet{
+				Family:  family,
+				SetName: nftables.SetName(ipset),
+				ForIPv6: forIPv6,
+			}, nil)
+		}
+	}
+}
+
+func (r *LinuxNIReconciler) getIntendedNICfg(ni NIConfig) dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        NISGName(ni.UUID),
+		Description: fmt.Sprintf("Network instance %s (%s)", ni.DisplayName, ni.UUID),
+	}
+	intendedCfg := dg.New(graphArgs)
+	if ni.ExternalBridge {
+		// Bridge created by NIM is also put into the intended state so that
+		// the Reconciler knows that the item is external even if it is missing.
+		intendedCfg.PutItem(linux.Bridge{
+			IfName:       ni.BridgeName,
+			CreatedByNIM: true,
+		}, nil)
+	} else {
+		intendedCfg.PutItem(linux.Bridge{
+			IfName:      ni.BridgeName,
+			MACAddress:  ni.BridgeMAC,
+			IPAddresses: ni.BridgeIPs,
+		}, nil)
+	}
+	if ni.Dnsmasq != nil {
+		dnsmasq := linux.Dnsmasq{
+			BridgeIfName: ni.BridgeName,
+			ConfigPath:   ni.Dnsmasq.ConfigPath,
+			PidFile:      ni.Dnsmasq.PidFile,
+			Config:       ni.Dnsmasq.Config,
+			HostsDir:     ni.Dnsmasq.HostsDir,
+			DhcpHostsDir: ni.Dnsmasq.DhcpHostsDir,
+		}
+		if r.useNftables() {
+			for _, ipset := range ni.Dnsmasq.IPSets {
+				forIPv6 := strings.HasPrefix(ipset, "ipv6.")
+				for _, family := range nftSetFamilies(forIPv6) {
+					dnsmasq.NftSets = append(dnsmasq.NftSets, linux.NftSet{
+						Family:  family,
+						SetName: nftables.SetName(ipset),
+					}.Name())
+				}
+			}
+		} else {
+			dnsmasq.IPSets = ni.Dnsmasq.IPSets
+		}
+		intendedCfg.PutItem(dnsmasq, nil)
+	}
+	if ni.Radvd != nil {
+		intendedCfg.PutItem(linux.Radvd{
+			BridgeIfName: ni.BridgeName,
+			ConfigPath:   ni.Radvd.ConfigPath,
+			PidFile:      ni.Radvd.PidFile,
+			Config:       ni.Radvd.Config,
+		}, nil)
+	}
+	if ni.NAT != nil {
+		intendedCfg.PutItem(linux.IptablesChain{
+			ChainName: natChainName(ni.BridgeName),
+			Table:     "nat",
+			JumpFrom:  "POSTROUTING" + iptables.AppChainSuffix,
+			Rules: []linux.IptablesRule{
+				{
+					Args: []string{"-o", ni.NAT.Uplink, "-s", ni.NAT.Subnet.String(),
+						"-j", "MASQUERADE"},
+					Description: "NAT traffic from the NI subnet leaving via uplink",
+				},
+			},
+		}, nil)
+		if ni.NAT.IPv6Subnet != nil && ni.NAT.NAT66 {
+			intendedCfg.PutItem(linux.IptablesChain{
+				ChainName: natChainName(ni.BridgeName),
+				Table:     "nat",
+				ForIPv6:   true,
+				JumpFrom:  "POSTROUTING" + iptables.AppChainSuffix,
+				Rules: []linux.IptablesRule{
+					{
+						Args: []string{"-o", ni.NAT.Uplink, "-s",
+							ni.NAT.IPv6Subnet.String(), "-j", "MASQUERADE"},
+						Description: "NAT66 traffic from the NI IPv6 subnet " +
+							"leaving via uplink",
+					},
+				},
+			}, nil)
+		}
+	}
+	if ni.Tunnel != nil {
+		for _, chain := range getIntendedTunnelChains(ni) {
+			intendedCfg.PutItem(chain, nil)
+		}
+	}
+	if ni.ExternalBridge {
+		// Routing of switch NI traffic is configured by NIM.
+		return intendedCfg
+	}
+	bridgeIfIndex, found, err := r.NetworkMonitor.GetInterfaceIndex(ni.BridgeName)
+	if err != nil {
+		r.Log.Errorf("getIntendedNICfg: failed to get ifIndex for %s: %v",
+			ni.BridgeName, err)
+		return intendedCfg
+	}
+	if !found {
+		// Routes and IP rules will be added once the bridge is created.
+		return intendedCfg
+	}
+	table := devicenetwork.BaseRTIndex + bridgeIfIndex
+	for _, rt := range r.getIntendedRoutes(ni, bridgeIfIndex, table) {
+		intendedCfg.PutItem(rt, nil)
+	}
+	for _, rule := range r.getIntendedIPRules(ni, table) {
+		intendedCfg.PutItem(rule, nil)
+	}
+	return intendedCfg
+}
+
+func natChainName(bridgeName string) string {
+	return "POSTROUTING" + iptables.AppChainSuffix + "-" + bridgeName
+}
+
+func forwardChainName(bridgeName string) string {
+	return "FORWARD" + iptables.AppChainSuffix + "-" + bridgeName
+}
+
+// getIntendedTunnelChains returns iptables chains of a NI with a tunnel:
+// NAT of the traffic leaving via the tunnel and DHCP filtering on the bridge port.
+func getIntendedTunnelChains(ni NIConfig) (chains []linux.IptablesChain) {
+	if ni.Tunnel.Masquerade {
+		chains = append(chains, linux.IptablesChain{
+			ChainName: natChainName(ni.BridgeName),
+			Table:     "nat",
+			JumpFrom:  "POSTROUTING" + iptables.AppChainSuffix,
+			Rules: []linux.IptablesRule{
+				{
+					Args: []string{"-o", ni.Tunnel.IfName, "-s", ni.Tunnel.Subnet.String(),
+						"-j", "MASQUERADE"},
+					Description: "NAT traffic from the NI subnet leaving via tunnel",
+				},
+			},
+		})
+	}
+	if ni.Tunnel.NoDHCPBridgePort != "" {
+		var rules []linux.IptablesRule
+		for _, physdev := range []string{"--physdev-in", "--physdev-out"} {
+			rules = append(rules, linux.IptablesRule{
+				Args: []string{"-i", ni.BridgeName, "-m", "physdev",
+					physdev, ni.Tunnel.NoDHCPBridgePort, "-p", "udp",
+					"--dport", "67:68", "-j", "DROP"},
+				Description: "Do not pass DHCP through the bridge port",
+			})
+		}
+		// Evaluated before ACLs of application VIFs, which allow DHCP.
+		chains = append(chains, linux.IptablesChain{
+			ChainName: forwardChainName(ni.BridgeName),
+			Table:     "filter",
+			JumpFrom:  "FORWARD" + iptables.AppChainSuffix,
+			JumpFirst: true,
+			Rules:     rules,
+		})
+	}
+	return chains
+}
+
+// getIntendedRoutes returns routes of the NI-specific routing table.
+// The table contains routes of the bridge, and for NAT-ed NI also IPv4 routes
+// of the uplink followed by the lowest-priority unreachable default route,
+// preventing packets from escaping the NI-specific routing table.
+// For dual-stack NI the same is done for IPv6.
+// NI with a tunnel has the routes through the tunnel instead of the uplink routes.
+func (r *LinuxNIReconciler) getIntendedRoutes(
+	ni NIConfig, bridgeIfIndex, table int) (routes []linux.Route) {
+	type outputIf struct {
+		ifName   string
+		onlyIPv4 bool
+	}
+	outputIfs := []outputIf{{ifName: ni.BridgeName}}
+	if ni.NAT != nil && ni.NAT.Uplink != "" {
+		outputIfs = append(outputIfs, outputIf{ifName: ni.NAT.Uplink,
+			onlyIPv4: ni.NAT.IPv6Subnet == nil})
+	}
+	for _, outIf := range outputIfs {
+		ifIndex, found, err := r.NetworkMonitor.GetInterfaceIndex(outIf.ifName)
+		if err != nil {
+			r.Log.Errorf("getIntendedRoutes: failed to get ifIndex for %s: %v",
+				outIf.ifName, err)
+			continue
+		}
+		if !found {
+			continue
+		}
+		mainRoutes, err := r.NetworkMonitor.ListRoutes(netmonitor.RouteFilters{
+			FilterByTable: true,
+			Table:         syscall.RT_TABLE_MAIN,
+			FilterByIf:    true,
+			IfIndex:       ifIndex,
+		})
+		if err != nil {
+			r.Log.Errorf("getIntendedRoutes: ListRoutes failed for ifIndex %d: %v",
+				ifIndex, err)
+			continue
+		}
+		for _, rt := range mainRoutes {
+			rtCopy, ok := rt.Data.(netlink.Route)
+			if !ok {
+				continue
+			}
+			if outIf.onlyIPv4 && rtCopy.Family != netlink.FAMILY_V4 {
+				continue
+			}
+			if rtCopy.Family == netlink.FAMILY_V6 && rtCopy.Dst != nil &&
+				rtCopy.Dst.IP.IsLinkLocalUnicast() {
+				// Link-local routes are copied only for the bridge.
+				if outIf.ifName != ni.BridgeName {
+					continue
+				}
+			}
+			if rtCopy.Family == netlink.FAMILY_V6 && rtCopy.Dst == nil {
+				// Make the name of the IPv6 default route differ from IPv4.
+				_, rtCopy.Dst, _ = net.ParseCIDR("::/0")
+			}
+			rtCopy.Table = table
+			// Clear any RTNH_F_LINKDOWN etc flags since add doesn't like them.
+			rtCopy.Flags = 0
+			routes = append(routes, linux.Route{
+				Route:     rtCopy,
+				OutputIf:  outIf.ifName,
+				ForBridge: ni.BridgeName,
+			})
+		}
+	}
+	if ni.Tunnel != nil {
+		routes = append(routes, r.getIntendedTunnelRoutes(ni, table)...)
+	}
+	if ni.NAT != nil || ni.Tunnel != nil {
+		_, defDst, _ := net.ParseCIDR("0.0.0.0/0")
+		routes = append(routes, linux.Route{
+			Route: netlink.Route{
+				Dst:      defDst,
+				Priority: int(^uint32(0)), // do not override any actual default route
+				Table:    table,
+				Type:     unix.RTN_UNREACHABLE,
+				Family:   netlink.FAMILY_V4,
+			},
+			ForBridge: ni.BridgeName,
+		})
+		if ni.NAT != nil && ni.NAT.IPv6Subnet != nil {
+			_, defDstV6, _ := net.ParseCIDR("::/0")
+			routes = append(routes, linux.Route{
+				Route: netlink.Route{
+					Dst:      defDstV6,
+					Priority: int(^uint32(0)),
+					Table:    table,
+					Type:     unix.RTN_UNREACHABLE,
+					Family:   netlink.FAMILY_V6,
+				},
+				ForBridge: ni.BridgeName,
+			})
+		}
+	}
+	return routes
+}
+
+// getIntendedTunnelRoutes returns IPv4 routes through the tunnel of the NI.
+// Routes are added only once the tunnel interface exists.
+func (r *LinuxNIReconciler) getIntendedTunnelRoutes(
+	ni NIConfig, table int) (routes []linux.Route) {
+	ifIndex, found, err := r.NetworkMonitor.GetInterfaceIndex(ni.Tunnel.IfName)
+	if err != nil {
+		r.Log.Errorf("getIntendedTunnelRoutes: failed to get ifIndex for %s: %v",
+			ni.Tunnel.IfName, err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	for _, dst := range ni.Tunnel.Routes {
+		if dst.IP.To4() == nil {
+			continue
+		}
+		routes = append(routes, linux.Route{
+			Route: netlink.Route{
+				LinkIndex: ifIndex,
+				Dst:       dst,
+				Table:     table,
+				Family:    netlink.FAMILY_V4,
+			},
+			OutputIf:  ni.Tunnel.IfName,
+			ForBridge: ni.BridgeName,
+		})
+	}
+	return routes
+}
+
+// getIntendedIPRules returns IP rules steering traffic of a NAT-ed NI
+// or of a NI with a tunnel.
+func (r *LinuxNIReconciler) getIntendedIPRules(
+	ni NIConfig, table int) (rules []linux.IPRule) {
+	if ni.Tunnel != nil {
+		return getSubnetIPRules(ni.BridgeName, ni.Tunnel.Subnet,
+			ni.Tunnel.Gateway, table)
+	}
+	if ni.NAT == nil {
+		return nil
+	}
+	rules = getSubnetIPRules(ni.BridgeName, ni.NAT.Subnet, ni.NAT.Gateway, table)
+	if ni.NAT.IPv6Subnet != nil {
+		rules = append(rules, getSubnetIPRules(ni.BridgeName,
+			ni.NAT.IPv6Subnet, ni.NAT.IPv6Gateway, table)...)
+	}
+	return rules
+}
+
+// getSubnetIPRules returns IP rules steering traffic of one NI subnet.
+func getSubnetIPRules(bridgeName string, subnet *net.IPNet, gateway net.IP,
+	table int) (rules []linux.IPRule) {
+	if gateway != nil {
+		gwSubnet := devicenetwork.HostSubnet(gateway)
+		// Packets destined to the gateway (bridge IP) are handled locally.
+		rules = append(rules, linux.IPRule{
+			Priority:  devicenetwork.PbrNatOutGatewayPrio,
+			Table:     syscall.RT_TABLE_LOCAL,
+			Src:       subnet,
+			Dst:       &gwSubnet,
+			ForBridge: bridgeName,
+		})
+	}
+	rules = append(rules, linux.IPRule{
+		Priority:  devicenetwork.PbrNatOutPrio,
+		Table:     table,
+		Src:       subnet,
+		ForBridge: bridgeName,
+	})
+	rules = append(rules, linux.IPRule{
+		Priority:  devicenetwork.PbrNatInPrio,
+		Table:     table,
+		Dst:       subnet,
+		ForBridge: bridgeName,
+	})
+	return rules
+}
+
+func (r *LinuxNIReconciler) getIntendedAppVIFCfg(vif AppVIFConfig) dg.Graph {
+	graphArgs := dg.InitArgs{
+		Name:        AppVIFSGName(vif.VIFName),
+		Description: fmt.Sprintf("VIF %s of application %s", vif.VIFName, vif.AppID),
+	}
+	intendedCfg := dg.New(graphArgs)
+	ni, hasNI := r.nis[vif.NI]
+	if !hasNI {
+		r.Log.Warnf("getIntendedAppVIFCfg: missing NI %s for VIF %s",
+			vif.NI, vif.VIFName)
+		return intendedCfg
+	}
+	// Ipsets with IPs assigned to the application (and other apps
+	// in the same NI resolved by the DNS).
+	var eids4, eids6 []string
+	for _, ip := range vif.EIDs {
+		if ip.To4() != nil {
+			eids4 = appendIfMissing(eids4, ip.String())
+		} else {
+			eids6 = appendIfMissing(eids6, ip.String())
+		}
+	}
+	if r.useNftables() {
+		for _, family := range nftSetFamilies(false) {
+			intendedCfg.PutItem(linux.NftSet{
+				Family:  family,
+				SetName: nftables.SetName(eidsIPSetName(vif.VIFName, false)),
+				Entries: eids4,
+			}, nil)
+		}
+		for _, family := range nftSetFamilies(true) {
+			intendedCfg.PutItem(linux.NftSet{
+				Family:  family,
+				SetName: nftables.SetName(eidsIPSetName(vif.VIFName, true)),
+				ForIPv6: true,
+				Entries: eids6,
+			}, nil)
+		}
+		for _, chain := range r.getIntendedNftChains(vif, ni) {
+			intendedCfg.PutItem(chain, nil)
+		}
+	} else {
+		intendedCfg.PutItem(linux.IPSet{
+			SetName:  eidsIPSetName(vif.VIFName, false),
+			TypeName: "hash:ip",
+			Entries:  eids4,
+		}, nil)
+		intendedCfg.PutItem(linux.IPSet{
+			SetName:  eidsIPSetName(vif.VIFName, true),
+			TypeName: "hash:ip",
+			ForIPv6:  true,
+			Entries:  eids6,
+		}, nil)
+		for _, chain := range r.getIntendedACLChains(vif) {
+			intendedCfg.PutItem(chain, nil)
+		}
+	}
+	if vif.Shaping != nil {
+		// VIF created by the hypervisor is also put into the intended state
+		// so that the Reconciler knows that the item is external.
+		intendedCfg.PutItem(linux.VIF{IfName: vif.VIFName}, nil)
+		intendedCfg.PutItem(linux.BandwidthShaper{
+			VIFName: vif.VIFName,
+			Ingress: vif.Shaping.Ingress,
+			Egress:  vif.Shaping.Egress,
+		}, nil)
+	}
+	if vif.DhcpHost != nil && ni.Dnsmasq != nil {
+		intendedCfg.PutItem(linux.DhcpHost{
+			BridgeIfName:   ni.BridgeName,
+			DhcpHostsDir:   ni.Dnsmasq.DhcpHostsDir,
+			DnsmasqPidFile: ni.Dnsmasq.PidFile,
+			MACAddress:     vif.DhcpHost.MAC,
+			IPAddress:      vif.DhcpHost.IP,
+			Hostname:       vif.DhcpHost.Hostname,
+		}, nil)
+	}
+	if vif.DhcpHostIPv6 != nil && ni.Dnsmasq != nil {
+		intendedCfg.PutItem(linux.DhcpHost{
+			BridgeIfName:   ni.BridgeName,
+			DhcpHostsDir:   ni.Dnsmasq.DhcpHostsDir,
+			DnsmasqPidFile: ni.Dnsmasq.PidFile,
+			MACAddress:     vif.DhcpHostIPv6.MAC,
+			IPAddress:      vif.DhcpHostIPv6.IP,
+			Hostname:       vif.DhcpHostIPv6.Hostname,
+		}, nil)
+	}
+	return intendedCfg
+}
+
+// getIntendedACLChains groups ACL rules of the VIF by IP version, table and chain.
+// Every group is put into a separate chain, which is jumped into from the corresponding
+// pre-created chain for app-scoped ACLs. Rules keep their relative order.
+// Additionally, chains used to mark flows are created for rules referencing them.
+func (r *LinuxNIReconciler) getIntendedACLChains(vif AppVIFConfig) (chains []linux.IptablesChain) {
+	type chainKey struct {
+		forIPv6 bool
+		table   string
+		chain   string
+	}
+	var chainKeys []chainKey
+	vifChains := make(map[chainKey]*linux.IptablesChain)
+	markChains := make(map[chainKey]linux.IptablesChain)
+	for _, rule := range vif.ACLRules {
+		table := rule.Table
+		if table == "" {
+			table = "filter"
+		}
+		key := chainKey{forIPv6: rule.IPVer == 6, table: table, chain: rule.Chain}
+		vifChain, exists := vifChains[key]
+		if !exists {
+			parentChain := rule.Chain + iptables.AppChainSuffix
+			vifChain = &linux.IptablesChain{
+				ChainName: parentChain + "-" + vif.VIFName,
+				Table:     table,
+				ForIPv6:   key.forIPv6,
+				JumpFrom:  parentChain,
+			}
+			vifChains[key] = vifChain
+			chainKeys = append(chainKeys, key)
+		}
+		var args []string
+		args = append(args, rule.Prefix...)
+		args = append(args, rule.Rule...)
+		args = append(args, rule.Action...)
+		vifChain.Rules = append(vifChain.Rules, linux.IptablesRule{
+			Args:        args,
+			Description: rule.RuleName,
+		})
+		for _, ipset := range referencedIPSets(rule) {
+			vifChain.RefersIPSets = appendIfMissing(vifChain.RefersIPSets, ipset)
+		}
+		if rule.ActionChainName == "" {
+			continue
+		}
+		vifChain.RefersChains = appendIfMissing(vifChain.RefersChains,
+			rule.ActionChainName)
+		markKey := chainKey{forIPv6: key.forIPv6, table: table,
+			chain: rule.ActionChainName}
+		if _, exists := markChains[markKey]; !exists {
+			markChains[markKey] = getMarkAndAcceptChain(markKey.chain, table,
+				key.forIPv6, rule.ActionChainMark)
+		}
+	}
+	for _, key := range chainKeys {
+		chains = append(chains, *vifChains[key])
+	}
+	var markKeys []chainKey
+	for key := range markChains {
+		markKeys = append(markKeys, key)
+	}
+	sort.Slice(markKeys, func(i, j int) bool {
+		return markKeys[i].chain < markKeys[j].chain
+	})
+	for _, key := range markKeys {
+		chains = append(chains, markChains[key])
+	}
+	return chains
+}
+
+// getMarkAndAcceptChain returns chain which marks connection (unless already marked)
+// and accepts the packet.
+func getMarkAndAcceptChain(chainName, table string, forIPv6 bool,
+	mark uint32) linux.IptablesChain {
+	return linux.IptablesChain{
+		ChainName: chainName,
+		Table:     table,
+		ForIPv6:   forIPv6,
+		Rules: []linux.IptablesRule{
+			{Args: []string{"-j", "CONNMARK", "--restore-mark"}},
+			{Args: []string{"-m", "mark", "!", "--mark", "0", "-j", "ACCEPT"},
+				Description: "Connection is already marked"},
+			{Args: []string{"-j", "CONNMARK", "--set-mark",
+				strconv.FormatUint(uint64(mark), 10)}},
+			{Args: []string{"-j", "CONNMARK", "--restore-mark"}},
+			{Args: []string{"-j", "ACCEPT"}},
+		},
+	}
+}
+
+// referencedIPSets returns names of ipsets referenced by the rule.
+func referencedIPSets(rule types.IPTablesRule) (ipsets []string) {
+	args := append(append([]string{}, rule.Prefix...), rule.Rule...)
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--match-set" {
+			ipsets = appendIfMissing(ipsets, args[i+1])
+		}
+	}
+	return ipsets
+}
+
+func eidsIPSetName(vifName string, forIPv6 bool) string {
+	if forIPv6 {
+		return "ipv6.eids." + vifName
+	}
+	return "ipv4.eids." + vifName
+}
+
+func isEIDsIPSet(ipset string) bool {
+	return strings.HasPrefix(ipset, "ipv4.eids.") ||
+		strings.HasPrefix(ipset, "ipv6.eids.")
+}
+
+func appendIfMissing(list []string, item string) []string {
+	for _, existing := range list {
+		if existing == item {
+			return list
+		}
+	}
+	return append(list, item)
+}
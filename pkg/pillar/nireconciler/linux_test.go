@@ -0,0 +1,560 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler_test
+
+import (
+	"context"
+	"log"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+	nirec "github.com/lf-edge/eve/pkg/pillar/nireconciler"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/tc"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+var (
+	niReconciler   *nirec.LinuxNIReconciler
+	networkMonitor *netmonitor.MockNetworkMonitor
+)
+
+func initTest(test *testing.T) *GomegaWithT {
+	t := NewGomegaWithT(test)
+	t.SetDefaultEventuallyTimeout(5 * time.Second)
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	networkMonitor = &netmonitor.MockNetworkMonitor{
+		Log:    log,
+		MainRT: syscall.RT_TABLE_MAIN,
+	}
+	niReconciler = &nirec.LinuxNIReconciler{
+		Log:            log,
+		NetworkMonitor: networkMonitor,
+	}
+	return t
+}
+
+func itemIsCreated(itemRef dg.ItemRef) bool {
+	_, state, _, found := niReconciler.GetCurrentState().Item(itemRef)
+	return found && state.IsCreated()
+}
+
+func itemDescription(itemRef dg.ItemRef) string {
+	item, _, _, found := niReconciler.GetCurrentState().Item(itemRef)
+	if !found {
+		return ""
+	}
+	return item.String()
+}
+
+func itemCountWithType(itemType string) (count int) {
+	currentState := niReconciler.GetCurrentState()
+	iter := currentState.Items(true)
+	for iter.Next() {
+		item, state := iter.Item()
+		if item.Type() == itemType && state.IsCreated() {
+			count++
+		}
+	}
+	return count
+}
+
+func macAddress(macAddr string) net.HardwareAddr {
+	mac, err := net.ParseMAC(macAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return mac
+}
+
+func ipAddress(ipAddr string) *net.IPNet {
+	ip, subnet, err := net.ParseCIDR(ipAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	subnet.IP = ip
+	return subnet
+}
+
+func ipSubnet(ipAddr string) *net.IPNet {
+	_, subnet, err := net.ParseCIDR(ipAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return subnet
+}
+
+func mockInterface(ifIndex int, ifName, ifType string) netmonitor.MockInterface {
+	return netmonitor.MockInterface{
+		Attrs: netmonitor.IfAttrs{
+			IfIndex:       ifIndex,
+			IfName:        ifName,
+			IfType:        ifType,
+			WithBroadcast: true,
+			AdminUp:       true,
+			LowerUp:       true,
+		},
+	}
+}
+
+func mockRoute(ifIndex int, dst *net.IPNet, gw net.IP) netmonitor.Route {
+	return netmonitor.Route{
+		IfIndex: ifIndex,
+		Dst:     dst,
+		Gw:      gw,
+		Table:   syscall.RT_TABLE_MAIN,
+		Data: netlink.Route{
+			LinkIndex: ifIndex,
+			Dst:       dst,
+			Gw:        gw,
+			Table:     syscall.RT_TABLE_MAIN,
+			Family:    netlink.FAMILY_V4,
+		},
+	}
+}
+
+func localNI(niID uuid.UUID) nirec.NIConfig {
+	return nirec.NIConfig{
+		UUID:        niID,
+		DisplayName: "local-ni",
+		Type:        types.NetworkInstanceTypeLocal,
+		BridgeName:  "bn1",
+		BridgeMAC:   macAddress("00:16:3e:06:00:01"),
+		BridgeIPs:   []*net.IPNet{ipAddress("10.11.12.1/24")},
+		Dnsmasq: &nirec.DnsmasqConfig{
+			ConfigPath:   "/run/zedrouter/dnsmasq.bn1.conf",
+			PidFile:      "/run/dnsmasq.bn1.pid",
+			Config:       "interface=bn1\n",
+			HostsDir:     "/run/zedrouter/hosts.bn1",
+			DhcpHostsDir: "/run/zedrouter/dhcp-hosts.bn1",
+			IPSets:       []string{"ipv4.example.com", "ipv6.example.com"},
+		},
+		NAT: &nirec.NATConfig{
+			Uplink:  "eth0",
+			Subnet:  ipSubnet("10.11.12.0/24"),
+			Gateway: net.ParseIP("10.11.12.1"),
+		},
+	}
+}
+
+func appVIF(niID uuid.UUID) nirec.AppVIFConfig {
+	appID, _ := uuid.NewV4()
+	return nirec.AppVIFConfig{
+		VIFName: "nbu1x1",
+		AppID:   appID,
+		NI:      niID,
+		ACLRules: types.IPTablesRuleList{
+			{
+				IPVer:  4,
+				Table:  "raw",
+				Chain:  "PREROUTING",
+				Prefix: []string{"-m", "physdev", "--physdev-in", "nbu1x1+"},
+				Rule: []string{"-i", "bn1", "-m", "set", "--match-set",
+					"ipv4.example.com", "dst"},
+				Action: []string{"-j", "ACCEPT"},
+			},
+			{
+				IPVer:  4,
+				Chain:  "FORWARD",
+				Prefix: []string{"-d", "10.11.12.2"},
+				Rule:   []string{"-o", "bn1"},
+				Action: []string{"-j", "DROP"},
+			},
+			{
+				IPVer:           4,
+				Table:           "mangle",
+				Chain:           "PREROUTING",
+				Prefix:          []string{"-m", "physdev", "--physdev-in", "nbu1x1+"},
+				Rule:            []string{"-i", "bn1"},
+				Action:          []string{"-j", "drop-all-bn1-nbu1x1"},
+				ActionChainName: "drop-all-bn1-nbu1x1",
+				ActionChainMark: 0x1ffffff,
+			},
+		},
+		EIDs:     []net.IP{net.ParseIP("10.11.12.2")},
+		GuestMAC: macAddress("02:16:3e:00:00:01"),
+		DhcpHost: &nirec.DhcpHostConfig{
+			MAC:      macAddress("02:16:3e:00:00:01"),
+			IP:       net.ParseIP("10.11.12.2"),
+			Hostname: "app1",
+		},
+	}
+}
+
+func TestLocalNIWithApp(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(mockInterface(1, "eth0", "device"))
+	networkMonitor.UpdateRoutes([]netmonitor.Route{
+		mockRoute(1, nil, net.ParseIP("192.168.10.1")),
+	})
+	// Simulate that the bridge was already created.
+	networkMonitor.AddOrUpdateInterface(mockInterface(2, "bn1", "bridge"))
+
+	niID, _ := uuid.NewV4()
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.UpdateNI(ctx, localNI(niID))
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.AsyncInProgress).To(BeFalse())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(itemIsCreated(dg.Reference(linux.Bridge{IfName: "bn1"}))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(linux.Dnsmasq{BridgeIfName: "bn1"}))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(linux.Radvd{BridgeIfName: "bn1"}))).To(BeFalse())
+	natChain := dg.Reference(linux.IptablesChain{
+		Table: "nat", ChainName: "POSTROUTING-apps-bn1"})
+	t.Expect(itemDescription(natChain)).To(ContainSubstring("MASQUERADE"))
+	// Default route via eth0 + unreachable default route
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(2))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(3))
+	// ipv4.local, ipv6.local + 2 ipsets filled by dnsmasq
+	t.Expect(itemCountWithType(linux.IPSetTypename)).To(Equal(4))
+
+	// Connect application.
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateAppVIF(ctx, appVIF(niID))
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	// + ipv4.eids.nbu1x1, ipv6.eids.nbu1x1
+	t.Expect(itemCountWithType(linux.IPSetTypename)).To(Equal(6))
+	// NAT chain + raw, filter and mangle chain for the VIF + marking chain
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(5))
+	t.Expect(itemCountWithType(linux.IP6tablesChainTypename)).To(Equal(0))
+	markChain := dg.Reference(linux.IptablesChain{
+		Table: "mangle", ChainName: "drop-all-bn1-nbu1x1"})
+	t.Expect(itemDescription(markChain)).To(ContainSubstring("--set-mark 33554431"))
+	vifChain := dg.Reference(linux.IptablesChain{
+		Table: "raw", ChainName: "PREROUTING-apps-nbu1x1"})
+	t.Expect(itemDescription(vifChain)).To(ContainSubstring("ipv4.example.com"))
+	t.Expect(itemCountWithType(linux.DhcpHostTypename)).To(Equal(1))
+
+	// Route added to the uplink should be propagated into the NI routing table.
+	networkMonitor.UpdateRoutes([]netmonitor.Route{
+		mockRoute(1, nil, net.ParseIP("192.168.10.1")),
+		mockRoute(1, ipSubnet("192.168.10.0/24"), nil),
+	})
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.ResumeReconcile(ctx)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(3))
+
+	// Disconnect application.
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.DelAppVIF(ctx, "nbu1x1")
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.IPSetTypename)).To(Equal(4))
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(1))
+	t.Expect(itemCountWithType(linux.DhcpHostTypename)).To(Equal(0))
+
+	// Deactivate NAT.
+	ni := localNI(niID)
+	ni.NAT = nil
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(0))
+	t.Expect(itemIsCreated(dg.Reference(linux.Dnsmasq{BridgeIfName: "bn1"}))).To(BeTrue())
+
+	// Remove network instance.
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.DelNI(ctx, niID)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(dg.Reference(linux.Bridge{IfName: "bn1"}))).To(BeFalse())
+	t.Expect(itemIsCreated(dg.Reference(linux.Dnsmasq{BridgeIfName: "bn1"}))).To(BeFalse())
+	// Only ipv4.local, ipv6.local remain.
+	t.Expect(itemCountWithType(linux.IPSetTypename)).To(Equal(2))
+}
+
+func TestDualStackLocalNI(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(mockInterface(1, "eth0", "device"))
+	v6DefRoute := mockRoute(1, nil, net.ParseIP("fe80::1"))
+	v6DefRoute.Data = netlink.Route{
+		LinkIndex: 1,
+		Gw:        net.ParseIP("fe80::1"),
+		Table:     syscall.RT_TABLE_MAIN,
+		Family:    netlink.FAMILY_V6,
+	}
+	networkMonitor.UpdateRoutes([]netmonitor.Route{
+		mockRoute(1, nil, net.ParseIP("192.168.10.1")),
+		v6DefRoute,
+	})
+	networkMonitor.AddOrUpdateInterface(mockInterface(2, "bn1", "bridge"))
+
+	niID, _ := uuid.NewV4()
+	ni := localNI(niID)
+	ni.BridgeIPs = append(ni.BridgeIPs, ipAddress("fd00:11:12::1/64"))
+	ni.Radvd = &nirec.RadvdConfig{
+		ConfigPath: "/run/zedrouter/radvd.bn1.conf",
+		PidFile:    "/run/radvd.bn1.pid",
+		Config:     "interface bn1 {}\n",
+	}
+	ni.NAT.IPv6Subnet = ipSubnet("fd00:11:12::/64")
+	ni.NAT.IPv6Gateway = net.ParseIP("fd00:11:12::1")
+	ni.NAT.NAT66 = true
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(itemIsCreated(dg.Reference(linux.Radvd{BridgeIfName: "bn1"}))).To(BeTrue())
+	nat66Chain := dg.Reference(linux.IptablesChain{
+		Table: "nat", ChainName: "POSTROUTING-apps-bn1", ForIPv6: true})
+	t.Expect(itemDescription(nat66Chain)).To(ContainSubstring("fd00:11:12::/64"))
+	// Default routes via eth0 + unreachable default route, for both IP versions
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(4))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(6))
+
+	// Connect application with both IPv4 and IPv6 address.
+	vif := appVIF(niID)
+	vif.EIDs = append(vif.EIDs, net.ParseIP("fd00:11:12::102"))
+	vif.DhcpHostIPv6 = &nirec.DhcpHostConfig{
+		MAC:      macAddress("02:16:3e:00:00:01"),
+		IP:       net.ParseIP("fd00:11:12::102"),
+		Hostname: "app1",
+	}
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateAppVIF(ctx, vif)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(itemCountWithType(linux.DhcpHostTypename)).To(Equal(2))
+	t.Expect(itemCountWithType(linux.IP6tablesChainTypename)).To(Equal(1))
+
+	// Routed mode: IPv6 traffic is not NATed.
+	ni.NAT.NAT66 = false
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(nat66Chain)).To(BeFalse())
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(6))
+
+	// Remove network instance.
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.DelAppVIF(ctx, "nbu1x1")
+	t.Expect(status.Error).To(BeNil())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.DelNI(ctx, niID)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(0))
+}
+
+func TestNftablesACLBackend(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(mockInterface(1, "eth0", "device"))
+	networkMonitor.UpdateRoutes([]netmonitor.Route{
+		mockRoute(1, nil, net.ParseIP("192.168.10.1")),
+	})
+	networkMonitor.AddOrUpdateInterface(mockInterface(2, "bn1", "bridge"))
+
+	niID, _ := uuid.NewV4()
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.UpdateNI(ctx, localNI(niID))
+	t.Expect(status.Error).To(BeNil())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateAppVIF(ctx, appVIF(niID))
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(5))
+
+	// Switch to nftables.
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.SetACLBackend(ctx, types.ACLBackendNftables)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	t.Expect(itemCountWithType(linux.IPSetTypename)).To(Equal(0))
+	// Only the NAT chain remains in iptables.
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(1))
+	// ip, ip6 and bridge table
+	t.Expect(itemCountWithType(linux.NftTableTypename)).To(Equal(3))
+	// local, host-based and eids sets, each in the IP and the bridge family
+	t.Expect(itemCountWithType(linux.NftSetTypename)).To(Equal(12))
+	// 12 base chains + raw (bridge), forward (bridge and ip), mangle
+	// and marking chain for the VIF
+	t.Expect(itemCountWithType(linux.NftChainTypename)).To(Equal(17))
+	rawChain := dg.Reference(linux.NftChain{
+		Family: "bridge", ChainName: "raw-PREROUTING-nbu1x1-ipv4"})
+	t.Expect(itemDescription(rawChain)).To(ContainSubstring("ipv4.example.com"))
+	fwdChain := dg.Reference(linux.NftChain{
+		Family: "ip", ChainName: "filter-FORWARD-nbu1x1"})
+	t.Expect(itemDescription(fwdChain)).To(ContainSubstring("bridged by bn1"))
+	markChain := dg.Reference(linux.NftChain{
+		Family: "ip", ChainName: "drop-all-bn1-nbu1x1"})
+	t.Expect(itemDescription(markChain)).To(ContainSubstring("--set-mark 33554431"))
+	t.Expect(itemDescription(dg.Reference(linux.Dnsmasq{BridgeIfName: "bn1"}))).To(
+		ContainSubstring("bridge/ipv4.example.com"))
+
+	// Switch back to iptables.
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.SetACLBackend(ctx, types.ACLBackendIptables)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.NftTableTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.NftSetTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.NftChainTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.IPSetTypename)).To(Equal(6))
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(5))
+}
+
+func TestAppVIFBandwidthShaping(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(mockInterface(1, "eth0", "device"))
+	networkMonitor.UpdateRoutes([]netmonitor.Route{
+		mockRoute(1, nil, net.ParseIP("192.168.10.1")),
+	})
+	networkMonitor.AddOrUpdateInterface(mockInterface(2, "bn1", "bridge"))
+
+	niID, _ := uuid.NewV4()
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.UpdateNI(ctx, localNI(niID))
+	t.Expect(status.Error).To(BeNil())
+
+	// VIF is not yet created by the hypervisor.
+	vif := appVIF(niID)
+	vif.Shaping = &nirec.ShapingConfig{
+		Ingress: tc.Direction{LimitKbps: 10000},
+		Egress: tc.Direction{
+			LimitKbps: 2000,
+			Classes: []tc.Class{
+				{AceID: 2, Mark: 0x1000002, RateKbps: 500, CeilKbps: 1000},
+			},
+		},
+	}
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateAppVIF(ctx, vif)
+	t.Expect(status.Error).To(BeNil())
+	shaper := dg.Reference(linux.BandwidthShaper{VIFName: "nbu1x1"})
+	t.Expect(itemIsCreated(shaper)).To(BeFalse())
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(5))
+
+	// Hypervisor creates the VIF.
+	networkMonitor.AddOrUpdateInterface(mockInterface(4, "nbu1x1", "device"))
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.ResumeReconcile(ctx)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(shaper)).To(BeTrue())
+	t.Expect(itemDescription(shaper)).To(ContainSubstring("limit: 2000 kbit/s"))
+
+	// Shaping is disabled.
+	vif.Shaping = nil
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateAppVIF(ctx, vif)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(shaper)).To(BeFalse())
+	t.Expect(itemCountWithType(linux.VIFTypename)).To(Equal(0))
+}
+
+func TestSwitchNIWithNIMBridge(test *testing.T) {
+	t := initTest(test)
+	niID, _ := uuid.NewV4()
+	ni := nirec.NIConfig{
+		UUID:           niID,
+		DisplayName:    "switch-ni",
+		Type:           types.NetworkInstanceTypeSwitch,
+		BridgeName:     "eth1",
+		ExternalBridge: true,
+		Dnsmasq: &nirec.DnsmasqConfig{
+			ConfigPath:   "/run/zedrouter/dnsmasq.eth1.conf",
+			PidFile:      "/run/dnsmasq.eth1.pid",
+			Config:       "interface=eth1\n",
+			HostsDir:     "/run/zedrouter/hosts.eth1",
+			DhcpHostsDir: "/run/zedrouter/dhcp-hosts.eth1",
+		},
+	}
+	// Bridge does not exist yet.
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(dg.Reference(linux.Dnsmasq{BridgeIfName: "eth1"}))).To(BeFalse())
+
+	// NIM creates the bridge.
+	networkMonitor.AddOrUpdateInterface(mockInterface(3, "eth1", "bridge"))
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.ResumeReconcile(ctx)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(dg.Reference(linux.Bridge{IfName: "eth1"}))).To(BeTrue())
+	t.Expect(itemIsCreated(dg.Reference(linux.Dnsmasq{BridgeIfName: "eth1"}))).To(BeTrue())
+	// Routing for switch NI is configured by NIM.
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+
+	// NIM removes the bridge.
+	networkMonitor.DelInterface("eth1")
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.ResumeReconcile(ctx)
+	t.Expect(itemIsCreated(dg.Reference(linux.Bridge{IfName: "eth1"}))).To(BeFalse())
+	t.Expect(itemIsCreated(dg.Reference(linux.Dnsmasq{BridgeIfName: "eth1"}))).To(BeFalse())
+}
+
+func TestNIWithTunnel(test *testing.T) {
+	t := initTest(test)
+	networkMonitor.AddOrUpdateInterface(mockInterface(2, "bn1", "bridge"))
+
+	niID, _ := uuid.NewV4()
+	ni := localNI(niID)
+	ni.Type = types.NetworkInstanceTypeCloud
+	ni.NAT = nil
+	ni.Tunnel = &nirec.TunnelConfig{
+		IfName:     "wg1",
+		Subnet:     ipSubnet("10.11.12.0/24"),
+		Gateway:    net.ParseIP("10.11.12.1"),
+		Routes:     []*net.IPNet{ipSubnet("10.20.0.0/16"), ipSubnet("fd00::/64")},
+		Masquerade: true,
+	}
+	ctx := reconciler.MockRun(context.Background())
+	status := niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(status.FailingItems).To(BeEmpty())
+	natChain := dg.Reference(linux.IptablesChain{
+		Table: "nat", ChainName: "POSTROUTING-apps-bn1"})
+	t.Expect(itemDescription(natChain)).To(ContainSubstring("-o wg1"))
+	// Only the unreachable default route until the tunnel is created.
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(1))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(3))
+
+	// Tunnel interface created by zedrouter, IPv6 route is skipped.
+	networkMonitor.AddOrUpdateInterface(mockInterface(3, "wg1", "wireguard"))
+	t.Eventually(status.ResumeReconcile).Should(Receive())
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.ResumeReconcile(ctx)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(2))
+
+	// Mesh NI without NAT does not pass DHCP through the VXLAN port.
+	ni.Type = types.NetworkInstanceTypeMesh
+	ni.Tunnel.Routes = nil
+	ni.Tunnel.Masquerade = false
+	ni.Tunnel.NoDHCPBridgePort = "vx1"
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemIsCreated(natChain)).To(BeFalse())
+	fwdChain := dg.Reference(linux.IptablesChain{
+		Table: "filter", ChainName: "FORWARD-apps-bn1"})
+	t.Expect(itemDescription(fwdChain)).To(ContainSubstring("jump from the top"))
+	t.Expect(itemDescription(fwdChain)).To(ContainSubstring("--physdev-out vx1"))
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(1))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(3))
+
+	// Deactivate routing through the tunnel.
+	ni.Tunnel = nil
+	ctx = reconciler.MockRun(context.Background())
+	status = niReconciler.UpdateNI(ctx, ni)
+	t.Expect(status.Error).To(BeNil())
+	t.Expect(itemCountWithType(linux.IPtablesChainTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.RouteTypename)).To(Equal(0))
+	t.Expect(itemCountWithType(linux.IPRuleTypename)).To(Equal(0))
+}
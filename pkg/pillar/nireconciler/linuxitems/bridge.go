@@ -0,0 +1,179 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/vishvananda/netlink"
+)
+
+// Bridge : Linux bridge used by a network instance.
+type Bridge struct {
+	IfName string
+	// MACAddress : MAC address assigned to the bridge.
+	// Not used with CreatedByNIM.
+	MACAddress net.HardwareAddr
+	// IPAddresses : IP addresses assigned to the bridge (with subnet mask).
+	// Not used with CreatedByNIM.
+	IPAddresses []*net.IPNet
+	// CreatedByNIM : bridge created by NIM for a switch NI with a port.
+	// Such bridge is only referenced by other items and it is not managed
+	// by NI Reconciler.
+	CreatedByNIM bool
+}
+
+// Name returns the interface name of the bridge.
+func (b Bridge) Name() string {
+	return b.IfName
+}
+
+// Label is not defined.
+func (b Bridge) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (b Bridge) Type() string {
+	return BridgeTypename
+}
+
+// Equal compares MAC and IP addresses.
+func (b Bridge) Equal(other depgraph.Item) bool {
+	b2 := other.(Bridge)
+	return b.CreatedByNIM == b2.CreatedByNIM &&
+		b.MACAddress.String() == b2.MACAddress.String() &&
+		equalIPNets(b.IPAddresses, b2.IPAddresses)
+}
+
+// External returns true if the bridge was created by NIM.
+func (b Bridge) External() bool {
+	return b.CreatedByNIM
+}
+
+// String describes the bridge.
+func (b Bridge) String() string {
+	if b.CreatedByNIM {
+		return fmt.Sprintf("Bridge %s created by NIM", b.IfName)
+	}
+	return fmt.Sprintf("Bridge: {ifName: %s, mac: %s, ips: %v}",
+		b.IfName, b.MACAddress, b.IPAddresses)
+}
+
+// Dependencies returns no dependencies.
+func (b Bridge) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
+
+// BridgeConfigurator implements Configurator interface (libs/reconciler)
+// for Linux bridges.
+type BridgeConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates the bridge, sets it UP and assigns IP addresses.
+func (c *BridgeConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	bridge := item.(Bridge)
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = bridge.IfName
+	attrs.HardwareAddr = bridge.MACAddress
+	link := &netlink.Bridge{LinkAttrs: attrs}
+	// Make sure we start from scratch (e.g. after zedrouter restart).
+	_ = netlink.LinkDel(link)
+	if err := netlink.LinkAdd(link); err != nil {
+		err = fmt.Errorf("failed to add bridge %s: %w", bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err := netlink.LinkSetUp(link); err != nil {
+		err = fmt.Errorf("failed to set bridge %s UP: %w", bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	// Disable ICMP redirects - apps should keep using the bridge as the gateway.
+	sysctlSetting := fmt.Sprintf("net.ipv4.conf.%s.send_redirects=0",
+		bridge.IfName)
+	out, err := base.Exec(c.Log, "sysctl", "-w", sysctlSetting).CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("failed to disable ICMP redirects for bridge %s: %v, "+
+			"output: %s", bridge.IfName, err, out)
+		c.Log.Error(err)
+		return err
+	}
+	for _, ipAddr := range bridge.IPAddresses {
+		addr := &netlink.Addr{IPNet: ipAddr}
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			err = fmt.Errorf("failed to add IP address %v to bridge %s: %w",
+				ipAddr, bridge.IfName, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Modify updates IP addresses assigned to the bridge.
+func (c *BridgeConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	oldBridge := oldItem.(Bridge)
+	newBridge := newItem.(Bridge)
+	link, err := netlink.LinkByName(newBridge.IfName)
+	if err != nil {
+		err = fmt.Errorf("failed to get link for bridge %s: %w",
+			newBridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	obsoleteIPs, newIPs := diffIPNets(oldBridge.IPAddresses, newBridge.IPAddresses)
+	for _, ipAddr := range obsoleteIPs {
+		addr := &netlink.Addr{IPNet: ipAddr}
+		if err := netlink.AddrDel(link, addr); err != nil {
+			err = fmt.Errorf("failed to del IP address %v from bridge %s: %w",
+				ipAddr, newBridge.IfName, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	for _, ipAddr := range newIPs {
+		addr := &netlink.Addr{IPNet: ipAddr}
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			err = fmt.Errorf("failed to add IP address %v to bridge %s: %w",
+				ipAddr, newBridge.IfName, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes the bridge.
+func (c *BridgeConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	bridge := item.(Bridge)
+	link, err := netlink.LinkByName(bridge.IfName)
+	if err != nil {
+		if _, notFound := err.(netlink.LinkNotFoundError); notFound {
+			return nil
+		}
+		err = fmt.Errorf("failed to get link for bridge %s: %w",
+			bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		err = fmt.Errorf("failed to delete bridge %s: %w", bridge.IfName, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if the MAC address has changed.
+func (c *BridgeConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldBridge := oldItem.(Bridge)
+	newBridge := newItem.(Bridge)
+	return oldBridge.MACAddress.String() != newBridge.MACAddress.String()
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// DhcpHost : static DHCP host entry for an application VIF.
+// Stored as a file inside the dhcp-hostsdir of the NI dnsmasq.
+type DhcpHost struct {
+	// BridgeIfName : bridge of the NI with the dnsmasq serving the host.
+	BridgeIfName string
+	// DhcpHostsDir : must match Dnsmasq.DhcpHostsDir.
+	DhcpHostsDir string
+	// DnsmasqPidFile : must match Dnsmasq.PidFile.
+	DnsmasqPidFile string
+	MACAddress     net.HardwareAddr
+	IPAddress      net.IP
+	Hostname       string
+}
+
+// Name returns the path to the file with the host entry.
+func (h DhcpHost) Name() string {
+	return h.filePath()
+}
+
+// Label is more human-readable than name.
+func (h DhcpHost) Label() string {
+	return fmt.Sprintf("DHCP host %s (%s)", h.Hostname, h.IPAddress)
+}
+
+// Type of the item.
+func (h DhcpHost) Type() string {
+	return DhcpHostTypename
+}
+
+// Equal compares the host entry.
+func (h DhcpHost) Equal(other depgraph.Item) bool {
+	h2 := other.(DhcpHost)
+	return h.entry() == h2.entry() &&
+		h.DnsmasqPidFile == h2.DnsmasqPidFile
+}
+
+// External returns false.
+func (h DhcpHost) External() bool {
+	return false
+}
+
+// String describes the DHCP host entry.
+func (h DhcpHost) String() string {
+	return fmt.Sprintf("DHCP host entry for bridge %s: %s",
+		h.BridgeIfName, h.entry())
+}
+
+// Dependencies returns dnsmasq of the NI as the only dependency.
+func (h DhcpHost) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: DnsmasqTypename,
+				ItemName: h.BridgeIfName,
+			},
+			Description: "Dnsmasq serving the host must be running",
+		},
+	}
+}
+
+func (h DhcpHost) isIPv6() bool {
+	return h.IPAddress.To4() == nil
+}
+
+func (h DhcpHost) filePath() string {
+	suffix := ".inet"
+	if h.isIPv6() {
+		suffix += "6"
+	}
+	return filepath.Join(h.DhcpHostsDir, h.MACAddress.String()+suffix)
+}
+
+func (h DhcpHost) entry() string {
+	if h.isIPv6() {
+		return fmt.Sprintf("%s,[%s],%s\n",
+			h.MACAddress, h.IPAddress, h.Hostname)
+	}
+	return fmt.Sprintf("%s,id:*,%s,%s\n",
+		h.MACAddress, h.IPAddress, h.Hostname)
+}
+
+// DhcpHostConfigurator implements Configurator interface (libs/reconciler)
+// for static DHCP host entries.
+type DhcpHostConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create writes the host entry into the dhcp-hostsdir.
+// Dnsmasq watches the directory and picks up new and changed files.
+func (c *DhcpHostConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	host := item.(DhcpHost)
+	if err := os.MkdirAll(host.DhcpHostsDir, 0755); err != nil {
+		err = fmt.Errorf("failed to create directory %s: %w",
+			host.DhcpHostsDir, err)
+		c.Log.Error(err)
+		return err
+	}
+	if err := os.WriteFile(host.filePath(), []byte(host.entry()), 0644); err != nil {
+		err = fmt.Errorf("failed to write DHCP host entry %s: %w",
+			host.filePath(), err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify is not implemented.
+func (c *DhcpHostConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete removes the host entry.
+// Dnsmasq does not notice removed files, therefore it is asked to re-read
+// the dhcp-hostsdir with SIGHUP.
+func (c *DhcpHostConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	host := item.(DhcpHost)
+	if err := os.Remove(host.filePath()); err != nil && !os.IsNotExist(err) {
+		err = fmt.Errorf("failed to remove DHCP host entry %s: %w",
+			host.filePath(), err)
+		c.Log.Error(err)
+		return err
+	}
+	if !signalProcess(host.DnsmasqPidFile, syscall.SIGHUP) {
+		c.Log.Warnf("Failed to send SIGHUP to dnsmasq for bridge %s",
+			host.BridgeIfName)
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *DhcpHostConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
+)
+
+const (
+	dnsmasqBinary = "/opt/zededa/bin/dnsmasq"
+	// How long to wait for a stopped daemon to exit.
+	daemonStopTimeout = 60 * time.Second
+)
+
+// Dnsmasq : dnsmasq instance providing DHCP and DNS services for a network instance.
+type Dnsmasq struct {
+	// BridgeIfName : bridge on which dnsmasq listens.
+	BridgeIfName string
+	// ConfigPath : where the config file should be written.
+	ConfigPath string
+	// PidFile : pidfile as configured in Config.
+	PidFile string
+	// Config : content of the config file.
+	// Generated by zedrouter.
+	Config string
+	// HostsDir : directory with hosts files (used for DNS).
+	HostsDir string
+	// DhcpHostsDir : directory with static DHCP host entries.
+	DhcpHostsDir string
+	// IPSets : ipsets which dnsmasq fills with IPs of resolved host names.
+	IPSets []string
+	// NftSets : nftables sets (see NftSet.Name()) which dnsmasq fills
+	// instead of ipsets when ACLs are implemented with nftables.
+	NftSets []string
+}
+
+// Name returns the bridge name - there is at most one dnsmasq per bridge.
+func (d Dnsmasq) Name() string {
+	return d.BridgeIfName
+}
+
+// Label is more human-readable than name.
+func (d Dnsmasq) Label() string {
+	return "dnsmasq for " + d.BridgeIfName
+}
+
+// Type of the item.
+func (d Dnsmasq) Type() string {
+	return DnsmasqTypename
+}
+
+// Equal compares the entire configuration.
+func (d Dnsmasq) Equal(other depgraph.Item) bool {
+	d2 := other.(Dnsmasq)
+	return d.ConfigPath == d2.ConfigPath &&
+		d.PidFile == d2.PidFile &&
+		d.Config == d2.Config &&
+		d.HostsDir == d2.HostsDir &&
+		d.DhcpHostsDir == d2.DhcpHostsDir &&
+		equalStrings(d.IPSets, d2.IPSets) &&
+		equalStrings(d.NftSets, d2.NftSets)
+}
+
+// External returns false.
+func (d Dnsmasq) External() bool {
+	return false
+}
+
+// String describes the dnsmasq instance.
+func (d Dnsmasq) String() string {
+	return fmt.Sprintf("Dnsmasq: {bridge: %s, configPath: %s, hostsDir: %s, "+
+		"dhcpHostsDir: %s, ipsets: %v, nftsets: %v, config:\n%s}", d.BridgeIfName,
+		d.ConfigPath, d.HostsDir, d.DhcpHostsDir, d.IPSets, d.NftSets, d.Config)
+}
+
+// Dependencies of dnsmasq are the bridge and all ipsets that dnsmasq fills.
+func (d Dnsmasq) Dependencies() (deps []depgraph.Dependency) {
+	deps = append(deps, depgraph.Dependency{
+		RequiredItem: depgraph.ItemRef{
+			ItemType: BridgeTypename,
+			ItemName: d.BridgeIfName,
+		},
+		Description: "Bridge must exist",
+	})
+	for _, ipset := range d.IPSets {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: IPSetTypename,
+				ItemName: ipset,
+			},
+			Description: "ipset filled by dnsmasq must exist",
+		})
+	}
+	for _, set := range d.NftSets {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: NftSetTypename,
+				ItemName: set,
+			},
+			Description: "nftables set filled by dnsmasq must exist",
+		})
+	}
+	return deps
+}
+
+// DnsmasqConfigurator implements Configurator interface (libs/reconciler)
+// for dnsmasq.
+type DnsmasqConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create writes the config file and starts dnsmasq.
+func (c *DnsmasqConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	dnsmasq := item.(Dnsmasq)
+	if err := c.writeConfig(dnsmasq); err != nil {
+		return err
+	}
+	return c.start(dnsmasq)
+}
+
+// Modify restarts dnsmasq with the new config.
+func (c *DnsmasqConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	oldDnsmasq := oldItem.(Dnsmasq)
+	newDnsmasq := newItem.(Dnsmasq)
+	stopDaemon(c.Log, oldDnsmasq.ConfigPath, oldDnsmasq.PidFile)
+	if err := c.writeConfig(newDnsmasq); err != nil {
+		return err
+	}
+	return c.start(newDnsmasq)
+}
+
+// Delete stops dnsmasq and removes the config file.
+func (c *DnsmasqConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	dnsmasq := item.(Dnsmasq)
+	stopDaemon(c.Log, dnsmasq.ConfigPath, dnsmasq.PidFile)
+	if err := os.Remove(dnsmasq.ConfigPath); err != nil && !os.IsNotExist(err) {
+		err = fmt.Errorf("failed to remove dnsmasq config %s: %w",
+			dnsmasq.ConfigPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns false - Modify is able to restart dnsmasq with the new config.
+// Items depending on dnsmasq (DHCP host entries) are kept.
+func (c *DnsmasqConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return false
+}
+
+func (c *DnsmasqConfigurator) writeConfig(dnsmasq Dnsmasq) error {
+	dirs := []string{filepath.Dir(dnsmasq.ConfigPath), dnsmasq.HostsDir,
+		dnsmasq.DhcpHostsDir}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			err = fmt.Errorf("failed to create directory %s: %w", dir, err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	err := os.WriteFile(dnsmasq.ConfigPath, []byte(dnsmasq.Config), 0644)
+	if err != nil {
+		err = fmt.Errorf("failed to write dnsmasq config %s: %w",
+			dnsmasq.ConfigPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (c *DnsmasqConfigurator) start(dnsmasq Dnsmasq) error {
+	// dnsmasq daemonizes itself.
+	out, err := base.Exec(c.Log, "nohup", dnsmasqBinary, "-C",
+		dnsmasq.ConfigPath).CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("failed to start dnsmasq for bridge %s: %v, output: %s",
+			dnsmasq.BridgeIfName, err, out)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// stopDaemon stops a daemon started with the given config file and waits
+// for the process to exit.
+func stopDaemon(log *base.LogObject, configPath, pidFile string) {
+	running := signalProcess(pidFile, syscall.Signal(0))
+	utils.PkillArgs(log, configPath, false, false)
+	startCheckTime := time.Now()
+	for running {
+		if time.Since(startCheckTime) > daemonStopTimeout {
+			log.Errorf("Daemon with config %s did not exit in %v",
+				configPath, daemonStopTimeout)
+			break
+		}
+		time.Sleep(time.Second)
+		running = signalProcess(pidFile, syscall.Signal(0))
+	}
+	if err := os.Remove(pidFile); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Failed to remove pidfile %s: %v", pidFile, err)
+	}
+}
+
+// DnsmasqSupportsNftset returns true if dnsmasq was built with the support
+// for filling nftables sets with resolved IPs (available since dnsmasq 2.87).
+func DnsmasqSupportsNftset(log *base.LogObject) bool {
+	out, err := base.Exec(log, dnsmasqBinary, "--version").CombinedOutput()
+	if err != nil {
+		return false
+	}
+	// Compile time options are listed in the output, disabled with "no-" prefix.
+	for _, option := range strings.Fields(string(out)) {
+		if option == "nftset" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/vishvananda/netlink"
+)
+
+// IPRule : IP rule steering traffic of a network instance.
+type IPRule struct {
+	Priority int
+	Table    int
+	// Src : match on the source IP subnet (optional).
+	Src *net.IPNet
+	// Dst : match on the destination IP subnet (optional).
+	Dst *net.IPNet
+	// ForBridge : bridge of the network instance which the rule is for.
+	ForBridge string
+}
+
+// Name combines all the rule attributes to construct a unique identifier.
+func (r IPRule) Name() string {
+	return fmt.Sprintf("%d/%s/%s/%d", r.Priority, ipNetToStr(r.Src),
+		ipNetToStr(r.Dst), r.Table)
+}
+
+// Label is more human-readable than name.
+func (r IPRule) Label() string {
+	return fmt.Sprintf("IP rule %d: from %s to %s lookup %d", r.Priority,
+		ipNetToStr(r.Src), ipNetToStr(r.Dst), r.Table)
+}
+
+// Type of the item.
+func (r IPRule) Type() string {
+	return IPRuleTypename
+}
+
+// Equal is a comparison method for two equally-named IP rule instances.
+// All attributes are encoded in the name.
+func (r IPRule) Equal(other depgraph.Item) bool {
+	return true
+}
+
+// External returns false.
+func (r IPRule) External() bool {
+	return false
+}
+
+// String describes the IP rule.
+func (r IPRule) String() string {
+	return fmt.Sprintf("IP rule for NI with bridge %s: "+
+		"{prio: %d, src: %s, dst: %s, table: %d}", r.ForBridge,
+		r.Priority, ipNetToStr(r.Src), ipNetToStr(r.Dst), r.Table)
+}
+
+// Dependencies returns the NI bridge as the only dependency.
+func (r IPRule) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: r.ForBridge,
+			},
+			Description: "NI bridge must exist",
+		},
+	}
+}
+
+func (r IPRule) netlinkRule() *netlink.Rule {
+	rule := netlink.NewRule()
+	rule.Priority = r.Priority
+	rule.Table = r.Table
+	rule.Src = r.Src
+	rule.Dst = r.Dst
+	rule.Family = netlink.FAMILY_V4
+	for _, ipNet := range []*net.IPNet{r.Src, r.Dst} {
+		if ipNet != nil && ipNet.IP.To4() == nil {
+			rule.Family = netlink.FAMILY_V6
+		}
+	}
+	return rule
+}
+
+func ipNetToStr(ipNet *net.IPNet) string {
+	if ipNet == nil {
+		return "all"
+	}
+	return ipNet.String()
+}
+
+// IPRuleConfigurator implements Configurator interface (libs/reconciler) for IP rules.
+type IPRuleConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create adds IP rule.
+func (c *IPRuleConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	rule := item.(IPRule).netlinkRule()
+	// Avoid duplicate rules (e.g. after zedrouter restart).
+	_ = netlink.RuleDel(rule)
+	if err := netlink.RuleAdd(rule); err != nil {
+		err = fmt.Errorf("failed to add IP rule %v: %w", rule, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify is not implemented.
+func (c *IPRuleConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete removes IP rule.
+func (c *IPRuleConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	rule := item.(IPRule).netlinkRule()
+	err := netlink.RuleDel(rule)
+	if err != nil && !errors.Is(err, syscall.ENOENT) {
+		err = fmt.Errorf("failed to delete IP rule %v: %w", rule, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *IPRuleConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
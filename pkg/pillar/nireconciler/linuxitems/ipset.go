@@ -0,0 +1,141 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// IPSet : Linux ipset.
+type IPSet struct {
+	SetName string
+	// TypeName : ipset type, e.g. "hash:ip" or "hash:net".
+	TypeName string
+	// ForIPv6 : selects the "inet6" family.
+	ForIPv6 bool
+	// Entries : statically configured entries.
+	// IPSets filled by dnsmasq should have no static entries.
+	Entries []string
+}
+
+// Name returns the ipset name.
+func (s IPSet) Name() string {
+	return s.SetName
+}
+
+// Label is not defined.
+func (s IPSet) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (s IPSet) Type() string {
+	return IPSetTypename
+}
+
+// Equal compares type and static entries.
+func (s IPSet) Equal(other depgraph.Item) bool {
+	s2 := other.(IPSet)
+	return s.TypeName == s2.TypeName &&
+		s.ForIPv6 == s2.ForIPv6 &&
+		equalStrings(s.Entries, s2.Entries)
+}
+
+// External returns false.
+func (s IPSet) External() bool {
+	return false
+}
+
+// String describes the ipset.
+func (s IPSet) String() string {
+	return fmt.Sprintf("IPSet: {name: %s, type: %s, family: %s, entries: %v}",
+		s.SetName, s.TypeName, s.family(), s.Entries)
+}
+
+// Dependencies returns no dependencies.
+func (s IPSet) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
+
+func (s IPSet) family() string {
+	if s.ForIPv6 {
+		return "inet6"
+	}
+	return "inet"
+}
+
+// IPSetConfigurator implements Configurator interface (libs/reconciler) for ipsets.
+type IPSetConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates the ipset and adds static entries.
+func (c *IPSetConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	ipset := item.(IPSet)
+	// ipset may have survived zedrouter restart.
+	args := []string{"create", "-exist", ipset.SetName, ipset.TypeName,
+		"family", ipset.family()}
+	out, err := base.Exec(c.Log, "ipset", args...).CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("failed to create ipset %s: %v, output: %s",
+			ipset.SetName, err, out)
+		c.Log.Error(err)
+		return err
+	}
+	// Do not flush entries added by dnsmasq into a set without static entries.
+	return c.setEntries(ipset, len(ipset.Entries) > 0)
+}
+
+// Modify replaces static entries.
+func (c *IPSetConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return c.setEntries(newItem.(IPSet), true)
+}
+
+// Delete destroys the ipset.
+func (c *IPSetConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	ipset := item.(IPSet)
+	out, err := base.Exec(c.Log, "ipset", "destroy", ipset.SetName).CombinedOutput()
+	if err != nil {
+		err = fmt.Errorf("failed to destroy ipset %s: %v, output: %s",
+			ipset.SetName, err, out)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if type or family has changed.
+func (c *IPSetConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldIPSet := oldItem.(IPSet)
+	newIPSet := newItem.(IPSet)
+	return oldIPSet.TypeName != newIPSet.TypeName ||
+		oldIPSet.ForIPv6 != newIPSet.ForIPv6
+}
+
+func (c *IPSetConfigurator) setEntries(ipset IPSet, flush bool) error {
+	if flush {
+		out, err := base.Exec(c.Log, "ipset", "flush", ipset.SetName).CombinedOutput()
+		if err != nil {
+			err = fmt.Errorf("failed to flush ipset %s: %v, output: %s",
+				ipset.SetName, err, out)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	for _, entry := range ipset.Entries {
+		out, err := base.Exec(c.Log, "ipset", "add", ipset.SetName,
+			entry).CombinedOutput()
+		if err != nil {
+			err = fmt.Errorf("failed to add %s into ipset %s: %v, output: %s",
+				entry, ipset.SetName, err, out)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
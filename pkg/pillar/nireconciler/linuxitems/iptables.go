@@ -0,0 +1,236 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+)
+
+// IptablesChain : custom iptables chain created by zedrouter.
+// Unlike the chains of DPC Reconciler, which are all pre-created, these chains
+// are created and removed dynamically as network instances and applications
+// come and go.
+type IptablesChain struct {
+	ChainName string
+	Table     string
+	ForIPv6   bool
+	Rules     []IptablesRule
+	// JumpFrom : optional pre-created chain (e.g. FORWARD-apps) into which
+	// a rule jumping to this chain is appended.
+	// Leave empty for chains referenced only from other custom chains.
+	JumpFrom string
+	// JumpFirst : insert the jump rule at the top of JumpFrom instead of appending it.
+	JumpFirst bool
+	// RefersChains : names of custom chains referred from rules.
+	RefersChains []string
+	// RefersIPSets : names of ipsets referred from rules.
+	RefersIPSets []string
+}
+
+// IptablesRule : single iptables rule.
+type IptablesRule struct {
+	// Args : any arguments except for -t, -A, -D, -I, -R.
+	Args []string
+	// Description : optionally describe the rule.
+	Description string
+}
+
+// Name returns unique identifier for an iptables chain.
+func (ch IptablesChain) Name() string {
+	return fmt.Sprintf("%s/%s", ch.table(), ch.ChainName)
+}
+
+// Label is not defined.
+func (ch IptablesChain) Label() string {
+	return ""
+}
+
+// Type of the item.
+// We use the same structure for both IPv4 and IPv6 iptables.
+func (ch IptablesChain) Type() string {
+	if ch.ForIPv6 {
+		return IP6tablesChainTypename
+	}
+	return IPtablesChainTypename
+}
+
+// Equal compares content of two instances of the same iptables chain.
+func (ch IptablesChain) Equal(other depgraph.Item) bool {
+	ch2 := other.(IptablesChain)
+	// If rules are equal than surely RefersChains and RefersIPSets are equal.
+	return ch.JumpFrom == ch2.JumpFrom && ch.JumpFirst == ch2.JumpFirst &&
+		reflect.DeepEqual(ch.Rules, ch2.Rules)
+}
+
+// External returns false.
+func (ch IptablesChain) External() bool {
+	return false
+}
+
+// String describes content of iptables chain.
+func (ch IptablesChain) String() string {
+	str := fmt.Sprintf("%s chain %s for table %s", ch.command(),
+		ch.ChainName, ch.table())
+	if ch.JumpFrom != "" && ch.JumpFirst {
+		str += fmt.Sprintf(" (jump from the top of %s)", ch.JumpFrom)
+	} else if ch.JumpFrom != "" {
+		str += fmt.Sprintf(" (jump from %s)", ch.JumpFrom)
+	}
+	str += " with rules:"
+	for _, rule := range ch.Rules {
+		str += fmt.Sprintf("\n  *  %s", strings.Join(rule.Args, " "))
+		if rule.Description != "" {
+			str += fmt.Sprintf("\n     (%s)", rule.Description)
+		}
+	}
+	return str
+}
+
+// Dependencies lists all referenced chains and ipsets as dependencies.
+func (ch IptablesChain) Dependencies() (deps []depgraph.Dependency) {
+	for _, referredChain := range ch.RefersChains {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.Reference(IptablesChain{
+				ChainName: referredChain,
+				Table:     ch.Table,
+				ForIPv6:   ch.ForIPv6,
+			}),
+			Description: "Referenced iptables chain must exist",
+		})
+	}
+	for _, ipset := range ch.RefersIPSets {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: IPSetTypename,
+				ItemName: ipset,
+			},
+			Description: "Referenced ipset must exist",
+		})
+	}
+	return deps
+}
+
+func (ch IptablesChain) command() string {
+	cmd := "iptables"
+	if ch.ForIPv6 {
+		cmd = "ip6tables"
+	}
+	return cmd
+}
+
+func (ch IptablesChain) table() string {
+	table := "filter"
+	if ch.Table != "" {
+		table = ch.Table
+	}
+	return table
+}
+
+// IptablesChainConfigurator implements Configurator interface (libs/reconciler)
+// for both iptables and ip6tables chains.
+type IptablesChainConfigurator struct {
+	Log *base.LogObject
+}
+
+func (c *IptablesChainConfigurator) exec(chain IptablesChain, args ...string) error {
+	args = append([]string{"-t", chain.table()}, args...)
+	if chain.ForIPv6 {
+		return iptables.Ip6tableCmd(c.Log, args...)
+	}
+	return iptables.IptableCmd(c.Log, args...)
+}
+
+// Create creates and populates ip(6)tables chain.
+func (c *IptablesChainConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	chain := item.(IptablesChain)
+	// The chain may have survived zedrouter restart.
+	if err := c.exec(chain, "-N", chain.ChainName); err != nil {
+		if !strings.Contains(err.Error(), "Chain already exists") {
+			err = fmt.Errorf("failed to create iptables chain: %w", err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	if err := c.setRules(chain); err != nil {
+		return err
+	}
+	if chain.JumpFrom != "" {
+		op := "-A"
+		if chain.JumpFirst {
+			op = "-I"
+		}
+		err := c.exec(chain, op, chain.JumpFrom, "-j", chain.ChainName)
+		if err != nil {
+			err = fmt.Errorf("failed to add jump into iptables chain: %w", err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Modify rules by recreating them.
+// But do not re-create the entire chain, that would recreate everything that depends on it.
+func (c *IptablesChainConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return c.setRules(newItem.(IptablesChain))
+}
+
+// Delete removes the jump rule, then flushes and removes the chain.
+func (c *IptablesChainConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	chain := item.(IptablesChain)
+	if chain.JumpFrom != "" {
+		err := c.exec(chain, "-D", chain.JumpFrom, "-j", chain.ChainName)
+		if err != nil {
+			err = fmt.Errorf("failed to remove jump into iptables chain: %w", err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	if err := c.exec(chain, "-F", chain.ChainName); err != nil {
+		err = fmt.Errorf("failed to flush the iptables chain: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	if err := c.exec(chain, "-X", chain.ChainName); err != nil {
+		err = fmt.Errorf("failed to delete the iptables chain: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if the chain should be jumped into from
+// a different chain or from a different position.
+func (c *IptablesChainConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldChain := oldItem.(IptablesChain)
+	newChain := newItem.(IptablesChain)
+	return oldChain.JumpFrom != newChain.JumpFrom ||
+		oldChain.JumpFirst != newChain.JumpFirst
+}
+
+func (c *IptablesChainConfigurator) setRules(chain IptablesChain) error {
+	// Make sure we start with empty content.
+	if err := c.exec(chain, "-F", chain.ChainName); err != nil {
+		err = fmt.Errorf("failed to flush the iptables chain: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	// Add rules one by one.
+	for _, rule := range chain.Rules {
+		args := append([]string{"-A", chain.ChainName}, rule.Args...)
+		if err := c.exec(chain, args...); err != nil {
+			err = fmt.Errorf("failed to add iptables rule: %w", err)
+			c.Log.Error(err)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,507 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
+)
+
+// NftTable : nftables table with application ACLs (nftables.AppTable)
+// for one family.
+type NftTable struct {
+	// Family : nftables.FamilyIP, nftables.FamilyIP6 or nftables.FamilyBridge.
+	Family string
+}
+
+// Name returns the table family (table name is the same for all families).
+func (t NftTable) Name() string {
+	return t.Family
+}
+
+// Label is not defined.
+func (t NftTable) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (t NftTable) Type() string {
+	return NftTableTypename
+}
+
+// Equal is always true (the table has no attributes).
+func (t NftTable) Equal(other depgraph.Item) bool {
+	return true
+}
+
+// External returns false.
+func (t NftTable) External() bool {
+	return false
+}
+
+// String describes the table.
+func (t NftTable) String() string {
+	return fmt.Sprintf("Nftables table %s %s", t.Family, nftables.AppTable)
+}
+
+// Dependencies returns no dependencies.
+func (t NftTable) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
+
+// NftTableConfigurator implements Configurator interface (libs/reconciler)
+// for nftables tables.
+type NftTableConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates an empty table.
+func (c *NftTableConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	table := item.(NftTable)
+	// The table may have survived zedrouter restart. Start with empty content
+	// (deleting a non-existent table would fail the whole transaction).
+	var script strings.Builder
+	fmt.Fprintf(&script, "add table %s %s\n", table.Family, nftables.AppTable)
+	fmt.Fprintf(&script, "delete table %s %s\n", table.Family, nftables.AppTable)
+	fmt.Fprintf(&script, "add table %s %s\n", table.Family, nftables.AppTable)
+	if err := nftables.ApplyScript(c.Log, script.String()); err != nil {
+		err = fmt.Errorf("failed to create nftables table: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify is not implemented.
+func (c *NftTableConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return fmt.Errorf("not implemented")
+}
+
+// Delete removes the table.
+func (c *NftTableConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	table := item.(NftTable)
+	_, err := nftables.NftCmdOut(c.Log, "delete", "table", table.Family,
+		nftables.AppTable)
+	if err != nil {
+		err = fmt.Errorf("failed to delete nftables table: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true (Modify is not implemented).
+func (c *NftTableConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
+
+// NftSet : nftables set of IP addresses, replacing ipset of the iptables backend.
+type NftSet struct {
+	// Family of the table with the set.
+	Family string
+	// SetName : see nftables.SetName.
+	SetName string
+	// ForIPv6 : set of IPv6 addresses.
+	ForIPv6 bool
+	// Interval : entries are IP prefixes.
+	Interval bool
+	// Entries : statically configured entries.
+	// Sets filled by dnsmasq should have no static entries.
+	Entries []string
+}
+
+// Name returns the family and the name of the set.
+func (s NftSet) Name() string {
+	return s.Family + "/" + s.SetName
+}
+
+// Label is not defined.
+func (s NftSet) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (s NftSet) Type() string {
+	return NftSetTypename
+}
+
+// Equal compares type and static entries.
+func (s NftSet) Equal(other depgraph.Item) bool {
+	s2 := other.(NftSet)
+	return s.ForIPv6 == s2.ForIPv6 &&
+		s.Interval == s2.Interval &&
+		equalStrings(s.Entries, s2.Entries)
+}
+
+// External returns false.
+func (s NftSet) External() bool {
+	return false
+}
+
+// String describes the set.
+func (s NftSet) String() string {
+	return fmt.Sprintf("Nftables set: {family: %s, name: %s, type: %s, "+
+		"interval: %t, entries: %v}", s.Family, s.SetName, s.elemType(),
+		s.Interval, s.Entries)
+}
+
+// Dependencies returns the table as the only dependency.
+func (s NftSet) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.Reference(NftTable{Family: s.Family}),
+			Description:  "nftables table must exist",
+		},
+	}
+}
+
+func (s NftSet) elemType() string {
+	if s.ForIPv6 {
+		return "ipv6_addr"
+	}
+	return "ipv4_addr"
+}
+
+func (s NftSet) addElements(script *strings.Builder) {
+	if len(s.Entries) == 0 {
+		return
+	}
+	fmt.Fprintf(script, "add element %s %s %s { %s }\n", s.Family,
+		nftables.AppTable, s.SetName, strings.Join(s.Entries, ", "))
+}
+
+// NftSetConfigurator implements Configurator interface (libs/reconciler)
+// for nftables sets.
+type NftSetConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates the set with static entries.
+func (c *NftSetConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	set := item.(NftSet)
+	var script strings.Builder
+	var flags string
+	if set.Interval {
+		flags = " flags interval;"
+	}
+	fmt.Fprintf(&script, "add set %s %s %s { type %s;%s }\n", set.Family,
+		nftables.AppTable, set.SetName, set.elemType(), flags)
+	set.addElements(&script)
+	if err := nftables.ApplyScript(c.Log, script.String()); err != nil {
+		err = fmt.Errorf("failed to create nftables set: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify atomically replaces static entries.
+func (c *NftSetConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	set := newItem.(NftSet)
+	var script strings.Builder
+	fmt.Fprintf(&script, "flush set %s %s %s\n", set.Family,
+		nftables.AppTable, set.SetName)
+	set.addElements(&script)
+	if err := nftables.ApplyScript(c.Log, script.String()); err != nil {
+		err = fmt.Errorf("failed to update nftables set: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Delete removes the set.
+func (c *NftSetConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	set := item.(NftSet)
+	_, err := nftables.NftCmdOut(c.Log, "delete", "set", set.Family,
+		nftables.AppTable, set.SetName)
+	if err != nil {
+		err = fmt.Errorf("failed to delete nftables set: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if the type of elements has changed.
+func (c *NftSetConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldSet := oldItem.(NftSet)
+	newSet := newItem.(NftSet)
+	return oldSet.ForIPv6 != newSet.ForIPv6 || oldSet.Interval != newSet.Interval
+}
+
+// NftChain : nftables chain with rules translated from iptables rules
+// (see nftables.TranslateRule).
+// Base chains (attached to a netfilter hook) are pre-created for the whole
+// lifetime of the nftables backend. Chains with ACLs of application VIFs
+// are jumped to from base chains, following the layout used with iptables.
+type NftChain struct {
+	// Family of the table with the chain.
+	Family    string
+	ChainName string
+	// Hook : netfilter hook of a base chain. Leave empty for regular chains.
+	Hook string
+	// Priority of a base chain.
+	Priority int
+	// NAT : base chain of the nat type.
+	NAT bool
+	// JumpFrom : optional base chain into which a rule jumping to this chain
+	// is appended.
+	JumpFrom string
+	// ForIPv6 : rules are ip6tables rules.
+	ForIPv6 bool
+	// Rules : iptables rules, translated when the chain is applied.
+	Rules []IptablesRule
+	// IptablesTable, IptablesChain : table and chain of the iptables backend
+	// where the rules would be applied. Used to tag rules with counters
+	// of interest (see nftables.CounterTag).
+	IptablesTable string
+	IptablesChain string
+	// VIFMAC : MAC address of the application VIF (see nftables.RuleContext).
+	VIFMAC net.HardwareAddr
+	// ReturnBridged : if defined, traffic bridged by this bridge immediately
+	// returns from the chain. Used with IP family when bridged traffic is filtered
+	// by the bridge family chain with the same rules.
+	ReturnBridged string
+	// RefersChains : names of chains referred from rules.
+	RefersChains []string
+	// RefersSets : names of sets referred from rules.
+	RefersSets []string
+}
+
+// Name returns the family and the name of the chain.
+func (ch NftChain) Name() string {
+	return ch.Family + "/" + ch.ChainName
+}
+
+// Label is not defined.
+func (ch NftChain) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (ch NftChain) Type() string {
+	return NftChainTypename
+}
+
+// Equal compares content of two instances of the same nftables chain.
+func (ch NftChain) Equal(other depgraph.Item) bool {
+	ch2 := other.(NftChain)
+	return ch.Hook == ch2.Hook &&
+		ch.Priority == ch2.Priority &&
+		ch.NAT == ch2.NAT &&
+		ch.JumpFrom == ch2.JumpFrom &&
+		ch.ForIPv6 == ch2.ForIPv6 &&
+		ch.IptablesTable == ch2.IptablesTable &&
+		ch.IptablesChain == ch2.IptablesChain &&
+		ch.VIFMAC.String() == ch2.VIFMAC.String() &&
+		ch.ReturnBridged == ch2.ReturnBridged &&
+		reflect.DeepEqual(ch.Rules, ch2.Rules)
+}
+
+// External returns false.
+func (ch NftChain) External() bool {
+	return false
+}
+
+// String describes content of nftables chain.
+func (ch NftChain) String() string {
+	str := fmt.Sprintf("Nftables chain %s for family %s", ch.ChainName, ch.Family)
+	if ch.Hook != "" {
+		str += fmt.Sprintf(" (hook %s, priority %d, nat: %t)",
+			ch.Hook, ch.Priority, ch.NAT)
+	}
+	if ch.JumpFrom != "" {
+		str += fmt.Sprintf(" (jump from %s)", ch.JumpFrom)
+	}
+	if ch.ReturnBridged != "" {
+		str += fmt.Sprintf(" (return traffic bridged by %s)", ch.ReturnBridged)
+	}
+	str += " with iptables rules:"
+	for _, rule := range ch.Rules {
+		str += fmt.Sprintf("\n  *  %s", strings.Join(rule.Args, " "))
+		if rule.Description != "" {
+			str += fmt.Sprintf("\n     (%s)", rule.Description)
+		}
+	}
+	return str
+}
+
+// Dependencies lists the table, the base chain and all referenced chains
+// and sets as dependencies.
+func (ch NftChain) Dependencies() (deps []depgraph.Dependency) {
+	deps = append(deps, depgraph.Dependency{
+		RequiredItem: depgraph.Reference(NftTable{Family: ch.Family}),
+		Description:  "nftables table must exist",
+	})
+	if ch.JumpFrom != "" {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.Reference(NftChain{
+				Family:    ch.Family,
+				ChainName: ch.JumpFrom,
+			}),
+			Description: "Base chain must exist",
+		})
+	}
+	for _, referredChain := range ch.RefersChains {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.Reference(NftChain{
+				Family:    ch.Family,
+				ChainName: referredChain,
+			}),
+			Description: "Referenced nftables chain must exist",
+		})
+	}
+	for _, set := range ch.RefersSets {
+		deps = append(deps, depgraph.Dependency{
+			RequiredItem: depgraph.Reference(NftSet{
+				Family:  ch.Family,
+				SetName: set,
+			}),
+			Description: "Referenced nftables set must exist",
+		})
+	}
+	return deps
+}
+
+// NftChainConfigurator implements Configurator interface (libs/reconciler)
+// for nftables chains.
+type NftChainConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create creates and populates nftables chain in one transaction.
+func (c *NftChainConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	chain := item.(NftChain)
+	var script strings.Builder
+	if chain.Hook != "" {
+		chainType := "filter"
+		if chain.NAT {
+			chainType = "nat"
+		}
+		fmt.Fprintf(&script, "add chain %s %s %s { type %s hook %s priority %d; }\n",
+			chain.Family, nftables.AppTable, chain.ChainName, chainType,
+			chain.Hook, chain.Priority)
+	} else {
+		fmt.Fprintf(&script, "add chain %s %s %s\n", chain.Family,
+			nftables.AppTable, chain.ChainName)
+	}
+	if err := c.addRules(&script, chain); err != nil {
+		return err
+	}
+	if chain.JumpFrom != "" {
+		fmt.Fprintf(&script, "add rule %s %s %s jump %s\n", chain.Family,
+			nftables.AppTable, chain.JumpFrom, chain.ChainName)
+	}
+	if err := nftables.ApplyScript(c.Log, script.String()); err != nil {
+		err = fmt.Errorf("failed to create nftables chain: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify atomically replaces all rules of the chain.
+func (c *NftChainConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	chain := newItem.(NftChain)
+	var script strings.Builder
+	if err := c.addRules(&script, chain); err != nil {
+		return err
+	}
+	if err := nftables.ApplyScript(c.Log, script.String()); err != nil {
+		err = fmt.Errorf("failed to update nftables chain: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Delete removes the jump rule and the chain in one transaction.
+func (c *NftChainConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	chain := item.(NftChain)
+	var script strings.Builder
+	if chain.JumpFrom != "" {
+		handles, err := nftables.JumpRuleHandles(c.Log, chain.Family,
+			chain.JumpFrom, chain.ChainName)
+		if err != nil {
+			err = fmt.Errorf("failed to find jump into nftables chain: %w", err)
+			c.Log.Error(err)
+			return err
+		}
+		for _, handle := range handles {
+			fmt.Fprintf(&script, "delete rule %s %s %s handle %d\n", chain.Family,
+				nftables.AppTable, chain.JumpFrom, handle)
+		}
+	}
+	fmt.Fprintf(&script, "flush chain %s %s %s\n", chain.Family,
+		nftables.AppTable, chain.ChainName)
+	fmt.Fprintf(&script, "delete chain %s %s %s\n", chain.Family,
+		nftables.AppTable, chain.ChainName)
+	if err := nftables.ApplyScript(c.Log, script.String()); err != nil {
+		err = fmt.Errorf("failed to delete nftables chain: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if the chain type or the base chain
+// to jump from has changed.
+func (c *NftChainConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	oldChain := oldItem.(NftChain)
+	newChain := newItem.(NftChain)
+	return oldChain.Hook != newChain.Hook ||
+		oldChain.Priority != newChain.Priority ||
+		oldChain.NAT != newChain.NAT ||
+		oldChain.JumpFrom != newChain.JumpFrom
+}
+
+// addRules adds commands into the script which flush the chain and add
+// translated rules.
+func (c *NftChainConfigurator) addRules(script *strings.Builder, chain NftChain) error {
+	prefix := fmt.Sprintf("add rule %s %s %s ", chain.Family,
+		nftables.AppTable, chain.ChainName)
+	fmt.Fprintf(script, "flush chain %s %s %s\n", chain.Family,
+		nftables.AppTable, chain.ChainName)
+	if chain.ReturnBridged != "" {
+		fmt.Fprintf(script, "%siifname %q oifname %q ct status & dnat == 0 return\n",
+			prefix, chain.ReturnBridged, chain.ReturnBridged)
+	}
+	ipVer := 4
+	if chain.ForIPv6 {
+		ipVer = 6
+	}
+	rc := nftables.RuleContext{
+		Bridge:  chain.Family == nftables.FamilyBridge,
+		ForIPv6: chain.ForIPv6,
+		VIFMAC:  chain.VIFMAC,
+	}
+	for _, rule := range chain.Rules {
+		nftRule, err := nftables.TranslateRule(rule.Args, rc)
+		if err != nil {
+			err = fmt.Errorf("failed to translate iptables rule %v: %w",
+				rule.Args, err)
+			c.Log.Error(err)
+			return err
+		}
+		if nftRule == "" {
+			// Rule can never match in this chain.
+			continue
+		}
+		tag := nftables.CounterTag(c.Log, chain.IptablesTable,
+			chain.IptablesChain, ipVer, rule.Args)
+		if tag != "" {
+			nftRule += fmt.Sprintf(" comment %q", tag)
+		}
+		fmt.Fprintf(script, "%s%s\n", prefix, nftRule)
+	}
+	return nil
+}
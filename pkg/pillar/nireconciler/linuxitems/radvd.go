@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// Radvd : radvd instance sending IPv6 router advertisements into a network instance.
+type Radvd struct {
+	// BridgeIfName : bridge on which radvd sends advertisements.
+	BridgeIfName string
+	// ConfigPath : where the config file should be written.
+	ConfigPath string
+	// PidFile : where radvd should write its PID.
+	PidFile string
+	// Config : content of the config file.
+	Config string
+}
+
+// Name returns the bridge name - there is at most one radvd per bridge.
+func (r Radvd) Name() string {
+	return r.BridgeIfName
+}
+
+// Label is more human-readable than name.
+func (r Radvd) Label() string {
+	return "radvd for " + r.BridgeIfName
+}
+
+// Type of the item.
+func (r Radvd) Type() string {
+	return RadvdTypename
+}
+
+// Equal compares the entire configuration.
+func (r Radvd) Equal(other depgraph.Item) bool {
+	r2 := other.(Radvd)
+	return r.ConfigPath == r2.ConfigPath &&
+		r.PidFile == r2.PidFile &&
+		r.Config == r2.Config
+}
+
+// External returns false.
+func (r Radvd) External() bool {
+	return false
+}
+
+// String describes the radvd instance.
+func (r Radvd) String() string {
+	return fmt.Sprintf("Radvd: {bridge: %s, configPath: %s, config:\n%s}",
+		r.BridgeIfName, r.ConfigPath, r.Config)
+}
+
+// Dependencies returns the bridge as the only dependency.
+func (r Radvd) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: r.BridgeIfName,
+			},
+			Description: "Bridge must exist",
+		},
+	}
+}
+
+// RadvdConfigurator implements Configurator interface (libs/reconciler) for radvd.
+type RadvdConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create writes the config file and starts radvd.
+func (c *RadvdConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	radvd := item.(Radvd)
+	if err := os.MkdirAll(filepath.Dir(radvd.ConfigPath), 0755); err != nil {
+		err = fmt.Errorf("failed to create directory for radvd config: %w", err)
+		c.Log.Error(err)
+		return err
+	}
+	err := os.WriteFile(radvd.ConfigPath, []byte(radvd.Config), 0644)
+	if err != nil {
+		err = fmt.Errorf("failed to write radvd config %s: %w",
+			radvd.ConfigPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	// radvd daemonizes itself.
+	out, err := base.Exec(c.Log, "nohup", "radvd", "-u", "radvd",
+		"-C", radvd.ConfigPath, "-p", radvd.PidFile).CombinedOutputWithTimeout()
+	if err != nil {
+		err = fmt.Errorf("failed to start radvd for bridge %s: %v, output: %s",
+			radvd.BridgeIfName, err, out)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify is not implemented.
+func (c *RadvdConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete stops radvd and removes the config file.
+func (c *RadvdConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	radvd := item.(Radvd)
+	stopDaemon(c.Log, radvd.ConfigPath, radvd.PidFile)
+	if err := os.Remove(radvd.ConfigPath); err != nil && !os.IsNotExist(err) {
+		err = fmt.Errorf("failed to remove radvd config %s: %w",
+			radvd.ConfigPath, err)
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *RadvdConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
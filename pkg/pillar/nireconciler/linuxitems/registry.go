@@ -0,0 +1,41 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/netmonitor"
+)
+
+// RegisterItems : register all configurators implemented by this package.
+func RegisterItems(log *base.LogObject, registry *reconciler.DefaultRegistry,
+	monitor netmonitor.NetworkMonitor) error {
+	type configurator struct {
+		c reconciler.Configurator
+		t string
+	}
+	configurators := []configurator{
+		{c: &BandwidthShaperConfigurator{Log: log}, t: BandwidthShaperTypename},
+		{c: &BridgeConfigurator{Log: log}, t: BridgeTypename},
+		{c: &DhcpHostConfigurator{Log: log}, t: DhcpHostTypename},
+		{c: &DnsmasqConfigurator{Log: log}, t: DnsmasqTypename},
+		{c: &IPRuleConfigurator{Log: log}, t: IPRuleTypename},
+		{c: &IPSetConfigurator{Log: log}, t: IPSetTypename},
+		{c: &IptablesChainConfigurator{Log: log}, t: IPtablesChainTypename},
+		{c: &IptablesChainConfigurator{Log: log}, t: IP6tablesChainTypename},
+		{c: &NftChainConfigurator{Log: log}, t: NftChainTypename},
+		{c: &NftSetConfigurator{Log: log}, t: NftSetTypename},
+		{c: &NftTableConfigurator{Log: log}, t: NftTableTypename},
+		{c: &RadvdConfigurator{Log: log}, t: RadvdTypename},
+		{c: &RouteConfigurator{Log: log}, t: RouteTypename},
+	}
+	for _, configurator := range configurators {
+		err := registry.Register(configurator.c, configurator.t)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"syscall"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// Route : network route inside the routing table of a network instance.
+type Route struct {
+	netlink.Route
+	// OutputIf : name of the output interface (should match Route.LinkIndex).
+	// Empty for unreachable routes.
+	OutputIf string
+	// ForBridge : bridge of the network instance which owns the routing table.
+	ForBridge string
+}
+
+// Name combines the route table ID, output interface and the destination
+// address to construct a unique route identifier.
+func (r Route) Name() string {
+	var dst string
+	if r.Route.Dst == nil {
+		dst = "default"
+	} else {
+		dst = r.Route.Dst.String()
+	}
+	outIf := r.OutputIf
+	if r.Route.Type == unix.RTN_UNREACHABLE {
+		outIf = "unreachable"
+	}
+	return fmt.Sprintf("%d/%s/%s", r.Table, outIf, dst)
+}
+
+// Label is more human-readable than name.
+func (r Route) Label() string {
+	var dst string
+	if r.Route.Dst == nil {
+		dst = "<default>"
+	} else {
+		dst = r.Route.Dst.String()
+	}
+	if r.Route.Type == unix.RTN_UNREACHABLE {
+		return fmt.Sprintf("IP route table %d unreachable %s", r.Table, dst)
+	}
+	return fmt.Sprintf("IP route table %d dst %s dev %v via %v",
+		r.Table, dst, r.OutputIf, r.Gw)
+}
+
+// Type of the item.
+func (r Route) Type() string {
+	return RouteTypename
+}
+
+// Equal is a comparison method for two equally-named route instances.
+func (r Route) Equal(other depgraph.Item) bool {
+	r2 := other.(Route)
+	return reflect.DeepEqual(r.Route, r2.Route)
+}
+
+// External returns false.
+func (r Route) External() bool {
+	return false
+}
+
+// String describes the network route.
+func (r Route) String() string {
+	return fmt.Sprintf("Network route for NI with bridge %s: %+v",
+		r.ForBridge, r.Route)
+}
+
+// Dependencies returns the NI bridge as the only dependency.
+// The routing table ID is derived from the bridge ifindex.
+func (r Route) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: BridgeTypename,
+				ItemName: r.ForBridge,
+			},
+			Description: "NI bridge must exist",
+		},
+	}
+}
+
+// RouteConfigurator implements Configurator interface (libs/reconciler) for network routes.
+type RouteConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create adds network route.
+func (c *RouteConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	route := item.(Route)
+	err := netlink.RouteAdd(&route.Route)
+	if err != nil && errors.Is(err, syscall.EEXIST) {
+		// Ignore duplicate route.
+		return nil
+	}
+	return err
+}
+
+// Modify is not implemented.
+func (c *RouteConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return errors.New("not implemented")
+}
+
+// Delete removes network route.
+func (c *RouteConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	route := item.(Route)
+	err := netlink.RouteDel(&route.Route)
+	if err != nil && errors.Is(err, syscall.ESRCH) {
+		// Route was already removed together with the output interface.
+		return nil
+	}
+	return err
+}
+
+// NeedsRecreate returns true - Modify is not implemented.
+func (c *RouteConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return true
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/tc"
+)
+
+// BandwidthShaper : tc configuration shaping bandwidth of an application VIF
+// (see package tc for the details).
+type BandwidthShaper struct {
+	VIFName string
+	// Ingress : shaping of the traffic towards the application.
+	Ingress tc.Direction
+	// Egress : shaping of the traffic sent by the application.
+	Egress tc.Direction
+}
+
+// Name returns the VIF name.
+func (s BandwidthShaper) Name() string {
+	return s.VIFName
+}
+
+// Label is more human-readable than name.
+func (s BandwidthShaper) Label() string {
+	return fmt.Sprintf("Bandwidth shaper for %s", s.VIFName)
+}
+
+// Type of the item.
+func (s BandwidthShaper) Type() string {
+	return BandwidthShaperTypename
+}
+
+// Equal compares shaping of both directions.
+func (s BandwidthShaper) Equal(other depgraph.Item) bool {
+	s2 := other.(BandwidthShaper)
+	return s.Ingress.Equal(s2.Ingress) && s.Egress.Equal(s2.Egress)
+}
+
+// External returns false.
+func (s BandwidthShaper) External() bool {
+	return false
+}
+
+// String describes the shaping configuration.
+func (s BandwidthShaper) String() string {
+	return fmt.Sprintf("Bandwidth shaper: {vif: %s, ingress: %s, egress: %s}",
+		s.VIFName, s.Ingress, s.Egress)
+}
+
+// Dependencies returns the VIF as the only dependency.
+func (s BandwidthShaper) Dependencies() (deps []depgraph.Dependency) {
+	return []depgraph.Dependency{
+		{
+			RequiredItem: depgraph.ItemRef{
+				ItemType: VIFTypename,
+				ItemName: s.VIFName,
+			},
+			Description: "VIF must exist",
+		},
+	}
+}
+
+// BandwidthShaperConfigurator implements Configurator interface (libs/reconciler)
+// for bandwidth shapers.
+type BandwidthShaperConfigurator struct {
+	Log *base.LogObject
+}
+
+// Create installs qdiscs, classes and filters shaping the VIF traffic.
+func (c *BandwidthShaperConfigurator) Create(ctx context.Context, item depgraph.Item) error {
+	shaper := item.(BandwidthShaper)
+	err := tc.ConfigureShaping(c.Log, shaper.VIFName, shaper.Ingress, shaper.Egress)
+	if err != nil {
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// Modify re-creates the shaping configuration.
+func (c *BandwidthShaperConfigurator) Modify(ctx context.Context, oldItem, newItem depgraph.Item) (err error) {
+	return c.Create(ctx, newItem)
+}
+
+// Delete removes the shaping configuration.
+func (c *BandwidthShaperConfigurator) Delete(ctx context.Context, item depgraph.Item) error {
+	shaper := item.(BandwidthShaper)
+	if err := tc.RemoveShaping(c.Log, shaper.VIFName); err != nil {
+		c.Log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// NeedsRecreate returns false - Modify is able to apply any change.
+func (c *BandwidthShaperConfigurator) NeedsRecreate(oldItem, newItem depgraph.Item) (recreate bool) {
+	return false
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+const (
+	// BridgeTypename : typename for Linux bridges used by network instances.
+	BridgeTypename = "Bridge"
+	// DnsmasqTypename : typename for dnsmasq instances (DHCP + DNS server for NI).
+	DnsmasqTypename = "Dnsmasq"
+	// DhcpHostTypename : typename for static DHCP host entries (one per app VIF).
+	DhcpHostTypename = "DHCP-Host"
+	// RadvdTypename : typename for radvd instances (IPv6 router advertisement).
+	RadvdTypename = "Radvd"
+	// IPSetTypename : typename for Linux ipsets.
+	IPSetTypename = "IPSet"
+	// IPtablesChainTypename : typename for a single iptables chain (IPv4).
+	IPtablesChainTypename = "Iptables-Chain"
+	// IP6tablesChainTypename : typename for a single ip6tables chain (IPv6).
+	IP6tablesChainTypename = "Ip6tables-Chain"
+	// NftTableTypename : typename for nftables tables with application ACLs.
+	NftTableTypename = "Nftables-Table"
+	// NftSetTypename : typename for nftables sets (replacing ipsets).
+	NftSetTypename = "Nftables-Set"
+	// NftChainTypename : typename for a single nftables chain.
+	NftChainTypename = "Nftables-Chain"
+	// RouteTypename : typename for routes installed into NI-specific routing tables.
+	RouteTypename = "Route"
+	// IPRuleTypename : typename for IP rules steering NI traffic.
+	IPRuleTypename = "IP-Rule"
+	// VIFTypename : typename for application VIFs (external items).
+	VIFTypename = "VIF"
+	// BandwidthShaperTypename : typename for tc configuration shaping VIF traffic.
+	BandwidthShaperTypename = "Bandwidth-Shaper"
+)
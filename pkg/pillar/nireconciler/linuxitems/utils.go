@@ -0,0 +1,74 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+func equalIPNets(list1, list2 []*net.IPNet) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if list1[i].String() != list2[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+// diffIPNets returns IP addresses removed and added between two lists.
+func diffIPNets(oldList, newList []*net.IPNet) (removed, added []*net.IPNet) {
+	for _, oldIP := range oldList {
+		if !containsIPNet(newList, oldIP) {
+			removed = append(removed, oldIP)
+		}
+	}
+	for _, newIP := range newList {
+		if !containsIPNet(oldList, newIP) {
+			added = append(added, newIP)
+		}
+	}
+	return removed, added
+}
+
+func containsIPNet(list []*net.IPNet, ipNet *net.IPNet) bool {
+	for _, item := range list {
+		if item.String() == ipNet.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStrings(list1, list2 []string) bool {
+	if len(list1) != len(list2) {
+		return false
+	}
+	for i := range list1 {
+		if list1[i] != list2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// signalProcess sends signal to the process whose PID is stored in the given
+// file. Returns false if the process is not running.
+func signalProcess(pidFile string, sig syscall.Signal) bool {
+	pidBytes, err := os.ReadFile(pidFile)
+	if err != nil {
+		return false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+	if err != nil || pid <= 0 {
+		return false
+	}
+	return syscall.Kill(pid, sig) == nil
+}
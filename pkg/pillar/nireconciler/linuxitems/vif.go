@@ -0,0 +1,52 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package linuxitems
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/libs/depgraph"
+)
+
+// VIF : application VIF created by the hypervisor.
+// NI Reconciler does not manage VIFs, it only references them by items which
+// can be configured only once the VIF exists.
+type VIF struct {
+	IfName string
+}
+
+// Name returns the interface name of the VIF.
+func (v VIF) Name() string {
+	return v.IfName
+}
+
+// Label is not defined.
+func (v VIF) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (v VIF) Type() string {
+	return VIFTypename
+}
+
+// Equal is always true (VIF is identified by its name).
+func (v VIF) Equal(other depgraph.Item) bool {
+	return true
+}
+
+// External returns true - VIFs are created by the hypervisor.
+func (v VIF) External() bool {
+	return true
+}
+
+// String describes the VIF.
+func (v VIF) String() string {
+	return fmt.Sprintf("VIF %s", v.IfName)
+}
+
+// Dependencies returns no dependencies.
+func (v VIF) Dependencies() (deps []depgraph.Dependency) {
+	return nil
+}
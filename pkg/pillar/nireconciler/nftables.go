@@ -0,0 +1,228 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/nftables"
+	linux "github.com/lf-edge/eve/pkg/pillar/nireconciler/linuxitems"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// With the nftables backend, ACL rules generated for iptables are translated
+// into nftables rules (see nftables.TranslateRule) and put into chains laid out
+// the same way as with iptables: every VIF has a chain for every (IP version,
+// table, chain) combination used by its rules, jumped to from a base chain,
+// which replaces the pre-created iptables chain for app-scoped ACLs.
+//
+// Iptables rules matching on bridge ports (physdev) rely on br_netfilter.
+// With nftables the output bridge port is known only in the bridge family.
+// Therefore, rules of the raw table (all matching on the input port) are
+// evaluated by the bridge family, and FORWARD rules are installed twice:
+// into the bridge family, where all bridged traffic is filtered, and into the IP
+// family, which filters only the routed traffic and returns bridged traffic.
+
+// nftBaseChain : nftables base chain replacing pre-created iptables chain
+// for app-scoped ACLs.
+type nftBaseChain struct {
+	// iptables table and chain
+	table string
+	chain string
+	// base chain of the bridge family
+	bridge   bool
+	hook     string
+	priority int
+	nat      bool
+}
+
+// Priorities are those of the iptables tables.
+var nftBaseChains = []nftBaseChain{
+	{table: "raw", chain: "PREROUTING", bridge: true,
+		hook: "prerouting", priority: -300},
+	{table: "mangle", chain: "PREROUTING",
+		hook: "prerouting", priority: -150},
+	{table: "nat", chain: "PREROUTING",
+		hook: "prerouting", priority: -100, nat: true},
+	{table: "filter", chain: "FORWARD", bridge: true,
+		hook: "forward", priority: -200},
+	{table: "filter", chain: "FORWARD",
+		hook: "forward", priority: 0},
+	{table: "filter", chain: "OUTPUT",
+		hook: "output", priority: 0},
+	{table: "nat", chain: "POSTROUTING",
+		hook: "postrouting", priority: 100, nat: true},
+}
+
+func (b nftBaseChain) name() string {
+	return b.table + "-" + b.chain
+}
+
+func (b nftBaseChain) families() []string {
+	if b.bridge {
+		return []string{nftables.FamilyBridge}
+	}
+	return []string{nftables.FamilyIP, nftables.FamilyIP6}
+}
+
+func (b nftBaseChain) family(forIPv6 bool) string {
+	if b.bridge {
+		return nftables.FamilyBridge
+	}
+	if forIPv6 {
+		return nftables.FamilyIP6
+	}
+	return nftables.FamilyIP
+}
+
+// vifChainName returns name of the chain with rules of the given VIF jumped to
+// from this base chain. IPv4 and IPv6 rules share the bridge family table.
+func (b nftBaseChain) vifChainName(vifName string, forIPv6 bool) string {
+	name := b.name() + "-" + vifName
+	if b.bridge {
+		if forIPv6 {
+			return name + "-ipv6"
+		}
+		return name + "-ipv4"
+	}
+	return name
+}
+
+// nftProbeScript is submitted to the kernel in the dry-run mode to check that
+// all nftables features used for ACLs are supported by the kernel. Matching
+// of bridge ports (meta ibrname/obrname) is provided by the nft_meta_bridge
+// module (CONFIG_NFT_BRIDGE_META), which is not enabled by every kernel config.
+const nftProbeScript = `add table bridge eve_probe
+add set bridge eve_probe probe-set { type ipv4_addr; flags interval; }
+add chain bridge eve_probe probe-chain { type filter hook forward priority 0; }
+add rule bridge eve_probe probe-chain meta ibrname "bn1" ip daddr @probe-set counter accept comment "probe"
+add rule bridge eve_probe probe-chain meta obrname "bn1" ct mark 0x1 counter drop
+`
+
+// CheckNftablesSupport returns error if the nftables backend of ACLs cannot
+// be used on this device.
+func CheckNftablesSupport(log *base.LogObject) error {
+	if !nftables.Available(log) {
+		return errors.New("nft utility is not available")
+	}
+	if err := nftables.CheckScript(log, nftProbeScript); err != nil {
+		return fmt.Errorf("kernel does not support required nftables features: %w", err)
+	}
+	if !linux.DnsmasqSupportsNftset(log) {
+		return errors.New("dnsmasq is built without nftset support")
+	}
+	return nil
+}
+
+func (r *LinuxNIReconciler) useNftables() bool {
+	return r.aclBackend == types.ACLBackendNftables
+}
+
+// nftSetFamilies returns families of tables where a set of IPv4/IPv6 addresses
+// is created.
+func nftSetFamilies(forIPv6 bool) []string {
+	if forIPv6 {
+		return []string{nftables.FamilyIP6, nftables.FamilyBridge}
+	}
+	return []string{nftables.FamilyIP, nftables.FamilyBridge}
+}
+
+// getIntendedNftChains is the nftables counterpart of getIntendedACLChains.
+func (r *LinuxNIReconciler) getIntendedNftChains(
+	vif AppVIFConfig, ni NIConfig) (chains []linux.NftChain) {
+	type chainKey struct {
+		forIPv6 bool
+		family  string
+		chain   string
+	}
+	var chainKeys []chainKey
+	vifChains := make(map[chainKey]*linux.NftChain)
+	markChains := make(map[chainKey]linux.NftChain)
+	for _, rule := range vif.ACLRules {
+		table := rule.Table
+		if table == "" {
+			table = "filter"
+		}
+		forIPv6 := rule.IPVer == 6
+		var found bool
+		for _, baseChain := range nftBaseChains {
+			if baseChain.table != table || baseChain.chain != rule.Chain {
+				continue
+			}
+			found = true
+			family := baseChain.family(forIPv6)
+			key := chainKey{forIPv6: forIPv6, family: family,
+				chain: baseChain.vifChainName(vif.VIFName, forIPv6)}
+			vifChain, exists := vifChains[key]
+			if !exists {
+				vifChain = &linux.NftChain{
+					Family:        family,
+					ChainName:     key.chain,
+					JumpFrom:      baseChain.name(),
+					ForIPv6:       forIPv6,
+					IptablesTable: table,
+					IptablesChain: rule.Chain,
+					VIFMAC:        vif.GuestMAC,
+				}
+				if rule.Chain == "FORWARD" && !baseChain.bridge {
+					vifChain.ReturnBridged = ni.BridgeName
+				}
+				vifChains[key] = vifChain
+				chainKeys = append(chainKeys, key)
+			}
+			var args []string
+			args = append(args, rule.Prefix...)
+			args = append(args, rule.Rule...)
+			args = append(args, rule.Action...)
+			vifChain.Rules = append(vifChain.Rules, linux.IptablesRule{
+				Args:        args,
+				Description: rule.RuleName,
+			})
+			for _, ipset := range referencedIPSets(rule) {
+				vifChain.RefersSets = appendIfMissing(vifChain.RefersSets,
+					nftables.SetName(ipset))
+			}
+			if rule.ActionChainName == "" {
+				continue
+			}
+			vifChain.RefersChains = appendIfMissing(vifChain.RefersChains,
+				rule.ActionChainName)
+			markKey := chainKey{forIPv6: forIPv6, family: family,
+				chain: rule.ActionChainName}
+			if _, exists := markChains[markKey]; !exists {
+				markChains[markKey] = linux.NftChain{
+					Family:    family,
+					ChainName: rule.ActionChainName,
+					ForIPv6:   forIPv6,
+					Rules: getMarkAndAcceptChain(rule.ActionChainName, table,
+						forIPv6, rule.ActionChainMark).Rules,
+				}
+			}
+		}
+		if !found {
+			r.Log.Errorf("getIntendedNftChains: unsupported chain %s/%s "+
+				"(VIF %s, rule %v)", table, rule.Chain, vif.VIFName, rule.Rule)
+		}
+	}
+	for _, key := range chainKeys {
+		chains = append(chains, *vifChains[key])
+	}
+	var markKeys []chainKey
+	for key := range markChains {
+		markKeys = append(markKeys, key)
+	}
+	sort.Slice(markKeys, func(i, j int) bool {
+		if markKeys[i].family != markKeys[j].family {
+			return markKeys[i].family < markKeys[j].family
+		}
+		return markKeys[i].chain < markKeys[j].chain
+	})
+	for _, key := range markKeys {
+		chains = append(chains, markChains[key])
+	}
+	return chains
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package nireconciler
+
+import (
+	"context"
+	"net"
+
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/tc"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+// NIReconciler should translate the configuration of network instances and
+// application network interfaces (VIFs) into the corresponding low-level network
+// configuration of the target network stack (bridges, dnsmasq, radvd, ipsets,
+// iptables chains or nftables sets and chains, routes, IP rules and tc shaping)
+// and apply it using the Reconciler (see libs/reconciler).
+// Configuration items are applied incrementally and in the order given by their
+// dependencies. Items which get out-of-sync with the intended state (e.g. routes
+// removed by the kernel) are automatically repaired.
+// It is not required for NIReconciler to be thread-safe.
+type NIReconciler interface {
+	// UpdateNI : create a new or update an existing network instance.
+	// Synchronous configuration operations are run from within the caller's Go routine.
+	UpdateNI(ctx context.Context, ni NIConfig) ReconcileStatus
+	// DelNI : remove network instance with all its configuration.
+	// VIFs connected to the NI should be removed first.
+	DelNI(ctx context.Context, niID uuid.UUID) ReconcileStatus
+	// UpdateAppVIF : create a new or update an existing application VIF.
+	UpdateAppVIF(ctx context.Context, vif AppVIFConfig) ReconcileStatus
+	// DelAppVIF : remove application VIF with all its configuration.
+	DelAppVIF(ctx context.Context, vifName string) ReconcileStatus
+	// SetACLBackend : select the packet filtering framework used to implement
+	// ACLs of application VIFs (types.ACLBackendIptables or types.ACLBackendNftables).
+	// ACLs of all VIFs are re-applied using the selected backend.
+	// Note that dnsmasq config (DnsmasqConfig.Config) generated for the previous
+	// backend should be updated by the caller.
+	SetACLBackend(ctx context.Context, backend string) ReconcileStatus
+	// ResumeReconcile : resume reconciliation after a signal received
+	// from ReconcileStatus.ResumeReconcile.
+	ResumeReconcile(ctx context.Context) ReconcileStatus
+}
+
+// NIConfig : configuration of a single network instance, translated by NIReconciler
+// into the corresponding low-level network configuration.
+type NIConfig struct {
+	UUID        uuid.UUID
+	DisplayName string
+	Type        types.NetworkInstanceType
+	BridgeName  string
+	// ExternalBridge : bridge created by NIM for a switch NI with a port.
+	// NIReconciler only references such bridge and does not manage its addresses.
+	ExternalBridge bool
+	// BridgeMAC : MAC address to assign to the bridge.
+	// Not used with ExternalBridge.
+	BridgeMAC net.HardwareAddr
+	// BridgeIPs : IP addresses to assign to the bridge.
+	// Not used with ExternalBridge.
+	BridgeIPs []*net.IPNet
+	// Dnsmasq : nil if dnsmasq should not run for this NI.
+	Dnsmasq *DnsmasqConfig
+	// Radvd : nil if radvd should not run for this NI.
+	Radvd *RadvdConfig
+	// NAT : nil if NI traffic should not be NATed and routed out via an uplink.
+	NAT *NATConfig
+	// Tunnel : nil if NI traffic should not be routed through a tunnel.
+	// Used instead of NAT.
+	Tunnel *TunnelConfig
+}
+
+// DnsmasqConfig : configuration for dnsmasq running for a network instance.
+type DnsmasqConfig struct {
+	ConfigPath string
+	PidFile    string
+	// Config : content of the config file.
+	Config       string
+	HostsDir     string
+	DhcpHostsDir string
+	// IPSets : names of ipsets that dnsmasq fills with resolved IPs.
+	// With the nftables backend these are names of the corresponding nftables
+	// sets before the conversion with nftables.SetName.
+	IPSets []string
+}
+
+// RadvdConfig : configuration for radvd running for a network instance.
+type RadvdConfig struct {
+	ConfigPath string
+	PidFile    string
+	// Config : content of the config file.
+	Config string
+}
+
+// NATConfig : NAT and routing configuration for a Local network instance.
+type NATConfig struct {
+	// Uplink : interface name of the uplink port.
+	Uplink string
+	// Subnet : NI subnet.
+	Subnet *net.IPNet
+	// Gateway : bridge IP used as the gateway by applications.
+	Gateway net.IP
+	// IPv6Subnet : IPv6 subnet of a dual-stack NI, nil for IPv4-only NI.
+	IPv6Subnet *net.IPNet
+	// IPv6Gateway : bridge IPv6 address of a dual-stack NI.
+	IPv6Gateway net.IP
+	// NAT66 : IPv6 traffic is NATed like IPv4 traffic. Otherwise the IPv6
+	// subnet is expected to be routed to the device by the uplink router.
+	NAT66 bool
+}
+
+// TunnelConfig : routing configuration for a network instance with a WireGuard
+// tunnel (WireGuard VPN and Mesh network instance).
+// The tunnel interface itself is created by zedrouter.
+type TunnelConfig struct {
+	// IfName : interface name of the tunnel.
+	IfName string
+	// Subnet : NI subnet.
+	Subnet *net.IPNet
+	// Gateway : bridge IP used as the gateway by applications.
+	Gateway net.IP
+	// Routes : networks routed through the tunnel, everything else
+	// leaving the NI subnet is unreachable.
+	Routes []*net.IPNet
+	// Masquerade : NAT traffic from the NI subnet leaving via the tunnel.
+	Masquerade bool
+	// NoDHCPBridgePort : optional bridge port through which DHCP is not passed
+	// (VXLAN interface of a Mesh NI, each member serves its own applications).
+	NoDHCPBridgePort string
+}
+
+// AppVIFConfig : configuration of a single application VIF connected
+// to a network instance.
+type AppVIFConfig struct {
+	VIFName string
+	AppID   uuid.UUID
+	NI      uuid.UUID
+	// ACLRules : iptables rules implementing ACLs of the VIF.
+	// Every rule should have IPVer, Table and Chain set (Chain is a name of a pre-created
+	// chain without iptables.AppChainSuffix).
+	// Rules with ActionChainName refer to a marking chain, which NIReconciler creates
+	// using ActionChainMark.
+	ACLRules types.IPTablesRuleList
+	// EIDs : IP addresses to put into the eids ipset of the VIF.
+	EIDs []net.IP
+	// GuestMAC : MAC address of the application side of the VIF.
+	// Used by the nftables backend to match traffic coming from the VIF
+	// outside of the bridge family.
+	GuestMAC net.HardwareAddr
+	// DhcpHost : optional static DHCP entry. Used only if the NI runs dnsmasq.
+	DhcpHost *DhcpHostConfig
+	// DhcpHostIPv6 : optional static DHCPv6 entry of a dual-stack NI.
+	DhcpHostIPv6 *DhcpHostConfig
+	// Shaping : optional bandwidth shaping of the VIF traffic.
+	Shaping *ShapingConfig
+}
+
+// ShapingConfig : bandwidth shaping of application VIF traffic.
+// Flows are assigned to classes using connection marks set by ACL rules.
+type ShapingConfig struct {
+	// Ingress : shaping of the traffic towards the application.
+	Ingress tc.Direction
+	// Egress : shaping of the traffic sent by the application.
+	Egress tc.Direction
+}
+
+// DhcpHostConfig : static DHCP host entry.
+type DhcpHostConfig struct {
+	MAC      net.HardwareAddr
+	IP       net.IP
+	Hostname string
+}
+
+// ReconcileStatus : state data related to config reconciliation.
+type ReconcileStatus struct {
+	// Error summarizing the outcome of the reconciliation.
+	// For operations targeting a specific NI or VIF it covers only
+	// the configuration items of that NI or VIF.
+	Error error
+	// True if any async operations are in progress.
+	AsyncInProgress bool
+	// ResumeReconcile channel is used by NIReconciler to signal that reconciliation
+	// should be resumed (call NIReconciler.ResumeReconcile). This is either
+	// because some config operation was running asynchronously and has just finalized
+	// (and should be followed up on), or because something changed in the current state
+	// that NIReconciler needs to reflect in the applied config.
+	ResumeReconcile <-chan struct{}
+	// CancelAsyncOps : send cancel signal to all asynchronously running operations.
+	CancelAsyncOps func()
+	// WaitForAsyncOps : wait for all asynchronously running operations to complete.
+	WaitForAsyncOps func()
+	// The set of configuration items currently in a failed state.
+	// Includes information about the last (failed) operation.
+	FailingItems reconciler.OperationLog
+}
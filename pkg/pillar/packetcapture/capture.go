@@ -0,0 +1,305 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package packetcapture captures packets received and sent on a network
+// interface into a pcapng file. It is used to troubleshoot network
+// instances, application VIFs and device ports on request of the local
+// profile server.
+package packetcapture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/packetcap/go-pcap/filter"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// How often is the capture loop woken up to check if the capture should end
+	// when no packets are received.
+	readTimeout = time.Second
+	// How often is the progress reported.
+	progressIntv = 5 * time.Second
+	// Room for the socket control message with the packet timestamp.
+	oobLen = 64
+	// Size of the buffer between the capture loop and the file.
+	writeBufSize = 64 << 10
+)
+
+// Config : parameters of a packet capture.
+type Config struct {
+	// Interface : name of the interface to capture on.
+	Interface string
+	// Filter : capture filter in the tcpdump syntax, empty to capture
+	// all packets.
+	Filter string
+	// SnapLen : maximum number of bytes captured from each packet.
+	SnapLen uint32
+	// Duration : the capture ends after this time.
+	Duration time.Duration
+	// MaxSize : the capture ends before the file would exceed this size.
+	MaxSize uint64
+	// Filename : path to the capture file, it must not exist.
+	Filename string
+}
+
+// Progress : progress of a packet capture.
+type Progress struct {
+	// Packets : number of packets written into the file.
+	Packets uint64
+	// Size : size of the file in bytes.
+	Size uint64
+	// Truncated is set when the capture ended because of Config.MaxSize.
+	Truncated bool
+}
+
+// Capture : packet capture opened on an interface.
+type Capture struct {
+	log    *base.LogObject
+	config Config
+	fd     int
+	file   *os.File
+	buf    *bufio.Writer
+	writer *pcapngWriter
+}
+
+// Open opens a packet socket bound to the interface, with the filter attached,
+// and creates the capture file. Run must be called to capture packets
+// and release the socket.
+func Open(log *base.LogObject, config Config) (*Capture, error) {
+	iface, err := net.InterfaceByName(config.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interface %s: %w", config.Interface, err)
+	}
+	linkType, err := getLinkType(iface.Name)
+	if err != nil {
+		return nil, err
+	}
+	var rawFilter []bpf.RawInstruction
+	if config.Filter != "" {
+		if linkType != linkTypeEthernet {
+			return nil, fmt.Errorf("capture filter is not supported on interface %s "+
+				"without Ethernet header", config.Interface)
+		}
+		rawFilter, err = compileFilter(config.Filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Socket with protocol 0 does not receive any packets until it is bound,
+	// hence no packet gets around the filter attached before.
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	err = setupSocket(fd, iface.Index, rawFilter, linkType == linkTypeEthernet)
+	if err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	file, err := os.OpenFile(config.Filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to create capture file: %w", err)
+	}
+	buf := bufio.NewWriterSize(file, writeBufSize)
+	writer, err := newPcapngWriter(buf, config.Interface, linkType,
+		config.SnapLen, config.Filter)
+	if err != nil {
+		unix.Close(fd)
+		file.Close()
+		return nil, fmt.Errorf("failed to write capture file header: %w", err)
+	}
+	return &Capture{
+		log:    log,
+		config: config,
+		fd:     fd,
+		file:   file,
+		buf:    buf,
+		writer: writer,
+	}, nil
+}
+
+// getLinkType returns the pcap link type matching the ARP hardware type
+// of the interface.
+func getLinkType(ifName string) (uint16, error) {
+	typeFile := filepath.Join("/sys/class/net", ifName, "type")
+	content, err := ioutil.ReadFile(typeFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get type of interface %s: %w", ifName, err)
+	}
+	arpHrdType, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", typeFile, err)
+	}
+	switch arpHrdType {
+	case unix.ARPHRD_ETHER, unix.ARPHRD_LOOPBACK:
+		return linkTypeEthernet, nil
+	case unix.ARPHRD_NONE, unix.ARPHRD_RAWIP:
+		// For example wwan interfaces in the raw-IP mode.
+		return linkTypeRaw, nil
+	}
+	return 0, fmt.Errorf("unsupported type %d of interface %s", arpHrdType, ifName)
+}
+
+func setupSocket(fd, ifIndex int, rawFilter []bpf.RawInstruction, promisc bool) error {
+	if len(rawFilter) > 0 {
+		prog := unix.SockFprog{
+			Len:    uint16(len(rawFilter)),
+			Filter: (*unix.SockFilter)(unsafe.Pointer(&rawFilter[0])),
+		}
+		err := unix.SetsockoptSockFprog(fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &prog)
+		if err != nil {
+			return fmt.Errorf("failed to attach capture filter: %w", err)
+		}
+	}
+	if err := unix.SetsockoptInt(fd, unix.SOL_SOCKET, unix.SO_TIMESTAMPNS, 1); err != nil {
+		return fmt.Errorf("failed to enable packet timestamps: %w", err)
+	}
+	tv := unix.NsecToTimeval(readTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		return fmt.Errorf("failed to set socket read timeout: %w", err)
+	}
+	err := unix.Bind(fd, &unix.SockaddrLinklayer{
+		Protocol: htons(unix.ETH_P_ALL),
+		Ifindex:  ifIndex,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bind packet socket: %w", err)
+	}
+	if promisc {
+		// Bridges do not pass frames between other ports up to the host
+		// unless in the promiscuous mode. The membership is dropped
+		// with the socket.
+		err = unix.SetsockoptPacketMreq(fd, unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP,
+			&unix.PacketMreq{Ifindex: int32(ifIndex), Type: unix.PACKET_MR_PROMISC})
+		if err != nil {
+			return fmt.Errorf("failed to enable promiscuous mode: %w", err)
+		}
+	}
+	return nil
+}
+
+// compileFilter compiles the tcpdump filter expression for Ethernet frames.
+func compileFilter(expr string) (rawFilter []bpf.RawInstruction, err error) {
+	defer func() {
+		// The filter compiler does not validate all the input.
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unsupported capture filter '%s'", expr)
+		}
+	}()
+	e := filter.NewExpression(expr)
+	if e == nil {
+		return nil, fmt.Errorf("invalid capture filter '%s'", expr)
+	}
+	instructions, err := e.Compile().Compile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile capture filter '%s': %w", expr, err)
+	}
+	rawFilter, err = bpf.Assemble(instructions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assemble capture filter '%s': %w", expr, err)
+	}
+	return rawFilter, nil
+}
+
+// Run captures packets until the duration elapses, the file reaches
+// the maximum size or stop is closed. Progress is reported periodically
+// while capturing. The socket and the file are closed before Run returns.
+func (c *Capture) Run(stop <-chan struct{}, progress func(Progress)) (Progress, error) {
+	p, err := c.capture(stop, progress)
+	if flushErr := c.buf.Flush(); err == nil && flushErr != nil {
+		err = fmt.Errorf("failed to write capture file: %w", flushErr)
+	}
+	unix.Close(c.fd)
+	if closeErr := c.file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close capture file: %w", closeErr)
+	}
+	p.Size = c.writer.size
+	return p, err
+}
+
+func (c *Capture) capture(stop <-chan struct{}, progress func(Progress)) (Progress, error) {
+	p := Progress{Size: c.writer.size}
+	deadline := time.Now().Add(c.config.Duration)
+	lastProgress := time.Now()
+	packet := make([]byte, c.config.SnapLen)
+	oob := make([]byte, oobLen)
+	for {
+		select {
+		case <-stop:
+			return p, nil
+		default:
+		}
+		now := time.Now()
+		if !now.Before(deadline) {
+			return p, nil
+		}
+		if now.Sub(lastProgress) >= progressIntv {
+			// Make the file readable up to the last packet.
+			if err := c.buf.Flush(); err != nil {
+				return p, fmt.Errorf("failed to write capture file: %w", err)
+			}
+			progress(p)
+			lastProgress = now
+		}
+		// With MSG_TRUNC the original length of the packet is returned.
+		n, oobn, _, _, err := unix.Recvmsg(c.fd, packet, oob, unix.MSG_TRUNC)
+		if err != nil {
+			if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+				continue
+			}
+			return p, fmt.Errorf("failed to read packet: %w", err)
+		}
+		capLen := n
+		if capLen > len(packet) {
+			capLen = len(packet)
+		}
+		if p.Size+packetBlockLen(capLen) > c.config.MaxSize {
+			p.Truncated = true
+			return p, nil
+		}
+		err = c.writer.writePacket(packetTimestamp(oob[:oobn]), packet[:capLen], n)
+		if err != nil {
+			return p, fmt.Errorf("failed to write capture file: %w", err)
+		}
+		p.Packets++
+		p.Size = c.writer.size
+	}
+}
+
+// packetTimestamp returns the time when the packet was received by the kernel.
+func packetTimestamp(oob []byte) time.Time {
+	msgs, err := unix.ParseSocketControlMessage(oob)
+	if err == nil {
+		for _, msg := range msgs {
+			if msg.Header.Level == unix.SOL_SOCKET &&
+				msg.Header.Type == unix.SCM_TIMESTAMPNS &&
+				len(msg.Data) >= int(unsafe.Sizeof(unix.Timespec{})) {
+				ts := (*unix.Timespec)(unsafe.Pointer(&msg.Data[0]))
+				return time.Unix(ts.Unix())
+			}
+		}
+	}
+	return time.Now()
+}
+
+// htons converts the value to the network byte order.
+func htons(v uint16) uint16 {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return *(*uint16)(unsafe.Pointer(&b[0]))
+}
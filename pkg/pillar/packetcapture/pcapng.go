@@ -0,0 +1,143 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package packetcapture
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// Link types (http://www.tcpdump.org/linktypes.html).
+const (
+	linkTypeEthernet uint16 = 1
+	// Packets begin with the IPv4 or IPv6 header.
+	linkTypeRaw uint16 = 101
+)
+
+// pcapng block types and options (draft-ietf-opsawg-pcapng).
+const (
+	blockSectionHeader       uint32 = 0x0A0D0D0A
+	blockInterfaceDesc       uint32 = 0x00000001
+	blockEnhancedPacket      uint32 = 0x00000006
+	byteOrderMagic           uint32 = 0x1A2B3C4D
+	optEndOfOpt              uint16 = 0
+	optSHBUserAppl           uint16 = 4
+	optIfName                uint16 = 2
+	optIfTsResol             uint16 = 9
+	optIfFilter              uint16 = 11
+	tsResolNanoseconds       uint8  = 9
+	ifFilterLibpcapString    uint8  = 0
+	sectionLengthUnspecified uint64 = 0xFFFFFFFFFFFFFFFF
+	// Block type and the total length before the body, the total length
+	// repeated after the body.
+	blockOverhead = 12
+	// Interface ID, timestamp, captured and original packet length.
+	enhancedPacketHeaderLen = 20
+)
+
+// Blocks are written in little-endian, readers detect the byte order
+// from the byte-order magic.
+var byteOrder = binary.LittleEndian
+
+// pcapngWriter writes packets captured on a single interface
+// in the pcapng format.
+type pcapngWriter struct {
+	w    io.Writer
+	size uint64
+}
+
+// newPcapngWriter writes the section header and the description
+// of the interface.
+func newPcapngWriter(w io.Writer, ifName string, linkType uint16,
+	snapLen uint32, filter string) (*pcapngWriter, error) {
+	pw := &pcapngWriter{w: w}
+	var shb []byte
+	shb = appendUint32(shb, byteOrderMagic)
+	shb = appendUint16(shb, 1) // major version
+	shb = appendUint16(shb, 0) // minor version
+	shb = appendUint64(shb, sectionLengthUnspecified)
+	shb = appendOption(shb, optSHBUserAppl, []byte("EVE"))
+	shb = appendOption(shb, optEndOfOpt, nil)
+	if err := pw.writeBlock(blockSectionHeader, shb); err != nil {
+		return nil, err
+	}
+	var idb []byte
+	idb = appendUint16(idb, linkType)
+	idb = appendUint16(idb, 0) // reserved
+	idb = appendUint32(idb, snapLen)
+	idb = appendOption(idb, optIfName, []byte(ifName))
+	idb = appendOption(idb, optIfTsResol, []byte{tsResolNanoseconds})
+	if filter != "" {
+		idb = appendOption(idb, optIfFilter,
+			append([]byte{ifFilterLibpcapString}, filter...))
+	}
+	idb = appendOption(idb, optEndOfOpt, nil)
+	if err := pw.writeBlock(blockInterfaceDesc, idb); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// packetBlockLen returns the number of bytes written for a packet
+// with the given captured length.
+func packetBlockLen(capLen int) uint64 {
+	return uint64(blockOverhead + enhancedPacketHeaderLen + pad4(capLen))
+}
+
+// writePacket writes one enhanced packet block.
+func (pw *pcapngWriter) writePacket(ts time.Time, data []byte, origLen int) error {
+	tsNano := uint64(ts.UnixNano())
+	var epb []byte
+	epb = appendUint32(epb, 0) // interface ID
+	epb = appendUint32(epb, uint32(tsNano>>32))
+	epb = appendUint32(epb, uint32(tsNano))
+	epb = appendUint32(epb, uint32(len(data)))
+	epb = appendUint32(epb, uint32(origLen))
+	epb = append(epb, data...)
+	epb = append(epb, make([]byte, pad4(len(data))-len(data))...)
+	return pw.writeBlock(blockEnhancedPacket, epb)
+}
+
+// writeBlock writes block type, total length, body and total length again.
+func (pw *pcapngWriter) writeBlock(blockType uint32, body []byte) error {
+	totalLen := uint32(blockOverhead + len(body))
+	block := make([]byte, 0, totalLen)
+	block = appendUint32(block, blockType)
+	block = appendUint32(block, totalLen)
+	block = append(block, body...)
+	block = appendUint32(block, totalLen)
+	n, err := pw.w.Write(block)
+	pw.size += uint64(n)
+	return err
+}
+
+func appendOption(b []byte, code uint16, value []byte) []byte {
+	b = appendUint16(b, code)
+	b = appendUint16(b, uint16(len(value)))
+	b = append(b, value...)
+	return append(b, make([]byte, pad4(len(value))-len(value))...)
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	var buf [2]byte
+	byteOrder.PutUint16(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	byteOrder.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func appendUint64(b []byte, v uint64) []byte {
+	var buf [8]byte
+	byteOrder.PutUint64(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package packetcapture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"golang.org/x/net/bpf"
+)
+
+type testBlock struct {
+	blockType uint32
+	body      []byte
+}
+
+func parseBlocks(t *testing.T, data []byte) (blocks []testBlock) {
+	for len(data) > 0 {
+		if len(data) < blockOverhead {
+			t.Fatalf("truncated block header")
+		}
+		blockType := binary.LittleEndian.Uint32(data[0:4])
+		totalLen := int(binary.LittleEndian.Uint32(data[4:8]))
+		if totalLen%4 != 0 || totalLen < blockOverhead || totalLen > len(data) {
+			t.Fatalf("invalid length %d of block 0x%x", totalLen, blockType)
+		}
+		if trailer := binary.LittleEndian.Uint32(data[totalLen-4:]); trailer != uint32(totalLen) {
+			t.Fatalf("block length %d does not match trailer %d", totalLen, trailer)
+		}
+		blocks = append(blocks, testBlock{
+			blockType: blockType,
+			body:      data[8 : totalLen-4],
+		})
+		data = data[totalLen:]
+	}
+	return blocks
+}
+
+func TestPcapngWriter(t *testing.T) {
+	var out bytes.Buffer
+	w, err := newPcapngWriter(&out, "bn1", linkTypeEthernet, 1500, "udp and port 53")
+	if err != nil {
+		t.Fatalf("newPcapngWriter failed: %v", err)
+	}
+	headerSize := w.size
+	ts := time.Unix(1650000000, 123456789)
+	packet := bytes.Repeat([]byte{0xab}, 61)
+	if err = w.writePacket(ts, packet, 1000); err != nil {
+		t.Fatalf("writePacket failed: %v", err)
+	}
+	if w.size != uint64(out.Len()) {
+		t.Errorf("writer size %d does not match output size %d", w.size, out.Len())
+	}
+	if w.size-headerSize != packetBlockLen(len(packet)) {
+		t.Errorf("expected packet block length %d, got %d",
+			packetBlockLen(len(packet)), w.size-headerSize)
+	}
+
+	blocks := parseBlocks(t, out.Bytes())
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 blocks, got %d", len(blocks))
+	}
+	if blocks[0].blockType != blockSectionHeader ||
+		binary.LittleEndian.Uint32(blocks[0].body[0:4]) != byteOrderMagic {
+		t.Errorf("invalid section header block")
+	}
+	idb := blocks[1]
+	if idb.blockType != blockInterfaceDesc {
+		t.Fatalf("expected interface description block, got 0x%x", idb.blockType)
+	}
+	if linkType := binary.LittleEndian.Uint16(idb.body[0:2]); linkType != linkTypeEthernet {
+		t.Errorf("expected Ethernet link type, got %d", linkType)
+	}
+	if snapLen := binary.LittleEndian.Uint32(idb.body[4:8]); snapLen != 1500 {
+		t.Errorf("expected snap length 1500, got %d", snapLen)
+	}
+	// The first option is the interface name.
+	if code := binary.LittleEndian.Uint16(idb.body[8:10]); code != optIfName ||
+		string(idb.body[12:15]) != "bn1" {
+		t.Errorf("expected interface name option, got code %d", code)
+	}
+	epb := blocks[2]
+	if epb.blockType != blockEnhancedPacket {
+		t.Fatalf("expected enhanced packet block, got 0x%x", epb.blockType)
+	}
+	tsNano := uint64(binary.LittleEndian.Uint32(epb.body[4:8]))<<32 |
+		uint64(binary.LittleEndian.Uint32(epb.body[8:12]))
+	if tsNano != uint64(ts.UnixNano()) {
+		t.Errorf("expected timestamp %d, got %d", ts.UnixNano(), tsNano)
+	}
+	capLen := binary.LittleEndian.Uint32(epb.body[12:16])
+	origLen := binary.LittleEndian.Uint32(epb.body[16:20])
+	if capLen != uint32(len(packet)) || origLen != 1000 {
+		t.Errorf("unexpected captured (%d) or original (%d) length", capLen, origLen)
+	}
+	if !bytes.Equal(epb.body[20:20+capLen], packet) {
+		t.Errorf("packet data does not match")
+	}
+}
+
+func TestCompileFilter(t *testing.T) {
+	rawFilter, err := compileFilter("udp and port 53")
+	if err != nil {
+		t.Fatalf("compileFilter failed: %v", err)
+	}
+	var instructions []bpf.Instruction
+	for _, raw := range rawFilter {
+		instructions = append(instructions, raw.Disassemble())
+	}
+	vm, err := bpf.NewVM(instructions)
+	if err != nil {
+		t.Fatalf("invalid filter program: %v", err)
+	}
+	// Ethernet + IPv4 + UDP header of a DNS request.
+	frame := make([]byte, 14+20+8)
+	binary.BigEndian.PutUint16(frame[12:14], 0x0800)
+	frame[14] = 0x45
+	frame[14+9] = 17
+	binary.BigEndian.PutUint16(frame[34:36], 40000)
+	binary.BigEndian.PutUint16(frame[36:38], 53)
+	if n, err := vm.Run(frame); err != nil || n == 0 {
+		t.Errorf("expected DNS request to match the filter (n=%d, err=%v)", n, err)
+	}
+	binary.BigEndian.PutUint16(frame[36:38], 443)
+	if n, err := vm.Run(frame); err != nil || n != 0 {
+		t.Errorf("expected HTTPS packet not to match the filter (n=%d, err=%v)", n, err)
+	}
+}
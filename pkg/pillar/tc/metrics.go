@@ -0,0 +1,93 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tc
+
+import (
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+// FetchClassMetrics returns statistics of leaf HTB classes shaping the traffic
+// of the given VIF. Returns nil if the VIF traffic is not shaped.
+func FetchClassMetrics(log *base.LogObject,
+	vifName string) ([]types.TrafficClassMetric, error) {
+	var metrics []types.TrafficClassMetric
+	vif, err := netlink.LinkByName(vifName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get link for VIF %s: %w", vifName, err)
+	}
+	vifMetrics, err := classMetrics(vif, true)
+	if err != nil {
+		return nil, err
+	}
+	metrics = append(metrics, vifMetrics...)
+	ifb, err := netlink.LinkByName(IfbName(vifName))
+	if err != nil {
+		// Traffic sent by the application is not shaped.
+		return metrics, nil
+	}
+	ifbMetrics, err := classMetrics(ifb, false)
+	if err != nil {
+		return nil, err
+	}
+	return append(metrics, ifbMetrics...), nil
+}
+
+func classMetrics(link netlink.Link,
+	ingress bool) (metrics []types.TrafficClassMetric, err error) {
+	classes, err := netlink.ClassList(link, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list classes of %s: %w",
+			link.Attrs().Name, err)
+	}
+	if len(classes) == 0 {
+		return nil, nil
+	}
+	qdiscHandle := netlink.MakeHandle(htbMajor, 0)
+	filters, err := netlink.FilterList(link, qdiscHandle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list filters of %s: %w",
+			link.Attrs().Name, err)
+	}
+	// Leaf class -> connection mark
+	marks := make(map[uint32]uint32)
+	for _, filter := range filters {
+		if fw, isFw := filter.(*netlink.Fw); isFw {
+			marks[fw.ClassId] = fw.Attrs().Handle
+		}
+	}
+	rootHandle := netlink.MakeHandle(htbMajor, rootMinor)
+	for _, class := range classes {
+		htbClass, isHTB := class.(*netlink.HtbClass)
+		if !isHTB || htbClass.Handle == rootHandle {
+			continue
+		}
+		metric := types.TrafficClassMetric{
+			Ingress: ingress,
+			// Rate and ceil are in bytes per second.
+			RateKbps: uint32(htbClass.Rate * 8 / 1000),
+			CeilKbps: uint32(htbClass.Ceil * 8 / 1000),
+		}
+		if mark, hasMark := marks[htbClass.Handle]; hasMark {
+			_, aceID, _ := iptables.ParseConnmark(mark)
+			metric.AceID = int32(aceID)
+		}
+		if stats := htbClass.Statistics; stats != nil {
+			if stats.Basic != nil {
+				metric.Bytes = stats.Basic.Bytes
+				metric.Packets = uint64(stats.Basic.Packets)
+			}
+			if stats.Queue != nil {
+				metric.Drops = uint64(stats.Queue.Drops)
+				metric.Overlimits = uint64(stats.Queue.Overlimits)
+			}
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
@@ -0,0 +1,377 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tc uses Linux traffic control to shape bandwidth of application VIFs.
+//
+// Traffic towards the application leaves the host through the VIF and it is shaped
+// by the HTB qdisc installed as the root qdisc of the VIF. Traffic sent by
+// the application enters the host through the VIF and it is redirected from the ingress
+// qdisc of the VIF into an IFB device, where it is shaped by another HTB qdisc.
+// Both HTB qdiscs have the same structure:
+//
+//	1: htb (default 1:2)
+//	└── 1:1   (rate = ceil = bitrate limit of the direction)
+//	    ├── 1:2   traffic not matched by any shaping ACE
+//	    ├── 1:10  first shaping ACE
+//	    ├── 1:11  second shaping ACE
+//	    ...
+//
+// Every leaf class has fq_codel qdisc attached. Packets are assigned to classes
+// by fw filters, matching connection marks of ACEs (see iptables.GetConnmark),
+// which are restored into packet marks using the connmark action.
+package tc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Bitrate used as the limit of a direction which is not limited.
+	unlimitedKbps = 10 * 1000 * 1000
+	// HTB requires non-zero rate for every class.
+	minRateKbps = 8
+	// Minor numbers of HTB classes.
+	rootMinor     = 1
+	defaultMinor  = 2
+	firstACEMinor = 10
+	// Major number of the HTB qdisc.
+	htbMajor = 1
+	// Filter priorities.
+	connmarkPrio = 1
+	fwPrio       = 2
+	// IfbPrefix : prefix used for names of IFB devices shaping traffic sent by apps.
+	IfbPrefix = "ifb-"
+)
+
+// Class : traffic class of a single shaping ACE.
+type Class struct {
+	AceID int32
+	// Mark : connection mark of flows matched by the ACE.
+	Mark uint32
+	// RateKbps : guaranteed bitrate in kbit/s, zero if none.
+	RateKbps uint32
+	// CeilKbps : maximum bitrate in kbit/s, zero if limited only by the direction limit.
+	CeilKbps uint32
+}
+
+// Direction : shaping of one direction of the VIF traffic.
+type Direction struct {
+	// LimitKbps : bitrate limit of all the traffic in this direction (kbit/s).
+	// Zero if not limited.
+	LimitKbps uint32
+	// Classes of shaping ACEs.
+	Classes []Class
+}
+
+// IsShaped returns true if the traffic is limited or divided into classes.
+func (d Direction) IsShaped() bool {
+	return d.LimitKbps != 0 || len(d.Classes) > 0
+}
+
+// Equal compares two shaping configurations.
+func (d Direction) Equal(d2 Direction) bool {
+	if d.LimitKbps != d2.LimitKbps || len(d.Classes) != len(d2.Classes) {
+		return false
+	}
+	for i := range d.Classes {
+		if d.Classes[i] != d2.Classes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// String describes the shaping configuration.
+func (d Direction) String() string {
+	return fmt.Sprintf("{limit: %d kbit/s, classes: %+v}", d.LimitKbps, d.Classes)
+}
+
+// IfbName returns the name of the IFB device used to shape the traffic
+// sent by the application through the given VIF.
+func IfbName(vifName string) string {
+	return IfbPrefix + vifName
+}
+
+// htbClass : parameters of a leaf HTB class.
+type htbClass struct {
+	minor    uint16
+	mark     uint32 // zero for the default class
+	rateKbps uint32
+	ceilKbps uint32
+}
+
+// htbClasses computes the bitrate of the root class and parameters of leaf classes.
+// Guaranteed bitrates are capped such that they fit into the root class,
+// the default class is guaranteed the remaining bitrate.
+func htbClasses(d Direction) (rootKbps uint32, classes []htbClass) {
+	rootKbps = d.LimitKbps
+	if rootKbps == 0 {
+		rootKbps = unlimitedKbps
+	}
+	available := rootKbps
+	for i, class := range d.Classes {
+		rate := class.RateKbps
+		if rate > available {
+			rate = available
+		}
+		available -= rate
+		if rate < minRateKbps {
+			rate = minRateKbps
+		}
+		ceil := class.CeilKbps
+		if ceil == 0 || ceil > rootKbps {
+			ceil = rootKbps
+		}
+		if ceil < rate {
+			ceil = rate
+		}
+		classes = append(classes, htbClass{
+			minor:    uint16(firstACEMinor + i),
+			mark:     class.Mark,
+			rateKbps: rate,
+			ceilKbps: ceil,
+		})
+	}
+	defaultRate := available
+	if defaultRate < minRateKbps {
+		defaultRate = minRateKbps
+	}
+	classes = append(classes, htbClass{
+		minor:    defaultMinor,
+		rateKbps: defaultRate,
+		ceilKbps: rootKbps,
+	})
+	return rootKbps, classes
+}
+
+// ConfigureShaping (re)configures shaping of both directions of the VIF traffic.
+// Ingress is the traffic towards the application, egress is the traffic sent by it.
+// Direction which is not shaped is cleared of any previously applied shaping.
+func ConfigureShaping(log *base.LogObject, vifName string,
+	ingress, egress Direction) error {
+	vif, err := netlink.LinkByName(vifName)
+	if err != nil {
+		return fmt.Errorf("failed to get link for VIF %s: %w", vifName, err)
+	}
+	// Traffic towards the application.
+	delRootQdisc(vif)
+	if ingress.IsShaped() {
+		if err = addHTB(vif, ingress, true); err != nil {
+			return fmt.Errorf("failed to shape traffic towards VIF %s: %w",
+				vifName, err)
+		}
+	}
+	// Traffic sent by the application.
+	delIngressQdisc(vif)
+	if !egress.IsShaped() {
+		delIfb(log, vifName)
+		return nil
+	}
+	ifb, err := getOrAddIfb(vifName)
+	if err != nil {
+		return err
+	}
+	delRootQdisc(ifb)
+	if err = addHTB(ifb, egress, false); err != nil {
+		return fmt.Errorf("failed to shape traffic from VIF %s: %w",
+			vifName, err)
+	}
+	if err = redirectToIfb(vif, ifb); err != nil {
+		return fmt.Errorf("failed to redirect traffic from VIF %s into %s: %w",
+			vifName, ifb.Attrs().Name, err)
+	}
+	return nil
+}
+
+// RemoveShaping removes all shaping applied for the VIF.
+// It is not an error if the VIF no longer exists.
+func RemoveShaping(log *base.LogObject, vifName string) error {
+	vif, err := netlink.LinkByName(vifName)
+	if err == nil {
+		delRootQdisc(vif)
+		delIngressQdisc(vif)
+	} else {
+		var notFound netlink.LinkNotFoundError
+		if !errors.As(err, &notFound) {
+			return fmt.Errorf("failed to get link for VIF %s: %w", vifName, err)
+		}
+	}
+	delIfb(log, vifName)
+	return nil
+}
+
+func delRootQdisc(link netlink.Link) {
+	// Returns error if there is no root qdisc other than the default one.
+	_ = netlink.QdiscDel(&netlink.GenericQdisc{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		QdiscType: "htb",
+	})
+}
+
+func delIngressQdisc(link netlink.Link) {
+	// Returns error if there is no ingress qdisc.
+	_ = netlink.QdiscDel(&netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	})
+}
+
+func getOrAddIfb(vifName string) (netlink.Link, error) {
+	ifbName := IfbName(vifName)
+	ifb, err := netlink.LinkByName(ifbName)
+	if err != nil {
+		attrs := netlink.NewLinkAttrs()
+		attrs.Name = ifbName
+		if err = netlink.LinkAdd(&netlink.Ifb{LinkAttrs: attrs}); err != nil {
+			return nil, fmt.Errorf("failed to add IFB %s: %w", ifbName, err)
+		}
+		if ifb, err = netlink.LinkByName(ifbName); err != nil {
+			return nil, fmt.Errorf("failed to get link for IFB %s: %w", ifbName, err)
+		}
+	}
+	if err = netlink.LinkSetUp(ifb); err != nil {
+		return nil, fmt.Errorf("failed to set IFB %s UP: %w", ifbName, err)
+	}
+	return ifb, nil
+}
+
+func delIfb(log *base.LogObject, vifName string) {
+	ifb, err := netlink.LinkByName(IfbName(vifName))
+	if err != nil {
+		return
+	}
+	if err = netlink.LinkDel(ifb); err != nil {
+		log.Errorf("delIfb: failed to delete IFB %s: %v", ifb.Attrs().Name, err)
+	}
+}
+
+func addHTB(link netlink.Link, d Direction, restoreMark bool) error {
+	linkIndex := link.Attrs().Index
+	qdiscHandle := netlink.MakeHandle(htbMajor, 0)
+	htb := netlink.NewHtb(netlink.QdiscAttrs{
+		LinkIndex: linkIndex,
+		Handle:    qdiscHandle,
+		Parent:    netlink.HANDLE_ROOT,
+	})
+	htb.Defcls = defaultMinor
+	if err := netlink.QdiscAdd(htb); err != nil {
+		return fmt.Errorf("failed to add HTB qdisc: %w", err)
+	}
+	rootKbps, classes := htbClasses(d)
+	rootHandle := netlink.MakeHandle(htbMajor, rootMinor)
+	rootClass := netlink.NewHtbClass(netlink.ClassAttrs{
+		LinkIndex: linkIndex,
+		Parent:    qdiscHandle,
+		Handle:    rootHandle,
+	}, netlink.HtbClassAttrs{
+		Rate: uint64(rootKbps) * 1000,
+		Ceil: uint64(rootKbps) * 1000,
+	})
+	if err := netlink.ClassAdd(rootClass); err != nil {
+		return fmt.Errorf("failed to add root HTB class: %w", err)
+	}
+	for _, class := range classes {
+		classHandle := netlink.MakeHandle(htbMajor, class.minor)
+		htbClass := netlink.NewHtbClass(netlink.ClassAttrs{
+			LinkIndex: linkIndex,
+			Parent:    rootHandle,
+			Handle:    classHandle,
+		}, netlink.HtbClassAttrs{
+			Rate: uint64(class.rateKbps) * 1000,
+			Ceil: uint64(class.ceilKbps) * 1000,
+		})
+		if err := netlink.ClassAdd(htbClass); err != nil {
+			return fmt.Errorf("failed to add HTB class %s: %w",
+				netlink.HandleStr(classHandle), err)
+		}
+		fqCodel := netlink.NewFqCodel(netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Parent:    classHandle,
+			Handle:    netlink.MakeHandle(class.minor, 0),
+		})
+		if err := netlink.QdiscAdd(fqCodel); err != nil {
+			return fmt.Errorf("failed to add fq_codel qdisc to class %s: %w",
+				netlink.HandleStr(classHandle), err)
+		}
+		if class.mark == 0 {
+			// Default class.
+			continue
+		}
+		fw := &netlink.Fw{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: linkIndex,
+				Parent:    qdiscHandle,
+				Handle:    class.mark,
+				Priority:  fwPrio,
+				Protocol:  unix.ETH_P_ALL,
+			},
+			ClassId: classHandle,
+		}
+		if err := netlink.FilterAdd(fw); err != nil {
+			return fmt.Errorf("failed to add fw filter for mark %#x: %w",
+				class.mark, err)
+		}
+	}
+	if restoreMark {
+		// Packet mark is not necessarily restored from the connection mark
+		// by iptables for bridged traffic.
+		connmark := netlink.NewConnmarkAction()
+		// Continue with the next filter.
+		connmark.Action = netlink.TC_ACT_UNSPEC
+		matchAll := &netlink.MatchAll{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: linkIndex,
+				Parent:    qdiscHandle,
+				Priority:  connmarkPrio,
+				Protocol:  unix.ETH_P_ALL,
+			},
+			Actions: []netlink.Action{connmark},
+		}
+		if err := netlink.FilterAdd(matchAll); err != nil {
+			return fmt.Errorf("failed to add connmark filter: %w", err)
+		}
+	}
+	return nil
+}
+
+func redirectToIfb(vif, ifb netlink.Link) error {
+	linkIndex := vif.Attrs().Index
+	ingressHandle := netlink.MakeHandle(0xffff, 0)
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    ingressHandle,
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscAdd(ingress); err != nil {
+		return fmt.Errorf("failed to add ingress qdisc: %w", err)
+	}
+	// Ingress qdisc runs before netfilter, packet mark is not yet set.
+	connmark := netlink.NewConnmarkAction()
+	redirect := netlink.NewMirredAction(ifb.Attrs().Index)
+	matchAll := &netlink.MatchAll{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: linkIndex,
+			Parent:    ingressHandle,
+			Priority:  connmarkPrio,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		Actions: []netlink.Action{connmark, redirect},
+	}
+	if err := netlink.FilterAdd(matchAll); err != nil {
+		return fmt.Errorf("failed to add redirect filter: %w", err)
+	}
+	return nil
+}
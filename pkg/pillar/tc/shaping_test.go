@@ -0,0 +1,85 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tc
+
+import (
+	"testing"
+)
+
+func TestHtbClasses(t *testing.T) {
+	testMatrix := map[string]struct {
+		direction  Direction
+		expRoot    uint32
+		expClasses []htbClass
+	}{
+		"limit only": {
+			direction: Direction{LimitKbps: 1000},
+			expRoot:   1000,
+			expClasses: []htbClass{
+				{minor: defaultMinor, rateKbps: 1000, ceilKbps: 1000},
+			},
+		},
+		"guaranteed rates within limit": {
+			direction: Direction{
+				LimitKbps: 1000,
+				Classes: []Class{
+					{AceID: 1, Mark: 0x1000001, RateKbps: 200},
+					{AceID: 2, Mark: 0x1000002, RateKbps: 300, CeilKbps: 500},
+				},
+			},
+			expRoot: 1000,
+			expClasses: []htbClass{
+				{minor: 10, mark: 0x1000001, rateKbps: 200, ceilKbps: 1000},
+				{minor: 11, mark: 0x1000002, rateKbps: 300, ceilKbps: 500},
+				{minor: defaultMinor, rateKbps: 500, ceilKbps: 1000},
+			},
+		},
+		"guaranteed rates above limit": {
+			direction: Direction{
+				LimitKbps: 1000,
+				Classes: []Class{
+					{AceID: 1, Mark: 0x1000001, RateKbps: 800},
+					{AceID: 2, Mark: 0x1000002, RateKbps: 800, CeilKbps: 2000},
+				},
+			},
+			expRoot: 1000,
+			expClasses: []htbClass{
+				{minor: 10, mark: 0x1000001, rateKbps: 800, ceilKbps: 1000},
+				{minor: 11, mark: 0x1000002, rateKbps: 200, ceilKbps: 1000},
+				{minor: defaultMinor, rateKbps: minRateKbps, ceilKbps: 1000},
+			},
+		},
+		"ceil without limit": {
+			direction: Direction{
+				Classes: []Class{
+					{AceID: 3, Mark: 0x1000003, CeilKbps: 2000},
+				},
+			},
+			expRoot: unlimitedKbps,
+			expClasses: []htbClass{
+				{minor: 10, mark: 0x1000003, rateKbps: minRateKbps, ceilKbps: 2000},
+				{minor: defaultMinor, rateKbps: unlimitedKbps, ceilKbps: unlimitedKbps},
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		root, classes := htbClasses(test.direction)
+		if root != test.expRoot {
+			t.Errorf("TestHtbClasses(%s): expected root %d kbit/s, got %d kbit/s",
+				testname, test.expRoot, root)
+		}
+		if len(classes) != len(test.expClasses) {
+			t.Errorf("TestHtbClasses(%s): expected classes %+v, got %+v",
+				testname, test.expClasses, classes)
+			continue
+		}
+		for i := range classes {
+			if classes[i] != test.expClasses[i] {
+				t.Errorf("TestHtbClasses(%s): expected class %+v, got %+v",
+					testname, test.expClasses[i], classes[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+// AppRestartPolicy : determines if an application instance is restarted
+// automatically when it stops running or fails its liveness probe.
+// Values match the AppRestartPolicy enum in the API.
+type AppRestartPolicy uint8
+
+const (
+	// AppRestartPolicyUnspecified : legacy behavior, halted domain is booted
+	// again after domainBootRetryTime, crashed container requires user action.
+	AppRestartPolicyUnspecified AppRestartPolicy = iota
+	// AppRestartPolicyAlways : restart whenever the application stops running.
+	AppRestartPolicyAlways
+	// AppRestartPolicyOnFailure : restart only after a crash, non-zero exit
+	// status or failed liveness probe.
+	AppRestartPolicyOnFailure
+	// AppRestartPolicyNever : never restart, only report failures.
+	AppRestartPolicyNever
+)
+
+// String returns the string name
+func (policy AppRestartPolicy) String() string {
+	switch policy {
+	case AppRestartPolicyUnspecified:
+		return "Unspecified"
+	case AppRestartPolicyAlways:
+		return "Always"
+	case AppRestartPolicyOnFailure:
+		return "OnFailure"
+	case AppRestartPolicyNever:
+		return "Never"
+	default:
+		return fmt.Sprintf("Unknown AppRestartPolicy %d", policy)
+	}
+}
+
+// AppProbeType : how the application is probed.
+type AppProbeType uint8
+
+const (
+	// AppProbeNone : probe is not configured.
+	AppProbeNone AppProbeType = iota
+	// AppProbeHTTPGet : HTTP GET request, 2xx and 3xx status codes are success.
+	AppProbeHTTPGet
+	// AppProbeTCPSocket : TCP connection is established.
+	AppProbeTCPSocket
+	// AppProbeExec : command executed inside the container exits with zero.
+	AppProbeExec
+)
+
+// String returns the string name
+func (probeType AppProbeType) String() string {
+	switch probeType {
+	case AppProbeNone:
+		return "None"
+	case AppProbeHTTPGet:
+		return "HTTPGet"
+	case AppProbeTCPSocket:
+		return "TCPSocket"
+	case AppProbeExec:
+		return "Exec"
+	default:
+		return fmt.Sprintf("Unknown AppProbeType %d", probeType)
+	}
+}
+
+// AppProbe : periodic check of an application instance.
+type AppProbe struct {
+	Type AppProbeType
+	// Port and Path are used by HTTPGet and TCPSocket probes.
+	Port uint16
+	Path string
+	// Command is used by the Exec probe.
+	Command []string
+	// InterfaceIndex : index into UnderlayNetworkList of the interface
+	// used to reach the application.
+	InterfaceIndex int
+	// TargetIP : IP address of the interface selected by InterfaceIndex.
+	// Filled in by zedmanager for domainmgr, nil until the address is known.
+	TargetIP         net.IP
+	InitialDelay     time.Duration
+	Period           time.Duration
+	Timeout          time.Duration
+	FailureThreshold uint32
+	SuccessThreshold uint32
+}
+
+// IsConfigured returns true if the probe should be run.
+func (probe AppProbe) IsConfigured() bool {
+	return probe.Type != AppProbeNone
+}
+
+// AppHealthConfig : health probes and restart policy of an application
+// instance.
+type AppHealthConfig struct {
+	Liveness      AppProbe
+	Readiness     AppProbe
+	RestartPolicy AppRestartPolicy
+	// BackoffInitial : delay before the first automatic restart, doubled
+	// with every consecutive restart up to BackoffMax.
+	BackoffInitial time.Duration
+	BackoffMax     time.Duration
+}
+
+// IsConfigured returns true if any probe or a restart policy is set.
+func (config AppHealthConfig) IsConfigured() bool {
+	return config.Liveness.IsConfigured() || config.Readiness.IsConfigured() ||
+		config.RestartPolicy != AppRestartPolicyUnspecified
+}
+
+// AppProbeResult : outcome of a probe after applying the thresholds.
+type AppProbeResult uint8
+
+const (
+	// AppProbeResultUnknown : probe is not configured or did not run yet.
+	AppProbeResultUnknown AppProbeResult = iota
+	// AppProbeResultSuccess : SuccessThreshold was reached.
+	AppProbeResultSuccess
+	// AppProbeResultFailure : FailureThreshold was reached.
+	AppProbeResultFailure
+)
+
+// String returns the string name
+func (result AppProbeResult) String() string {
+	switch result {
+	case AppProbeResultUnknown:
+		return "Unknown"
+	case AppProbeResultSuccess:
+		return "Success"
+	case AppProbeResultFailure:
+		return "Failure"
+	default:
+		return fmt.Sprintf("Unknown AppProbeResult %d", result)
+	}
+}
+
+// AppProbeStatus : results of a probe.
+type AppProbeStatus struct {
+	Result               AppProbeResult
+	ConsecutiveFailures  uint32
+	ConsecutiveSuccesses uint32
+	LastProbeTime        time.Time
+	// LastError : error of the last failed probe.
+	LastError string
+}
+
+// AppHealthStatus : results of the health probes and automatic restarts
+// of an application instance.
+type AppHealthStatus struct {
+	Liveness  AppProbeStatus
+	Readiness AppProbeStatus
+	// Ready : readiness probe succeeded or the application is running
+	// and readiness probe is not configured.
+	Ready bool
+	// RestartCount : number of automatic restarts.
+	RestartCount uint32
+	// CrashLoopCount : number of restarts since the application last ran
+	// without failures, determines the back-off delay.
+	CrashLoopCount    uint32
+	LastRestartReason string
+	LastRestartTime   time.Time
+	// NextRestartTime : set while an automatic restart is scheduled.
+	NextRestartTime time.Time
+}
@@ -44,6 +44,9 @@ type BlobStatus struct {
 	CurrentSize            int64 // current total downloaded size as reported by the downloader
 	// Progress percentage downloaded 0-100, defined by CurrentSize/TotalSize
 	Progress uint
+	// VerifySignature the signatures of the root blob of an image from
+	// an OCI registry are downloaded and verified
+	VerifySignature bool
 	// ErrorAndTimeWithSource provide common error handling capabilities
 	ErrorAndTimeWithSource
 }
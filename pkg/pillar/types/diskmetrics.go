@@ -190,4 +190,15 @@ type ImgInfo struct {
 	Format      string `json:"format"`
 	ActualSize  uint64 `json:"actual-size"`
 	DirtyFlag   bool   `json:"dirty-flag"`
+	// Snapshots is the list of internal snapshots of qcow2 image
+	Snapshots []ImgSnapshotInfo `json:"snapshots,omitempty"`
+}
+
+// ImgSnapshotInfo is the internal snapshot as reported by qemu-img info
+type ImgSnapshotInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	VMStateSize uint64 `json:"vm-state-size"`
+	DateSec     int64  `json:"date-sec"`
+	DateNsec    int64  `json:"date-nsec"`
 }
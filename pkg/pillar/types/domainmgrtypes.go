@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -43,6 +44,14 @@ type DomainConfig struct {
 
 	// MetaDataType for select type of metadata service for app
 	MetaDataType MetaDataType
+
+	// Health probes and restart policy
+	Health AppHealthConfig
+
+	// PurgeStartedAt is set when the application instance is purged.
+	// State kept for the domain across activations, such as the virtual
+	// TPM, which is older than that is discarded.
+	PurgeStartedAt time.Time
 }
 
 // MetaDataType of metadata service for app
@@ -215,6 +224,7 @@ type VmConfig struct {
 	VncDisplay         uint32
 	VncPasswd          string
 	DisableLogs        bool
+	VTPM               VTPMType
 }
 
 type VmMode uint8
@@ -228,6 +238,32 @@ const (
 	LEGACY
 )
 
+// VTPMType is the interface of the virtual TPM device of a VM
+type VTPMType uint8
+
+const (
+	// VTPMNone - no virtual TPM
+	VTPMNone VTPMType = iota
+	// VTPMCRB - TPM 2.0 with the Command Response Buffer interface
+	VTPMCRB
+	// VTPMTIS - TPM 2.0 with the TPM Interface Specification interface
+	VTPMTIS
+)
+
+// String returns the name of the qemu device model family
+func (t VTPMType) String() string {
+	switch t {
+	case VTPMNone:
+		return "none"
+	case VTPMCRB:
+		return "tpm-crb"
+	case VTPMTIS:
+		return "tpm-tis"
+	default:
+		return fmt.Sprintf("Unknown VTPMType %d", t)
+	}
+}
+
 // Task represents any runnable entity on EVE
 type Task interface {
 	Setup(DomainStatus, DomainConfig, *AssignableAdapters, *os.File) error
@@ -239,6 +275,73 @@ type Task interface {
 	Cleanup(string) error
 }
 
+// TaskExecutor is implemented by tasks which can run a command inside
+// a running domain, e.g. for an exec health probe.
+type TaskExecutor interface {
+	Exec(domainName string, args []string, timeout time.Duration) error
+}
+
+// GuestAgent is implemented by tasks which can talk to an agent running
+// in the guest OS of a domain, i.e. the QEMU guest agent in KVM VMs.
+type GuestAgent interface {
+	// GuestInfo returns an error if the agent does not respond
+	GuestInfo(domainName string) (GuestInfo, error)
+	// GuestSetTime sets the clock of the guest e.g. after the host
+	// was suspended
+	GuestSetTime(domainName string, t time.Time) error
+	// GuestFsFreeze freezes the file systems of the guest and returns
+	// their number. They stay frozen until GuestFsThaw.
+	GuestFsFreeze(domainName string) (int, error)
+	// GuestFsThaw thaws the file systems frozen by GuestFsFreeze
+	GuestFsThaw(domainName string) (int, error)
+}
+
+// GuestInfo : information about the guest OS reported by its agent
+type GuestInfo struct {
+	AgentConnected bool
+	OSName         string
+	OSVersion      string
+	KernelRelease  string
+	Hostname       string
+	// Addresses of guest interfaces except for loopback and link-local
+	IPAddrs []net.IP
+}
+
+// GuestFsFreezeConfig : request of volumemgr to freeze the file systems
+// of a running VM while it creates a snapshot of a volume of the VM.
+// Domainmgr thaws them once the config is deleted.
+type GuestFsFreezeConfig struct {
+	UUIDandVersion UUIDandVersion
+	DomainName     string
+	// Counter : distinguishes the requests, copied into the status
+	Counter uint32
+}
+
+// Key : same as the key of DomainStatus
+func (config GuestFsFreezeConfig) Key() string {
+	return config.UUIDandVersion.UUID.String()
+}
+
+// GuestFsFreezeStatus : published by domainmgr for GuestFsFreezeConfig
+type GuestFsFreezeStatus struct {
+	UUIDandVersion UUIDandVersion
+	DomainName     string
+	// Counter : of the request which this is the result of
+	Counter uint32
+	// Frozen : the file systems are frozen until the config is deleted
+	Frozen bool
+	// FrozenCount : number of file systems frozen by the guest agent
+	FrozenCount int
+	// Error : why the file systems were not frozen (e.g. no guest agent),
+	// the snapshot is crash-consistent only
+	Error string
+}
+
+// Key : same as the key of DomainStatus
+func (status GuestFsFreezeStatus) Key() string {
+	return status.UUIDandVersion.UUID.String()
+}
+
 type DomainStatus struct {
 	UUIDandVersion     UUIDandVersion
 	DisplayName        string
@@ -267,6 +370,32 @@ type DomainStatus struct {
 	OCIConfigDir   string            // folder holding an OCI Image config for this domain (empty string means no config)
 	EnvVariables   map[string]string // List of environment variables to be set in container
 	VmConfig                         // From DomainConfig
+	Health         AppHealthStatus
+	// Lifecycle metadata from the config of the OCI image (if any)
+	ImageLifecycle ImageLifecycle
+	// Reported by the guest agent of a VM (if any)
+	GuestInfo GuestInfo
+}
+
+// ImageHealthcheck : HEALTHCHECK instruction of a Docker image.
+type ImageHealthcheck struct {
+	// Command to run inside the container, empty if the image does not
+	// define a healthcheck or disables it.
+	Command     []string
+	Interval    time.Duration
+	Timeout     time.Duration
+	StartPeriod time.Duration
+	Retries     int
+}
+
+// ImageLifecycle : container lifecycle metadata from the config of an OCI
+// image which are not part of the OCI runtime spec.
+type ImageLifecycle struct {
+	Healthcheck ImageHealthcheck
+	// StopSignal : signal sent to the container to stop it, e.g. SIGQUIT.
+	StopSignal string
+	// StopTimeout : grace period before the container is killed.
+	StopTimeout time.Duration
 }
 
 func (status DomainStatus) Key() string {
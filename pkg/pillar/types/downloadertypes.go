@@ -22,6 +22,9 @@ type DownloaderConfig struct {
 	Size        uint64 // In bytes
 	FinalObjDir string // final Object Store
 	RefCount    uint
+	// PullSignatures the signatures of the image are pulled from the
+	// OCI registry next to Target
+	PullSignatures bool
 }
 
 func (config DownloaderConfig) Key() string {
@@ -224,3 +227,11 @@ type DatastoreContext struct {
 	Password        string
 	Region          string
 }
+
+// PeerCacheService is the mDNS service type under which EVE devices announce
+// the blobs served from their CAS to other devices on the same LAN
+const PeerCacheService = "_eve-cas._tcp"
+
+// PeerCacheBlobPath is the URL path prefix of the blobs served to other EVE
+// devices, the sha256 of the blob follows it
+const PeerCacheBlobPath = "/blobs/sha256/"
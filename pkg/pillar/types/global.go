@@ -161,6 +161,16 @@ const (
 	VaultReadyCutOffTime GlobalSettingKey = "timer.vault.ready.cutoff"
 	// LogRemainToSendMBytes Max gzip log files remain on device to be sent in Mbytes
 	LogRemainToSendMBytes GlobalSettingKey = "newlog.gzipfiles.ondisk.maxmegabytes"
+	// DeferredOnDiskMaxKBytes Max size of the deferred messages kept on device in Kbytes
+	DeferredOnDiskMaxKBytes GlobalSettingKey = "deferred.ondisk.maxkilobytes"
+	// DeferredOnDiskMaxAge global setting key
+	DeferredOnDiskMaxAge GlobalSettingKey = "timer.deferred.ondisk.maxage"
+	// ZfsScrubInterval global setting key
+	ZfsScrubInterval GlobalSettingKey = "timer.zfs.scrub.interval"
+	// AppStopTimeoutMax global setting key, caps the grace period of containers
+	AppStopTimeoutMax GlobalSettingKey = "timer.app.stop.timeout.max"
+	// MetricsExporterPort global setting key, zero disables the local metrics endpoint
+	MetricsExporterPort GlobalSettingKey = "metrics.exporter.port"
 
 	// ForceFallbackCounter global setting key
 	ForceFallbackCounter = "force.fallback.counter"
@@ -169,6 +179,21 @@ const (
 	// how the EVE microservices will use free and non-free (e.g., WWAN)
 	// ports for image downloads.
 	DownloadMaxPortCost GlobalSettingKey = "network.download.max.cost"
+	// DownloadConcurrency global setting key controls how many ranges of an image
+	// are downloaded in parallel, zero uses the default of the datastore type
+	DownloadConcurrency GlobalSettingKey = "network.download.concurrency"
+	// PeerCachePort global setting key, non-zero port enables serving blobs
+	// to and downloading them from other EVE devices on the same LAN
+	PeerCachePort GlobalSettingKey = "network.peer.cache.port"
+	// FlowlogSampling global setting key, only one of every N application flows
+	// is flow-logged
+	FlowlogSampling GlobalSettingKey = "network.flowlog.sampling"
+	// FlowlogMaxRate global setting key, maximum number of flow records
+	// logged per second
+	FlowlogMaxRate GlobalSettingKey = "network.flowlog.max.rate"
+	// PacketCaptureQuota global setting key, maximum total size in MiB
+	// of packet capture files kept in /persist/pcap
+	PacketCaptureQuota GlobalSettingKey = "network.packetcapture.quota"
 
 	// Bool Items
 	// UsbAccess global setting key
@@ -201,6 +226,21 @@ const (
 	// DefaultRemoteLogLevel global setting key
 	DefaultRemoteLogLevel GlobalSettingKey = "debug.default.remote.loglevel"
 
+	// MetricsExporterInterface global setting key, the port or the network
+	// instance where the local metrics endpoint is listening
+	MetricsExporterInterface GlobalSettingKey = "metrics.exporter.interface"
+
+	// ImageSignaturePolicy global setting key, what happens to the images
+	// from OCI registries with missing or invalid signatures
+	ImageSignaturePolicy GlobalSettingKey = "image.signature.policy"
+	// ImageSignatureTrustAnchors global setting key, PEM bundle of the public
+	// keys and the CA certificates the image signatures are verified with
+	ImageSignatureTrustAnchors GlobalSettingKey = "image.signature.trust.anchors"
+
+	// NetworkACLBackend global setting key, the packet filtering framework
+	// used to implement application ACLs
+	NetworkACLBackend GlobalSettingKey = "network.acl.backend"
+
 	// XXX Temporary flag to disable RFC 3442 classless static route usage
 	DisableDHCPAllOnesNetMask GlobalSettingKey = "debug.disable.dhcp.all-ones.netmask"
 
@@ -756,7 +796,27 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 		uint32(eveMemoryLimitInBytes), 0xFFFFFFFF)
 	// LogRemainToSendMBytes - Default is 2 Gbytes, minimum is 10 Mbytes
 	configItemSpecMap.AddIntItem(LogRemainToSendMBytes, 2048, 10, 0xFFFFFFFF)
+	// DeferredOnDiskMaxKBytes - Default is 20 Mbytes, zero disables persisting
+	configItemSpecMap.AddIntItem(DeferredOnDiskMaxKBytes, 20*1024, 0, 1024*1024)
+	// DeferredOnDiskMaxAge - Default is one week, zero means no limit
+	configItemSpecMap.AddIntItem(DeferredOnDiskMaxAge, 7*24*3600, 0, 0xFFFFFFFF)
+	// ZfsScrubInterval - Default is 30 days, zero disables scheduling of scrub
+	configItemSpecMap.AddIntItem(ZfsScrubInterval, 30*24*3600, 0, 0xFFFFFFFF)
+	// AppStopTimeoutMax - Default is 2 minutes, at most the 10 minutes
+	// domainmgr waits for a domain to halt
+	configItemSpecMap.AddIntItem(AppStopTimeoutMax, 120, 0, 600)
+	// MetricsExporterPort - Default is zero, the local metrics endpoint is disabled
+	configItemSpecMap.AddIntItem(MetricsExporterPort, 0, 0, 65535)
 	configItemSpecMap.AddIntItem(DownloadMaxPortCost, 0, 0, 255)
+	configItemSpecMap.AddIntItem(DownloadConcurrency, 0, 0, 16)
+	// PeerCachePort - Default is zero, the LAN peer cache is disabled
+	configItemSpecMap.AddIntItem(PeerCachePort, 0, 0, 65535)
+	// FlowlogSampling - Default is 1, every flow is logged
+	configItemSpecMap.AddIntItem(FlowlogSampling, 1, 1, 65535)
+	// FlowlogMaxRate - Default is 1000 flows per second, zero means no limit
+	configItemSpecMap.AddIntItem(FlowlogMaxRate, 1000, 0, 0xFFFFFFFF)
+	// PacketCaptureQuota - Default is 100 MiB
+	configItemSpecMap.AddIntItem(PacketCaptureQuota, 100, 1, 4096)
 
 	// Add Bool Items
 	configItemSpecMap.AddBoolItem(UsbAccess, true) // Controller likely default to false
@@ -776,6 +836,12 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddStringItem(SSHAuthorizedKeys, "", blankValidator)
 	configItemSpecMap.AddStringItem(DefaultLogLevel, "info", parseLevel)
 	configItemSpecMap.AddStringItem(DefaultRemoteLogLevel, "info", parseLevel)
+	configItemSpecMap.AddStringItem(MetricsExporterInterface, "", blankValidator)
+	configItemSpecMap.AddStringItem(ImageSignaturePolicy, ImageSignaturePolicyDisabled,
+		parseImageSignaturePolicy)
+	configItemSpecMap.AddStringItem(ImageSignatureTrustAnchors, "", blankValidator)
+	configItemSpecMap.AddStringItem(NetworkACLBackend, ACLBackendIptables,
+		parseACLBackend)
 
 	// Add Agent Settings
 	configItemSpecMap.AddAgentSettingStringItem(LogLevel, "info", parseLevel)
@@ -793,6 +859,25 @@ func parseLevel(level string) error {
 	return err
 }
 
+// parseImageSignaturePolicy - accepts the known image signature policies
+func parseImageSignaturePolicy(policy string) error {
+	switch policy {
+	case ImageSignaturePolicyDisabled, ImageSignaturePolicyAudit,
+		ImageSignaturePolicyEnforce:
+		return nil
+	}
+	return fmt.Errorf("unknown image signature policy %s", policy)
+}
+
+// parseACLBackend - accepts the known ACL backends
+func parseACLBackend(backend string) error {
+	switch backend {
+	case ACLBackendIptables, ACLBackendNftables:
+		return nil
+	}
+	return fmt.Errorf("unknown ACL backend %s", backend)
+}
+
 // blankValidator - A validator that accepts any string
 func blankValidator(s string) error {
 	return nil
@@ -171,7 +171,17 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		Dom0DiskUsageMaxBytes,
 		ForceFallbackCounter,
 		LogRemainToSendMBytes,
+		DeferredOnDiskMaxKBytes,
+		DeferredOnDiskMaxAge,
+		ZfsScrubInterval,
+		AppStopTimeoutMax,
+		MetricsExporterPort,
 		DownloadMaxPortCost,
+		DownloadConcurrency,
+		PeerCachePort,
+		FlowlogSampling,
+		FlowlogMaxRate,
+		PacketCaptureQuota,
 		// Bool Items
 		UsbAccess,
 		VgaAccess,
@@ -187,6 +197,10 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		SSHAuthorizedKeys,
 		DefaultLogLevel,
 		DefaultRemoteLogLevel,
+		MetricsExporterInterface,
+		ImageSignaturePolicy,
+		ImageSignatureTrustAnchors,
+		NetworkACLBackend,
 		DisableDHCPAllOnesNetMask,
 		ProcessCloudInitMultiPart,
 		EdgeViewToken,
@@ -21,6 +21,8 @@ const (
 	SealedDirName = PersistDir + "/vault"
 	// VolumeEncryptedDirName - sealed directory used to store volumes
 	VolumeEncryptedDirName = SealedDirName + "/volumes"
+	// VTPMStateDirName - sealed directory with the state of virtual TPMs of VMs
+	VTPMStateDirName = SealedDirName + "/vtpm"
 	// ClearDirName - directory which is not encrypted
 	ClearDirName = PersistDir + "/clear"
 	// VolumeClearDirName - Not encrypted directory used to store volumes
@@ -87,6 +89,10 @@ const (
 	// ZFSArcMaxSizeFile - file with zfs_arc_max size in bytes
 	ZFSArcMaxSizeFile = "/hostfs/sys/module/zfs/parameters/zfs_arc_max"
 
+	// PacketCaptureDir - directory with packet captures requested
+	// by the local profile server
+	PacketCaptureDir = PersistDir + "/pcap"
+
 	// ContainerdContentDir - path to containerd`s content store
 	ContainerdContentDir = PersistDir + "/containerd/io.containerd.content.v1.content"
 )
@@ -0,0 +1,252 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// DefaultPacketCaptureDuration : used if the request does not specify duration.
+	DefaultPacketCaptureDuration = time.Minute
+	// MaxPacketCaptureDuration : longer captures are shortened.
+	MaxPacketCaptureDuration = time.Hour
+	// DefaultPacketCaptureMaxSize : used if the request does not limit
+	// the file size.
+	DefaultPacketCaptureMaxSize = 10 << 20
+	// DefaultPacketCaptureSnapLen : used if the request does not limit
+	// the number of bytes captured from each packet.
+	DefaultPacketCaptureSnapLen = 65535
+)
+
+// PacketCaptureTargetType : type of the interface to capture packets on.
+type PacketCaptureTargetType uint8
+
+const (
+	// PacketCaptureTargetUnspecified : target is not known.
+	PacketCaptureTargetUnspecified PacketCaptureTargetType = iota
+	// PacketCaptureTargetNetworkInstance : bridge of a network instance.
+	PacketCaptureTargetNetworkInstance
+	// PacketCaptureTargetAppInterface : virtual interface of an application.
+	PacketCaptureTargetAppInterface
+	// PacketCaptureTargetPort : device port.
+	PacketCaptureTargetPort
+)
+
+// String returns human-readable name of the target type.
+func (t PacketCaptureTargetType) String() string {
+	switch t {
+	case PacketCaptureTargetNetworkInstance:
+		return "network-instance"
+	case PacketCaptureTargetAppInterface:
+		return "app-interface"
+	case PacketCaptureTargetPort:
+		return "port"
+	}
+	return "unspecified"
+}
+
+// PacketCaptureConfig : packet capture requested by the local profile server.
+// Published by zedagent, the capture is run by zedrouter.
+type PacketCaptureConfig struct {
+	// ID : identifier chosen by the local profile server.
+	ID         string
+	TargetType PacketCaptureTargetType
+	// NetworkInstance : UUID of the NI to capture on (its bridge).
+	NetworkInstance uuid.UUID
+	// AppInstance and AppIntfIndex select the application VIF to capture on.
+	AppInstance  uuid.UUID
+	AppIntfIndex int
+	// Port : logical label of the device port to capture on.
+	Port string
+	// Filter : capture filter in the tcpdump syntax.
+	Filter   string
+	Duration time.Duration
+	// MaxSize : maximum size of the capture file in bytes.
+	MaxSize uint64
+	SnapLen uint32
+	// Stop is set to end a running capture early.
+	Stop bool
+}
+
+// Key is used for pubsub
+func (config PacketCaptureConfig) Key() string {
+	return config.ID
+}
+
+// Target returns human-readable description of the capture target.
+func (config PacketCaptureConfig) Target() string {
+	switch config.TargetType {
+	case PacketCaptureTargetNetworkInstance:
+		return fmt.Sprintf("%s %s", config.TargetType, config.NetworkInstance)
+	case PacketCaptureTargetAppInterface:
+		return fmt.Sprintf("%s %s/%d", config.TargetType, config.AppInstance,
+			config.AppIntfIndex)
+	case PacketCaptureTargetPort:
+		return fmt.Sprintf("%s %s", config.TargetType, config.Port)
+	}
+	return config.TargetType.String()
+}
+
+// LogCreate :
+func (config PacketCaptureConfig) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.PacketCaptureConfigLogType, "",
+		nilUUID, config.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("target", config.Target()).
+		AddField("filter", config.Filter).
+		Noticef("Packet capture config create")
+}
+
+// LogModify :
+func (config PacketCaptureConfig) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.PacketCaptureConfigLogType, "",
+		nilUUID, config.LogKey())
+
+	oldConfig, ok := old.(PacketCaptureConfig)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of PacketCaptureConfig type")
+	}
+	logObject.CloneAndAddField("diff", cmp.Diff(oldConfig, config)).
+		Noticef("Packet capture config modify")
+}
+
+// LogDelete :
+func (config PacketCaptureConfig) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.PacketCaptureConfigLogType, "",
+		nilUUID, config.LogKey())
+	logObject.Noticef("Packet capture config delete")
+
+	base.DeleteLogObject(logBase, config.LogKey())
+}
+
+// LogKey :
+func (config PacketCaptureConfig) LogKey() string {
+	return string(base.PacketCaptureConfigLogType) + "-" + config.Key()
+}
+
+// PacketCaptureState : progress of a packet capture.
+// Values are the same as those of PacketCaptureState from local_profile.proto.
+type PacketCaptureState uint8
+
+const (
+	// PacketCaptureStateUnspecified : state is not known.
+	PacketCaptureStateUnspecified PacketCaptureState = iota
+	// PacketCaptureStatePending : capture is being started.
+	PacketCaptureStatePending
+	// PacketCaptureStateRunning : packets are being captured.
+	PacketCaptureStateRunning
+	// PacketCaptureStateCompleted : capture has ended, the file is ready.
+	PacketCaptureStateCompleted
+	// PacketCaptureStateUploaded : capture file was posted to the local
+	// profile server. Only zedagent knows about this state.
+	PacketCaptureStateUploaded
+	// PacketCaptureStateFailed : capture could not run or its file was removed.
+	PacketCaptureStateFailed
+)
+
+// String returns human-readable name of the state.
+func (s PacketCaptureState) String() string {
+	switch s {
+	case PacketCaptureStatePending:
+		return "pending"
+	case PacketCaptureStateRunning:
+		return "running"
+	case PacketCaptureStateCompleted:
+		return "completed"
+	case PacketCaptureStateUploaded:
+		return "uploaded"
+	case PacketCaptureStateFailed:
+		return "failed"
+	}
+	return "unspecified"
+}
+
+// PacketCaptureStatus : progress of a packet capture, published by zedrouter.
+type PacketCaptureStatus struct {
+	ID    string
+	State PacketCaptureState
+	// Interface : name of the interface used for the capture.
+	Interface string
+	StartTime time.Time
+	EndTime   time.Time
+	// Packets : number of packets written into the file.
+	Packets uint64
+	// Size : size of the capture file in bytes.
+	Size uint64
+	// Truncated is set if the capture ended because of MaxSize.
+	Truncated bool
+	// Filename : absolute path to the capture file (pcapng).
+	Filename string
+	ErrorAndTime
+}
+
+// Key is used for pubsub
+func (status PacketCaptureStatus) Key() string {
+	return status.ID
+}
+
+// Ended returns true if the capture is not going to change anymore.
+func (status PacketCaptureStatus) Ended() bool {
+	return status.State == PacketCaptureStateCompleted ||
+		status.State == PacketCaptureStateFailed
+}
+
+// LogCreate :
+func (status PacketCaptureStatus) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.PacketCaptureStatusLogType, "",
+		nilUUID, status.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.CloneAndAddField("state", status.State.String()).
+		AddField("interface", status.Interface).
+		Noticef("Packet capture status create")
+}
+
+// LogModify :
+func (status PacketCaptureStatus) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.PacketCaptureStatusLogType, "",
+		nilUUID, status.LogKey())
+
+	oldStatus, ok := old.(PacketCaptureStatus)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of PacketCaptureStatus type")
+	}
+	if oldStatus.State != status.State || oldStatus.Error != status.Error {
+		logObject.CloneAndAddField("state", status.State.String()).
+			AddField("old-state", oldStatus.State.String()).
+			AddField("packets", status.Packets).
+			AddField("size", status.Size).
+			AddField("error", status.Error).
+			Noticef("Packet capture status modify")
+	} else {
+		// Progress is updated every few seconds while capturing.
+		logObject.CloneAndAddField("packets", status.Packets).
+			AddField("size", status.Size).
+			Tracef("Packet capture status modify other change")
+	}
+}
+
+// LogDelete :
+func (status PacketCaptureStatus) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.PacketCaptureStatusLogType, "",
+		nilUUID, status.LogKey())
+	logObject.CloneAndAddField("state", status.State.String()).
+		Noticef("Packet capture status delete")
+
+	base.DeleteLogObject(logBase, status.LogKey())
+}
+
+// LogKey :
+func (status PacketCaptureStatus) LogKey() string {
+	return string(base.PacketCaptureStatusLogType) + "-" + status.Key()
+}
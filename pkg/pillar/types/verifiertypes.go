@@ -6,12 +6,15 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/google/go-cmp/cmp"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 )
 
 // Types for verifying the images.
-// For now we just verify the sha checksum.
+// We verify the sha checksum, and the signatures pulled together with
+// the images from OCI registries.
 // For defense-in-depth we assume that the ZedManager with the help of
 // dom0 has moved the image file to a read-only directory before asking
 // for the file to be verified.
@@ -25,6 +28,9 @@ type VerifyImageConfig struct {
 	Size         int64  //FileLocation size
 	RefCount     uint
 	Expired      bool // Used in delete handshake
+	// VerifySignature is set for the root blobs of the images from OCI
+	// registries, which are downloaded together with their signatures
+	VerifySignature bool
 }
 
 // Key returns the pubsub Key
@@ -99,6 +105,56 @@ type VerifyImageStatus struct {
 	ErrorAndTime
 	RefCount uint
 	Expired  bool // Used in delete handshake
+	// Result of the verification of the signatures. Signers are the
+	// identities of the signers of the valid signatures, Attestations is
+	// the number of attestations signed by a trust anchor.
+	SignatureState ImageSignatureState
+	SignatureError string
+	Signers        []string
+	Attestations   int
+}
+
+// Values of the ImageSignaturePolicy global setting
+const (
+	// ImageSignaturePolicyDisabled : the signatures are neither pulled nor verified
+	ImageSignaturePolicyDisabled = "disabled"
+	// ImageSignaturePolicyAudit : the result of the verification is
+	// reported, the images are used regardless
+	ImageSignaturePolicyAudit = "audit"
+	// ImageSignaturePolicyEnforce : the images without a valid signature
+	// fail the verification
+	ImageSignaturePolicyEnforce = "enforce"
+)
+
+// ImageSignatureState is the result of the verification of the signatures
+// of the image against the trust anchors
+type ImageSignatureState uint8
+
+const (
+	// SignatureNotChecked : verification disabled or not applicable
+	SignatureNotChecked ImageSignatureState = iota
+	// SignatureMissing : the image has no signatures
+	SignatureMissing
+	// SignatureInvalid : no signature is valid for the trust anchors
+	SignatureInvalid
+	// SignatureVerified : signed by a trust anchor
+	SignatureVerified
+)
+
+// String returns the string representation of the ImageSignatureState
+func (state ImageSignatureState) String() string {
+	switch state {
+	case SignatureNotChecked:
+		return "not-checked"
+	case SignatureMissing:
+		return "missing"
+	case SignatureInvalid:
+		return "invalid"
+	case SignatureVerified:
+		return "verified"
+	default:
+		return fmt.Sprintf("Unknown ImageSignatureState %d", state)
+	}
 }
 
 // Key returns the pubsub Key
@@ -118,6 +174,7 @@ func (status VerifyImageStatus) LogCreate(logBase *base.LogObject) {
 		AddField("expired-bool", status.Expired).
 		AddField("size-int64", status.Size).
 		AddField("filelocation", status.FileLocation).
+		AddField("signature-state", status.SignatureState.String()).
 		Noticef("VerifyImage status create")
 }
 
@@ -23,6 +23,7 @@ type VolumeConfig struct {
 	ReadOnly                bool
 	RefCount                uint
 	GenerationCounter       int64
+	LocalGenerationCounter  int64 // included in GenerationCounter
 	Encrypted               bool
 	DisplayName             string
 	HasNoAppReferences      bool
@@ -114,6 +115,7 @@ type VolumeStatus struct {
 	MaxVolSize              uint64
 	ReadOnly                bool
 	GenerationCounter       int64
+	LocalGenerationCounter  int64 // included in GenerationCounter
 	Encrypted               bool
 	DisplayName             string
 	State                   SwState
@@ -417,3 +419,90 @@ func (status VolumeRefStatus) LogDelete(logBase *base.LogObject) {
 func (status VolumeRefStatus) LogKey() string {
 	return string(base.VolumeRefStatusLogType) + "-" + status.Key()
 }
+
+// VolumeSnapshotState is the state of the snapshot of a volume
+type VolumeSnapshotState uint8
+
+const (
+	// VolumeSnapshotStateUnknown : snapshot was not processed yet
+	VolumeSnapshotStateUnknown VolumeSnapshotState = iota
+	// VolumeSnapshotStateWaiting : waiting for the volume to be created
+	VolumeSnapshotStateWaiting
+	// VolumeSnapshotStateCreated : snapshot is created or failed to be created
+	VolumeSnapshotStateCreated
+	// VolumeSnapshotStateRolledBack : volume is rolled back to the snapshot
+	// or rollback failed
+	VolumeSnapshotStateRolledBack
+	// VolumeSnapshotStateDeleting : config is deleted, waiting for the
+	// application using the volume to halt to delete the snapshot
+	VolumeSnapshotStateDeleting
+)
+
+// String returns the string name
+func (state VolumeSnapshotState) String() string {
+	switch state {
+	case VolumeSnapshotStateUnknown:
+		return "UNKNOWN"
+	case VolumeSnapshotStateWaiting:
+		return "WAITING"
+	case VolumeSnapshotStateCreated:
+		return "CREATED"
+	case VolumeSnapshotStateRolledBack:
+		return "ROLLEDBACK"
+	case VolumeSnapshotStateDeleting:
+		return "DELETING"
+	default:
+		return fmt.Sprintf("Unknown state %d", state)
+	}
+}
+
+// VolumeSnapshotConfig requests a snapshot of the volume.
+// The snapshot is created when the config appears and deleted when
+// the config is deleted. Incrementing RollbackCounter rolls the volume
+// back to the content of the snapshot. The rollback, and any operation on
+// a qcow2 volume, waits until the application using the volume is halted.
+type VolumeSnapshotConfig struct {
+	// SnapshotID is unique per volume and used as the name of the snapshot
+	SnapshotID        string
+	VolumeID          uuid.UUID
+	GenerationCounter int64
+	DisplayName       string
+	RollbackCounter   uint32
+}
+
+// Key : VolumeSnapshotConfig unique key
+func (config VolumeSnapshotConfig) Key() string {
+	return fmt.Sprintf("%s@%s", config.VolumeKey(), config.SnapshotID)
+}
+
+// VolumeKey : Unique key of volume referenced in VolumeSnapshotConfig
+func (config VolumeSnapshotConfig) VolumeKey() string {
+	return fmt.Sprintf("%s#%d", config.VolumeID.String(), config.GenerationCounter)
+}
+
+// VolumeSnapshotStatus is published by volumemgr for every VolumeSnapshotConfig.
+// The list of snapshots of a volume is the set of the statuses with the
+// matching VolumeKey.
+type VolumeSnapshotStatus struct {
+	SnapshotID        string
+	VolumeID          uuid.UUID
+	GenerationCounter int64
+	DisplayName       string
+	State             VolumeSnapshotState
+	CreateTime        time.Time
+	// RollbackCounter is the last processed RollbackCounter from config
+	RollbackCounter uint32
+	RollbackTime    time.Time
+
+	ErrorAndTimeWithSource
+}
+
+// Key : VolumeSnapshotStatus unique key
+func (status VolumeSnapshotStatus) Key() string {
+	return fmt.Sprintf("%s@%s", status.VolumeKey(), status.SnapshotID)
+}
+
+// VolumeKey : Unique key of volume referenced in VolumeSnapshotStatus
+func (status VolumeSnapshotStatus) VolumeKey() string {
+	return fmt.Sprintf("%s#%d", status.VolumeID.String(), status.GenerationCounter)
+}
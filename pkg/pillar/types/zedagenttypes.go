@@ -648,3 +648,105 @@ func (acs LocalAppCommands) LookupByAppUUID(appUUID uuid.UUID) *LocalAppCommand
 	}
 	return nil
 }
+
+// DevCommand : device command requested to run by a local server.
+type DevCommand uint8
+
+// Integer values are in-sync with proto enum LocalDevCmd_Command.
+const (
+	DevCommandUnspecified DevCommand = iota
+	DevCommandReboot
+	DevCommandBaseOsFallback
+	DevCommandCollectDiagnostics
+)
+
+// VolumeCommand : volume command requested to run by a local server.
+type VolumeCommand uint8
+
+// Integer values are in-sync with proto enum VolumeCommand_Command.
+const (
+	VolumeCommandUnspecified VolumeCommand = iota
+	VolumeCommandRedownload
+)
+
+// VolumeCommandState : progress of a volume command.
+type VolumeCommandState uint8
+
+const (
+	// VolumeCommandStateUnspecified : not started yet
+	VolumeCommandStateUnspecified VolumeCommandState = iota
+	// VolumeCommandStateRemovingContent : waiting for volumemgr to remove
+	// the content tree of the volume
+	VolumeCommandStateRemovingContent
+	// VolumeCommandStateRecreating : waiting for volumemgr to create
+	// the new generation of the volume
+	VolumeCommandStateRecreating
+)
+
+// LocalDevCommands : device and volume commands requested from a local server.
+// Persisted by zedagent, it also holds the counters which zedagent adds to those
+// received from the controller to apply the commands.
+type LocalDevCommands struct {
+	Dev     LocalDevCommand
+	Volumes []LocalVolumeCommand
+	// ForceFallbackCounter : incremented for every base-OS fallback.
+	ForceFallbackCounter int
+}
+
+// LocalDevCommand : A device command requested from a local server.
+type LocalDevCommand struct {
+	// Command to execute.
+	Command DevCommand
+	// LocalServerTimestamp : timestamp made by the local server when the request was created.
+	LocalServerTimestamp uint64
+	// DeviceTimestamp : timestamp made by EVE when the request was received.
+	DeviceTimestamp time.Time
+	// Completed is set to true once the command completes.
+	Completed bool
+	// LastCompletedTimestamp : (server) timestamp of the last completed command.
+	// If Completed is true, then this happens to be the same as LocalServerTimestamp.
+	LastCompletedTimestamp uint64
+}
+
+// LocalVolumeCommand : A volume command requested from a local server.
+type LocalVolumeCommand struct {
+	// VolumeUUID : UUID of the volume for which the command should be run.
+	VolumeUUID uuid.UUID
+	// ContentID : UUID of the content tree of the volume which is downloaded again.
+	ContentID uuid.UUID
+	// Command to execute.
+	Command VolumeCommand
+	// LocalServerTimestamp : timestamp made by the local server when the request was created.
+	LocalServerTimestamp uint64
+	// DeviceTimestamp : timestamp made by EVE when the request was received.
+	DeviceTimestamp time.Time
+	// State : progress of the command which is not completed yet.
+	State VolumeCommandState
+	// Completed is set to true once the command completes.
+	Completed bool
+	// LastCompletedTimestamp : (server) timestamp of the last command completed for this volume.
+	// If Completed is true, then this happens to be the same as LocalServerTimestamp.
+	LastCompletedTimestamp uint64
+	// LocalGenerationCounter : incremented for every re-created volume,
+	// added to the generation counter of the volume and to the purge counter
+	// of the applications using it.
+	LocalGenerationCounter int64
+}
+
+// LookupByVolumeUUID : returns pointer (or nil) for the entry corresponding to the given volume.
+func (dcs LocalDevCommands) LookupByVolumeUUID(volumeUUID uuid.UUID) *LocalVolumeCommand {
+	for i := range dcs.Volumes {
+		if dcs.Volumes[i].VolumeUUID == volumeUUID {
+			return &dcs.Volumes[i]
+		}
+	}
+	return nil
+}
+
+// VolumeGenerationCounter : returns the local generation counter of the given volume.
+func (dcs LocalDevCommands) VolumeGenerationCounter(volumeUUID uuid.UUID) int64 {
+	if volCmd := dcs.LookupByVolumeUUID(volumeUUID); volCmd != nil {
+		return volCmd.LocalGenerationCounter
+	}
+	return 0
+}
@@ -72,6 +72,41 @@ type AppInstanceConfig struct {
 	MetaDataType MetaDataType
 
 	ProfileList []string
+
+	// Health probes and restart policy
+	Health AppHealthConfig
+
+	// Application instances which must be started before this one
+	Dependencies []AppDependency
+}
+
+// AppDependencyCondition is the state a dependency must reach before
+// the dependent application instance is started.
+type AppDependencyCondition uint8
+
+const (
+	// AppDependencyRunning : dependency is booted and running
+	AppDependencyRunning AppDependencyCondition = iota
+	// AppDependencyReady : dependency is running and its readiness probe succeeds
+	AppDependencyReady
+)
+
+// String returns the name of the condition
+func (cond AppDependencyCondition) String() string {
+	switch cond {
+	case AppDependencyRunning:
+		return "running"
+	case AppDependencyReady:
+		return "ready"
+	default:
+		return fmt.Sprintf("unknown(%d)", cond)
+	}
+}
+
+// AppDependency is a reference to another application instance
+type AppDependency struct {
+	AppUUID   uuid.UUID
+	Condition AppDependencyCondition
 }
 
 type AppInstanceOpsCmd struct {
@@ -163,12 +198,22 @@ type AppInstanceStatus struct {
 	State          SwState
 	MissingNetwork bool // If some Network UUID not found
 	MissingMemory  bool // Waiting for memory
+	// Display name (or UUID) of the dependency this instance waits for
+	// before it is started or, for a dependency, of the dependent
+	// application instance which has to stop first.
+	WaitingForDependency string
 
 	EffectiveActivate bool //set here effective activate after profile check and apply
 
 	// Status of the application command (purge, restart) requested via the local profile server.
 	LocalCommand LocalAppCommand
 
+	// Results of health probes and automatic restarts reported by domainmgr
+	Health AppHealthStatus
+
+	// Guest OS information reported by domainmgr
+	GuestInfo GuestInfo
+
 	// All error strings across all steps and all StorageStatus
 	// ErrorAndTimeWithSource provides SetError, SetErrrorWithSource, etc
 	ErrorAndTimeWithSource
@@ -959,7 +959,7 @@ type WirelessConfig struct {
 type WirelessStatus struct {
 	WType    WirelessType
 	Cellular WwanNetworkStatus
-	// TODO: Wifi status
+	Wifi     WifiStatus
 }
 
 const (
@@ -1990,9 +1990,23 @@ type UnderlayNetworkConfig struct {
 	Network      uuid.UUID // Points to a NetworkInstance.
 	ACLs         []ACE
 	AccessVlanID uint32
+	Bandwidth    AdapterBandwidth
 	IfIdx        uint32 // If we have multiple interfaces on that network, we will increase the index
 }
 
+// AdapterBandwidth : bitrate limits (in kbit/s) of an application network adapter.
+// Ingress is the traffic towards the application, egress is the traffic sent by it.
+// Zero means no limit.
+type AdapterBandwidth struct {
+	IngressLimit uint32
+	EgressLimit  uint32
+}
+
+// IsLimited returns true if any direction of the adapter traffic is limited.
+func (b AdapterBandwidth) IsLimited() bool {
+	return b.IngressLimit != 0 || b.EgressLimit != 0
+}
+
 type UnderlayNetworkStatus struct {
 	UnderlayNetworkConfig
 	ACLs int // drop ACLs field from UnderlayNetworkConfig
@@ -2147,6 +2161,8 @@ type NetworkInstanceInfo struct {
 	BridgeIPAddr  string
 	BridgeMac     string
 	BridgeIfindex int
+	// BridgeIPv6Addr is set for dual-stack network instance
+	BridgeIPv6Addr string
 
 	// interface names for the Logicallabel
 	IfNameList []string // Recorded at time of activate
@@ -2238,6 +2254,7 @@ const (
 	NST_BRIDGE
 	NST_NAT // Default?
 	NST_LB  // What is this?
+	NST_WIREGUARD
 	// XXX Add a NST_L3/NST_ROUTER to describe IP forwarding?
 	NST_LAST = 255
 )
@@ -2396,6 +2413,24 @@ type NetworkMetric struct {
 	RxAclDrops          uint64 // For implicit deny/drop at end
 	TxAclRateLimitDrops uint64 // For all rate limited rules
 	RxAclRateLimitDrops uint64 // For all rate limited rules
+	// Classes shaping bandwidth of an application VIF.
+	TrafficClasses []TrafficClassMetric
+}
+
+// TrafficClassMetric : statistics of a traffic class (tc HTB class)
+// used to shape bandwidth of an application VIF.
+type TrafficClassMetric struct {
+	// AceID : ACE selecting the traffic of the class.
+	// Zero for the traffic not matched by any shaping ACE.
+	AceID int32
+	// Ingress : traffic towards the application, otherwise sent by it.
+	Ingress    bool
+	RateKbps   uint32
+	CeilKbps   uint32
+	Bytes      uint64
+	Packets    uint64
+	Drops      uint64
+	Overlimits uint64
 }
 
 type NetworkInstanceType int32
@@ -2406,6 +2441,7 @@ const (
 	NetworkInstanceTypeSwitch      NetworkInstanceType = 1
 	NetworkInstanceTypeLocal       NetworkInstanceType = 2
 	NetworkInstanceTypeCloud       NetworkInstanceType = 3
+	NetworkInstanceTypeMesh        NetworkInstanceType = 4
 	NetworkInstanceTypeHoneyPot    NetworkInstanceType = 5
 	NetworkInstanceTypeTransparent NetworkInstanceType = 6
 	NetworkInstanceTypeLast        NetworkInstanceType = 255
@@ -2449,14 +2485,91 @@ type NetworkInstanceConfig struct {
 	DhcpRange       IpRange
 	DnsNameToIPList []DnsNameToIP // Used for DNS and ACL ipset
 
+	// IPv6 configuration of a dual-stack Local network instance.
+	// Set only with IpType AddressTypeIPV4, the fields above then
+	// describe the IPv4 configuration. See IsDualStack().
+	SubnetIPv6     net.IPNet
+	GatewayIPv6    net.IP
+	DhcpRangeIPv6  IpRange
+	IPv6UplinkMode IPv6UplinkMode
+
 	// For other network services - Proxy / StrongSwan etc..
 	OpaqueConfig string
+	// VpnType selects the service of NetworkInstanceTypeCloud
+	VpnType VpnType
+	// CipherBlockStatus carries the encrypted secrets of the service
+	CipherBlockStatus CipherBlockStatus
+
+	// FlowExporter : export of application flows to a local collector
+	FlowExporter FlowExporterConfig
 
 	// Any errrors from the parser
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
 }
 
+// IPv6UplinkMode : how IPv6 traffic of a dual-stack network instance
+// is forwarded through the port.
+// The values here should be same as the ones defined in zconfig.IPv6UplinkMode
+type IPv6UplinkMode uint8
+
+const (
+	// IPv6UplinkModeNAT66 : application addresses are translated to the port
+	// address (masquerade).
+	IPv6UplinkModeNAT66 IPv6UplinkMode = iota
+	// IPv6UplinkModeRouted : the IPv6 subnet is routed to the device
+	// by the upstream router, application addresses are not translated.
+	IPv6UplinkModeRouted
+)
+
+// String returns the mode name.
+func (m IPv6UplinkMode) String() string {
+	switch m {
+	case IPv6UplinkModeNAT66:
+		return "NAT66"
+	case IPv6UplinkModeRouted:
+		return "Routed"
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(m))
+}
+
+// FlowExportProtocol : protocol used to export flow records.
+type FlowExportProtocol uint8
+
+const (
+	// FlowExportProtocolIPFIX : IPFIX (RFC 7011)
+	FlowExportProtocolIPFIX FlowExportProtocol = iota
+	// FlowExportProtocolNetflowV9 : NetFlow version 9 (RFC 3954)
+	FlowExportProtocolNetflowV9
+)
+
+// String returns the protocol name.
+func (p FlowExportProtocol) String() string {
+	switch p {
+	case FlowExportProtocolIPFIX:
+		return "IPFIX"
+	case FlowExportProtocolNetflowV9:
+		return "NetFlow-v9"
+	}
+	return fmt.Sprintf("Unknown(%d)", uint8(p))
+}
+
+// FlowExporterConfig : export of application flow records and DNS requests
+// of a network instance to an IPFIX/NetFlow collector.
+type FlowExporterConfig struct {
+	// Collector : <IP or hostname>:<UDP port>, empty disables the export
+	Collector string
+	Protocol  FlowExportProtocol
+	// EnterpriseNumber : IANA Private Enterprise Number of the IPFIX
+	// enterprise-specific fields with app UUID, app name and ACE ID
+	EnterpriseNumber uint32
+}
+
+// IsEnabled returns true if flows should be exported.
+func (c FlowExporterConfig) IsEnabled() bool {
+	return c.Collector != ""
+}
+
 func (config *NetworkInstanceConfig) Key() string {
 	return config.UUID.String()
 }
@@ -2509,6 +2622,12 @@ func (config *NetworkInstanceConfig) IsIPv6() bool {
 	return false
 }
 
+// IsDualStack returns true if applications get both IPv4 and IPv6 address
+// from the network instance.
+func (config *NetworkInstanceConfig) IsDualStack() bool {
+	return config.IpType == AddressTypeIPV4 && config.SubnetIPv6.IP != nil
+}
+
 type ChangeInProgressType int32
 
 const (
@@ -2540,6 +2659,18 @@ type NetworkInstanceStatus struct {
 	OpaqueStatus string
 	VpnStatus    *VpnStatus
 
+	// WireGuardListenPort : UDP port on which the WireGuard tunnel
+	// of the network instance receives from the peers, zero if there is none
+	WireGuardListenPort uint16
+
+	// ACLBackend : packet filtering framework used to implement ACLs
+	// of the applications connected to the network instance
+	ACLBackend string
+	// ACLBackendError : why the configured ACL backend is not used,
+	// empty if there was no fallback
+	ACLBackendError     string
+	ACLBackendErrorTime time.Time
+
 	NetworkInstanceProbeStatus
 }
 
@@ -2595,6 +2726,10 @@ type AppNetworkACLArgs struct {
 	VifName    string
 	BridgeIP   string
 	AppIP      string
+	// BridgeIPv6 and AppIPv6 are set for dual-stack network instance,
+	// IPv6 rules are then created in addition to the IPv4 rules.
+	BridgeIPv6 string
+	AppIPv6    string
 	UpLinks    []string // List of ifnames
 	NIType     NetworkInstanceType
 	// This is the same AppNum that comes from AppNetworkStatus
@@ -2612,17 +2747,27 @@ type IPTablesRule struct {
 	RuleID           int32    // Unique rule ID
 	RuleName         string
 	ActionChainName  string
-	IsUserConfigured bool // Does this rule come from user configuration/manifest?
-	IsMarkingRule    bool // Rule does marking of packet for flow tracking.
-	IsPortMapRule    bool // Is this a port map rule?
-	IsLimitDropRule  bool // Is this a policer limit drop rule?
-	IsDefaultDrop    bool // Is this a default drop rule that forwards to dummy?
-	AnyPhysdev       bool // Apply rule irrespective of the input/output physical device.
+	ActionChainMark  uint32 // Connmark set by the chain ActionChainName
+	IsUserConfigured bool   // Does this rule come from user configuration/manifest?
+	IsMarkingRule    bool   // Rule does marking of packet for flow tracking.
+	IsPortMapRule    bool   // Is this a port map rule?
+	IsLimitDropRule  bool   // Is this a policer limit drop rule?
+	IsDefaultDrop    bool   // Is this a default drop rule that forwards to dummy?
+	AnyPhysdev       bool   // Apply rule irrespective of the input/output physical device.
 }
 
 // IPTablesRuleList : list of iptables rules
 type IPTablesRuleList []IPTablesRule
 
+// Values of the NetworkACLBackend global setting
+const (
+	// ACLBackendIptables : ACLs are applied as iptables rules and ipsets
+	ACLBackendIptables = "iptables"
+	// ACLBackendNftables : ACLs are translated into nftables rules and sets
+	// and applied in atomic transactions
+	ACLBackendNftables = "nftables"
+)
+
 /*
  * Tx/Rx of bridge is equal to the total of Tx/Rx on all member
  * virtual interfaces excluding the bridge itself.
@@ -2758,6 +2903,10 @@ type ACEAction struct {
 
 	PortMap    bool // Is port mapping part of action?
 	TargetPort int  // Internal port
+
+	Shape     bool   // Is bandwidth shaping enabled?
+	ShapeRate uint32 // Guaranteed bitrate in kbit/s (0 if none)
+	ShapeCeil uint32 // Maximum bitrate in kbit/s (0 if not limited)
 }
 
 // Retrieved from geolocation service for device underlay connectivity
@@ -2780,6 +2929,60 @@ type AdditionalInfoApp struct {
 	Hostname    string `json:",omitempty"` // From reverse DNS
 }
 
+// VpnType : the implementation of the VPN network instance
+type VpnType uint8
+
+const (
+	// VpnTypeStrongSwan : IPsec VPN using strongSwan
+	VpnTypeStrongSwan VpnType = iota
+	// VpnTypeWireGuard : WireGuard tunnel
+	VpnTypeWireGuard
+)
+
+// WireGuardConfig : Input Opaque Config of the WireGuard network instance.
+// The private key is delivered in the CipherBlock of the network instance.
+type WireGuardConfig struct {
+	ListenPort int    // UDP port, zero picks a random one
+	Address    string // address of the tunnel interface in CIDR notation
+	Mtu        int    // zero uses the default of 1420
+	// Masquerade the traffic of the apps to the address of the tunnel,
+	// for the peers which do not route the subnet of the network instance
+	Masquerade bool
+	Peers      []WireGuardPeerConfig
+}
+
+// WireGuardPeerConfig : a peer of the WireGuard network instance
+type WireGuardPeerConfig struct {
+	Name                string
+	PublicKey           string   // base64
+	Endpoint            string   // host:port, empty if the peer connects to us
+	AllowedIPs          []string // CIDRs routed to the peer through the tunnel
+	PersistentKeepalive int      // seconds, zero disables it
+}
+
+// MeshConfig : Input Opaque Config of the Mesh network instance.
+// The private key of the WireGuard tunnel is delivered in the CipherBlock
+// of the network instance.
+type MeshConfig struct {
+	ListenPort int    // UDP port of the tunnel, zero uses 51820
+	Address    string // tunnel address of this device in CIDR notation
+	Mtu        int    // MTU of the tunnel, zero uses the default of 1420
+	VNI        uint32 // VXLAN network identifier, zero uses 1
+	// MaxMembers : the DHCP range is split into this many blocks, one for
+	// each device of the mesh, zero uses 16
+	MaxMembers int
+	Peers      []MeshPeerConfig
+}
+
+// MeshPeerConfig : another device of the Mesh network instance
+type MeshPeerConfig struct {
+	Name                string
+	PublicKey           string // base64
+	Endpoint            string // host:port, empty if the peer connects to us
+	TunnelIP            string // tunnel address of the peer
+	PersistentKeepalive int    // seconds, zero disables it
+}
+
 // Input Opaque Config
 type StrongSwanConfig struct {
 	VpnRole          string
@@ -2923,6 +3126,8 @@ type VpnConnMetrics struct {
 	NIType    NetworkInstanceType
 	LEndPoint VpnEndPointMetrics
 	REndPoint VpnEndPointMetrics
+	// HandshakeAge seconds since the last WireGuard handshake
+	HandshakeAge uint64
 }
 
 type VpnMetrics struct {
@@ -3191,7 +3396,14 @@ func AddToIP(ip net.IP, addition int) net.IP {
 		byte3 := byte(val & 0xFF)
 		return net.IPv4(byte0, byte1, byte2, byte3)
 	}
-	//TBD:XXX, IPv6 handling
+	if addr := ip.To16(); addr != nil {
+		// Add to the lower 64 bits, which is enough for a /64 subnet.
+		val := binary.BigEndian.Uint64(addr[8:]) + uint64(addition)
+		newIP := make(net.IP, net.IPv6len)
+		copy(newIP, addr[:8])
+		binary.BigEndian.PutUint64(newIP[8:], val)
+		return newIP
+	}
 	return net.IP{}
 }
 
@@ -3506,6 +3718,120 @@ func (wm WwanMetrics) LogKey() string {
 	return string(base.WwanMetricsLogType) + "-" + wm.Key()
 }
 
+// WifiStatus contains status information for a WiFi adapter as reported
+// by wpa_supplicant.
+type WifiStatus struct {
+	// Interface : name of the wireless interface, e.g. wlan0.
+	Interface string
+	State     WifiState
+	// SSID and BSSID of the access point the adapter is associated with.
+	SSID         string
+	BSSID        string
+	FrequencyMHz uint32
+	// KeyMgmt : key management protocol used with the access point,
+	// e.g. WPA2-PSK.
+	KeyMgmt string
+	// AuthFailures : number of consecutive authentication failures
+	// for the network wpa_supplicant is trying to connect to.
+	// Reset once connected.
+	AuthFailures uint32
+	// LastAuthFailure : reason of the last authentication failure
+	// as reported by wpa_supplicant, e.g. WRONG_KEY.
+	LastAuthFailure     string
+	LastAuthFailureTime time.Time
+	// ScanResults : networks visible in the last periodic scan.
+	ScanResults  []WifiScanResult
+	LastScanTime time.Time
+	// Error : failed to obtain status from wpa_supplicant.
+	Error string
+}
+
+// WifiScanResult : WiFi network (access point) found by a scan.
+type WifiScanResult struct {
+	SSID         string
+	BSSID        string
+	FrequencyMHz uint32
+	// SignalLevel in dBm.
+	SignalLevel int32
+	// Flags : security capabilities, e.g. [WPA2-PSK-CCMP][ESS].
+	Flags string
+}
+
+// WifiState : simplified state of wpa_supplicant
+type WifiState string
+
+const (
+	// WifiStateUnspecified : state is not known
+	WifiStateUnspecified WifiState = ""
+	// WifiStateDisabled : WiFi adapter is disabled (e.g. by rfkill)
+	WifiStateDisabled WifiState = "disabled"
+	// WifiStateDisconnected : not associated with any access point
+	WifiStateDisconnected WifiState = "disconnected"
+	// WifiStateScanning : scanning for a configured network
+	WifiStateScanning WifiState = "scanning"
+	// WifiStateConnecting : authentication, association or key handshake
+	// is in progress
+	WifiStateConnecting WifiState = "connecting"
+	// WifiStateConnected : associated and authenticated with an access point
+	WifiStateConnected WifiState = "connected"
+)
+
+// WlanMetrics is published by nim.
+// Note that currently only one WiFi adapter per device is supported.
+type WlanMetrics struct {
+	// Interface : name of the wireless interface, e.g. wlan0.
+	// Empty if metrics are not available.
+	Interface string
+	// RSSI of the associated access point in dBm.
+	RSSI int32
+	// Noise in dBm, math.MaxInt32 if not provided by the driver.
+	Noise         int32
+	TxBitrateKbps uint32
+	FrequencyMHz  uint32
+}
+
+// Key is used for pubsub
+func (wm WlanMetrics) Key() string {
+	return "global"
+}
+
+// LogCreate :
+func (wm WlanMetrics) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.WlanMetricsLogType, "",
+		nilUUID, wm.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.Metricf("Wlan metrics create")
+}
+
+// LogModify :
+func (wm WlanMetrics) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.WlanMetricsLogType, "",
+		nilUUID, wm.LogKey())
+
+	oldWm, ok := old.(WlanMetrics)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object passed is not of WlanMetrics type")
+	}
+	logObject.CloneAndAddField("diff", cmp.Diff(oldWm, wm)).
+		Metricf("Wlan metrics modify")
+}
+
+// LogDelete :
+func (wm WlanMetrics) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.WlanMetricsLogType, "",
+		nilUUID, wm.LogKey())
+	logObject.Metricf("Wlan metrics delete")
+
+	base.DeleteLogObject(logBase, wm.LogKey())
+}
+
+// LogKey :
+func (wm WlanMetrics) LogKey() string {
+	return string(base.WlanMetricsLogType) + "-" + wm.Key()
+}
+
 // WwanNetworkMetrics contains metrics for a single cellular network.
 type WwanNetworkMetrics struct {
 	// Logical label of the cellular modem in PhysicalIO.
@@ -57,6 +57,24 @@ func TestIsIPv6(t *testing.T) {
 		assert.IsType(t, test.expectedValue, isIPv6)
 	}
 }
+
+func TestIsDualStack(t *testing.T) {
+	_, subnetIPv6, _ := net.ParseCIDR("fd00:1::/64")
+	config := NetworkInstanceConfig{IpType: AddressTypeIPV4}
+	assert.False(t, config.IsDualStack())
+	config.SubnetIPv6 = *subnetIPv6
+	assert.True(t, config.IsDualStack())
+	config.IpType = AddressTypeIPV6
+	assert.False(t, config.IsDualStack())
+}
+
+func TestAddToIP(t *testing.T) {
+	assert.Equal(t, "10.1.0.255", AddToIP(net.ParseIP("10.1.0.1"), 254).String())
+	assert.Equal(t, "10.1.1.0", AddToIP(net.ParseIP("10.1.0.1"), 255).String())
+	assert.Equal(t, "fd00:1::101", AddToIP(net.ParseIP("fd00:1::1"), 0x100).String())
+	assert.Equal(t, "fd00:1:0:0:1::", AddToIP(net.ParseIP("fd00:1::ffff:ffff:ffff"), 1).String())
+}
+
 func TestGetUnderlayConfig(t *testing.T) {
 	testMatrix := map[string]struct {
 		network uuid.UUID
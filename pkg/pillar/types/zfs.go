@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 const (
@@ -84,6 +85,9 @@ type ZFSPoolStatus struct {
 	Disks            []*StorageDiskState
 	CollectorErrors  string
 	Children         []*StorageChildren
+	Scan             StorageScanStatus
+	Vdevs            []*StorageVdevState
+	NextScrubTime    time.Time // zero if scrub scheduling is disabled
 }
 
 //Key for pubsub
@@ -100,8 +104,20 @@ type DiskDescription struct {
 
 //StorageDiskState represent state of disk
 type StorageDiskState struct {
-	DiskName *DiskDescription
-	Status   StorageStatus
+	DiskName       *DiskDescription
+	Status         StorageStatus
+	ReadErrors     uint64
+	WriteErrors    uint64
+	ChecksumErrors uint64
+}
+
+// StorageVdevState represent error counters of a top-level vdev
+type StorageVdevState struct {
+	Name           string
+	Status         StorageStatus
+	ReadErrors     uint64
+	WriteErrors    uint64
+	ChecksumErrors uint64
 }
 
 // StorageChildren stores children of zfs pool
@@ -110,3 +126,51 @@ type StorageChildren struct {
 	Disks       []*StorageDiskState
 	Children    []*StorageChildren
 }
+
+// StorageScanFunc indicates type of scan of the pool
+type StorageScanFunc int32
+
+// StorageScanFunc enum should be in sync with info api
+const (
+	StorageScanFuncUnspecified StorageScanFunc = 0 // No scan was done
+	StorageScanFuncScrub       StorageScanFunc = 1 // Verification of checksums of all data
+	StorageScanFuncResilver    StorageScanFunc = 2 // Reconstruction of data on replaced or reattached disk
+)
+
+// StorageScanState indicates state of scan of the pool
+type StorageScanState int32
+
+// StorageScanState enum should be in sync with info api
+const (
+	StorageScanStateUnspecified StorageScanState = 0
+	StorageScanStateScanning    StorageScanState = 1
+	StorageScanStateFinished    StorageScanState = 2
+	StorageScanStateCanceled    StorageScanState = 3
+)
+
+// StorageScanStatus stores progress of the current or the last scrub or resilver
+type StorageScanStatus struct {
+	Func      StorageScanFunc
+	State     StorageScanState
+	StartTime time.Time
+	EndTime   time.Time
+	ToExamine uint64 // bytes
+	Examined  uint64 // bytes
+	Repaired  uint64 // bytes
+	Errors    uint64
+}
+
+// Progress returns percent of examined bytes
+func (s StorageScanStatus) Progress() uint32 {
+	if s.State == StorageScanStateFinished {
+		return 100
+	}
+	if s.ToExamine == 0 {
+		return 0
+	}
+	progress := s.Examined * 100 / s.ToExamine
+	if progress > 100 {
+		progress = 100
+	}
+	return uint32(progress)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package wpactrl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Status : interface status as returned by the STATUS command.
+type Status struct {
+	// WpaState : state of the wpa_supplicant state machine,
+	// e.g. SCANNING, ASSOCIATING or COMPLETED.
+	WpaState  string
+	SSID      string
+	BSSID     string
+	Frequency uint32
+	KeyMgmt   string
+}
+
+// SignalPoll : link quality as returned by the SIGNAL_POLL command.
+type SignalPoll struct {
+	// RSSI in dBm.
+	RSSI int32
+	// Noise in dBm, NoiseUnknown if not reported by the driver.
+	Noise int32
+	// LinkSpeed : transmit rate in Mbps.
+	LinkSpeed uint32
+	Frequency uint32
+}
+
+// NoiseUnknown is reported by wpa_supplicant if the driver does not provide
+// the noise level.
+const NoiseUnknown = 9999
+
+// ScanResult : access point found by a scan.
+type ScanResult struct {
+	BSSID     string
+	Frequency uint32
+	// SignalLevel in dBm.
+	SignalLevel int32
+	Flags       string
+	SSID        string
+}
+
+// Event : unsolicited message sent by wpa_supplicant to attached clients.
+type Event struct {
+	// Level : message level, e.g. 2 for MSG_INFO.
+	Level int
+	// Name : the first word of the message, e.g. CTRL-EVENT-CONNECTED.
+	Name string
+	// Params : key=value pairs following the name, quotes are removed
+	// from the values.
+	Params map[string]string
+	// Text : the whole message without the level.
+	Text string
+}
+
+// Events handled by users of this package.
+const (
+	EventConnected        = "CTRL-EVENT-CONNECTED"
+	EventDisconnected     = "CTRL-EVENT-DISCONNECTED"
+	EventStateChange      = "CTRL-EVENT-STATE-CHANGE"
+	EventScanResults      = "CTRL-EVENT-SCAN-RESULTS"
+	EventSSIDTempDisabled = "CTRL-EVENT-SSID-TEMP-DISABLED"
+	EventTerminating      = "CTRL-EVENT-TERMINATING"
+)
+
+// ParseKeyValues parses reply with one key=value pair per line.
+func ParseKeyValues(reply string) map[string]string {
+	values := make(map[string]string)
+	for _, line := range strings.Split(reply, "\n") {
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		values[kv[0]] = kv[1]
+	}
+	return values
+}
+
+// ParseStatus parses reply to the STATUS command.
+func ParseStatus(reply string) (status Status, err error) {
+	values := ParseKeyValues(reply)
+	status.WpaState = values["wpa_state"]
+	if status.WpaState == "" {
+		return status, fmt.Errorf("missing wpa_state in STATUS reply: %q", reply)
+	}
+	status.SSID = values["ssid"]
+	status.BSSID = values["bssid"]
+	status.KeyMgmt = values["key_mgmt"]
+	if freq, ok := values["freq"]; ok {
+		if status.Frequency, err = parseUint32(freq); err != nil {
+			return status, fmt.Errorf("invalid freq in STATUS reply: %w", err)
+		}
+	}
+	return status, nil
+}
+
+// ParseSignalPoll parses reply to the SIGNAL_POLL command.
+func ParseSignalPoll(reply string) (signal SignalPoll, err error) {
+	if strings.HasPrefix(reply, "FAIL") {
+		return signal, fmt.Errorf("SIGNAL_POLL failed: %s", strings.TrimSpace(reply))
+	}
+	values := ParseKeyValues(reply)
+	signal.Noise = NoiseUnknown
+	for key, value := range values {
+		switch key {
+		case "RSSI":
+			signal.RSSI, err = parseInt32(value)
+		case "NOISE":
+			signal.Noise, err = parseInt32(value)
+		case "LINKSPEED":
+			signal.LinkSpeed, err = parseUint32(value)
+		case "FREQUENCY":
+			signal.Frequency, err = parseUint32(value)
+		}
+		if err != nil {
+			return signal, fmt.Errorf("invalid %s in SIGNAL_POLL reply: %w", key, err)
+		}
+	}
+	return signal, nil
+}
+
+// ParseScanResults parses reply to the SCAN_RESULTS command.
+// The first line is a header, each following line describes one BSS
+// with tab-separated bssid, frequency, signal level, flags and ssid.
+func ParseScanResults(reply string) (results []ScanResult, err error) {
+	lines := strings.Split(strings.TrimRight(reply, "\n"), "\n")
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "bssid") {
+		return nil, fmt.Errorf("unexpected SCAN_RESULTS reply: %q", reply)
+	}
+	for _, line := range lines[1:] {
+		fields := strings.SplitN(line, "\t", 5)
+		if len(fields) < 4 {
+			return nil, fmt.Errorf("invalid scan result: %q", line)
+		}
+		result := ScanResult{
+			BSSID: fields[0],
+			Flags: fields[3],
+		}
+		if result.Frequency, err = parseUint32(fields[1]); err != nil {
+			return nil, fmt.Errorf("invalid frequency in scan result %q: %w", line, err)
+		}
+		if result.SignalLevel, err = parseInt32(fields[2]); err != nil {
+			return nil, fmt.Errorf("invalid signal level in scan result %q: %w", line, err)
+		}
+		if len(fields) == 5 {
+			// Empty for hidden networks.
+			result.SSID = fields[4]
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// ParseEvent parses event message, e.g.:
+//
+//	<3>CTRL-EVENT-SSID-TEMP-DISABLED id=0 ssid="my net" auth_failures=1 duration=10 reason=WRONG_KEY
+func ParseEvent(msg string) Event {
+	event := Event{Params: make(map[string]string)}
+	msg = strings.TrimRight(msg, "\n")
+	if strings.HasPrefix(msg, "<") {
+		if end := strings.Index(msg, ">"); end > 0 {
+			event.Level, _ = strconv.Atoi(msg[1:end])
+			msg = msg[end+1:]
+		}
+	}
+	event.Text = msg
+	tokens := splitEventTokens(msg)
+	if len(tokens) == 0 {
+		return event
+	}
+	event.Name = tokens[0]
+	for _, token := range tokens[1:] {
+		kv := strings.SplitN(token, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		event.Params[kv[0]] = strings.Trim(kv[1], "\"")
+	}
+	return event
+}
+
+// splitEventTokens splits the message by spaces outside of double quotes.
+func splitEventTokens(msg string) (tokens []string) {
+	var token strings.Builder
+	var quoted bool
+	for _, r := range msg {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			token.WriteRune(r)
+		case r == ' ' && !quoted:
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+		default:
+			token.WriteRune(r)
+		}
+	}
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+	return tokens
+}
+
+func parseUint32(value string) (uint32, error) {
+	v, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+	return uint32(v), err
+}
+
+func parseInt32(value string) (int32, error) {
+	v, err := strconv.ParseInt(strings.TrimSpace(value), 10, 32)
+	return int32(v), err
+}
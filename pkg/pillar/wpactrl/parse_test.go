@@ -0,0 +1,139 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package wpactrl
+
+import (
+	"testing"
+)
+
+func TestParseStatus(t *testing.T) {
+	reply := "bssid=00:11:22:33:44:55\nfreq=2437\nssid=my net\nid=0\n" +
+		"mode=station\npairwise_cipher=CCMP\ngroup_cipher=CCMP\n" +
+		"key_mgmt=WPA2-PSK\nwpa_state=COMPLETED\nip_address=192.168.1.10\n"
+	status, err := ParseStatus(reply)
+	if err != nil {
+		t.Fatalf("ParseStatus failed: %v", err)
+	}
+	expected := Status{
+		WpaState:  "COMPLETED",
+		SSID:      "my net",
+		BSSID:     "00:11:22:33:44:55",
+		Frequency: 2437,
+		KeyMgmt:   "WPA2-PSK",
+	}
+	if status != expected {
+		t.Errorf("expected %+v, got %+v", expected, status)
+	}
+
+	status, err = ParseStatus("wpa_state=SCANNING\naddress=02:00:00:00:00:01\n")
+	if err != nil {
+		t.Fatalf("ParseStatus failed: %v", err)
+	}
+	if status.WpaState != "SCANNING" || status.SSID != "" || status.Frequency != 0 {
+		t.Errorf("unexpected status when not associated: %+v", status)
+	}
+
+	if _, err = ParseStatus("FAIL\n"); err == nil {
+		t.Errorf("expected error for reply without wpa_state")
+	}
+}
+
+func TestParseSignalPoll(t *testing.T) {
+	signal, err := ParseSignalPoll("RSSI=-52\nLINKSPEED=65\nNOISE=9999\nFREQUENCY=2437\n")
+	if err != nil {
+		t.Fatalf("ParseSignalPoll failed: %v", err)
+	}
+	expected := SignalPoll{
+		RSSI:      -52,
+		Noise:     NoiseUnknown,
+		LinkSpeed: 65,
+		Frequency: 2437,
+	}
+	if signal != expected {
+		t.Errorf("expected %+v, got %+v", expected, signal)
+	}
+
+	if _, err = ParseSignalPoll("FAIL\n"); err == nil {
+		t.Errorf("expected error for failed SIGNAL_POLL")
+	}
+	if _, err = ParseSignalPoll("RSSI=strong\n"); err == nil {
+		t.Errorf("expected error for invalid RSSI")
+	}
+}
+
+func TestParseScanResults(t *testing.T) {
+	reply := "bssid / frequency / signal level / flags / ssid\n" +
+		"00:11:22:33:44:55\t2437\t-48\t[WPA2-PSK-CCMP][ESS]\tmy net\n" +
+		"66:77:88:99:aa:bb\t5180\t-71\t[ESS]\t\n"
+	results, err := ParseScanResults(reply)
+	if err != nil {
+		t.Fatalf("ParseScanResults failed: %v", err)
+	}
+	expected := []ScanResult{
+		{
+			BSSID:       "00:11:22:33:44:55",
+			Frequency:   2437,
+			SignalLevel: -48,
+			Flags:       "[WPA2-PSK-CCMP][ESS]",
+			SSID:        "my net",
+		},
+		{
+			BSSID:       "66:77:88:99:aa:bb",
+			Frequency:   5180,
+			SignalLevel: -71,
+			Flags:       "[ESS]",
+		},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %d: %+v", len(expected), len(results), results)
+	}
+	for i := range expected {
+		if results[i] != expected[i] {
+			t.Errorf("result %d: expected %+v, got %+v", i, expected[i], results[i])
+		}
+	}
+
+	results, err = ParseScanResults("bssid / frequency / signal level / flags / ssid\n")
+	if err != nil || len(results) != 0 {
+		t.Errorf("expected no results, got %+v (err: %v)", results, err)
+	}
+	if _, err = ParseScanResults("UNKNOWN COMMAND\n"); err == nil {
+		t.Errorf("expected error for unexpected reply")
+	}
+}
+
+func TestParseEvent(t *testing.T) {
+	event := ParseEvent("<3>CTRL-EVENT-SSID-TEMP-DISABLED id=0 ssid=\"my net\" " +
+		"auth_failures=2 duration=20 reason=WRONG_KEY")
+	if event.Level != 3 {
+		t.Errorf("expected level 3, got %d", event.Level)
+	}
+	if event.Name != EventSSIDTempDisabled {
+		t.Errorf("expected event %s, got %s", EventSSIDTempDisabled, event.Name)
+	}
+	expectedParams := map[string]string{
+		"id":            "0",
+		"ssid":          "my net",
+		"auth_failures": "2",
+		"duration":      "20",
+		"reason":        "WRONG_KEY",
+	}
+	if len(event.Params) != len(expectedParams) {
+		t.Errorf("expected params %v, got %v", expectedParams, event.Params)
+	}
+	for key, value := range expectedParams {
+		if event.Params[key] != value {
+			t.Errorf("expected %s=%s, got %s", key, value, event.Params[key])
+		}
+	}
+
+	event = ParseEvent("<3>CTRL-EVENT-CONNECTED - Connection to 00:11:22:33:44:55 " +
+		"completed [id=0 id_str=]")
+	if event.Name != EventConnected {
+		t.Errorf("expected event %s, got %s", EventConnected, event.Name)
+	}
+	if event.Text != "CTRL-EVENT-CONNECTED - Connection to 00:11:22:33:44:55 completed [id=0 id_str=]" {
+		t.Errorf("unexpected event text: %s", event.Text)
+	}
+}
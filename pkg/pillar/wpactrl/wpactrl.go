@@ -0,0 +1,145 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package wpactrl implements a client for the control interface
+// of wpa_supplicant. The interface is a unix datagram socket created
+// by wpa_supplicant for every wireless interface it manages inside
+// the directory configured with ctrl_interface.
+package wpactrl
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// How long to wait for wpa_supplicant to reply to a request.
+	requestTimeout = 5 * time.Second
+	// Replies to SCAN_RESULTS can be quite long.
+	maxMsgLen = 64 << 10
+)
+
+// Used to generate unique names of client sockets.
+var clientCounter uint32
+
+// Conn : connection to the control interface of wpa_supplicant
+// for one wireless interface.
+type Conn struct {
+	conn      *net.UnixConn
+	localPath string
+	attached  bool
+}
+
+// Open connects to the control socket ctrlDir/ifName. The client socket
+// is created inside clientDir, wpa_supplicant sends replies to its path.
+func Open(ctrlDir, ifName, clientDir string) (*Conn, error) {
+	localPath := filepath.Join(clientDir, fmt.Sprintf("wpactrl_%d_%d",
+		os.Getpid(), atomic.AddUint32(&clientCounter, 1)))
+	// Left from a previous run with the same PID.
+	os.Remove(localPath)
+	conn, err := net.DialUnix("unixgram",
+		&net.UnixAddr{Name: localPath, Net: "unixgram"},
+		&net.UnixAddr{Name: filepath.Join(ctrlDir, ifName), Net: "unixgram"})
+	if err != nil {
+		os.Remove(localPath)
+		return nil, fmt.Errorf("failed to connect to wpa_supplicant for %s: %w",
+			ifName, err)
+	}
+	return &Conn{conn: conn, localPath: localPath}, nil
+}
+
+// Close detaches from events (if attached) and closes the connection.
+func (c *Conn) Close() error {
+	if c.attached {
+		_, _ = c.Request("DETACH")
+	}
+	err := c.conn.Close()
+	os.Remove(c.localPath)
+	return err
+}
+
+// Request sends a command and returns the reply of wpa_supplicant.
+// Commands which only report success return "OK" or "FAIL..." as the reply,
+// it is up to the caller to check it.
+func (c *Conn) Request(cmd string) (string, error) {
+	if _, err := c.conn.Write([]byte(cmd)); err != nil {
+		return "", fmt.Errorf("failed to send %s to wpa_supplicant: %w", cmd, err)
+	}
+	deadline := time.Now().Add(requestTimeout)
+	buf := make([]byte, maxMsgLen)
+	for {
+		if err := c.conn.SetReadDeadline(deadline); err != nil {
+			return "", err
+		}
+		n, err := c.conn.Read(buf)
+		if err != nil {
+			return "", fmt.Errorf("failed to receive reply to %s from wpa_supplicant: %w",
+				cmd, err)
+		}
+		reply := string(buf[:n])
+		if c.attached && isEventMsg(reply) {
+			// Events are not expected on this connection when it is used
+			// for requests, skip them.
+			continue
+		}
+		return reply, nil
+	}
+}
+
+// Attach registers the connection to receive events from wpa_supplicant.
+// Events are then read with ReadEvent and the connection should not be used
+// for other requests.
+func (c *Conn) Attach() error {
+	reply, err := c.Request("ATTACH")
+	if err != nil {
+		return err
+	}
+	if !isOK(reply) {
+		return fmt.Errorf("ATTACH failed: %s", strings.TrimSpace(reply))
+	}
+	c.attached = true
+	return nil
+}
+
+// ErrTimeout is returned by ReadEvent if no event was received in time.
+var ErrTimeout = errors.New("timeout waiting for wpa_supplicant event")
+
+// ReadEvent waits for the next event. Replies to requests which may still
+// arrive (e.g. to ATTACH after a timeout) are skipped.
+func (c *Conn) ReadEvent(timeout time.Duration) (Event, error) {
+	buf := make([]byte, maxMsgLen)
+	deadline := time.Now().Add(timeout)
+	for {
+		if err := c.conn.SetReadDeadline(deadline); err != nil {
+			return Event{}, err
+		}
+		n, err := c.conn.Read(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				return Event{}, ErrTimeout
+			}
+			return Event{}, fmt.Errorf("failed to receive event from wpa_supplicant: %w",
+				err)
+		}
+		msg := string(buf[:n])
+		if isEventMsg(msg) {
+			return ParseEvent(msg), nil
+		}
+	}
+}
+
+// Events are prefixed with the message level, e.g. "<3>".
+func isEventMsg(msg string) bool {
+	return strings.HasPrefix(msg, "<")
+}
+
+func isOK(reply string) bool {
+	return strings.TrimSpace(reply) == "OK"
+}
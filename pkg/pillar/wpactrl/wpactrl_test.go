@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package wpactrl
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeSupplicant replies to requests like wpa_supplicant and sends an event
+// to the client once it is attached.
+func fakeSupplicant(t *testing.T, ctrlDir, ifName string) *net.UnixConn {
+	conn, err := net.ListenUnixgram("unixgram",
+		&net.UnixAddr{Name: filepath.Join(ctrlDir, ifName), Net: "unixgram"})
+	if err != nil {
+		t.Fatalf("failed to create control socket: %v", err)
+	}
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, from, err := conn.ReadFromUnix(buf)
+			if err != nil {
+				return
+			}
+			switch string(buf[:n]) {
+			case "PING":
+				conn.WriteToUnix([]byte("PONG\n"), from)
+			case "ATTACH":
+				conn.WriteToUnix([]byte("OK\n"), from)
+				conn.WriteToUnix([]byte("<2>CTRL-EVENT-SCAN-RESULTS "), from)
+			case "DETACH":
+				conn.WriteToUnix([]byte("OK\n"), from)
+			default:
+				conn.WriteToUnix([]byte("UNKNOWN COMMAND\n"), from)
+			}
+		}
+	}()
+	return conn
+}
+
+func TestConn(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wpactrl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	supplicant := fakeSupplicant(t, dir, "wlan0")
+	defer supplicant.Close()
+
+	if _, err = Open(dir, "wlan1", dir); err == nil {
+		t.Errorf("expected error when connecting to non-existent interface")
+	}
+
+	conn, err := Open(dir, "wlan0", dir)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	reply, err := conn.Request("PING")
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	if reply != "PONG\n" {
+		t.Errorf("expected PONG, got %q", reply)
+	}
+	if err = conn.Attach(); err != nil {
+		t.Fatalf("Attach failed: %v", err)
+	}
+	event, err := conn.ReadEvent(time.Second)
+	if err != nil {
+		t.Fatalf("ReadEvent failed: %v", err)
+	}
+	if event.Name != EventScanResults || event.Level != 2 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if _, err = conn.ReadEvent(100 * time.Millisecond); err != ErrTimeout {
+		t.Errorf("expected timeout, got %v", err)
+	}
+	if err = conn.Close(); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+	// Client socket is removed on close.
+	entries, _ := ioutil.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("expected only the control socket to remain, found %d entries",
+			len(entries))
+	}
+}
@@ -25,6 +25,8 @@ import (
 // After failure call
 // 	zedcloud.SetDeferred(key, buf, size, url, zedcloudCtx)
 // or AddDeferred to build a queue for each key
+// To keep the items which could not be sent across reboots call
+//	zedcloud.EnableDeferredPersist(zedcloudCtx, persistConfig)
 
 type deferredItem struct {
 	itemType      interface{}
@@ -33,6 +35,9 @@ type deferredItem struct {
 	size          int64
 	url           string
 	bailOnHTTPErr bool // Return 4xx and 5xx without trying other interfaces
+	createTime    time.Time
+	seq           uint64 // Sequence number in deferredStore; zero if not assigned
+	dirty         bool   // Not yet written to deferredStore
 }
 
 const maxTimeToHandleDeferred = time.Minute
@@ -48,6 +53,7 @@ type DeferredContext struct {
 	sentHandler            *SentHandlerFunction
 	zedcloudCtx            *ZedCloudContext
 	iteration              int
+	store                  *deferredStore // nil if not persisted
 }
 
 //TypePriorityCheckFunction returns true in case of find type with high priority
@@ -180,10 +186,14 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 		for _, el := range ctx.deferredItems {
 			if el.buf != nil {
 				newDeferredItems = append(newDeferredItems, el)
+			} else if ctx.store != nil {
+				ctx.store.remove(el.seq)
 			}
 		}
 		ctx.deferredItems = newDeferredItems
 	}
+	// Whatever we failed to send is kept on disk
+	ctx.flushToStore(log)
 
 	if len(ctx.deferredItems) == 0 {
 		stopTimer(log, ctx)
@@ -221,6 +231,8 @@ func (ctx *DeferredContext) setDeferred(zedcloudCtx *ZedCloudContext,
 		size:          size,
 		url:           url,
 		bailOnHTTPErr: bailOnHTTPErr,
+		createTime:    time.Now(),
+		dirty:         true,
 	}
 	found := false
 	ind := 0
@@ -233,11 +245,16 @@ func (ctx *DeferredContext) setDeferred(zedcloudCtx *ZedCloudContext,
 	}
 	if found {
 		log.Tracef("Replacing key %s", key)
+		// keep the place in the persisted order
+		item.seq = itemList.seq
 		ctx.deferredItems[ind] = &item
 	} else {
 		log.Tracef("Adding key %s", key)
 		ctx.deferredItems = append(ctx.deferredItems, &item)
 	}
+	// Persist right away so that the item survives a crash or reboot
+	// before the next retry
+	ctx.flushToStore(log)
 }
 
 // RemoveDeferred removes key from deferred items if exists
@@ -256,6 +273,9 @@ func (ctx *DeferredContext) removeDeferred(zedcloudCtx *ZedCloudContext, key str
 	for ind, itemList := range ctx.deferredItems {
 		if itemList.key == key {
 			log.Tracef("Deleting key %s", key)
+			if ctx.store != nil {
+				ctx.store.remove(itemList.seq)
+			}
 			ctx.deferredItems = append(ctx.deferredItems[:ind], ctx.deferredItems[ind+1:]...)
 			break
 		}
@@ -265,6 +285,134 @@ func (ctx *DeferredContext) removeDeferred(zedcloudCtx *ZedCloudContext, key str
 	}
 }
 
+// EnableDeferredPersist sets up the persistent backing store for the
+// deferred items. Items found in the store are added to the queue in the
+// original order subject to the MaxSize and MaxAge limits, and the items
+// queued later are written to the store as soon as they are deferred.
+// Should be called after GetDeferredChan.
+func EnableDeferredPersist(zedcloudCtx *ZedCloudContext, config DeferredPersistConfig) error {
+	return zedcloudCtx.deferredCtx.enablePersist(zedcloudCtx.log, config)
+}
+
+func (ctx *DeferredContext) enablePersist(log *base.LogObject, config DeferredPersistConfig) error {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	store := newDeferredStore(config)
+	items, err := store.load(log)
+	if err != nil {
+		return err
+	}
+	ctx.store = store
+	// The limits might have been lowered since the items were written
+	if evicted := store.enforceLimits(ctx.priorityOf); len(evicted) != 0 {
+		log.Warnf("EnableDeferredPersist(%s) evicted %d items",
+			config.Dir, len(evicted))
+		var kept []*deferredItem
+		for _, item := range items {
+			if _, ok := store.entries[item.seq]; ok {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+	log.Noticef("EnableDeferredPersist(%s) loaded %d items size %d",
+		config.Dir, len(items), store.totalSize)
+	if len(items) == 0 {
+		return nil
+	}
+	// Anything queued in the meantime is more recent
+	var newDeferredItems []*deferredItem
+	for _, item := range items {
+		if ctx.findItem(item.key) != nil {
+			store.remove(item.seq)
+			continue
+		}
+		newDeferredItems = append(newDeferredItems, item)
+	}
+	if len(ctx.deferredItems) == 0 && len(newDeferredItems) != 0 {
+		startTimer(log, ctx)
+	}
+	ctx.deferredItems = append(newDeferredItems, ctx.deferredItems...)
+	return nil
+}
+
+// SetDeferredPersistLimits updates the size and age limits of the
+// persistent store if enabled
+func SetDeferredPersistLimits(zedcloudCtx *ZedCloudContext, maxSize int64, maxAge time.Duration) {
+	zedcloudCtx.deferredCtx.setPersistLimits(zedcloudCtx.log, maxSize, maxAge)
+}
+
+func (ctx *DeferredContext) setPersistLimits(log *base.LogObject, maxSize int64, maxAge time.Duration) {
+	if ctx.lock == nil {
+		return
+	}
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	if ctx.store == nil {
+		return
+	}
+	if ctx.store.MaxSize == maxSize && ctx.store.MaxAge == maxAge {
+		return
+	}
+	log.Functionf("SetDeferredPersistLimits(%d, %v)", maxSize, maxAge)
+	ctx.store.MaxSize = maxSize
+	ctx.store.MaxAge = maxAge
+	ctx.enforceStoreLimits(log)
+}
+
+func (ctx *DeferredContext) findItem(key string) *deferredItem {
+	for _, item := range ctx.deferredItems {
+		if item.key == key {
+			return item
+		}
+	}
+	return nil
+}
+
+// priorityOf returns the index of the first priorityCheckFunction matching
+// the itemType hence higher values mean lower priority
+func (ctx *DeferredContext) priorityOf(itemType interface{}) int {
+	for i, f := range ctx.priorityCheckFunctions {
+		if f(itemType) {
+			return i
+		}
+	}
+	return len(ctx.priorityCheckFunctions)
+}
+
+// flushToStore writes the items which are not yet persisted
+func (ctx *DeferredContext) flushToStore(log *base.LogObject) {
+	if ctx.store == nil {
+		return
+	}
+	written := false
+	for _, item := range ctx.deferredItems {
+		if !item.dirty || item.buf == nil {
+			continue
+		}
+		if err := ctx.store.save(item); err != nil {
+			log.Errorf("flushToStore: failed to persist %s: %v",
+				item.key, err)
+			continue
+		}
+		item.dirty = false
+		written = true
+	}
+	if written {
+		ctx.enforceStoreLimits(log)
+	}
+}
+
+func (ctx *DeferredContext) enforceStoreLimits(log *base.LogObject) {
+	evicted := ctx.store.enforceLimits(ctx.priorityOf)
+	if len(evicted) != 0 {
+		log.Warnf("enforceStoreLimits: evicted %d items from %s",
+			len(evicted), ctx.store.Dir)
+	}
+}
+
 // Try every minute backoff to every 15 minutes
 func startTimer(log *base.LogObject, ctx *DeferredContext) {
 
@@ -0,0 +1,250 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Persistent backing store for the deferred items so that the queue survives
+// reboots and agent restarts
+
+package zedcloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+const deferredFileSuffix = ".json"
+
+// ItemTypeMarshalFunction converts itemType into a string to be persisted.
+// Returns false if items of the given type should not be persisted.
+type ItemTypeMarshalFunction func(itemType interface{}) (string, bool)
+
+// ItemTypeUnmarshalFunction restores itemType from the persisted string
+type ItemTypeUnmarshalFunction func(itemType string) (interface{}, error)
+
+// DeferredPersistConfig describes the on-disk backing store for deferred items
+type DeferredPersistConfig struct {
+	// Dir where we keep one file per deferred item
+	Dir string
+	// MaxSize is the limit for the sum of the persisted items in bytes.
+	// Zero disables persisting of new items.
+	MaxSize int64
+	// MaxAge is the limit for the age of a persisted item. Zero means no limit.
+	MaxAge            time.Duration
+	MarshalItemType   ItemTypeMarshalFunction
+	UnmarshalItemType ItemTypeUnmarshalFunction
+}
+
+// persistedDeferredItem is what we write to disk for a deferredItem
+type persistedDeferredItem struct {
+	Seq           uint64
+	Key           string
+	ItemType      string
+	URL           string
+	Size          int64
+	BailOnHTTPErr bool
+	CreateTime    time.Time
+	Data          []byte
+}
+
+// deferredStoreEntry tracks what we have on disk for a sequence number
+type deferredStoreEntry struct {
+	fileSize   int64
+	createTime time.Time
+	itemType   interface{}
+}
+
+type deferredStore struct {
+	DeferredPersistConfig
+	nextSeq   uint64
+	totalSize int64
+	entries   map[uint64]deferredStoreEntry
+}
+
+func newDeferredStore(config DeferredPersistConfig) *deferredStore {
+	return &deferredStore{
+		DeferredPersistConfig: config,
+		nextSeq:               1,
+		entries:               make(map[uint64]deferredStoreEntry),
+	}
+}
+
+func (s *deferredStore) filename(seq uint64) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("%020d%s", seq, deferredFileSuffix))
+}
+
+// load reads the persisted items and returns them in the original order.
+// Items which are too old or can not be parsed are removed.
+func (s *deferredStore) load(log *base.LogObject) ([]*deferredItem, error) {
+	if err := os.MkdirAll(s.Dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(s.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var items []*deferredItem
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, deferredFileSuffix) {
+			// leftovers from WriteRename
+			if strings.HasPrefix(name, "tmp") {
+				os.Remove(filepath.Join(s.Dir, name))
+			}
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, deferredFileSuffix), 10, 64)
+		if err != nil {
+			log.Warnf("deferredStore.load: unexpected file %s", name)
+			continue
+		}
+		item, err := s.readItem(seq)
+		if err != nil {
+			log.Errorf("deferredStore.load: dropping %s: %v", name, err)
+			os.Remove(s.filename(seq))
+			continue
+		}
+		if s.MaxAge != 0 && time.Since(item.createTime) > s.MaxAge {
+			log.Noticef("deferredStore.load: dropping %s for %s created %v",
+				name, item.key, item.createTime)
+			os.Remove(s.filename(seq))
+			continue
+		}
+		s.entries[seq] = deferredStoreEntry{
+			fileSize:   file.Size(),
+			createTime: item.createTime,
+			itemType:   item.itemType,
+		}
+		s.totalSize += file.Size()
+		if seq >= s.nextSeq {
+			s.nextSeq = seq + 1
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].seq < items[j].seq
+	})
+	return items, nil
+}
+
+func (s *deferredStore) readItem(seq uint64) (*deferredItem, error) {
+	contents, err := ioutil.ReadFile(s.filename(seq))
+	if err != nil {
+		return nil, err
+	}
+	var p persistedDeferredItem
+	if err := json.Unmarshal(contents, &p); err != nil {
+		return nil, err
+	}
+	if p.Seq != seq {
+		return nil, fmt.Errorf("sequence mismatch %d", p.Seq)
+	}
+	itemType, err := s.UnmarshalItemType(p.ItemType)
+	if err != nil {
+		return nil, err
+	}
+	return &deferredItem{
+		itemType:      itemType,
+		key:           p.Key,
+		buf:           bytes.NewBuffer(p.Data),
+		size:          p.Size,
+		url:           p.URL,
+		bailOnHTTPErr: p.BailOnHTTPErr,
+		createTime:    p.CreateTime,
+		seq:           seq,
+	}, nil
+}
+
+// save writes the item to disk assigning a sequence number if the item
+// does not have one yet. Replaced items keep their sequence number to
+// preserve the original order.
+func (s *deferredStore) save(item *deferredItem) error {
+	if item.seq == 0 {
+		item.seq = s.nextSeq
+		s.nextSeq++
+	}
+	itemType, ok := s.MarshalItemType(item.itemType)
+	if !ok || s.MaxSize == 0 {
+		s.remove(item.seq)
+		return nil
+	}
+	p := persistedDeferredItem{
+		Seq:           item.seq,
+		Key:           item.key,
+		ItemType:      itemType,
+		URL:           item.url,
+		Size:          item.size,
+		BailOnHTTPErr: item.bailOnHTTPErr,
+		CreateTime:    item.createTime,
+		Data:          item.buf.Bytes(),
+	}
+	contents, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	if err := fileutils.WriteRename(s.filename(item.seq), contents); err != nil {
+		return err
+	}
+	s.totalSize -= s.entries[item.seq].fileSize
+	s.entries[item.seq] = deferredStoreEntry{
+		fileSize:   int64(len(contents)),
+		createTime: item.createTime,
+		itemType:   item.itemType,
+	}
+	s.totalSize += int64(len(contents))
+	return nil
+}
+
+// remove deletes the file for the sequence number if we have one
+func (s *deferredStore) remove(seq uint64) {
+	entry, ok := s.entries[seq]
+	if !ok {
+		return
+	}
+	os.Remove(s.filename(seq))
+	s.totalSize -= entry.fileSize
+	delete(s.entries, seq)
+}
+
+// enforceLimits removes items older than MaxAge and then evicts items until
+// we are within MaxSize. The victim is the oldest item among the ones with
+// the lowest priority; priorityOf returns a higher number for lower priority.
+// Returns the sequence numbers of the removed items.
+func (s *deferredStore) enforceLimits(priorityOf func(itemType interface{}) int) []uint64 {
+	var removed []uint64
+	if s.MaxAge != 0 {
+		for seq, entry := range s.entries {
+			if time.Since(entry.createTime) > s.MaxAge {
+				s.remove(seq)
+				removed = append(removed, seq)
+			}
+		}
+	}
+	for s.totalSize > s.MaxSize && len(s.entries) > 0 {
+		var victim uint64
+		victimPrio := -1
+		for seq, entry := range s.entries {
+			prio := priorityOf(entry.itemType)
+			if prio < victimPrio {
+				continue
+			}
+			if prio == victimPrio && seq > victim {
+				continue
+			}
+			victim = seq
+			victimPrio = prio
+		}
+		s.remove(victim)
+		removed = append(removed, victim)
+	}
+	return removed
+}
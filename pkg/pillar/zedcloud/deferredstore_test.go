@@ -0,0 +1,185 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedcloud
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/flextimer"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// test item types; 0 is the high priority one
+type testItemType int
+
+func newTestDeferredContext(t *testing.T, dir string, maxSize int64) (*DeferredContext, *base.LogObject) {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "deferredstore_test", 1234)
+	ctx := &DeferredContext{
+		lock: &sync.Mutex{},
+		priorityCheckFunctions: []TypePriorityCheckFunction{
+			func(itemType interface{}) bool {
+				return itemType.(testItemType) == 0
+			},
+			func(itemType interface{}) bool {
+				return true
+			},
+		},
+	}
+	config := DeferredPersistConfig{
+		Dir:     dir,
+		MaxSize: maxSize,
+		MarshalItemType: func(itemType interface{}) (string, bool) {
+			return strconv.Itoa(int(itemType.(testItemType))), true
+		},
+		UnmarshalItemType: func(itemType string) (interface{}, error) {
+			i, err := strconv.Atoi(itemType)
+			return testItemType(i), err
+		},
+	}
+	ctx.store = newDeferredStore(config)
+	items, err := ctx.store.load(log)
+	assert.NoError(t, err)
+	ctx.deferredItems = items
+	return ctx, log
+}
+
+func addTestItem(ctx *DeferredContext, key string, itemType testItemType) {
+	ctx.deferredItems = append(ctx.deferredItems, &deferredItem{
+		key:        key,
+		itemType:   itemType,
+		buf:        bytes.NewBufferString("payload for " + key),
+		url:        "https://controller/" + key,
+		createTime: time.Now(),
+		dirty:      true,
+	})
+}
+
+func TestDeferredStoreReplayOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deferredstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, log := newTestDeferredContext(t, dir, 1024*1024)
+	for i := 0; i < 12; i++ {
+		addTestItem(ctx, fmt.Sprintf("key%d", i), testItemType(i%2))
+	}
+	ctx.flushToStore(log)
+	// replace keeps the original place
+	ctx.deferredItems[3].buf = bytes.NewBufferString("replaced")
+	ctx.deferredItems[3].dirty = true
+	ctx.flushToStore(log)
+
+	// simulate restart
+	ctx2, _ := newTestDeferredContext(t, dir, 1024*1024)
+	assert.Equal(t, len(ctx.deferredItems), len(ctx2.deferredItems))
+	for i, item := range ctx2.deferredItems {
+		assert.Equal(t, fmt.Sprintf("key%d", i), item.key)
+		assert.Equal(t, testItemType(i%2), item.itemType)
+		assert.Equal(t, ctx.deferredItems[i].buf.String(), item.buf.String())
+		assert.Equal(t, ctx.deferredItems[i].url, item.url)
+	}
+	assert.Equal(t, "replaced", ctx2.deferredItems[3].buf.String())
+
+	// new items are appended after the replayed ones
+	addTestItem(ctx2, "key12", 0)
+	ctx2.flushToStore(log)
+	assert.Equal(t, uint64(13), ctx2.deferredItems[12].seq)
+}
+
+func TestDeferredStoreEviction(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deferredstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, log := newTestDeferredContext(t, dir, 1024*1024)
+	addTestItem(ctx, "low1", 1)
+	addTestItem(ctx, "high1", 0)
+	addTestItem(ctx, "low2", 1)
+	addTestItem(ctx, "high2", 0)
+	ctx.flushToStore(log)
+	itemSize := ctx.store.entries[1].fileSize
+
+	// Room for three items; the oldest low priority item goes first
+	ctx.setPersistLimits(log, 3*itemSize+itemSize/2, 0)
+	ctx2, _ := newTestDeferredContext(t, dir, 1024*1024)
+	var keys []string
+	for _, item := range ctx2.deferredItems {
+		keys = append(keys, item.key)
+	}
+	assert.Equal(t, []string{"high1", "low2", "high2"}, keys)
+
+	// Room for one item; only high priority ones are left
+	ctx.setPersistLimits(log, itemSize+itemSize/2, 0)
+	ctx3, _ := newTestDeferredContext(t, dir, 1024*1024)
+	keys = nil
+	for _, item := range ctx3.deferredItems {
+		keys = append(keys, item.key)
+	}
+	assert.Equal(t, []string{"high2"}, keys)
+}
+
+func TestDeferredStoreLoadLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deferredstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, log := newTestDeferredContext(t, dir, 1024*1024)
+	addTestItem(ctx, "low1", 1)
+	addTestItem(ctx, "high1", 0)
+	addTestItem(ctx, "low2", 1)
+	ctx.flushToStore(log)
+	itemSize := ctx.store.entries[1].fileSize
+
+	// simulate restart with a lower limit
+	ctx2 := &DeferredContext{
+		lock:                   &sync.Mutex{},
+		ticker:                 flextimer.NewRangeTicker(longTime1, longTime2),
+		priorityCheckFunctions: ctx.priorityCheckFunctions,
+	}
+	config := ctx.store.DeferredPersistConfig
+	config.MaxSize = 2*itemSize + itemSize/2
+	assert.NoError(t, ctx2.enablePersist(log, config))
+	var keys []string
+	for _, item := range ctx2.deferredItems {
+		keys = append(keys, item.key)
+	}
+	assert.Equal(t, []string{"high1", "low2"}, keys)
+	assert.Len(t, ctx2.store.entries, 2)
+}
+
+func TestSetDeferredPersistsImmediately(t *testing.T) {
+	dir, err := ioutil.TempDir("", "deferredstore_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, log := newTestDeferredContext(t, dir, 1024*1024)
+	zedcloudCtx := &ZedCloudContext{log: log}
+	ctx.ticker = flextimer.NewRangeTicker(longTime1, longTime2)
+	ctx.setDeferred(zedcloudCtx, "key1", bytes.NewBufferString("data"), 4,
+		"https://controller/key1", false, testItemType(1))
+
+	// simulate a crash before handleDeferred ran
+	ctx2, _ := newTestDeferredContext(t, dir, 1024*1024)
+	assert.Len(t, ctx2.deferredItems, 1)
+	assert.Equal(t, "key1", ctx2.deferredItems[0].key)
+	assert.Equal(t, "data", ctx2.deferredItems[0].buf.String())
+}
@@ -171,6 +171,47 @@ func GetVolumesInDataset(log *base.LogObject, dataset string) ([]string, error)
 	return lines, nil
 }
 
+//CreateSnapshot creates snapshot with provided name of the dataset
+func CreateSnapshot(log *base.LogObject, dataset, snapshot string) (string, error) {
+	args := append(zfsPath, "snapshot", dataset+"@"+snapshot)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//RollbackSnapshot reverts dataset to the snapshot with provided name
+//snapshots more recent than the provided one will be destroyed
+func RollbackSnapshot(log *base.LogObject, dataset, snapshot string) (string, error) {
+	args := append(zfsPath, "rollback", "-r", dataset+"@"+snapshot)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//DestroySnapshot removes snapshot with provided name of the dataset
+func DestroySnapshot(log *base.LogObject, dataset, snapshot string) (string, error) {
+	return DestroyDataset(log, dataset+"@"+snapshot)
+}
+
+//GetSnapshots obtains names of snapshots of the dataset
+func GetSnapshots(log *base.LogObject, dataset string) ([]string, error) {
+	args := append(zfsPath, "list", "-H", "-d", "1",
+		"-o", "name",
+		"-t", "snapshot",
+		dataset)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("GetSnapshots: output=%s error=%s", stdoutStderr, err)
+	}
+	var snapshots []string
+	sc := bufio.NewScanner(bytes.NewReader(stdoutStderr))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if strings.HasPrefix(line, dataset+"@") {
+			snapshots = append(snapshots, strings.TrimPrefix(line, dataset+"@"))
+		}
+	}
+	return snapshots, nil
+}
+
 //GetDatasetByDevice returns dataset for provided device path
 func GetDatasetByDevice(device string) string {
 	if !strings.HasPrefix(device, types.ZVolDevicePrefix) {
@@ -257,6 +298,27 @@ func AddVDev(log *base.LogObject, pool, vdev string) (string, error) {
 	return strings.TrimSpace(string(stdoutStderr)), nil
 }
 
+//AddVDevGroup add new top-level vdev of vdevType (mirror, raidz1, raidz2) built from vdevs to pool
+func AddVDevGroup(log *base.LogObject, pool, vdevType string, vdevs ...string) (string, error) {
+	args := append(zpoolPath, "add", "-f", pool, vdevType)
+	args = append(args, vdevs...)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
+//DetachVDev detach vdev from mirror
+func DetachVDev(log *base.LogObject, pool, vdev string) (string, error) {
+	args := append(zpoolPath, "detach", pool, vdev)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}
+
 //ReplaceVDev replaces vdev from the pool
 func ReplaceVDev(log *base.LogObject, pool, oldVdev, newVdev string) (string, error) {
 	args := append(zpoolPath, "replace", pool, oldVdev, newVdev)
@@ -422,5 +484,69 @@ func GetZfsDiskAndStatus(disk libzfs.VDevTree) (*types.StorageDiskState, error)
 	rDiskStatus.DiskName.Name = *proto.String(diskZfsName)
 	rDiskStatus.DiskName.Serial = *proto.String(serialNumber)
 	rDiskStatus.Status = GetZfsDeviceStatusFromStr(disk.Stat.State.String())
+	rDiskStatus.ReadErrors = disk.Stat.ReadErrors
+	rDiskStatus.WriteErrors = disk.Stat.WriteErrors
+	rDiskStatus.ChecksumErrors = disk.Stat.ChecksumErrors
 	return rDiskStatus, nil
 }
+
+// GetZfsVdevStates takes a root libzfs.VDevTree of the pool as input and
+// returns error counters of its top-level vdevs
+func GetZfsVdevStates(vdevs libzfs.VDevTree) []*types.StorageVdevState {
+	var states []*types.StorageVdevState
+	for _, vdev := range vdevs.Devices {
+		states = append(states, &types.StorageVdevState{
+			Name:           vdev.Name,
+			Status:         GetZfsDeviceStatusFromStr(vdev.Stat.State.String()),
+			ReadErrors:     vdev.Stat.ReadErrors,
+			WriteErrors:    vdev.Stat.WriteErrors,
+			ChecksumErrors: vdev.Stat.ChecksumErrors,
+		})
+	}
+	return states
+}
+
+// GetZfsScanStatus takes a root libzfs.VDevTree of the pool as input and
+// returns status of the current or the last scrub or resilver
+func GetZfsScanStatus(vdevs libzfs.VDevTree) types.StorageScanStatus {
+	scanStat := vdevs.ScanStat
+	status := types.StorageScanStatus{
+		ToExamine: scanStat.ToExamine,
+		Examined:  scanStat.Examined,
+		Repaired:  scanStat.Processed,
+		Errors:    scanStat.Errors,
+	}
+	switch scanStat.Func {
+	case libzfs.PoolScanScrub:
+		status.Func = types.StorageScanFuncScrub
+	case libzfs.PoolScanResilver:
+		status.Func = types.StorageScanFuncResilver
+	default:
+		return types.StorageScanStatus{}
+	}
+	switch scanStat.State {
+	case libzfs.DSSScanning:
+		status.State = types.StorageScanStateScanning
+	case libzfs.DSSFinished:
+		status.State = types.StorageScanStateFinished
+	case libzfs.DSSCanceled:
+		status.State = types.StorageScanStateCanceled
+	}
+	if scanStat.StartTime != 0 {
+		status.StartTime = time.Unix(int64(scanStat.StartTime), 0)
+	}
+	if scanStat.EndTime != 0 && status.State != types.StorageScanStateScanning {
+		status.EndTime = time.Unix(int64(scanStat.EndTime), 0)
+	}
+	return status
+}
+
+// StartScrub starts scrub of the pool
+func StartScrub(log *base.LogObject, pool string) (string, error) {
+	args := append(zpoolPath, "scrub", pool)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return string(stdoutStderr), err
+	}
+	return strings.TrimSpace(string(stdoutStderr)), nil
+}